@@ -122,13 +122,29 @@ type ClientInterface interface {
 
 	PutFindingsFindingID(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetIgnoreRules request
+	GetIgnoreRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutIgnoreRules request with any body
+	PutIgnoreRulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutIgnoreRules(ctx context.Context, body PutIgnoreRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProviderInstances request
+	GetProviderInstances(ctx context.Context, params *GetProviderInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetScanConfigs request
 	GetScanConfigs(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostScanConfigs request with any body
-	PostScanConfigsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostScanConfigsWithBody(ctx context.Context, params *PostScanConfigsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostScanConfigs(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostScanConfigs(ctx context.Context, params *PostScanConfigsParams, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostScanConfigsValidate request with any body
+	PostScanConfigsValidateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostScanConfigsValidate(ctx context.Context, body PostScanConfigsValidateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteScanConfigsScanConfigID request
 	DeleteScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -146,6 +162,9 @@ type ClientInterface interface {
 
 	PutScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostScanConfigsScanConfigIDRun request
+	PostScanConfigsScanConfigIDRun(ctx context.Context, scanConfigID ScanConfigID, params *PostScanConfigsScanConfigIDRunParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetScanResults request
 	GetScanResults(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -167,13 +186,22 @@ type ClientInterface interface {
 
 	PutScanResultsScanResultID(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetScanResultsScanResultIDDiff request
+	GetScanResultsScanResultIDDiff(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScanResultsScanResultIDLogs request
+	GetScanResultsScanResultIDLogs(ctx context.Context, scanResultID ScanResultID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScanResultsScanResultIDSbom request
+	GetScanResultsScanResultIDSbom(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetScans request
 	GetScans(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostScans request with any body
-	PostScansWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostScansWithBody(ctx context.Context, params *PostScansParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostScans(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostScans(ctx context.Context, params *PostScansParams, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteScansScanID request
 	DeleteScansScanID(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -191,6 +219,28 @@ type ClientInterface interface {
 
 	PutScansScanID(ctx context.Context, scanID ScanID, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostScansScanIDAbort request
+	PostScansScanIDAbort(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScansScanIDScanResults request
+	GetScansScanIDScanResults(ctx context.Context, scanID ScanID, params *GetScansScanIDScanResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSeverityOverrides request
+	GetSeverityOverrides(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutSeverityOverrides request with any body
+	PutSeverityOverridesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutSeverityOverrides(ctx context.Context, body PutSeverityOverridesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaggingRules request
+	GetTaggingRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutTaggingRules request with any body
+	PutTaggingRulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutTaggingRules(ctx context.Context, body PutTaggingRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTargets request
 	GetTargets(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -199,6 +249,14 @@ type ClientInterface interface {
 
 	PostTargets(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostTargetsImport request with any body
+	PostTargetsImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTargetsImport(ctx context.Context, body PostTargetsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTargetsUnscanned request
+	GetTargetsUnscanned(ctx context.Context, params *GetTargetsUnscannedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteTargetsTargetID request
 	DeleteTargetsTargetID(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -360,6 +418,54 @@ func (c *Client) PutFindingsFindingID(ctx context.Context, findingID FindingID,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetIgnoreRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIgnoreRulesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutIgnoreRulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutIgnoreRulesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutIgnoreRules(ctx context.Context, body PutIgnoreRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutIgnoreRulesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProviderInstances(ctx context.Context, params *GetProviderInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProviderInstancesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetScanConfigs(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetScanConfigsRequest(c.Server, params)
 	if err != nil {
@@ -372,8 +478,32 @@ func (c *Client) GetScanConfigs(ctx context.Context, params *GetScanConfigsParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScanConfigsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScanConfigsRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostScanConfigsWithBody(ctx context.Context, params *PostScanConfigsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostScanConfigs(ctx context.Context, params *PostScanConfigsParams, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostScanConfigsValidateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigsValidateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -384,8 +514,8 @@ func (c *Client) PostScanConfigsWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScanConfigs(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScanConfigsRequest(c.Server, body)
+func (c *Client) PostScanConfigsValidate(ctx context.Context, body PostScanConfigsValidateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigsValidateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -468,6 +598,18 @@ func (c *Client) PutScanConfigsScanConfigID(ctx context.Context, scanConfigID Sc
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostScanConfigsScanConfigIDRun(ctx context.Context, scanConfigID ScanConfigID, params *PostScanConfigsScanConfigIDRunParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigsScanConfigIDRunRequest(c.Server, scanConfigID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetScanResults(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetScanResultsRequest(c.Server, params)
 	if err != nil {
@@ -564,6 +706,42 @@ func (c *Client) PutScanResultsScanResultID(ctx context.Context, scanResultID Sc
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetScanResultsScanResultIDDiff(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsScanResultIDDiffRequest(c.Server, scanResultID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetScanResultsScanResultIDLogs(ctx context.Context, scanResultID ScanResultID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsScanResultIDLogsRequest(c.Server, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetScanResultsScanResultIDSbom(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsScanResultIDSbomRequest(c.Server, scanResultID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetScans(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetScansRequest(c.Server, params)
 	if err != nil {
@@ -576,8 +754,8 @@ func (c *Client) GetScans(ctx context.Context, params *GetScansParams, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScansWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScansRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostScansWithBody(ctx context.Context, params *PostScansParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScansRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -588,8 +766,8 @@ func (c *Client) PostScansWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScans(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScansRequest(c.Server, body)
+func (c *Client) PostScans(ctx context.Context, params *PostScansParams, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScansRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -672,6 +850,102 @@ func (c *Client) PutScansScanID(ctx context.Context, scanID ScanID, body PutScan
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostScansScanIDAbort(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScansScanIDAbortRequest(c.Server, scanID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetScansScanIDScanResults(ctx context.Context, scanID ScanID, params *GetScansScanIDScanResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScansScanIDScanResultsRequest(c.Server, scanID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSeverityOverrides(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSeverityOverridesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSeverityOverridesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSeverityOverridesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSeverityOverrides(ctx context.Context, body PutSeverityOverridesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSeverityOverridesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaggingRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaggingRulesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutTaggingRulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutTaggingRulesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutTaggingRules(ctx context.Context, body PutTaggingRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutTaggingRulesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetTargets(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetTargetsRequest(c.Server, params)
 	if err != nil {
@@ -708,6 +982,42 @@ func (c *Client) PostTargets(ctx context.Context, body PostTargetsJSONRequestBod
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostTargetsImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTargetsImportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTargetsImport(ctx context.Context, body PostTargetsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTargetsImportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTargetsUnscanned(ctx context.Context, params *GetTargetsUnscannedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTargetsUnscannedRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteTargetsTargetID(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteTargetsTargetIDRequest(c.Server, targetID)
 	if err != nil {
@@ -1280,8 +1590,8 @@ func NewPutFindingsFindingIDRequestWithBody(server string, findingID FindingID,
 	return req, nil
 }
 
-// NewGetScanConfigsRequest generates requests for GetScanConfigs
-func NewGetScanConfigsRequest(server string, params *GetScanConfigsParams) (*http.Request, error) {
+// NewGetIgnoreRulesRequest generates requests for GetIgnoreRules
+func NewGetIgnoreRulesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1289,7 +1599,7 @@ func NewGetScanConfigsRequest(server string, params *GetScanConfigsParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/scanConfigs")
+	operationPath := fmt.Sprintf("/ignoreRules")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1299,25 +1609,171 @@ func NewGetScanConfigsRequest(server string, params *GetScanConfigsParams) (*htt
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.Filter != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Select != nil {
+	return req, nil
+}
+
+// NewPutIgnoreRulesRequest calls the generic PutIgnoreRules builder with application/json body
+func NewPutIgnoreRulesRequest(server string, body PutIgnoreRulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutIgnoreRulesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutIgnoreRulesRequestWithBody generates requests for PutIgnoreRules with any type of body
+func NewPutIgnoreRulesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ignoreRules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProviderInstancesRequest generates requests for GetProviderInstances
+func NewGetProviderInstancesRequest(server string, params *GetProviderInstancesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/provider/instances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Location != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "location", runtime.ParamLocationQuery, *params.Location); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScanConfigsRequest generates requests for GetScanConfigs
+func NewGetScanConfigsRequest(server string, params *GetScanConfigsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
 			return nil, err
@@ -1424,18 +1880,18 @@ func NewGetScanConfigsRequest(server string, params *GetScanConfigsParams) (*htt
 }
 
 // NewPostScanConfigsRequest calls the generic PostScanConfigs builder with application/json body
-func NewPostScanConfigsRequest(server string, body PostScanConfigsJSONRequestBody) (*http.Request, error) {
+func NewPostScanConfigsRequest(server string, params *PostScanConfigsParams, body PostScanConfigsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostScanConfigsRequestWithBody(server, "application/json", bodyReader)
+	return NewPostScanConfigsRequestWithBody(server, params, "application/json", bodyReader)
 }
 
 // NewPostScanConfigsRequestWithBody generates requests for PostScanConfigs with any type of body
-func NewPostScanConfigsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+func NewPostScanConfigsRequestWithBody(server string, params *PostScanConfigsParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1460,6 +1916,57 @@ func NewPostScanConfigsRequestWithBody(server string, contentType string, body i
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewPostScanConfigsValidateRequest calls the generic PostScanConfigsValidate builder with application/json body
+func NewPostScanConfigsValidateRequest(server string, body PostScanConfigsValidateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostScanConfigsValidateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostScanConfigsValidateRequestWithBody generates requests for PostScanConfigsValidate with any type of body
+func NewPostScanConfigsValidateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
@@ -1661,6 +2168,60 @@ func NewPutScanConfigsScanConfigIDRequestWithBody(server string, scanConfigID Sc
 	return req, nil
 }
 
+// NewPostScanConfigsScanConfigIDRunRequest generates requests for PostScanConfigsScanConfigIDRun
+func NewPostScanConfigsScanConfigIDRunRequest(server string, scanConfigID ScanConfigID, params *PostScanConfigsScanConfigIDRunParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs/%s/run", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Force != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetScanResultsRequest generates requests for GetScanResults
 func NewGetScanResultsRequest(server string, params *GetScanResultsParams) (*http.Request, error) {
 	var err error
@@ -1904,6 +2465,22 @@ func NewGetScanResultsScanResultIDRequest(server string, scanResultID ScanResult
 
 	}
 
+	if params.Format != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
 	queryURL.RawQuery = queryValues.Encode()
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -2008,11 +2585,149 @@ func NewPutScanResultsScanResultIDRequestWithBody(server string, scanResultID Sc
 	return req, nil
 }
 
-// NewGetScansRequest generates requests for GetScans
-func NewGetScansRequest(server string, params *GetScansParams) (*http.Request, error) {
+// NewGetScanResultsScanResultIDDiffRequest generates requests for GetScanResultsScanResultIDDiff
+func NewGetScanResultsScanResultIDDiffRequest(server string, scanResultID ScanResultID, params *GetScanResultsScanResultIDDiffParams) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/diff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "against", runtime.ParamLocationQuery, params.Against); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScanResultsScanResultIDLogsRequest generates requests for GetScanResultsScanResultIDLogs
+func NewGetScanResultsScanResultIDLogsRequest(server string, scanResultID ScanResultID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScanResultsScanResultIDSbomRequest generates requests for GetScanResultsScanResultIDSbom
+func NewGetScanResultsScanResultIDSbomRequest(server string, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/sbom", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Format != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScansRequest generates requests for GetScans
+func NewGetScansRequest(server string, params *GetScansParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -2152,18 +2867,18 @@ func NewGetScansRequest(server string, params *GetScansParams) (*http.Request, e
 }
 
 // NewPostScansRequest calls the generic PostScans builder with application/json body
-func NewPostScansRequest(server string, body PostScansJSONRequestBody) (*http.Request, error) {
+func NewPostScansRequest(server string, params *PostScansParams, body PostScansJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostScansRequestWithBody(server, "application/json", bodyReader)
+	return NewPostScansRequestWithBody(server, params, "application/json", bodyReader)
 }
 
 // NewPostScansRequestWithBody generates requests for PostScans with any type of body
-func NewPostScansRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+func NewPostScansRequestWithBody(server string, params *PostScansParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2181,6 +2896,26 @@ func NewPostScansRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.DryRun != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -2188,6 +2923,17 @@ func NewPostScansRequestWithBody(server string, contentType string, body io.Read
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
+	}
+
 	return req, nil
 }
 
@@ -2389,16 +3135,57 @@ func NewPutScansScanIDRequestWithBody(server string, scanID ScanID, contentType
 	return req, nil
 }
 
-// NewGetTargetsRequest generates requests for GetTargets
-func NewGetTargetsRequest(server string, params *GetTargetsParams) (*http.Request, error) {
+// NewPostScansScanIDAbortRequest generates requests for PostScansScanIDAbort
+func NewPostScansScanIDAbortRequest(server string, scanID ScanID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets")
+	operationPath := fmt.Sprintf("/scans/%s/abort", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScansScanIDScanResultsRequest generates requests for GetScansScanIDScanResults
+func NewGetScansScanIDScanResultsRequest(server string, scanID ScanID, params *GetScansScanIDScanResultsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scans/%s/scanResults", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2532,19 +3319,46 @@ func NewGetTargetsRequest(server string, params *GetTargetsParams) (*http.Reques
 	return req, nil
 }
 
-// NewPostTargetsRequest calls the generic PostTargets builder with application/json body
-func NewPostTargetsRequest(server string, body PostTargetsJSONRequestBody) (*http.Request, error) {
+// NewGetSeverityOverridesRequest generates requests for GetSeverityOverrides
+func NewGetSeverityOverridesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/severityOverrides")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutSeverityOverridesRequest calls the generic PutSeverityOverrides builder with application/json body
+func NewPutSeverityOverridesRequest(server string, body PutSeverityOverridesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostTargetsRequestWithBody(server, "application/json", bodyReader)
+	return NewPutSeverityOverridesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostTargetsRequestWithBody generates requests for PostTargets with any type of body
-func NewPostTargetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutSeverityOverridesRequestWithBody generates requests for PutSeverityOverrides with any type of body
+func NewPutSeverityOverridesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2552,7 +3366,7 @@ func NewPostTargetsRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets")
+	operationPath := fmt.Sprintf("/severityOverrides")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2562,7 +3376,7 @@ func NewPostTargetsRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2572,23 +3386,16 @@ func NewPostTargetsRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
-// NewDeleteTargetsTargetIDRequest generates requests for DeleteTargetsTargetID
-func NewDeleteTargetsTargetIDRequest(server string, targetID TargetID) (*http.Request, error) {
+// NewGetTaggingRulesRequest generates requests for GetTaggingRules
+func NewGetTaggingRulesRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/taggingRules")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2598,7 +3405,7 @@ func NewDeleteTargetsTargetIDRequest(server string, targetID TargetID) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2606,23 +3413,27 @@ func NewDeleteTargetsTargetIDRequest(server string, targetID TargetID) (*http.Re
 	return req, nil
 }
 
-// NewGetTargetsTargetIDRequest generates requests for GetTargetsTargetID
-func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetTargetsTargetIDParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+// NewPutTaggingRulesRequest calls the generic PutTaggingRules builder with application/json body
+func NewPutTaggingRulesRequest(server string, body PutTaggingRulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutTaggingRulesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutTaggingRulesRequestWithBody generates requests for PutTaggingRules with any type of body
+func NewPutTaggingRulesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/taggingRules")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2632,15 +3443,108 @@ func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetT
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	if params.Select != nil {
+	req.Header.Add("Content-Type", contentType)
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
+	return req, nil
+}
+
+// NewGetTargetsRequest generates requests for GetTargets
+func NewGetTargetsRequest(server string, params *GetTargetsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/targets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
 			for k, v := range parsed {
 				for _, v2 := range v {
 					queryValues.Add(k, v2)
@@ -2666,6 +3570,22 @@ func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetT
 
 	}
 
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
 	queryURL.RawQuery = queryValues.Encode()
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -2676,34 +3596,27 @@ func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetT
 	return req, nil
 }
 
-// NewPatchTargetsTargetIDRequest calls the generic PatchTargetsTargetID builder with application/json body
-func NewPatchTargetsTargetIDRequest(server string, targetID TargetID, body PatchTargetsTargetIDJSONRequestBody) (*http.Request, error) {
+// NewPostTargetsRequest calls the generic PostTargets builder with application/json body
+func NewPostTargetsRequest(server string, body PostTargetsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchTargetsTargetIDRequestWithBody(server, targetID, "application/json", bodyReader)
+	return NewPostTargetsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPatchTargetsTargetIDRequestWithBody generates requests for PatchTargetsTargetID with any type of body
-func NewPatchTargetsTargetIDRequestWithBody(server string, targetID TargetID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostTargetsRequestWithBody generates requests for PostTargets with any type of body
+func NewPostTargetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/targets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2713,7 +3626,7 @@ func NewPatchTargetsTargetIDRequestWithBody(server string, targetID TargetID, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2723,34 +3636,27 @@ func NewPatchTargetsTargetIDRequestWithBody(server string, targetID TargetID, co
 	return req, nil
 }
 
-// NewPutTargetsTargetIDRequest calls the generic PutTargetsTargetID builder with application/json body
-func NewPutTargetsTargetIDRequest(server string, targetID TargetID, body PutTargetsTargetIDJSONRequestBody) (*http.Request, error) {
+// NewPostTargetsImportRequest calls the generic PostTargetsImport builder with application/json body
+func NewPostTargetsImportRequest(server string, body PostTargetsImportJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutTargetsTargetIDRequestWithBody(server, targetID, "application/json", bodyReader)
+	return NewPostTargetsImportRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPutTargetsTargetIDRequestWithBody generates requests for PutTargetsTargetID with any type of body
-func NewPutTargetsTargetIDRequestWithBody(server string, targetID TargetID, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostTargetsImportRequestWithBody generates requests for PostTargetsImport with any type of body
+func NewPostTargetsImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/targets/import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2760,7 +3666,7 @@ func NewPutTargetsTargetIDRequestWithBody(server string, targetID TargetID, cont
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2770,184 +3676,1425 @@ func NewPutTargetsTargetIDRequestWithBody(server string, targetID TargetID, cont
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewGetTargetsUnscannedRequest generates requests for GetTargetsUnscanned
+func NewGetTargetsUnscannedRequest(server string, params *GetTargetsUnscannedParams) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/targets/unscanned")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetDiscoveryScopes request
-	GetDiscoveryScopesWithResponse(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*GetDiscoveryScopesResponse, error)
 
-	// PutDiscoveryScopes request with any body
-	PutDiscoveryScopesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PutDiscoveryScopesWithResponse(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error)
+	queryValues := queryURL.Query()
 
-	// GetFindings request
-	GetFindingsWithResponse(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*GetFindingsResponse, error)
+	if params.Filter != nil {
 
-	// PostFindings request with any body
-	PostFindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PostFindingsWithResponse(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error)
+	}
 
-	// DeleteFindingsFindingID request
-	DeleteFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*DeleteFindingsFindingIDResponse, error)
+	if params.Select != nil {
 
-	// GetFindingsFindingID request
-	GetFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*GetFindingsFindingIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// PatchFindingsFindingID request with any body
-	PatchFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error)
+	}
 
-	PatchFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error)
+	if params.Count != nil {
 
-	// PutFindingsFindingID request with any body
-	PutFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PutFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error)
+	}
 
-	// GetScanConfigs request
-	GetScanConfigsWithResponse(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsResponse, error)
+	if params.Top != nil {
 
-	// PostScanConfigs request with any body
-	PostScanConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTargetsTargetIDRequest generates requests for DeleteTargetsTargetID
+func NewDeleteTargetsTargetIDRequest(server string, targetID TargetID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTargetsTargetIDRequest generates requests for GetTargetsTargetID
+func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetTargetsTargetIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchTargetsTargetIDRequest calls the generic PatchTargetsTargetID builder with application/json body
+func NewPatchTargetsTargetIDRequest(server string, targetID TargetID, body PatchTargetsTargetIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchTargetsTargetIDRequestWithBody(server, targetID, "application/json", bodyReader)
+}
+
+// NewPatchTargetsTargetIDRequestWithBody generates requests for PatchTargetsTargetID with any type of body
+func NewPatchTargetsTargetIDRequestWithBody(server string, targetID TargetID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutTargetsTargetIDRequest calls the generic PutTargetsTargetID builder with application/json body
+func NewPutTargetsTargetIDRequest(server string, targetID TargetID, body PutTargetsTargetIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutTargetsTargetIDRequestWithBody(server, targetID, "application/json", bodyReader)
+}
+
+// NewPutTargetsTargetIDRequestWithBody generates requests for PutTargetsTargetID with any type of body
+func NewPutTargetsTargetIDRequestWithBody(server string, targetID TargetID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetDiscoveryScopes request
+	GetDiscoveryScopesWithResponse(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*GetDiscoveryScopesResponse, error)
+
+	// PutDiscoveryScopes request with any body
+	PutDiscoveryScopesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error)
+
+	PutDiscoveryScopesWithResponse(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error)
+
+	// GetFindings request
+	GetFindingsWithResponse(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*GetFindingsResponse, error)
+
+	// PostFindings request with any body
+	PostFindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error)
+
+	PostFindingsWithResponse(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error)
+
+	// DeleteFindingsFindingID request
+	DeleteFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*DeleteFindingsFindingIDResponse, error)
+
+	// GetFindingsFindingID request
+	GetFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*GetFindingsFindingIDResponse, error)
+
+	// PatchFindingsFindingID request with any body
+	PatchFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error)
+
+	PatchFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error)
+
+	// PutFindingsFindingID request with any body
+	PutFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error)
+
+	PutFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error)
+
+	// GetIgnoreRules request
+	GetIgnoreRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIgnoreRulesResponse, error)
+
+	// PutIgnoreRules request with any body
+	PutIgnoreRulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutIgnoreRulesResponse, error)
+
+	PutIgnoreRulesWithResponse(ctx context.Context, body PutIgnoreRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutIgnoreRulesResponse, error)
+
+	// GetProviderInstances request
+	GetProviderInstancesWithResponse(ctx context.Context, params *GetProviderInstancesParams, reqEditors ...RequestEditorFn) (*GetProviderInstancesResponse, error)
+
+	// GetScanConfigs request
+	GetScanConfigsWithResponse(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsResponse, error)
+
+	// PostScanConfigs request with any body
+	PostScanConfigsWithBodyWithResponse(ctx context.Context, params *PostScanConfigsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error)
+
+	PostScanConfigsWithResponse(ctx context.Context, params *PostScanConfigsParams, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error)
+
+	// PostScanConfigsValidate request with any body
+	PostScanConfigsValidateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsValidateResponse, error)
+
+	PostScanConfigsValidateWithResponse(ctx context.Context, body PostScanConfigsValidateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsValidateResponse, error)
+
+	// DeleteScanConfigsScanConfigID request
+	DeleteScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*DeleteScanConfigsScanConfigIDResponse, error)
+
+	// GetScanConfigsScanConfigID request
+	GetScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDResponse, error)
+
+	// PatchScanConfigsScanConfigID request with any body
+	PatchScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error)
+
+	PatchScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error)
+
+	// PutScanConfigsScanConfigID request with any body
+	PutScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error)
+
+	PutScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error)
+
+	// PostScanConfigsScanConfigIDRun request
+	PostScanConfigsScanConfigIDRunWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PostScanConfigsScanConfigIDRunParams, reqEditors ...RequestEditorFn) (*PostScanConfigsScanConfigIDRunResponse, error)
+
+	// GetScanResults request
+	GetScanResultsWithResponse(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*GetScanResultsResponse, error)
+
+	// PostScanResults request with any body
+	PostScanResultsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error)
+
+	PostScanResultsWithResponse(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error)
+
+	// GetScanResultsScanResultID request
+	GetScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDResponse, error)
+
+	// PatchScanResultsScanResultID request with any body
+	PatchScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error)
+
+	PatchScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error)
+
+	// PutScanResultsScanResultID request with any body
+	PutScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error)
+
+	PutScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error)
+
+	// GetScanResultsScanResultIDDiff request
+	GetScanResultsScanResultIDDiffWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDDiffParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDDiffResponse, error)
+
+	// GetScanResultsScanResultIDLogs request
+	GetScanResultsScanResultIDLogsWithResponse(ctx context.Context, scanResultID ScanResultID, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDLogsResponse, error)
+
+	// GetScanResultsScanResultIDSbom request
+	GetScanResultsScanResultIDSbomWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDSbomResponse, error)
+
+	// GetScans request
+	GetScansWithResponse(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*GetScansResponse, error)
+
+	// PostScans request with any body
+	PostScansWithBodyWithResponse(ctx context.Context, params *PostScansParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScansResponse, error)
+
+	PostScansWithResponse(ctx context.Context, params *PostScansParams, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScansResponse, error)
+
+	// DeleteScansScanID request
+	DeleteScansScanIDWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*DeleteScansScanIDResponse, error)
+
+	// GetScansScanID request
+	GetScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*GetScansScanIDResponse, error)
+
+	// PatchScansScanID request with any body
+	PatchScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error)
+
+	PatchScansScanIDWithResponse(ctx context.Context, scanID ScanID, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error)
+
+	// PutScansScanID request with any body
+	PutScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error)
+
+	PutScansScanIDWithResponse(ctx context.Context, scanID ScanID, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error)
+
+	// PostScansScanIDAbort request
+	PostScansScanIDAbortWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*PostScansScanIDAbortResponse, error)
+
+	// GetScansScanIDScanResults request
+	GetScansScanIDScanResultsWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDScanResultsParams, reqEditors ...RequestEditorFn) (*GetScansScanIDScanResultsResponse, error)
+
+	// GetSeverityOverrides request
+	GetSeverityOverridesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSeverityOverridesResponse, error)
+
+	// PutSeverityOverrides request with any body
+	PutSeverityOverridesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSeverityOverridesResponse, error)
+
+	PutSeverityOverridesWithResponse(ctx context.Context, body PutSeverityOverridesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSeverityOverridesResponse, error)
+
+	// GetTaggingRules request
+	GetTaggingRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaggingRulesResponse, error)
+
+	// PutTaggingRules request with any body
+	PutTaggingRulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTaggingRulesResponse, error)
+
+	PutTaggingRulesWithResponse(ctx context.Context, body PutTaggingRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTaggingRulesResponse, error)
+
+	// GetTargets request
+	GetTargetsWithResponse(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*GetTargetsResponse, error)
+
+	// PostTargets request with any body
+	PostTargetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error)
+
+	PostTargetsWithResponse(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error)
+
+	// PostTargetsImport request with any body
+	PostTargetsImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTargetsImportResponse, error)
+
+	PostTargetsImportWithResponse(ctx context.Context, body PostTargetsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTargetsImportResponse, error)
+
+	// GetTargetsUnscanned request
+	GetTargetsUnscannedWithResponse(ctx context.Context, params *GetTargetsUnscannedParams, reqEditors ...RequestEditorFn) (*GetTargetsUnscannedResponse, error)
+
+	// DeleteTargetsTargetID request
+	DeleteTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*DeleteTargetsTargetIDResponse, error)
+
+	// GetTargetsTargetID request
+	GetTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *GetTargetsTargetIDParams, reqEditors ...RequestEditorFn) (*GetTargetsTargetIDResponse, error)
+
+	// PatchTargetsTargetID request with any body
+	PatchTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error)
+
+	PatchTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, body PatchTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error)
+
+	// PutTargetsTargetID request with any body
+	PutTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error)
+
+	PutTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, body PutTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error)
+}
+
+type GetDiscoveryScopesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scopes
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDiscoveryScopesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDiscoveryScopesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutDiscoveryScopesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scopes
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutDiscoveryScopesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutDiscoveryScopesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Findings
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Finding
+	JSON400      *ApiResponse
+	JSON409      *FindingExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFindingsFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFindingsFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFindingsFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFindingsFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Finding
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFindingsFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFindingsFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchFindingsFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Finding
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchFindingsFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchFindingsFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutFindingsFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Finding
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutFindingsFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutFindingsFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIgnoreRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IgnoreRules
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIgnoreRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIgnoreRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutIgnoreRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IgnoreRules
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutIgnoreRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutIgnoreRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProviderInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProviderInstances
+	JSON400      *ApiResponse
+	JSON503      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProviderInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProviderInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfigs
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfig
+	JSON201      *ScanConfig
+	JSON400      *ApiResponse
+	JSON409      *ScanConfigExists
+	JSON422      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScanConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanConfigsValidateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfigValidation
+	JSON503      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScanConfigsValidateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanConfigsValidateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteScanConfigsScanConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteScanConfigsScanConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteScanConfigsScanConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanConfigsScanConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfig
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanConfigsScanConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanConfigsScanConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchScanConfigsScanConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfig
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanConfigExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchScanConfigsScanConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchScanConfigsScanConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutScanConfigsScanConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfig
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanConfigExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutScanConfigsScanConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScanConfigsScanConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanConfigsScanConfigIDRunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ScanConfigRun
+	JSON404      *ApiResponse
+	JSON409      *ScanExists
+	JSON503      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScanConfigsScanConfigIDRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanConfigsScanConfigIDRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanResultsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TargetScanResults
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanResultsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanResultsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TargetScanResult
+	JSON400      *ApiResponse
+	JSON409      *TargetScanResultExists
+	JSONDefault  *ApiResponse
+}
 
-	PostScanConfigsWithResponse(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error)
+// Status returns HTTPResponse.Status
+func (r PostScanResultsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteScanConfigsScanConfigID request
-	DeleteScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*DeleteScanConfigsScanConfigIDResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanResultsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetScanConfigsScanConfigID request
-	GetScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDResponse, error)
+type GetScanResultsScanResultIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TargetScanResult
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
 
-	// PatchScanConfigsScanConfigID request with any body
-	PatchScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	PatchScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// PutScanConfigsScanConfigID request with any body
-	PutScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error)
+type PatchScanResultsScanResultIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TargetScanResult
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *TargetScanResultExists
+	JSONDefault  *ApiResponse
+}
 
-	PutScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error)
+// Status returns HTTPResponse.Status
+func (r PatchScanResultsScanResultIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetScanResults request
-	GetScanResultsWithResponse(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*GetScanResultsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchScanResultsScanResultIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// PostScanResults request with any body
-	PostScanResultsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error)
+type PutScanResultsScanResultIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TargetScanResult
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *TargetScanResultExists
+	JSONDefault  *ApiResponse
+}
 
-	PostScanResultsWithResponse(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error)
+// Status returns HTTPResponse.Status
+func (r PutScanResultsScanResultIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetScanResultsScanResultID request
-	GetScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScanResultsScanResultIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// PatchScanResultsScanResultID request with any body
-	PatchScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error)
+type GetScanResultsScanResultIDDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanResultDiff
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
 
-	PatchScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// PutScanResultsScanResultID request with any body
-	PutScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	PutScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error)
+type GetScanResultsScanResultIDLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScannerLogs
+	JSON404      *ApiResponse
+	JSON501      *ApiResponse
+	JSON503      *ApiResponse
+	JSONDefault  *ApiResponse
+}
 
-	// GetScans request
-	GetScansWithResponse(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*GetScansResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// PostScans request with any body
-	PostScansWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScansResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	PostScansWithResponse(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScansResponse, error)
+type GetScanResultsScanResultIDSbomResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
 
-	// DeleteScansScanID request
-	DeleteScansScanIDWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*DeleteScansScanIDResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDSbomResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetScansScanID request
-	GetScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*GetScansScanIDResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDSbomResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// PatchScansScanID request with any body
-	PatchScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error)
+type GetScansResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scans
+	JSONDefault  *ApiResponse
+}
 
-	PatchScansScanIDWithResponse(ctx context.Context, scanID ScanID, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetScansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// PutScansScanID request with any body
-	PutScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	PutScansScanIDWithResponse(ctx context.Context, scanID ScanID, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error)
+type PostScansResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		union json.RawMessage
+	}
+	JSON201     *Scan
+	JSON400     *ApiResponse
+	JSON409     *ScanExists
+	JSON422     *ApiResponse
+	JSONDefault *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteScansScanIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSON409      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteScansScanIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteScansScanIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScansScanIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scan
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScansScanIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScansScanIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetTargets request
-	GetTargetsWithResponse(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*GetTargetsResponse, error)
+type PatchScansScanIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scan
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanExists
+	JSONDefault  *ApiResponse
+}
 
-	// PostTargets request with any body
-	PostTargetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error)
+// Status returns HTTPResponse.Status
+func (r PatchScansScanIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	PostTargetsWithResponse(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchScansScanIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeleteTargetsTargetID request
-	DeleteTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*DeleteTargetsTargetIDResponse, error)
+type PutScansScanIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scan
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanExists
+	JSONDefault  *ApiResponse
+}
 
-	// GetTargetsTargetID request
-	GetTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *GetTargetsTargetIDParams, reqEditors ...RequestEditorFn) (*GetTargetsTargetIDResponse, error)
+// Status returns HTTPResponse.Status
+func (r PutScansScanIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// PatchTargetsTargetID request with any body
-	PatchTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScansScanIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	PatchTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, body PatchTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error)
+type PostScansScanIDAbortResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanAbort
+	JSON404      *ApiResponse
+	JSON409      *ApiResponse
+	JSONDefault  *ApiResponse
+}
 
-	// PutTargetsTargetID request with any body
-	PutTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error)
+// Status returns HTTPResponse.Status
+func (r PostScansScanIDAbortResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	PutTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, body PutTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScansScanIDAbortResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetDiscoveryScopesResponse struct {
+type GetScansScanIDScanResultsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Scopes
+	JSON200      *TargetScanResults
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDiscoveryScopesResponse) Status() string {
+func (r GetScansScanIDScanResultsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2955,22 +5102,22 @@ func (r GetDiscoveryScopesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDiscoveryScopesResponse) StatusCode() int {
+func (r GetScansScanIDScanResultsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PutDiscoveryScopesResponse struct {
+type GetSeverityOverridesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Scopes
+	JSON200      *SeverityOverrides
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r PutDiscoveryScopesResponse) Status() string {
+func (r GetSeverityOverridesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2978,22 +5125,23 @@ func (r PutDiscoveryScopesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutDiscoveryScopesResponse) StatusCode() int {
+func (r GetSeverityOverridesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetFindingsResponse struct {
+type PutSeverityOverridesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Findings
+	JSON200      *SeverityOverrides
+	JSON400      *ApiResponse
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetFindingsResponse) Status() string {
+func (r PutSeverityOverridesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3001,24 +5149,22 @@ func (r GetFindingsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetFindingsResponse) StatusCode() int {
+func (r PutSeverityOverridesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostFindingsResponse struct {
+type GetTaggingRulesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Finding
-	JSON400      *ApiResponse
-	JSON409      *FindingExists
+	JSON200      *TaggingRules
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r PostFindingsResponse) Status() string {
+func (r GetTaggingRulesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3026,23 +5172,23 @@ func (r PostFindingsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostFindingsResponse) StatusCode() int {
+func (r GetTaggingRulesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteFindingsFindingIDResponse struct {
+type PutTaggingRulesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ApiResponse
+	JSON200      *TaggingRules
+	JSON400      *ApiResponse
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteFindingsFindingIDResponse) Status() string {
+func (r PutTaggingRulesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3050,23 +5196,22 @@ func (r DeleteFindingsFindingIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFindingsFindingIDResponse) StatusCode() int {
+func (r PutTaggingRulesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetFindingsFindingIDResponse struct {
+type GetTargetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Finding
-	JSON404      *ApiResponse
+	JSON200      *Targets
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetFindingsFindingIDResponse) Status() string {
+func (r GetTargetsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3074,24 +5219,24 @@ func (r GetFindingsFindingIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetFindingsFindingIDResponse) StatusCode() int {
+func (r GetTargetsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PatchFindingsFindingIDResponse struct {
+type PostTargetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Finding
+	JSON201      *Target
 	JSON400      *ApiResponse
-	JSON404      *ApiResponse
+	JSON409      *TargetExists
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchFindingsFindingIDResponse) Status() string {
+func (r PostTargetsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3099,24 +5244,23 @@ func (r PatchFindingsFindingIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchFindingsFindingIDResponse) StatusCode() int {
+func (r PostTargetsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PutFindingsFindingIDResponse struct {
+type PostTargetsImportResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Finding
+	JSON200      *TargetImportReport
 	JSON400      *ApiResponse
-	JSON404      *ApiResponse
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r PutFindingsFindingIDResponse) Status() string {
+func (r PostTargetsImportResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3124,22 +5268,23 @@ func (r PutFindingsFindingIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutFindingsFindingIDResponse) StatusCode() int {
+func (r PostTargetsImportResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetScanConfigsResponse struct {
+type GetTargetsUnscannedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ScanConfigs
+	JSON200      *Targets
+	JSON400      *ApiResponse
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetScanConfigsResponse) Status() string {
+func (r GetTargetsUnscannedResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3147,24 +5292,24 @@ func (r GetScanConfigsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetScanConfigsResponse) StatusCode() int {
+func (r GetTargetsUnscannedResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostScanConfigsResponse struct {
+type DeleteTargetsTargetIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *ScanConfig
+	JSON200      *SuccessResponse
 	JSON400      *ApiResponse
-	JSON409      *ScanConfigExists
+	JSON404      *ApiResponse
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r PostScanConfigsResponse) Status() string {
+func (r DeleteTargetsTargetIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3172,23 +5317,23 @@ func (r PostScanConfigsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostScanConfigsResponse) StatusCode() int {
+func (r DeleteTargetsTargetIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteScanConfigsScanConfigIDResponse struct {
+type GetTargetsTargetIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
+	JSON200      *Target
 	JSON404      *ApiResponse
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteScanConfigsScanConfigIDResponse) Status() string {
+func (r GetTargetsTargetIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3196,23 +5341,24 @@ func (r DeleteScanConfigsScanConfigIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteScanConfigsScanConfigIDResponse) StatusCode() int {
+func (r GetTargetsTargetIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetScanConfigsScanConfigIDResponse struct {
+type PatchTargetsTargetIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ScanConfig
+	JSON200      *Target
 	JSON404      *ApiResponse
+	JSON409      *TargetExists
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetScanConfigsScanConfigIDResponse) Status() string {
+func (r PatchTargetsTargetIDResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3220,913 +5366,1272 @@ func (r GetScanConfigsScanConfigIDResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetScanConfigsScanConfigIDResponse) StatusCode() int {
+func (r PatchTargetsTargetIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PatchScanConfigsScanConfigIDResponse struct {
+type PutTargetsTargetIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ScanConfig
+	JSON200      *Target
 	JSON400      *ApiResponse
 	JSON404      *ApiResponse
-	JSON409      *ScanConfigExists
+	JSON409      *TargetExists
 	JSONDefault  *ApiResponse
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchScanConfigsScanConfigIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Status returns HTTPResponse.Status
+func (r PutTargetsTargetIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutTargetsTargetIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetDiscoveryScopesWithResponse request returning *GetDiscoveryScopesResponse
+func (c *ClientWithResponses) GetDiscoveryScopesWithResponse(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*GetDiscoveryScopesResponse, error) {
+	rsp, err := c.GetDiscoveryScopes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDiscoveryScopesResponse(rsp)
+}
+
+// PutDiscoveryScopesWithBodyWithResponse request with arbitrary body returning *PutDiscoveryScopesResponse
+func (c *ClientWithResponses) PutDiscoveryScopesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error) {
+	rsp, err := c.PutDiscoveryScopesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutDiscoveryScopesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutDiscoveryScopesWithResponse(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error) {
+	rsp, err := c.PutDiscoveryScopes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutDiscoveryScopesResponse(rsp)
+}
+
+// GetFindingsWithResponse request returning *GetFindingsResponse
+func (c *ClientWithResponses) GetFindingsWithResponse(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*GetFindingsResponse, error) {
+	rsp, err := c.GetFindings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFindingsResponse(rsp)
+}
+
+// PostFindingsWithBodyWithResponse request with arbitrary body returning *PostFindingsResponse
+func (c *ClientWithResponses) PostFindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error) {
+	rsp, err := c.PostFindingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFindingsWithResponse(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error) {
+	rsp, err := c.PostFindings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingsResponse(rsp)
+}
+
+// DeleteFindingsFindingIDWithResponse request returning *DeleteFindingsFindingIDResponse
+func (c *ClientWithResponses) DeleteFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*DeleteFindingsFindingIDResponse, error) {
+	rsp, err := c.DeleteFindingsFindingID(ctx, findingID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFindingsFindingIDResponse(rsp)
+}
+
+// GetFindingsFindingIDWithResponse request returning *GetFindingsFindingIDResponse
+func (c *ClientWithResponses) GetFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*GetFindingsFindingIDResponse, error) {
+	rsp, err := c.GetFindingsFindingID(ctx, findingID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFindingsFindingIDResponse(rsp)
+}
+
+// PatchFindingsFindingIDWithBodyWithResponse request with arbitrary body returning *PatchFindingsFindingIDResponse
+func (c *ClientWithResponses) PatchFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error) {
+	rsp, err := c.PatchFindingsFindingIDWithBody(ctx, findingID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePatchFindingsFindingIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchScanConfigsScanConfigIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PatchFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error) {
+	rsp, err := c.PatchFindingsFindingID(ctx, findingID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePatchFindingsFindingIDResponse(rsp)
 }
 
-type PutScanConfigsScanConfigIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScanConfig
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *ScanConfigExists
-	JSONDefault  *ApiResponse
+// PutFindingsFindingIDWithBodyWithResponse request with arbitrary body returning *PutFindingsFindingIDResponse
+func (c *ClientWithResponses) PutFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error) {
+	rsp, err := c.PutFindingsFindingIDWithBody(ctx, findingID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutFindingsFindingIDResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PutScanConfigsScanConfigIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PutFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error) {
+	rsp, err := c.PutFindingsFindingID(ctx, findingID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePutFindingsFindingIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutScanConfigsScanConfigIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetIgnoreRulesWithResponse request returning *GetIgnoreRulesResponse
+func (c *ClientWithResponses) GetIgnoreRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIgnoreRulesResponse, error) {
+	rsp, err := c.GetIgnoreRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetIgnoreRulesResponse(rsp)
 }
 
-type GetScanResultsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetScanResults
-	JSONDefault  *ApiResponse
+// PutIgnoreRulesWithBodyWithResponse request with arbitrary body returning *PutIgnoreRulesResponse
+func (c *ClientWithResponses) PutIgnoreRulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutIgnoreRulesResponse, error) {
+	rsp, err := c.PutIgnoreRulesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutIgnoreRulesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetScanResultsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PutIgnoreRulesWithResponse(ctx context.Context, body PutIgnoreRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutIgnoreRulesResponse, error) {
+	rsp, err := c.PutIgnoreRules(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePutIgnoreRulesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScanResultsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetProviderInstancesWithResponse request returning *GetProviderInstancesResponse
+func (c *ClientWithResponses) GetProviderInstancesWithResponse(ctx context.Context, params *GetProviderInstancesParams, reqEditors ...RequestEditorFn) (*GetProviderInstancesResponse, error) {
+	rsp, err := c.GetProviderInstances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetProviderInstancesResponse(rsp)
 }
 
-type PostScanResultsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TargetScanResult
-	JSON400      *ApiResponse
-	JSON409      *TargetScanResultExists
-	JSONDefault  *ApiResponse
+// GetScanConfigsWithResponse request returning *GetScanConfigsResponse
+func (c *ClientWithResponses) GetScanConfigsWithResponse(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsResponse, error) {
+	rsp, err := c.GetScanConfigs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanConfigsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostScanResultsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostScanConfigsWithBodyWithResponse request with arbitrary body returning *PostScanConfigsResponse
+func (c *ClientWithResponses) PostScanConfigsWithBodyWithResponse(ctx context.Context, params *PostScanConfigsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error) {
+	rsp, err := c.PostScanConfigsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostScanConfigsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostScanResultsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostScanConfigsWithResponse(ctx context.Context, params *PostScanConfigsParams, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error) {
+	rsp, err := c.PostScanConfigs(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostScanConfigsResponse(rsp)
 }
 
-type GetScanResultsScanResultIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetScanResult
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
+// PostScanConfigsValidateWithBodyWithResponse request with arbitrary body returning *PostScanConfigsValidateResponse
+func (c *ClientWithResponses) PostScanConfigsValidateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsValidateResponse, error) {
+	rsp, err := c.PostScanConfigsValidateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanConfigsValidateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetScanResultsScanResultIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostScanConfigsValidateWithResponse(ctx context.Context, body PostScanConfigsValidateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsValidateResponse, error) {
+	rsp, err := c.PostScanConfigsValidate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostScanConfigsValidateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScanResultsScanResultIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteScanConfigsScanConfigIDWithResponse request returning *DeleteScanConfigsScanConfigIDResponse
+func (c *ClientWithResponses) DeleteScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*DeleteScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.DeleteScanConfigsScanConfigID(ctx, scanConfigID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteScanConfigsScanConfigIDResponse(rsp)
 }
 
-type PatchScanResultsScanResultIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetScanResult
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *TargetScanResultExists
-	JSONDefault  *ApiResponse
+// GetScanConfigsScanConfigIDWithResponse request returning *GetScanConfigsScanConfigIDResponse
+func (c *ClientWithResponses) GetScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.GetScanConfigsScanConfigID(ctx, scanConfigID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanConfigsScanConfigIDResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchScanResultsScanResultIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PatchScanConfigsScanConfigIDWithBodyWithResponse request with arbitrary body returning *PatchScanConfigsScanConfigIDResponse
+func (c *ClientWithResponses) PatchScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.PatchScanConfigsScanConfigIDWithBody(ctx, scanConfigID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePatchScanConfigsScanConfigIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchScanResultsScanResultIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PatchScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.PatchScanConfigsScanConfigID(ctx, scanConfigID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePatchScanConfigsScanConfigIDResponse(rsp)
 }
 
-type PutScanResultsScanResultIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetScanResult
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *TargetScanResultExists
-	JSONDefault  *ApiResponse
+// PutScanConfigsScanConfigIDWithBodyWithResponse request with arbitrary body returning *PutScanConfigsScanConfigIDResponse
+func (c *ClientWithResponses) PutScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.PutScanConfigsScanConfigIDWithBody(ctx, scanConfigID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanConfigsScanConfigIDResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PutScanResultsScanResultIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PutScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.PutScanConfigsScanConfigID(ctx, scanConfigID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePutScanConfigsScanConfigIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutScanResultsScanResultIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostScanConfigsScanConfigIDRunWithResponse request returning *PostScanConfigsScanConfigIDRunResponse
+func (c *ClientWithResponses) PostScanConfigsScanConfigIDRunWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PostScanConfigsScanConfigIDRunParams, reqEditors ...RequestEditorFn) (*PostScanConfigsScanConfigIDRunResponse, error) {
+	rsp, err := c.PostScanConfigsScanConfigIDRun(ctx, scanConfigID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostScanConfigsScanConfigIDRunResponse(rsp)
 }
 
-type GetScansResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scans
-	JSONDefault  *ApiResponse
+// GetScanResultsWithResponse request returning *GetScanResultsResponse
+func (c *ClientWithResponses) GetScanResultsWithResponse(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*GetScanResultsResponse, error) {
+	rsp, err := c.GetScanResults(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetScansResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostScanResultsWithBodyWithResponse request with arbitrary body returning *PostScanResultsResponse
+func (c *ClientWithResponses) PostScanResultsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error) {
+	rsp, err := c.PostScanResultsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostScanResultsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScansResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostScanResultsWithResponse(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error) {
+	rsp, err := c.PostScanResults(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostScanResultsResponse(rsp)
 }
 
-type PostScansResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Scan
-	JSON400      *ApiResponse
-	JSON409      *ScanExists
-	JSONDefault  *ApiResponse
+// GetScanResultsScanResultIDWithResponse request returning *GetScanResultsScanResultIDResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDResponse, error) {
+	rsp, err := c.GetScanResultsScanResultID(ctx, scanResultID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsScanResultIDResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostScansResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PatchScanResultsScanResultIDWithBodyWithResponse request with arbitrary body returning *PatchScanResultsScanResultIDResponse
+func (c *ClientWithResponses) PatchScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error) {
+	rsp, err := c.PatchScanResultsScanResultIDWithBody(ctx, scanResultID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePatchScanResultsScanResultIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostScansResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PatchScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error) {
+	rsp, err := c.PatchScanResultsScanResultID(ctx, scanResultID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePatchScanResultsScanResultIDResponse(rsp)
 }
 
-type DeleteScansScanIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
+// PutScanResultsScanResultIDWithBodyWithResponse request with arbitrary body returning *PutScanResultsScanResultIDResponse
+func (c *ClientWithResponses) PutScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error) {
+	rsp, err := c.PutScanResultsScanResultIDWithBody(ctx, scanResultID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanResultsScanResultIDResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteScansScanIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PutScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error) {
+	rsp, err := c.PutScanResultsScanResultID(ctx, scanResultID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePutScanResultsScanResultIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteScansScanIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetScanResultsScanResultIDDiffWithResponse request returning *GetScanResultsScanResultIDDiffResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDDiffWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDDiffParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDDiffResponse, error) {
+	rsp, err := c.GetScanResultsScanResultIDDiff(ctx, scanResultID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetScanResultsScanResultIDDiffResponse(rsp)
 }
 
-type GetScansScanIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scan
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
+// GetScanResultsScanResultIDLogsWithResponse request returning *GetScanResultsScanResultIDLogsResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDLogsWithResponse(ctx context.Context, scanResultID ScanResultID, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDLogsResponse, error) {
+	rsp, err := c.GetScanResultsScanResultIDLogs(ctx, scanResultID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsScanResultIDLogsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetScansScanIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetScanResultsScanResultIDSbomWithResponse request returning *GetScanResultsScanResultIDSbomResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDSbomWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDSbomResponse, error) {
+	rsp, err := c.GetScanResultsScanResultIDSbom(ctx, scanResultID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetScanResultsScanResultIDSbomResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScansScanIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetScansWithResponse request returning *GetScansResponse
+func (c *ClientWithResponses) GetScansWithResponse(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*GetScansResponse, error) {
+	rsp, err := c.GetScans(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetScansResponse(rsp)
 }
 
-type PatchScansScanIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scan
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *ScanExists
-	JSONDefault  *ApiResponse
+// PostScansWithBodyWithResponse request with arbitrary body returning *PostScansResponse
+func (c *ClientWithResponses) PostScansWithBodyWithResponse(ctx context.Context, params *PostScansParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScansResponse, error) {
+	rsp, err := c.PostScansWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScansResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchScansScanIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostScansWithResponse(ctx context.Context, params *PostScansParams, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScansResponse, error) {
+	rsp, err := c.PostScans(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostScansResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchScansScanIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteScansScanIDWithResponse request returning *DeleteScansScanIDResponse
+func (c *ClientWithResponses) DeleteScansScanIDWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*DeleteScansScanIDResponse, error) {
+	rsp, err := c.DeleteScansScanID(ctx, scanID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteScansScanIDResponse(rsp)
 }
 
-type PutScansScanIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scan
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *ScanExists
-	JSONDefault  *ApiResponse
+// GetScansScanIDWithResponse request returning *GetScansScanIDResponse
+func (c *ClientWithResponses) GetScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*GetScansScanIDResponse, error) {
+	rsp, err := c.GetScansScanID(ctx, scanID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScansScanIDResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PutScansScanIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PatchScansScanIDWithBodyWithResponse request with arbitrary body returning *PatchScansScanIDResponse
+func (c *ClientWithResponses) PatchScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error) {
+	rsp, err := c.PatchScansScanIDWithBody(ctx, scanID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePatchScansScanIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutScansScanIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PatchScansScanIDWithResponse(ctx context.Context, scanID ScanID, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error) {
+	rsp, err := c.PatchScansScanID(ctx, scanID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePatchScansScanIDResponse(rsp)
 }
 
-type GetTargetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Targets
-	JSONDefault  *ApiResponse
+// PutScansScanIDWithBodyWithResponse request with arbitrary body returning *PutScansScanIDResponse
+func (c *ClientWithResponses) PutScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error) {
+	rsp, err := c.PutScansScanIDWithBody(ctx, scanID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScansScanIDResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTargetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PutScansScanIDWithResponse(ctx context.Context, scanID ScanID, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error) {
+	rsp, err := c.PutScansScanID(ctx, scanID, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePutScansScanIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTargetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostScansScanIDAbortWithResponse request returning *PostScansScanIDAbortResponse
+func (c *ClientWithResponses) PostScansScanIDAbortWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*PostScansScanIDAbortResponse, error) {
+	rsp, err := c.PostScansScanIDAbort(ctx, scanID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostScansScanIDAbortResponse(rsp)
 }
 
-type PostTargetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Target
-	JSON400      *ApiResponse
-	JSON409      *TargetExists
-	JSONDefault  *ApiResponse
+// GetScansScanIDScanResultsWithResponse request returning *GetScansScanIDScanResultsResponse
+func (c *ClientWithResponses) GetScansScanIDScanResultsWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDScanResultsParams, reqEditors ...RequestEditorFn) (*GetScansScanIDScanResultsResponse, error) {
+	rsp, err := c.GetScansScanIDScanResults(ctx, scanID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScansScanIDScanResultsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostTargetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetSeverityOverridesWithResponse request returning *GetSeverityOverridesResponse
+func (c *ClientWithResponses) GetSeverityOverridesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSeverityOverridesResponse, error) {
+	rsp, err := c.GetSeverityOverrides(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetSeverityOverridesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTargetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PutSeverityOverridesWithBodyWithResponse request with arbitrary body returning *PutSeverityOverridesResponse
+func (c *ClientWithResponses) PutSeverityOverridesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSeverityOverridesResponse, error) {
+	rsp, err := c.PutSeverityOverridesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePutSeverityOverridesResponse(rsp)
 }
 
-type DeleteTargetsTargetIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
+func (c *ClientWithResponses) PutSeverityOverridesWithResponse(ctx context.Context, body PutSeverityOverridesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSeverityOverridesResponse, error) {
+	rsp, err := c.PutSeverityOverrides(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSeverityOverridesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTargetsTargetIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetTaggingRulesWithResponse request returning *GetTaggingRulesResponse
+func (c *ClientWithResponses) GetTaggingRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaggingRulesResponse, error) {
+	rsp, err := c.GetTaggingRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetTaggingRulesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTargetsTargetIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PutTaggingRulesWithBodyWithResponse request with arbitrary body returning *PutTaggingRulesResponse
+func (c *ClientWithResponses) PutTaggingRulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTaggingRulesResponse, error) {
+	rsp, err := c.PutTaggingRulesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePutTaggingRulesResponse(rsp)
 }
 
-type GetTargetsTargetIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Target
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
+func (c *ClientWithResponses) PutTaggingRulesWithResponse(ctx context.Context, body PutTaggingRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTaggingRulesResponse, error) {
+	rsp, err := c.PutTaggingRules(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutTaggingRulesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTargetsTargetIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetTargetsWithResponse request returning *GetTargetsResponse
+func (c *ClientWithResponses) GetTargetsWithResponse(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*GetTargetsResponse, error) {
+	rsp, err := c.GetTargets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetTargetsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTargetsTargetIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostTargetsWithBodyWithResponse request with arbitrary body returning *PostTargetsResponse
+func (c *ClientWithResponses) PostTargetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error) {
+	rsp, err := c.PostTargetsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostTargetsResponse(rsp)
 }
 
-type PatchTargetsTargetIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Target
-	JSON404      *ApiResponse
-	JSON409      *TargetExists
-	JSONDefault  *ApiResponse
+func (c *ClientWithResponses) PostTargetsWithResponse(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error) {
+	rsp, err := c.PostTargets(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTargetsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchTargetsTargetIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostTargetsImportWithBodyWithResponse request with arbitrary body returning *PostTargetsImportResponse
+func (c *ClientWithResponses) PostTargetsImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTargetsImportResponse, error) {
+	rsp, err := c.PostTargetsImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostTargetsImportResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchTargetsTargetIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostTargetsImportWithResponse(ctx context.Context, body PostTargetsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTargetsImportResponse, error) {
+	rsp, err := c.PostTargetsImport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostTargetsImportResponse(rsp)
 }
 
-type PutTargetsTargetIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Target
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *TargetExists
-	JSONDefault  *ApiResponse
+// GetTargetsUnscannedWithResponse request returning *GetTargetsUnscannedResponse
+func (c *ClientWithResponses) GetTargetsUnscannedWithResponse(ctx context.Context, params *GetTargetsUnscannedParams, reqEditors ...RequestEditorFn) (*GetTargetsUnscannedResponse, error) {
+	rsp, err := c.GetTargetsUnscanned(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTargetsUnscannedResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PutTargetsTargetIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteTargetsTargetIDWithResponse request returning *DeleteTargetsTargetIDResponse
+func (c *ClientWithResponses) DeleteTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*DeleteTargetsTargetIDResponse, error) {
+	rsp, err := c.DeleteTargetsTargetID(ctx, targetID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteTargetsTargetIDResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutTargetsTargetIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetTargetsTargetIDWithResponse request returning *GetTargetsTargetIDResponse
+func (c *ClientWithResponses) GetTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *GetTargetsTargetIDParams, reqEditors ...RequestEditorFn) (*GetTargetsTargetIDResponse, error) {
+	rsp, err := c.GetTargetsTargetID(ctx, targetID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetTargetsTargetIDResponse(rsp)
 }
 
-// GetDiscoveryScopesWithResponse request returning *GetDiscoveryScopesResponse
-func (c *ClientWithResponses) GetDiscoveryScopesWithResponse(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*GetDiscoveryScopesResponse, error) {
-	rsp, err := c.GetDiscoveryScopes(ctx, params, reqEditors...)
+// PatchTargetsTargetIDWithBodyWithResponse request with arbitrary body returning *PatchTargetsTargetIDResponse
+func (c *ClientWithResponses) PatchTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error) {
+	rsp, err := c.PatchTargetsTargetIDWithBody(ctx, targetID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDiscoveryScopesResponse(rsp)
+	return ParsePatchTargetsTargetIDResponse(rsp)
 }
 
-// PutDiscoveryScopesWithBodyWithResponse request with arbitrary body returning *PutDiscoveryScopesResponse
-func (c *ClientWithResponses) PutDiscoveryScopesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error) {
-	rsp, err := c.PutDiscoveryScopesWithBody(ctx, contentType, body, reqEditors...)
+func (c *ClientWithResponses) PatchTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, body PatchTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error) {
+	rsp, err := c.PatchTargetsTargetID(ctx, targetID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutDiscoveryScopesResponse(rsp)
+	return ParsePatchTargetsTargetIDResponse(rsp)
 }
 
-func (c *ClientWithResponses) PutDiscoveryScopesWithResponse(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error) {
-	rsp, err := c.PutDiscoveryScopes(ctx, body, reqEditors...)
+// PutTargetsTargetIDWithBodyWithResponse request with arbitrary body returning *PutTargetsTargetIDResponse
+func (c *ClientWithResponses) PutTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error) {
+	rsp, err := c.PutTargetsTargetIDWithBody(ctx, targetID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutDiscoveryScopesResponse(rsp)
+	return ParsePutTargetsTargetIDResponse(rsp)
 }
 
-// GetFindingsWithResponse request returning *GetFindingsResponse
-func (c *ClientWithResponses) GetFindingsWithResponse(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*GetFindingsResponse, error) {
-	rsp, err := c.GetFindings(ctx, params, reqEditors...)
+func (c *ClientWithResponses) PutTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, body PutTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error) {
+	rsp, err := c.PutTargetsTargetID(ctx, targetID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFindingsResponse(rsp)
+	return ParsePutTargetsTargetIDResponse(rsp)
 }
 
-// PostFindingsWithBodyWithResponse request with arbitrary body returning *PostFindingsResponse
-func (c *ClientWithResponses) PostFindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error) {
-	rsp, err := c.PostFindingsWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetDiscoveryScopesResponse parses an HTTP response from a GetDiscoveryScopesWithResponse call
+func ParseGetDiscoveryScopesResponse(rsp *http.Response) (*GetDiscoveryScopesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostFindingsResponse(rsp)
+
+	response := &GetDiscoveryScopesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Scopes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostFindingsWithResponse(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error) {
-	rsp, err := c.PostFindings(ctx, body, reqEditors...)
+// ParsePutDiscoveryScopesResponse parses an HTTP response from a PutDiscoveryScopesWithResponse call
+func ParsePutDiscoveryScopesResponse(rsp *http.Response) (*PutDiscoveryScopesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostFindingsResponse(rsp)
+
+	response := &PutDiscoveryScopesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Scopes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteFindingsFindingIDWithResponse request returning *DeleteFindingsFindingIDResponse
-func (c *ClientWithResponses) DeleteFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*DeleteFindingsFindingIDResponse, error) {
-	rsp, err := c.DeleteFindingsFindingID(ctx, findingID, reqEditors...)
+// ParseGetFindingsResponse parses an HTTP response from a GetFindingsWithResponse call
+func ParseGetFindingsResponse(rsp *http.Response) (*GetFindingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteFindingsFindingIDResponse(rsp)
+
+	response := &GetFindingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Findings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetFindingsFindingIDWithResponse request returning *GetFindingsFindingIDResponse
-func (c *ClientWithResponses) GetFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*GetFindingsFindingIDResponse, error) {
-	rsp, err := c.GetFindingsFindingID(ctx, findingID, params, reqEditors...)
+// ParsePostFindingsResponse parses an HTTP response from a PostFindingsWithResponse call
+func ParsePostFindingsResponse(rsp *http.Response) (*PostFindingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFindingsFindingIDResponse(rsp)
+
+	response := &PostFindingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Finding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest FindingExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// PatchFindingsFindingIDWithBodyWithResponse request with arbitrary body returning *PatchFindingsFindingIDResponse
-func (c *ClientWithResponses) PatchFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error) {
-	rsp, err := c.PatchFindingsFindingIDWithBody(ctx, findingID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ParseDeleteFindingsFindingIDResponse parses an HTTP response from a DeleteFindingsFindingIDWithResponse call
+func ParseDeleteFindingsFindingIDResponse(rsp *http.Response) (*DeleteFindingsFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteFindingsFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchFindingsFindingIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error) {
-	rsp, err := c.PatchFindingsFindingID(ctx, findingID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchFindingsFindingIDResponse(rsp)
+	return response, nil
 }
 
-// PutFindingsFindingIDWithBodyWithResponse request with arbitrary body returning *PutFindingsFindingIDResponse
-func (c *ClientWithResponses) PutFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error) {
-	rsp, err := c.PutFindingsFindingIDWithBody(ctx, findingID, contentType, body, reqEditors...)
+// ParseGetFindingsFindingIDResponse parses an HTTP response from a GetFindingsFindingIDWithResponse call
+func ParseGetFindingsFindingIDResponse(rsp *http.Response) (*GetFindingsFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutFindingsFindingIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error) {
-	rsp, err := c.PutFindingsFindingID(ctx, findingID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetFindingsFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutFindingsFindingIDResponse(rsp)
-}
 
-// GetScanConfigsWithResponse request returning *GetScanConfigsResponse
-func (c *ClientWithResponses) GetScanConfigsWithResponse(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsResponse, error) {
-	rsp, err := c.GetScanConfigs(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetScanConfigsResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Finding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// PostScanConfigsWithBodyWithResponse request with arbitrary body returning *PostScanConfigsResponse
-func (c *ClientWithResponses) PostScanConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error) {
-	rsp, err := c.PostScanConfigsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostScanConfigsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-func (c *ClientWithResponses) PostScanConfigsWithResponse(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error) {
-	rsp, err := c.PostScanConfigs(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePostScanConfigsResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteScanConfigsScanConfigIDWithResponse request returning *DeleteScanConfigsScanConfigIDResponse
-func (c *ClientWithResponses) DeleteScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*DeleteScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.DeleteScanConfigsScanConfigID(ctx, scanConfigID, reqEditors...)
+// ParsePatchFindingsFindingIDResponse parses an HTTP response from a PatchFindingsFindingIDWithResponse call
+func ParsePatchFindingsFindingIDResponse(rsp *http.Response) (*PatchFindingsFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteScanConfigsScanConfigIDResponse(rsp)
-}
 
-// GetScanConfigsScanConfigIDWithResponse request returning *GetScanConfigsScanConfigIDResponse
-func (c *ClientWithResponses) GetScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.GetScanConfigsScanConfigID(ctx, scanConfigID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchFindingsFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetScanConfigsScanConfigIDResponse(rsp)
-}
 
-// PatchScanConfigsScanConfigIDWithBodyWithResponse request with arbitrary body returning *PatchScanConfigsScanConfigIDResponse
-func (c *ClientWithResponses) PatchScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.PatchScanConfigsScanConfigIDWithBody(ctx, scanConfigID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Finding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchScanConfigsScanConfigIDResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.PatchScanConfigsScanConfigID(ctx, scanConfigID, body, reqEditors...)
+// ParsePutFindingsFindingIDResponse parses an HTTP response from a PutFindingsFindingIDWithResponse call
+func ParsePutFindingsFindingIDResponse(rsp *http.Response) (*PutFindingsFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchScanConfigsScanConfigIDResponse(rsp)
-}
 
-// PutScanConfigsScanConfigIDWithBodyWithResponse request with arbitrary body returning *PutScanConfigsScanConfigIDResponse
-func (c *ClientWithResponses) PutScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.PutScanConfigsScanConfigIDWithBody(ctx, scanConfigID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutFindingsFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutScanConfigsScanConfigIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.PutScanConfigsScanConfigID(ctx, scanConfigID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Finding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutScanConfigsScanConfigIDResponse(rsp)
+
+	return response, nil
 }
 
-// GetScanResultsWithResponse request returning *GetScanResultsResponse
-func (c *ClientWithResponses) GetScanResultsWithResponse(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*GetScanResultsResponse, error) {
-	rsp, err := c.GetScanResults(ctx, params, reqEditors...)
+// ParseGetIgnoreRulesResponse parses an HTTP response from a GetIgnoreRulesWithResponse call
+func ParseGetIgnoreRulesResponse(rsp *http.Response) (*GetIgnoreRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetScanResultsResponse(rsp)
-}
 
-// PostScanResultsWithBodyWithResponse request with arbitrary body returning *PostScanResultsResponse
-func (c *ClientWithResponses) PostScanResultsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error) {
-	rsp, err := c.PostScanResultsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetIgnoreRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostScanResultsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostScanResultsWithResponse(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error) {
-	rsp, err := c.PostScanResults(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IgnoreRules
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePostScanResultsResponse(rsp)
+
+	return response, nil
 }
 
-// GetScanResultsScanResultIDWithResponse request returning *GetScanResultsScanResultIDResponse
-func (c *ClientWithResponses) GetScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDResponse, error) {
-	rsp, err := c.GetScanResultsScanResultID(ctx, scanResultID, params, reqEditors...)
+// ParsePutIgnoreRulesResponse parses an HTTP response from a PutIgnoreRulesWithResponse call
+func ParsePutIgnoreRulesResponse(rsp *http.Response) (*PutIgnoreRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetScanResultsScanResultIDResponse(rsp)
-}
 
-// PatchScanResultsScanResultIDWithBodyWithResponse request with arbitrary body returning *PatchScanResultsScanResultIDResponse
-func (c *ClientWithResponses) PatchScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error) {
-	rsp, err := c.PatchScanResultsScanResultIDWithBody(ctx, scanResultID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutIgnoreRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchScanResultsScanResultIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error) {
-	rsp, err := c.PatchScanResultsScanResultID(ctx, scanResultID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IgnoreRules
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchScanResultsScanResultIDResponse(rsp)
-}
 
-// PutScanResultsScanResultIDWithBodyWithResponse request with arbitrary body returning *PutScanResultsScanResultIDResponse
-func (c *ClientWithResponses) PutScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error) {
-	rsp, err := c.PutScanResultsScanResultIDWithBody(ctx, scanResultID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutScanResultsScanResultIDResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error) {
-	rsp, err := c.PutScanResultsScanResultID(ctx, scanResultID, body, reqEditors...)
+// ParseGetProviderInstancesResponse parses an HTTP response from a GetProviderInstancesWithResponse call
+func ParseGetProviderInstancesResponse(rsp *http.Response) (*GetProviderInstancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutScanResultsScanResultIDResponse(rsp)
-}
 
-// GetScansWithResponse request returning *GetScansResponse
-func (c *ClientWithResponses) GetScansWithResponse(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*GetScansResponse, error) {
-	rsp, err := c.GetScans(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetProviderInstancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetScansResponse(rsp)
-}
 
-// PostScansWithBodyWithResponse request with arbitrary body returning *PostScansResponse
-func (c *ClientWithResponses) PostScansWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScansResponse, error) {
-	rsp, err := c.PostScansWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostScansResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProviderInstances
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) PostScansWithResponse(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScansResponse, error) {
-	rsp, err := c.PostScans(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostScansResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// DeleteScansScanIDWithResponse request returning *DeleteScansScanIDResponse
-func (c *ClientWithResponses) DeleteScansScanIDWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*DeleteScansScanIDResponse, error) {
-	rsp, err := c.DeleteScansScanID(ctx, scanID, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseDeleteScansScanIDResponse(rsp)
+
+	return response, nil
 }
 
-// GetScansScanIDWithResponse request returning *GetScansScanIDResponse
-func (c *ClientWithResponses) GetScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*GetScansScanIDResponse, error) {
-	rsp, err := c.GetScansScanID(ctx, scanID, params, reqEditors...)
+// ParseGetScanConfigsResponse parses an HTTP response from a GetScanConfigsWithResponse call
+func ParseGetScanConfigsResponse(rsp *http.Response) (*GetScanConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetScansScanIDResponse(rsp)
-}
 
-// PatchScansScanIDWithBodyWithResponse request with arbitrary body returning *PatchScansScanIDResponse
-func (c *ClientWithResponses) PatchScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error) {
-	rsp, err := c.PatchScansScanIDWithBody(ctx, scanID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetScanConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchScansScanIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchScansScanIDWithResponse(ctx context.Context, scanID ScanID, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error) {
-	rsp, err := c.PatchScansScanID(ctx, scanID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanConfigs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchScansScanIDResponse(rsp)
+
+	return response, nil
 }
 
-// PutScansScanIDWithBodyWithResponse request with arbitrary body returning *PutScansScanIDResponse
-func (c *ClientWithResponses) PutScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error) {
-	rsp, err := c.PutScansScanIDWithBody(ctx, scanID, contentType, body, reqEditors...)
+// ParsePostScanConfigsResponse parses an HTTP response from a PostScanConfigsWithResponse call
+func ParsePostScanConfigsResponse(rsp *http.Response) (*PostScanConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutScansScanIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutScansScanIDWithResponse(ctx context.Context, scanID ScanID, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error) {
-	rsp, err := c.PutScansScanID(ctx, scanID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostScanConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutScansScanIDResponse(rsp)
-}
 
-// GetTargetsWithResponse request returning *GetTargetsResponse
-func (c *ClientWithResponses) GetTargetsWithResponse(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*GetTargetsResponse, error) {
-	rsp, err := c.GetTargets(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ScanConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanConfigExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetTargetsResponse(rsp)
+
+	return response, nil
 }
 
-// PostTargetsWithBodyWithResponse request with arbitrary body returning *PostTargetsResponse
-func (c *ClientWithResponses) PostTargetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error) {
-	rsp, err := c.PostTargetsWithBody(ctx, contentType, body, reqEditors...)
+// ParsePostScanConfigsValidateResponse parses an HTTP response from a PostScanConfigsValidateWithResponse call
+func ParsePostScanConfigsValidateResponse(rsp *http.Response) (*PostScanConfigsValidateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostTargetsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostTargetsWithResponse(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error) {
-	rsp, err := c.PostTargets(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostScanConfigsValidateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostTargetsResponse(rsp)
-}
 
-// DeleteTargetsTargetIDWithResponse request returning *DeleteTargetsTargetIDResponse
-func (c *ClientWithResponses) DeleteTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*DeleteTargetsTargetIDResponse, error) {
-	rsp, err := c.DeleteTargetsTargetID(ctx, targetID, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanConfigValidation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseDeleteTargetsTargetIDResponse(rsp)
+
+	return response, nil
 }
 
-// GetTargetsTargetIDWithResponse request returning *GetTargetsTargetIDResponse
-func (c *ClientWithResponses) GetTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *GetTargetsTargetIDParams, reqEditors ...RequestEditorFn) (*GetTargetsTargetIDResponse, error) {
-	rsp, err := c.GetTargetsTargetID(ctx, targetID, params, reqEditors...)
+// ParseDeleteScanConfigsScanConfigIDResponse parses an HTTP response from a DeleteScanConfigsScanConfigIDWithResponse call
+func ParseDeleteScanConfigsScanConfigIDResponse(rsp *http.Response) (*DeleteScanConfigsScanConfigIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTargetsTargetIDResponse(rsp)
-}
 
-// PatchTargetsTargetIDWithBodyWithResponse request with arbitrary body returning *PatchTargetsTargetIDResponse
-func (c *ClientWithResponses) PatchTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error) {
-	rsp, err := c.PatchTargetsTargetIDWithBody(ctx, targetID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteScanConfigsScanConfigIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchTargetsTargetIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, body PatchTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error) {
-	rsp, err := c.PatchTargetsTargetID(ctx, targetID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchTargetsTargetIDResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// PutTargetsTargetIDWithBodyWithResponse request with arbitrary body returning *PutTargetsTargetIDResponse
-func (c *ClientWithResponses) PutTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error) {
-	rsp, err := c.PutTargetsTargetIDWithBody(ctx, targetID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePutTargetsTargetIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, body PutTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error) {
-	rsp, err := c.PutTargetsTargetID(ctx, targetID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutTargetsTargetIDResponse(rsp)
+	return response, nil
 }
 
-// ParseGetDiscoveryScopesResponse parses an HTTP response from a GetDiscoveryScopesWithResponse call
-func ParseGetDiscoveryScopesResponse(rsp *http.Response) (*GetDiscoveryScopesResponse, error) {
+// ParseGetScanConfigsScanConfigIDResponse parses an HTTP response from a GetScanConfigsScanConfigIDWithResponse call
+func ParseGetScanConfigsScanConfigIDResponse(rsp *http.Response) (*GetScanConfigsScanConfigIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDiscoveryScopesResponse{
+	response := &GetScanConfigsScanConfigIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Scopes
+		var dest ScanConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4139,27 +6644,48 @@ func ParseGetDiscoveryScopesResponse(rsp *http.Response) (*GetDiscoveryScopesRes
 	return response, nil
 }
 
-// ParsePutDiscoveryScopesResponse parses an HTTP response from a PutDiscoveryScopesWithResponse call
-func ParsePutDiscoveryScopesResponse(rsp *http.Response) (*PutDiscoveryScopesResponse, error) {
+// ParsePatchScanConfigsScanConfigIDResponse parses an HTTP response from a PatchScanConfigsScanConfigIDWithResponse call
+func ParsePatchScanConfigsScanConfigIDResponse(rsp *http.Response) (*PatchScanConfigsScanConfigIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutDiscoveryScopesResponse{
+	response := &PatchScanConfigsScanConfigIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Scopes
+		var dest ScanConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanConfigExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4172,27 +6698,48 @@ func ParsePutDiscoveryScopesResponse(rsp *http.Response) (*PutDiscoveryScopesRes
 	return response, nil
 }
 
-// ParseGetFindingsResponse parses an HTTP response from a GetFindingsWithResponse call
-func ParseGetFindingsResponse(rsp *http.Response) (*GetFindingsResponse, error) {
+// ParsePutScanConfigsScanConfigIDResponse parses an HTTP response from a PutScanConfigsScanConfigIDWithResponse call
+func ParsePutScanConfigsScanConfigIDResponse(rsp *http.Response) (*PutScanConfigsScanConfigIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFindingsResponse{
+	response := &PutScanConfigsScanConfigIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Findings
+		var dest ScanConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanConfigExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4205,41 +6752,48 @@ func ParseGetFindingsResponse(rsp *http.Response) (*GetFindingsResponse, error)
 	return response, nil
 }
 
-// ParsePostFindingsResponse parses an HTTP response from a PostFindingsWithResponse call
-func ParsePostFindingsResponse(rsp *http.Response) (*PostFindingsResponse, error) {
+// ParsePostScanConfigsScanConfigIDRunResponse parses an HTTP response from a PostScanConfigsScanConfigIDRunWithResponse call
+func ParsePostScanConfigsScanConfigIDRunResponse(rsp *http.Response) (*PostScanConfigsScanConfigIDRunResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostFindingsResponse{
+	response := &PostScanConfigsScanConfigIDRunResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Finding
+		var dest ScanConfigRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest FindingExists
+		var dest ScanExists
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4252,33 +6806,73 @@ func ParsePostFindingsResponse(rsp *http.Response) (*PostFindingsResponse, error
 	return response, nil
 }
 
-// ParseDeleteFindingsFindingIDResponse parses an HTTP response from a DeleteFindingsFindingIDWithResponse call
-func ParseDeleteFindingsFindingIDResponse(rsp *http.Response) (*DeleteFindingsFindingIDResponse, error) {
+// ParseGetScanResultsResponse parses an HTTP response from a GetScanResultsWithResponse call
+func ParseGetScanResultsResponse(rsp *http.Response) (*GetScanResultsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFindingsFindingIDResponse{
+	response := &GetScanResultsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest TargetScanResults
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostScanResultsResponse parses an HTTP response from a PostScanResultsWithResponse call
+func ParsePostScanResultsResponse(rsp *http.Response) (*PostScanResultsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostScanResultsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TargetScanResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest TargetScanResultExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -4292,22 +6886,22 @@ func ParseDeleteFindingsFindingIDResponse(rsp *http.Response) (*DeleteFindingsFi
 	return response, nil
 }
 
-// ParseGetFindingsFindingIDResponse parses an HTTP response from a GetFindingsFindingIDWithResponse call
-func ParseGetFindingsFindingIDResponse(rsp *http.Response) (*GetFindingsFindingIDResponse, error) {
+// ParseGetScanResultsScanResultIDResponse parses an HTTP response from a GetScanResultsScanResultIDWithResponse call
+func ParseGetScanResultsScanResultIDResponse(rsp *http.Response) (*GetScanResultsScanResultIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFindingsFindingIDResponse{
+	response := &GetScanResultsScanResultIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Finding
+		var dest TargetScanResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4332,22 +6926,22 @@ func ParseGetFindingsFindingIDResponse(rsp *http.Response) (*GetFindingsFindingI
 	return response, nil
 }
 
-// ParsePatchFindingsFindingIDResponse parses an HTTP response from a PatchFindingsFindingIDWithResponse call
-func ParsePatchFindingsFindingIDResponse(rsp *http.Response) (*PatchFindingsFindingIDResponse, error) {
+// ParsePatchScanResultsScanResultIDResponse parses an HTTP response from a PatchScanResultsScanResultIDWithResponse call
+func ParsePatchScanResultsScanResultIDResponse(rsp *http.Response) (*PatchScanResultsScanResultIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchFindingsFindingIDResponse{
+	response := &PatchScanResultsScanResultIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Finding
+		var dest TargetScanResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4367,6 +6961,13 @@ func ParsePatchFindingsFindingIDResponse(rsp *http.Response) (*PatchFindingsFind
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest TargetScanResultExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4379,22 +6980,22 @@ func ParsePatchFindingsFindingIDResponse(rsp *http.Response) (*PatchFindingsFind
 	return response, nil
 }
 
-// ParsePutFindingsFindingIDResponse parses an HTTP response from a PutFindingsFindingIDWithResponse call
-func ParsePutFindingsFindingIDResponse(rsp *http.Response) (*PutFindingsFindingIDResponse, error) {
+// ParsePutScanResultsScanResultIDResponse parses an HTTP response from a PutScanResultsScanResultIDWithResponse call
+func ParsePutScanResultsScanResultIDResponse(rsp *http.Response) (*PutScanResultsScanResultIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutFindingsFindingIDResponse{
+	response := &PutScanResultsScanResultIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Finding
+		var dest TargetScanResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4414,6 +7015,13 @@ func ParsePutFindingsFindingIDResponse(rsp *http.Response) (*PutFindingsFindingI
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest TargetScanResultExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4426,27 +7034,41 @@ func ParsePutFindingsFindingIDResponse(rsp *http.Response) (*PutFindingsFindingI
 	return response, nil
 }
 
-// ParseGetScanConfigsResponse parses an HTTP response from a GetScanConfigsWithResponse call
-func ParseGetScanConfigsResponse(rsp *http.Response) (*GetScanConfigsResponse, error) {
+// ParseGetScanResultsScanResultIDDiffResponse parses an HTTP response from a GetScanResultsScanResultIDDiffWithResponse call
+func ParseGetScanResultsScanResultIDDiffResponse(rsp *http.Response) (*GetScanResultsScanResultIDDiffResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScanConfigsResponse{
+	response := &GetScanResultsScanResultIDDiffResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScanConfigs
+		var dest ScanResultDiff
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4459,40 +7081,47 @@ func ParseGetScanConfigsResponse(rsp *http.Response) (*GetScanConfigsResponse, e
 	return response, nil
 }
 
-// ParsePostScanConfigsResponse parses an HTTP response from a PostScanConfigsWithResponse call
-func ParsePostScanConfigsResponse(rsp *http.Response) (*PostScanConfigsResponse, error) {
+// ParseGetScanResultsScanResultIDLogsResponse parses an HTTP response from a GetScanResultsScanResultIDLogsWithResponse call
+func ParseGetScanResultsScanResultIDLogsResponse(rsp *http.Response) (*GetScanResultsScanResultIDLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostScanConfigsResponse{
+	response := &GetScanResultsScanResultIDLogsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ScanConfig
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScannerLogs
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanConfigExists
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -4506,27 +7135,20 @@ func ParsePostScanConfigsResponse(rsp *http.Response) (*PostScanConfigsResponse,
 	return response, nil
 }
 
-// ParseDeleteScanConfigsScanConfigIDResponse parses an HTTP response from a DeleteScanConfigsScanConfigIDWithResponse call
-func ParseDeleteScanConfigsScanConfigIDResponse(rsp *http.Response) (*DeleteScanConfigsScanConfigIDResponse, error) {
+// ParseGetScanResultsScanResultIDSbomResponse parses an HTTP response from a GetScanResultsScanResultIDSbomWithResponse call
+func ParseGetScanResultsScanResultIDSbomResponse(rsp *http.Response) (*GetScanResultsScanResultIDSbomResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteScanConfigsScanConfigIDResponse{
+	response := &GetScanResultsScanResultIDSbomResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4546,34 +7168,27 @@ func ParseDeleteScanConfigsScanConfigIDResponse(rsp *http.Response) (*DeleteScan
 	return response, nil
 }
 
-// ParseGetScanConfigsScanConfigIDResponse parses an HTTP response from a GetScanConfigsScanConfigIDWithResponse call
-func ParseGetScanConfigsScanConfigIDResponse(rsp *http.Response) (*GetScanConfigsScanConfigIDResponse, error) {
+// ParseGetScansResponse parses an HTTP response from a GetScansWithResponse call
+func ParseGetScansResponse(rsp *http.Response) (*GetScansResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScanConfigsScanConfigIDResponse{
+	response := &GetScansResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScanConfig
+		var dest Scans
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4586,48 +7201,57 @@ func ParseGetScanConfigsScanConfigIDResponse(rsp *http.Response) (*GetScanConfig
 	return response, nil
 }
 
-// ParsePatchScanConfigsScanConfigIDResponse parses an HTTP response from a PatchScanConfigsScanConfigIDWithResponse call
-func ParsePatchScanConfigsScanConfigIDResponse(rsp *http.Response) (*PatchScanConfigsScanConfigIDResponse, error) {
+// ParsePostScansResponse parses an HTTP response from a PostScansWithResponse call
+func ParsePostScansResponse(rsp *http.Response) (*PostScansResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchScanConfigsScanConfigIDResponse{
+	response := &PostScansResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScanConfig
+		var dest struct {
+			union json.RawMessage
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Scan
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanConfigExists
+		var dest ScanExists
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4640,34 +7264,27 @@ func ParsePatchScanConfigsScanConfigIDResponse(rsp *http.Response) (*PatchScanCo
 	return response, nil
 }
 
-// ParsePutScanConfigsScanConfigIDResponse parses an HTTP response from a PutScanConfigsScanConfigIDWithResponse call
-func ParsePutScanConfigsScanConfigIDResponse(rsp *http.Response) (*PutScanConfigsScanConfigIDResponse, error) {
+// ParseDeleteScansScanIDResponse parses an HTTP response from a DeleteScansScanIDWithResponse call
+func ParseDeleteScansScanIDResponse(rsp *http.Response) (*DeleteScansScanIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutScanConfigsScanConfigIDResponse{
+	response := &DeleteScansScanIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScanConfig
+		var dest SuccessResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4676,7 +7293,7 @@ func ParsePutScanConfigsScanConfigIDResponse(rsp *http.Response) (*PutScanConfig
 		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanConfigExists
+		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4694,27 +7311,34 @@ func ParsePutScanConfigsScanConfigIDResponse(rsp *http.Response) (*PutScanConfig
 	return response, nil
 }
 
-// ParseGetScanResultsResponse parses an HTTP response from a GetScanResultsWithResponse call
-func ParseGetScanResultsResponse(rsp *http.Response) (*GetScanResultsResponse, error) {
+// ParseGetScansScanIDResponse parses an HTTP response from a GetScansScanIDWithResponse call
+func ParseGetScansScanIDResponse(rsp *http.Response) (*GetScansScanIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScanResultsResponse{
+	response := &GetScansScanIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetScanResults
+		var dest Scan
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4727,26 +7351,26 @@ func ParseGetScanResultsResponse(rsp *http.Response) (*GetScanResultsResponse, e
 	return response, nil
 }
 
-// ParsePostScanResultsResponse parses an HTTP response from a PostScanResultsWithResponse call
-func ParsePostScanResultsResponse(rsp *http.Response) (*PostScanResultsResponse, error) {
+// ParsePatchScansScanIDResponse parses an HTTP response from a PatchScansScanIDWithResponse call
+func ParsePatchScansScanIDResponse(rsp *http.Response) (*PatchScansScanIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostScanResultsResponse{
+	response := &PatchScansScanIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TargetScanResult
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Scan
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
@@ -4755,8 +7379,15 @@ func ParsePostScanResultsResponse(rsp *http.Response) (*PostScanResultsResponse,
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetScanResultExists
+		var dest ScanExists
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4774,27 +7405,34 @@ func ParsePostScanResultsResponse(rsp *http.Response) (*PostScanResultsResponse,
 	return response, nil
 }
 
-// ParseGetScanResultsScanResultIDResponse parses an HTTP response from a GetScanResultsScanResultIDWithResponse call
-func ParseGetScanResultsScanResultIDResponse(rsp *http.Response) (*GetScanResultsScanResultIDResponse, error) {
+// ParsePutScansScanIDResponse parses an HTTP response from a PutScansScanIDWithResponse call
+func ParsePutScansScanIDResponse(rsp *http.Response) (*PutScansScanIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScanResultsScanResultIDResponse{
+	response := &PutScansScanIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetScanResult
+		var dest Scan
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4802,6 +7440,13 @@ func ParseGetScanResultsScanResultIDResponse(rsp *http.Response) (*GetScanResult
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4814,34 +7459,27 @@ func ParseGetScanResultsScanResultIDResponse(rsp *http.Response) (*GetScanResult
 	return response, nil
 }
 
-// ParsePatchScanResultsScanResultIDResponse parses an HTTP response from a PatchScanResultsScanResultIDWithResponse call
-func ParsePatchScanResultsScanResultIDResponse(rsp *http.Response) (*PatchScanResultsScanResultIDResponse, error) {
+// ParsePostScansScanIDAbortResponse parses an HTTP response from a PostScansScanIDAbortWithResponse call
+func ParsePostScansScanIDAbortResponse(rsp *http.Response) (*PostScansScanIDAbortResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchScanResultsScanResultIDResponse{
+	response := &PostScansScanIDAbortResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetScanResult
+		var dest ScanAbort
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4850,7 +7488,7 @@ func ParsePatchScanResultsScanResultIDResponse(rsp *http.Response) (*PatchScanRe
 		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetScanResultExists
+		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4868,22 +7506,22 @@ func ParsePatchScanResultsScanResultIDResponse(rsp *http.Response) (*PatchScanRe
 	return response, nil
 }
 
-// ParsePutScanResultsScanResultIDResponse parses an HTTP response from a PutScanResultsScanResultIDWithResponse call
-func ParsePutScanResultsScanResultIDResponse(rsp *http.Response) (*PutScanResultsScanResultIDResponse, error) {
+// ParseGetScansScanIDScanResultsResponse parses an HTTP response from a GetScansScanIDScanResultsWithResponse call
+func ParseGetScansScanIDScanResultsResponse(rsp *http.Response) (*GetScansScanIDScanResultsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutScanResultsScanResultIDResponse{
+	response := &GetScansScanIDScanResultsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetScanResult
+		var dest TargetScanResults
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4903,13 +7541,6 @@ func ParsePutScanResultsScanResultIDResponse(rsp *http.Response) (*PutScanResult
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetScanResultExists
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4922,22 +7553,22 @@ func ParsePutScanResultsScanResultIDResponse(rsp *http.Response) (*PutScanResult
 	return response, nil
 }
 
-// ParseGetScansResponse parses an HTTP response from a GetScansWithResponse call
-func ParseGetScansResponse(rsp *http.Response) (*GetScansResponse, error) {
+// ParseGetSeverityOverridesResponse parses an HTTP response from a GetSeverityOverridesWithResponse call
+func ParseGetSeverityOverridesResponse(rsp *http.Response) (*GetSeverityOverridesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScansResponse{
+	response := &GetSeverityOverridesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Scans
+		var dest SeverityOverrides
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4955,26 +7586,26 @@ func ParseGetScansResponse(rsp *http.Response) (*GetScansResponse, error) {
 	return response, nil
 }
 
-// ParsePostScansResponse parses an HTTP response from a PostScansWithResponse call
-func ParsePostScansResponse(rsp *http.Response) (*PostScansResponse, error) {
+// ParsePutSeverityOverridesResponse parses an HTTP response from a PutSeverityOverridesWithResponse call
+func ParsePutSeverityOverridesResponse(rsp *http.Response) (*PutSeverityOverridesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostScansResponse{
+	response := &PutSeverityOverridesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Scan
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SeverityOverrides
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
@@ -4983,13 +7614,6 @@ func ParsePostScansResponse(rsp *http.Response) (*PostScansResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanExists
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5002,34 +7626,27 @@ func ParsePostScansResponse(rsp *http.Response) (*PostScansResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteScansScanIDResponse parses an HTTP response from a DeleteScansScanIDWithResponse call
-func ParseDeleteScansScanIDResponse(rsp *http.Response) (*DeleteScansScanIDResponse, error) {
+// ParseGetTaggingRulesResponse parses an HTTP response from a GetTaggingRulesWithResponse call
+func ParseGetTaggingRulesResponse(rsp *http.Response) (*GetTaggingRulesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteScansScanIDResponse{
+	response := &GetTaggingRulesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest TaggingRules
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5042,33 +7659,33 @@ func ParseDeleteScansScanIDResponse(rsp *http.Response) (*DeleteScansScanIDRespo
 	return response, nil
 }
 
-// ParseGetScansScanIDResponse parses an HTTP response from a GetScansScanIDWithResponse call
-func ParseGetScansScanIDResponse(rsp *http.Response) (*GetScansScanIDResponse, error) {
+// ParsePutTaggingRulesResponse parses an HTTP response from a PutTaggingRulesWithResponse call
+func ParsePutTaggingRulesResponse(rsp *http.Response) (*PutTaggingRulesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScansScanIDResponse{
+	response := &PutTaggingRulesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Scan
+		var dest TaggingRules
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -5082,48 +7699,27 @@ func ParseGetScansScanIDResponse(rsp *http.Response) (*GetScansScanIDResponse, e
 	return response, nil
 }
 
-// ParsePatchScansScanIDResponse parses an HTTP response from a PatchScansScanIDWithResponse call
-func ParsePatchScansScanIDResponse(rsp *http.Response) (*PatchScansScanIDResponse, error) {
+// ParseGetTargetsResponse parses an HTTP response from a GetTargetsWithResponse call
+func ParseGetTargetsResponse(rsp *http.Response) (*GetTargetsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchScansScanIDResponse{
+	response := &GetTargetsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Scan
+		var dest Targets
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanExists
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5136,26 +7732,26 @@ func ParsePatchScansScanIDResponse(rsp *http.Response) (*PatchScansScanIDRespons
 	return response, nil
 }
 
-// ParsePutScansScanIDResponse parses an HTTP response from a PutScansScanIDWithResponse call
-func ParsePutScansScanIDResponse(rsp *http.Response) (*PutScansScanIDResponse, error) {
+// ParsePostTargetsResponse parses an HTTP response from a PostTargetsWithResponse call
+func ParsePostTargetsResponse(rsp *http.Response) (*PostTargetsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutScansScanIDResponse{
+	response := &PostTargetsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Scan
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Target
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
@@ -5164,15 +7760,8 @@ func ParsePutScansScanIDResponse(rsp *http.Response) (*PutScansScanIDResponse, e
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanExists
+		var dest TargetExists
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5190,27 +7779,34 @@ func ParsePutScansScanIDResponse(rsp *http.Response) (*PutScansScanIDResponse, e
 	return response, nil
 }
 
-// ParseGetTargetsResponse parses an HTTP response from a GetTargetsWithResponse call
-func ParseGetTargetsResponse(rsp *http.Response) (*GetTargetsResponse, error) {
+// ParsePostTargetsImportResponse parses an HTTP response from a PostTargetsImportWithResponse call
+func ParsePostTargetsImportResponse(rsp *http.Response) (*PostTargetsImportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTargetsResponse{
+	response := &PostTargetsImportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Targets
+		var dest TargetImportReport
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5223,26 +7819,26 @@ func ParseGetTargetsResponse(rsp *http.Response) (*GetTargetsResponse, error) {
 	return response, nil
 }
 
-// ParsePostTargetsResponse parses an HTTP response from a PostTargetsWithResponse call
-func ParsePostTargetsResponse(rsp *http.Response) (*PostTargetsResponse, error) {
+// ParseGetTargetsUnscannedResponse parses an HTTP response from a GetTargetsUnscannedWithResponse call
+func ParseGetTargetsUnscannedResponse(rsp *http.Response) (*GetTargetsUnscannedResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostTargetsResponse{
+	response := &GetTargetsUnscannedResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Target
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Targets
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
@@ -5251,13 +7847,6 @@ func ParsePostTargetsResponse(rsp *http.Response) (*PostTargetsResponse, error)
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetExists
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
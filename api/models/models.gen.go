@@ -13,7 +13,9 @@ import (
 
 // Defines values for CloudProvider.
 const (
-	AWS CloudProvider = "AWS"
+	AWS   CloudProvider = "AWS"
+	Azure CloudProvider = "Azure"
+	GCP   CloudProvider = "GCP"
 )
 
 // Defines values for MisconfigurationSeverity.
@@ -45,6 +47,7 @@ const (
 // Defines values for ScanStateReason.
 const (
 	ScanStateReasonAborted                     ScanStateReason = "Aborted"
+	ScanStateReasonBudgetExhausted             ScanStateReason = "BudgetExhausted"
 	ScanStateReasonDiscoveryFailed             ScanStateReason = "DiscoveryFailed"
 	ScanStateReasonNothingToScan               ScanStateReason = "NothingToScan"
 	ScanStateReasonOneOrMoreTargetFailedToScan ScanStateReason = "OneOrMoreTargetFailedToScan"
@@ -66,6 +69,7 @@ const (
 // Defines values for ScanDataStateReason.
 const (
 	ScanDataStateReasonAborted                     ScanDataStateReason = "Aborted"
+	ScanDataStateReasonBudgetExhausted             ScanDataStateReason = "BudgetExhausted"
 	ScanDataStateReasonDiscoveryFailed             ScanDataStateReason = "DiscoveryFailed"
 	ScanDataStateReasonNothingToScan               ScanDataStateReason = "NothingToScan"
 	ScanDataStateReasonOneOrMoreTargetFailedToScan ScanDataStateReason = "OneOrMoreTargetFailedToScan"
@@ -74,6 +78,13 @@ const (
 	ScanDataStateReasonUnexpected                  ScanDataStateReason = "Unexpected"
 )
 
+// Defines values for ScanFamiliesConfigPreset.
+const (
+	Full        ScanFamiliesConfigPreset = "full"
+	Quick       ScanFamiliesConfigPreset = "quick"
+	SecretsOnly ScanFamiliesConfigPreset = "secrets-only"
+)
+
 // Defines values for ScanType.
 const (
 	EXPLOIT          ScanType = "EXPLOIT"
@@ -85,6 +96,32 @@ const (
 	VULNERABILITY    ScanType = "VULNERABILITY"
 )
 
+// Defines values for ScannerImagePullCredentialsMethod.
+const (
+	EcrInstanceProfile ScannerImagePullCredentialsMethod = "ecrInstanceProfile"
+	Static             ScannerImagePullCredentialsMethod = "static"
+)
+
+// Defines values for SnapshotIntegrityCheckState.
+const (
+	MISMATCH    SnapshotIntegrityCheckState = "MISMATCH"
+	UNSUPPORTED SnapshotIntegrityCheckState = "UNSUPPORTED"
+	VERIFIED    SnapshotIntegrityCheckState = "VERIFIED"
+)
+
+// Defines values for TagSelectorOperator.
+const (
+	AND TagSelectorOperator = "AND"
+	OR  TagSelectorOperator = "OR"
+)
+
+// Defines values for TargetImportRowResultResult.
+const (
+	Created TargetImportRowResultResult = "Created"
+	Failed  TargetImportRowResultResult = "Failed"
+	Skipped TargetImportRowResultResult = "Skipped"
+)
+
 // Defines values for TargetScanStateState.
 const (
 	ABORTED    TargetScanStateState = "ABORTED"
@@ -104,6 +141,18 @@ const (
 	NEGLIGIBLE VulnerabilitySeverity = "NEGLIGIBLE"
 )
 
+// Defines values for GetScanResultsScanResultIDParamsFormat.
+const (
+	Json  GetScanResultsScanResultIDParamsFormat = "json"
+	Sarif GetScanResultsScanResultIDParamsFormat = "sarif"
+)
+
+// Defines values for GetScanResultsScanResultIDSbomParamsFormat.
+const (
+	Cyclonedx GetScanResultsScanResultIDSbomParamsFormat = "cyclonedx"
+	Spdx      GetScanResultsScanResultIDSbomParamsFormat = "spdx"
+)
+
 // ApiResponse An object that is returned in all cases of failures.
 type ApiResponse struct {
 	Message *string `json:"message,omitempty"`
@@ -129,11 +178,19 @@ type AwsScanScope struct {
 	// InstanceTagExclusion VM instances will not be scanned if they contain all of these tags (even if they match instanceTagSelector). If empty, not taken into account.
 	InstanceTagExclusion *[]Tag `json:"instanceTagExclusion"`
 
-	// InstanceTagSelector VM instances will be scanned if they contain all of these tags. If empty, not taken into account.
-	InstanceTagSelector        *[]Tag       `json:"instanceTagSelector"`
-	ObjectType                 string       `json:"objectType"`
-	Regions                    *[]AwsRegion `json:"regions"`
-	ShouldScanStoppedInstances *bool        `json:"shouldScanStoppedInstances,omitempty"`
+	// InstanceTagSelector VM instances will be scanned if they contain all of these tags, or any of them if instanceTagSelectorOperator is OR. If empty, not taken into account.
+	InstanceTagSelector *[]Tag `json:"instanceTagSelector"`
+
+	// InstanceTagSelectorOperator How the tags of a tag selector are combined. AND requires all of the tags, OR requires any of them. Defaults to AND.
+	InstanceTagSelectorOperator *TagSelectorOperator `json:"instanceTagSelectorOperator,omitempty"`
+	ObjectType                  string               `json:"objectType"`
+	Regions                     *[]AwsRegion         `json:"regions"`
+
+	// ShouldScanStoppedInstances Also scan the VM instances which are stopped (deallocated on
+	// Azure, terminated or suspended on GCP). They aren't started, the
+	// snapshots are taken of their volumes as they are. Only the
+	// running VM instances are scanned if not set.
+	ShouldScanStoppedInstances *bool `json:"shouldScanStoppedInstances,omitempty"`
 }
 
 // AwsSecurityGroup AWS security group
@@ -147,6 +204,35 @@ type AwsVPC struct {
 	SecurityGroups *[]AwsSecurityGroup `json:"securityGroups"`
 }
 
+// AzureScanScope The scope of a configured scan in an Azure subscription.
+type AzureScanScope struct {
+	// InstanceTagExclusion VM instances will not be scanned if they contain all of these tags (even if they match instanceTagSelector). If empty, not taken into account.
+	InstanceTagExclusion *[]Tag `json:"instanceTagExclusion"`
+
+	// InstanceTagSelector VM instances will be scanned if they contain all of these tags, or any of them if instanceTagSelectorOperator is OR. If empty, not taken into account.
+	InstanceTagSelector *[]Tag `json:"instanceTagSelector"`
+
+	// InstanceTagSelectorOperator How the tags of a tag selector are combined. AND requires all of the tags, OR requires any of them. Defaults to AND.
+	InstanceTagSelectorOperator *TagSelectorOperator `json:"instanceTagSelectorOperator,omitempty"`
+	ObjectType                  string               `json:"objectType"`
+
+	// ResourceGroups Scan only VM instances in these resource groups. If empty, all resource groups in the subscription are scanned.
+	ResourceGroups *[]string `json:"resourceGroups"`
+
+	// ShouldScanStoppedInstances Also scan the VM instances which are stopped (deallocated on
+	// Azure, terminated or suspended on GCP). They aren't started, the
+	// snapshots are taken of their volumes as they are. Only the
+	// running VM instances are scanned if not set.
+	ShouldScanStoppedInstances *bool `json:"shouldScanStoppedInstances,omitempty"`
+}
+
+// AzureSubscriptionScope Azure subscription scope
+type AzureSubscriptionScope struct {
+	ObjectType     string    `json:"objectType"`
+	ResourceGroups *[]string `json:"resourceGroups"`
+	SubscriptionID *string   `json:"subscriptionID,omitempty"`
+}
+
 // CloudProvider defines model for CloudProvider.
 type CloudProvider string
 
@@ -157,6 +243,16 @@ type DirInfo struct {
 	ObjectType string  `json:"objectType"`
 }
 
+// ExistingSnapshot An existing snapshot of the root volume of a target to scan.
+type ExistingSnapshot struct {
+	// InstanceID The ID of the instance of the target.
+	InstanceID string `json:"instanceID"`
+
+	// SnapshotID The provider specific ID of the snapshot, the snapshot ID in
+	// AWS, the snapshot resource ID in Azure or the snapshot name in GCP.
+	SnapshotID string `json:"snapshotID"`
+}
+
 // Exploit defines model for Exploit.
 type Exploit struct {
 	CveID       *string   `json:"cveID,omitempty"`
@@ -201,8 +297,14 @@ type Finding struct {
 	// InvalidatedOn When this finding was invalidated by a newer scan
 	InvalidatedOn *time.Time `json:"invalidatedOn,omitempty"`
 
+	// Partition The device name of the partition of the asset this finding was found on, if known
+	Partition *string `json:"partition,omitempty"`
+
 	// Scan Describes an expandable relationship to Scan object
 	Scan *ScanRelationship `json:"scan,omitempty"`
+
+	// Tags The tags set by the tagging rules matching the finding when it was reported. Filter on a tag with contains(tags, '"<tag>"').
+	Tags *[]string `json:"tags,omitempty"`
 }
 
 // Finding_FindingInfo defines model for Finding.FindingInfo.
@@ -227,6 +329,63 @@ type Findings struct {
 	Items *[]Finding `json:"items,omitempty"`
 }
 
+// GcpProjectScope GCP project scope
+type GcpProjectScope struct {
+	ObjectType string    `json:"objectType"`
+	ProjectID  *string   `json:"projectID,omitempty"`
+	Zones      *[]string `json:"zones"`
+}
+
+// GcpScanScope The scope of a configured scan in a GCP project.
+type GcpScanScope struct {
+	// InstanceTagExclusion VM instances will not be scanned if they contain all of these labels (even if they match instanceTagSelector). If empty, not taken into account.
+	InstanceTagExclusion *[]Tag `json:"instanceTagExclusion"`
+
+	// InstanceTagSelector VM instances will be scanned if they contain all of these labels, or any of them if instanceTagSelectorOperator is OR. If empty, not taken into account.
+	InstanceTagSelector *[]Tag `json:"instanceTagSelector"`
+
+	// InstanceTagSelectorOperator How the tags of a tag selector are combined. AND requires all of the tags, OR requires any of them. Defaults to AND.
+	InstanceTagSelectorOperator *TagSelectorOperator `json:"instanceTagSelectorOperator,omitempty"`
+	ObjectType                  string               `json:"objectType"`
+
+	// ShouldScanStoppedInstances Also scan the VM instances which are stopped (deallocated on
+	// Azure, terminated or suspended on GCP). They aren't started, the
+	// snapshots are taken of their volumes as they are. Only the
+	// running VM instances are scanned if not set.
+	ShouldScanStoppedInstances *bool `json:"shouldScanStoppedInstances,omitempty"`
+
+	// Zones Scan only VM instances in these zones. If empty, all zones in the project are scanned.
+	Zones *[]string `json:"zones"`
+}
+
+// IgnoreRule Ignores the findings matching all of the set conditions, at least one
+// condition must be set. A rule with vulnerabilityName, secretRuleID or
+// misconfigurationTestID matches only findings of that type, a rule with
+// only a path matches the vulnerabilities, secrets and misconfigurations
+// found under it.
+type IgnoreRule struct {
+	// MisconfigurationTestID The ID of the test of the misconfiguration scanner which found the misconfiguration.
+	MisconfigurationTestID *string `json:"misconfigurationTestID,omitempty"`
+
+	// Path A glob of the path of the finding, for example /opt/*/testdata.
+	// The findings under a matching directory match as well.
+	Path *string `json:"path,omitempty"`
+
+	// Reason Why the findings are accepted.
+	Reason *string `json:"reason,omitempty"`
+
+	// SecretRuleID The ID of the rule of the secrets scanner which found the secret, for example generic-api-key.
+	SecretRuleID *string `json:"secretRuleID,omitempty"`
+
+	// VulnerabilityName The name of the vulnerability, for example a CVE ID.
+	VulnerabilityName *string `json:"vulnerabilityName,omitempty"`
+}
+
+// IgnoreRules Organization defined rules of the known accepted findings, which are excluded from the findings totals of the scans.
+type IgnoreRules struct {
+	Rules *[]IgnoreRule `json:"rules,omitempty"`
+}
+
 // Malware defines model for Malware.
 type Malware struct {
 	MalwareName *string      `json:"malwareName,omitempty"`
@@ -239,6 +398,9 @@ type Malware struct {
 // MalwareConfig defines model for MalwareConfig.
 type MalwareConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// ScannersList The malware scanners to run (clam, yara). If not set, the default scanner (clam) will be used.
+	ScannersList *[]string `json:"scannersList,omitempty"`
 }
 
 // MalwareFindingInfo defines model for MalwareFindingInfo.
@@ -297,6 +459,21 @@ type MisconfigurationSeverity string
 // MisconfigurationsConfig defines model for MisconfigurationsConfig.
 type MisconfigurationsConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// ScannersList The misconfiguration scanners to run (lynis, kics). If not set, the default scanner (lynis) will be used.
+	ScannersList *[]string `json:"scannersList,omitempty"`
+}
+
+// MisconfigurationsDiff defines model for MisconfigurationsDiff.
+type MisconfigurationsDiff struct {
+	// Added The misconfigurations found only in the scan result.
+	Added *[]Misconfiguration `json:"added,omitempty"`
+
+	// Removed The misconfigurations found only in the scan result compared with.
+	Removed *[]Misconfiguration `json:"removed,omitempty"`
+
+	// Unchanged The misconfigurations found in both scan results.
+	Unchanged *[]Misconfiguration `json:"unchanged,omitempty"`
 }
 
 // Package defines model for Package.
@@ -322,6 +499,18 @@ type PackageFindingInfo struct {
 	Version    *string   `json:"version,omitempty"`
 }
 
+// PackageManagerHints Hints about the operating system of the scanned targets. When set, the
+// package databases of the hinted package managers are catalogued
+// explicitly in addition to the configured SBOM analyzers, which improves
+// package detection on less common distributions.
+type PackageManagerHints struct {
+	// Distro The distribution of the scanned targets, for example alpine, debian, ubuntu, rhel, amazonlinux, gentoo or arch. Its package manager is added to the hinted package managers.
+	Distro *string `json:"distro,omitempty"`
+
+	// PackageManagers The package managers installed on the scanned targets, one of apk, dpkg, rpm, portage or alpm.
+	PackageManagers *[]string `json:"packageManagers,omitempty"`
+}
+
 // PodInfo defines model for PodInfo.
 type PodInfo struct {
 	Location   *string `json:"location,omitempty"`
@@ -329,6 +518,50 @@ type PodInfo struct {
 	PodName    *string `json:"podName,omitempty"`
 }
 
+// ProviderInstance An instance as it is currently discovered by the provider.
+type ProviderInstance struct {
+	AvailabilityZone *string        `json:"availabilityZone,omitempty"`
+	InstanceID       *string        `json:"instanceID,omitempty"`
+	InstanceProvider *CloudProvider `json:"instanceProvider,omitempty"`
+
+	// Location The region on AWS and Azure, the zone on GCP.
+	Location *string `json:"location,omitempty"`
+
+	// State The state of the instance as reported by the provider.
+	State *string `json:"state,omitempty"`
+
+	// Tags The tags of the instance, the labels on GCP.
+	Tags *[]Tag `json:"tags,omitempty"`
+}
+
+// ProviderInstances defines model for ProviderInstances.
+type ProviderInstances struct {
+	// Count Total count of the instances discovered by the provider.
+	Count *int `json:"count,omitempty"`
+
+	// Items List of the instances in the given page.
+	Items *[]ProviderInstance `json:"items,omitempty"`
+}
+
+// RegistryAuth Credentials of a container registry. Either username and password or
+// token should be set. The password and the token are write-only, they
+// aren't returned by the API and can't be filtered or selected on, so
+// they have to be set again when the auths are updated.
+type RegistryAuth struct {
+	// Authority The registry the credentials are used for, for example docker.io.
+	Authority string  `json:"authority"`
+	Password  *string `json:"password,omitempty"`
+	Token     *string `json:"token,omitempty"`
+	Username  *string `json:"username,omitempty"`
+}
+
+// RegistryConfig Configuration of the container registries accessed by the scanners, for example to pull private images referenced on a target.
+type RegistryConfig struct {
+	Auths         *[]RegistryAuth `json:"auths,omitempty"`
+	SkipVerifyTLS *bool           `json:"skipVerifyTLS,omitempty"`
+	UseHTTP       *bool           `json:"useHTTP,omitempty"`
+}
+
 // Rootkit defines model for Rootkit.
 type Rootkit struct {
 	Message     *string      `json:"message,omitempty"`
@@ -355,25 +588,47 @@ type RootkitType string
 // RootkitsConfig defines model for RootkitsConfig.
 type RootkitsConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// ScannersList The rootkit scanners to run. If not set, the default scanner (chkrootkit) will be used.
+	ScannersList *[]string `json:"scannersList,omitempty"`
 }
 
-// RuntimeScheduleScanConfig Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
+// RuntimeScheduleScanConfig Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime, the ScanConfig is disabled once the scan is started, or if the operationTime was missed. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
 type RuntimeScheduleScanConfig struct {
 	// CronLine Cron schedule expressions.
 	CronLine *string `json:"cronLine,omitempty"`
 
 	// OperationTime The next time this ScanConfig should trigger a scan.
 	OperationTime *time.Time `json:"operationTime,omitempty"`
+
+	// Timezone IANA time zone name the cronLine is evaluated in, for example Europe/London. If not set, the cronLine is evaluated in the time zone of the operationTime.
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // SBOMConfig defines model for SBOMConfig.
 type SBOMConfig struct {
-	Enabled *bool `json:"enabled,omitempty"`
+	// AnalyzersList The SBOM analyzers to run. If not set, the default analyzers (syft and trivy) will be used.
+	AnalyzersList *[]string `json:"analyzersList,omitempty"`
+	Enabled       *bool     `json:"enabled,omitempty"`
+
+	// PackageManagerHints Hints about the operating system of the scanned targets. When set, the
+	// package databases of the hinted package managers are catalogued
+	// explicitly in addition to the configured SBOM analyzers, which improves
+	// package detection on less common distributions.
+	PackageManagerHints *PackageManagerHints `json:"packageManagerHints,omitempty"`
+
+	// Registry Configuration of the container registries accessed by the scanners, for example to pull private images referenced on a target.
+	Registry *RegistryConfig `json:"registry,omitempty"`
+
+	// TrivyTimeoutSeconds Timeout of the trivy SBOM analyzer in seconds. If not set, a default of 300 seconds will be used.
+	TrivyTimeoutSeconds *int `json:"trivyTimeoutSeconds,omitempty"`
 }
 
 // SbomScan defines model for SbomScan.
 type SbomScan struct {
-	Packages *[]Package `json:"packages"`
+	// PackageManagerHints The package managers whose package databases were catalogued because of the package manager hints of the scan config.
+	PackageManagerHints *[]string  `json:"packageManagerHints,omitempty"`
+	Packages            *[]Package `json:"packages"`
 }
 
 // Scan defines model for Scan.
@@ -412,23 +667,74 @@ type ScanState string
 // ScanStateReason Machine-readable, UpperCamelCase text indicating the reason for the condition's last transition.
 type ScanStateReason string
 
+// ScanAbort The result of a scan abort request.
+type ScanAbort struct {
+	// AlreadyAborted Set if the scan was already aborted before this request.
+	AlreadyAborted *bool `json:"alreadyAborted,omitempty"`
+
+	// JobsRunning The number of scan jobs of the scan which were still running when the abort was requested.
+	JobsRunning *int `json:"jobsRunning,omitempty"`
+}
+
 // ScanConfig defines model for ScanConfig.
 type ScanConfig struct {
 	// Disabled if true, the scan config is disabled and no scan should run from it
-	Disabled            *bool   `json:"disabled,omitempty"`
-	Id                  *string `json:"id,omitempty"`
-	MaxParallelScanners *int    `json:"maxParallelScanners,omitempty"`
-	Name                *string `json:"name,omitempty"`
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// ExistingSnapshots Existing snapshots of the targets' root volumes, for example
+	// golden or backup snapshots, which are scanned instead of taking
+	// a new snapshot of the root volume. The snapshots are copied to
+	// the scanner region if needed and are not deleted after the scan.
+	ExistingSnapshots *[]ExistingSnapshot `json:"existingSnapshots,omitempty"`
+	Id                *string             `json:"id,omitempty"`
+
+	// IgnoreRules Rules of the known accepted findings of the scans of this config,
+	// applied in addition to the global ignore rules.
+	IgnoreRules         *[]IgnoreRule `json:"ignoreRules,omitempty"`
+	MaxParallelScanners *int          `json:"maxParallelScanners,omitempty"`
+
+	// MaxScannerInstanceHours The budget of scanner instance hours, summed over the scan jobs
+	// of each scan. Once it is used up no new scan jobs are launched,
+	// the remaining targets are not scanned and the scan is completed
+	// when the running jobs are done. If not set, the budget is
+	// unlimited.
+	MaxScannerInstanceHours *float64 `json:"maxScannerInstanceHours,omitempty"`
+	Name                    *string  `json:"name,omitempty"`
+
+	// NextRunTime The next time a scan will be started from this ScanConfig according to its schedule. Not set if the ScanConfig is disabled or will not start any more scans.
+	NextRunTime *time.Time `json:"nextRunTime,omitempty"`
+
+	// PartitionsToScan The partitions of the targets' volumes to scan, identified by
+	// their filesystem label, filesystem UUID or device name. If not
+	// set, all the partitions are scanned.
+	PartitionsToScan *[]string `json:"partitionsToScan,omitempty"`
+
+	// ScanAllVolumes If true, all the volumes attached to the targets are scanned
+	// instead of only their root volume. The findings of all the
+	// volumes of a target are reported in its scan result.
+	ScanAllVolumes *bool `json:"scanAllVolumes,omitempty"`
 
 	// ScanFamiliesConfig The configuration of the scanner families within a scan config
 	ScanFamiliesConfig *ScanFamiliesConfig `json:"scanFamiliesConfig,omitempty"`
 
+	// ScanJobTimeoutSeconds The timeout in seconds of the scan job of each target. If not set, the orchestrator's global job result timeout is used
+	ScanJobTimeoutSeconds *int `json:"scanJobTimeoutSeconds,omitempty"`
+
 	// ScannerInstanceCreationConfig Configuration of scanner instance
 	ScannerInstanceCreationConfig *ScannerInstanceCreationConfig `json:"scannerInstanceCreationConfig,omitempty"`
 
-	// Scheduled Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
+	// Scheduled Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime, the ScanConfig is disabled once the scan is started, or if the operationTime was missed. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
 	Scheduled *RuntimeScheduleScanConfig `json:"scheduled,omitempty"`
 	Scope     *ScanScopeType             `json:"scope,omitempty"`
+
+	// VerifySnapshotIntegrity If true, the copies of the targets' snapshots in the scanner
+	// region are verified against the source snapshots by comparing
+	// the checksums of their blocks before they are scanned, where the
+	// provider exposes them. A target whose copy doesn't match its
+	// source isn't scanned. The verification of a copy is recorded in
+	// the scan result of the target and reads all the blocks of both
+	// snapshots, which adds time to the scan.
+	VerifySnapshotIntegrity *bool `json:"verifySnapshotIntegrity,omitempty"`
 }
 
 // ScanConfigData Fields for a ScanConfig so they can be shared between the ScanConfig,
@@ -438,19 +744,58 @@ type ScanConfigData struct {
 	// Disabled if true, the scan config is disabled and no scan should run from it
 	Disabled *bool `json:"disabled,omitempty"`
 
+	// ExistingSnapshots Existing snapshots of the targets' root volumes, for example
+	// golden or backup snapshots, which are scanned instead of taking
+	// a new snapshot of the root volume. The snapshots are copied to
+	// the scanner region if needed and are not deleted after the scan.
+	ExistingSnapshots *[]ExistingSnapshot `json:"existingSnapshots,omitempty"`
+
+	// IgnoreRules Rules of the known accepted findings of the scans of this config,
+	// applied in addition to the global ignore rules.
+	IgnoreRules *[]IgnoreRule `json:"ignoreRules,omitempty"`
+
 	// MaxParallelScanners The maximum number of scanners that can run in parallel for each scan
-	MaxParallelScanners *int    `json:"maxParallelScanners,omitempty"`
-	Name                *string `json:"name,omitempty"`
+	MaxParallelScanners *int `json:"maxParallelScanners,omitempty"`
+
+	// MaxScannerInstanceHours The budget of scanner instance hours, summed over the scan jobs
+	// of each scan. Once it is used up no new scan jobs are launched,
+	// the remaining targets are not scanned and the scan is completed
+	// when the running jobs are done. If not set, the budget is
+	// unlimited.
+	MaxScannerInstanceHours *float64 `json:"maxScannerInstanceHours,omitempty"`
+	Name                    *string  `json:"name,omitempty"`
+
+	// PartitionsToScan The partitions of the targets' volumes to scan, identified by
+	// their filesystem label, filesystem UUID or device name. If not
+	// set, all the partitions are scanned.
+	PartitionsToScan *[]string `json:"partitionsToScan,omitempty"`
+
+	// ScanAllVolumes If true, all the volumes attached to the targets are scanned
+	// instead of only their root volume. The findings of all the
+	// volumes of a target are reported in its scan result.
+	ScanAllVolumes *bool `json:"scanAllVolumes,omitempty"`
 
 	// ScanFamiliesConfig The configuration of the scanner families within a scan config
 	ScanFamiliesConfig *ScanFamiliesConfig `json:"scanFamiliesConfig,omitempty"`
 
+	// ScanJobTimeoutSeconds The timeout in seconds of the scan job of each target. If not set, the orchestrator's global job result timeout is used
+	ScanJobTimeoutSeconds *int `json:"scanJobTimeoutSeconds,omitempty"`
+
 	// ScannerInstanceCreationConfig Configuration of scanner instance
 	ScannerInstanceCreationConfig *ScannerInstanceCreationConfig `json:"scannerInstanceCreationConfig,omitempty"`
 
-	// Scheduled Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
+	// Scheduled Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime, the ScanConfig is disabled once the scan is started, or if the operationTime was missed. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
 	Scheduled *RuntimeScheduleScanConfig `json:"scheduled,omitempty"`
 	Scope     *ScanScopeType             `json:"scope,omitempty"`
+
+	// VerifySnapshotIntegrity If true, the copies of the targets' snapshots in the scanner
+	// region are verified against the source snapshots by comparing
+	// the checksums of their blocks before they are scanned, where the
+	// provider exposes them. A target whose copy doesn't match its
+	// source isn't scanned. The verification of a copy is recorded in
+	// the scan result of the target and reads all the blocks of both
+	// snapshots, which adds time to the scan.
+	VerifySnapshotIntegrity *bool `json:"verifySnapshotIntegrity,omitempty"`
 }
 
 // ScanConfigExists defines model for ScanConfigExists.
@@ -462,18 +807,48 @@ type ScanConfigExists struct {
 	ScanConfig *ScanConfig `json:"scanConfig,omitempty"`
 }
 
+// ScanConfigProblem defines model for ScanConfigProblem.
+type ScanConfigProblem struct {
+	// Field The path of the scan config field the problem was found on, for example scanFamiliesConfig.secrets.scannersList.
+	Field   *string `json:"field,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
 // ScanConfigRelationship defines model for ScanConfigRelationship.
 type ScanConfigRelationship struct {
-	Disabled            *interface{} `json:"disabled,omitempty"`
-	Id                  string       `json:"id"`
-	MaxParallelScanners *interface{} `json:"maxParallelScanners,omitempty"`
-	Name                *interface{} `json:"name,omitempty"`
-	ScanFamiliesConfig  *interface{} `json:"scanFamiliesConfig,omitempty"`
+	Disabled          *interface{} `json:"disabled,omitempty"`
+	ExistingSnapshots *interface{} `json:"existingSnapshots,omitempty"`
+	Id                string       `json:"id"`
+
+	// IgnoreRules Rules of the known accepted findings of the scans of this config,
+	// applied in addition to the global ignore rules.
+	IgnoreRules             *[]IgnoreRule `json:"ignoreRules,omitempty"`
+	MaxParallelScanners     *interface{}  `json:"maxParallelScanners,omitempty"`
+	MaxScannerInstanceHours *interface{}  `json:"maxScannerInstanceHours,omitempty"`
+	Name                    *interface{}  `json:"name,omitempty"`
+	PartitionsToScan        *interface{}  `json:"partitionsToScan,omitempty"`
+	ScanAllVolumes          *interface{}  `json:"scanAllVolumes,omitempty"`
+	ScanFamiliesConfig      *interface{}  `json:"scanFamiliesConfig,omitempty"`
+	ScanJobTimeoutSeconds   *interface{}  `json:"scanJobTimeoutSeconds,omitempty"`
 
 	// ScannerInstanceCreationConfig Configuration of scanner instance
 	ScannerInstanceCreationConfig *ScannerInstanceCreationConfig `json:"scannerInstanceCreationConfig,omitempty"`
 	Scheduled                     *interface{}                   `json:"scheduled,omitempty"`
 	Scope                         *interface{}                   `json:"scope,omitempty"`
+	VerifySnapshotIntegrity       *interface{}                   `json:"verifySnapshotIntegrity,omitempty"`
+}
+
+// ScanConfigRun The scan started by a run of a scan config.
+type ScanConfigRun struct {
+	ScanID *string `json:"scanID,omitempty"`
+}
+
+// ScanConfigValidation The result of the validation of a scan config.
+type ScanConfigValidation struct {
+	Problems *[]ScanConfigProblem `json:"problems,omitempty"`
+
+	// Valid True if no problem was found.
+	Valid *bool `json:"valid,omitempty"`
 }
 
 // ScanConfigs defines model for ScanConfigs.
@@ -531,14 +906,48 @@ type ScanExists struct {
 
 // ScanFamiliesConfig The configuration of the scanner families within a scan config
 type ScanFamiliesConfig struct {
-	Exploits          *ExploitsConfig          `json:"exploits,omitempty"`
-	Malware           *MalwareConfig           `json:"malware,omitempty"`
-	Misconfigurations *MisconfigurationsConfig `json:"misconfigurations,omitempty"`
-	Rootkits          *RootkitsConfig          `json:"rootkits,omitempty"`
-	Sbom              *SBOMConfig              `json:"sbom,omitempty"`
-	Secrets           *SecretsConfig           `json:"secrets,omitempty"`
-	Vulnerabilities   *VulnerabilitiesConfig   `json:"vulnerabilities,omitempty"`
-}
+	Exploits *ExploitsConfig `json:"exploits,omitempty"`
+	Malware  *MalwareConfig  `json:"malware,omitempty"`
+
+	// MaxParallelScannersPerFamily The maximum number of scanners of a family, for example grype and
+	// trivy, which run at the same time on a scanner instance. The
+	// families run one after the other. If not set, all the scanners of
+	// a family run at once.
+	MaxParallelScannersPerFamily *int                     `json:"maxParallelScannersPerFamily,omitempty"`
+	Misconfigurations            *MisconfigurationsConfig `json:"misconfigurations,omitempty"`
+
+	// Preset A named combination of families the config is based on: quick
+	// scans the SBOM and the vulnerabilities, full scans all the
+	// families and secrets-only scans the secrets. The families set in
+	// this config are merged over the ones of the preset field by field,
+	// for example to enable another family or to disable a family of
+	// the preset.
+	Preset   *ScanFamiliesConfigPreset `json:"preset,omitempty"`
+	Rootkits *RootkitsConfig           `json:"rootkits,omitempty"`
+	Sbom     *SBOMConfig               `json:"sbom,omitempty"`
+
+	// ScannerCommandOptions Extra command line arguments and environment variables passed to
+	// the scanner binaries, keyed by scanner name. Supported by the
+	// chkrootkit, rkhunter, gitleaks, trufflehog, clam, yara, lynis,
+	// kics and osv scanners.
+	ScannerCommandOptions *map[string]ScannerCommandOptions `json:"scannerCommandOptions,omitempty"`
+
+	// ScannerConfigOverlay Optional partial families configuration in YAML format. It is
+	// deep-merged over the scanner configuration generated from this
+	// scan families config, allowing to tune scanner settings that
+	// are not exposed by the API.
+	ScannerConfigOverlay *string                `json:"scannerConfigOverlay,omitempty"`
+	Secrets              *SecretsConfig         `json:"secrets,omitempty"`
+	Vulnerabilities      *VulnerabilitiesConfig `json:"vulnerabilities,omitempty"`
+}
+
+// ScanFamiliesConfigPreset A named combination of families the config is based on: quick
+// scans the SBOM and the vulnerabilities, full scans all the
+// families and secrets-only scans the secrets. The families set in
+// this config are merged over the ones of the preset field by field,
+// for example to enable another family or to disable a family of
+// the preset.
+type ScanFamiliesConfigPreset string
 
 // ScanFindingsSummary A summary of the scan findings.
 type ScanFindingsSummary struct {
@@ -553,6 +962,59 @@ type ScanFindingsSummary struct {
 	TotalVulnerabilities *VulnerabilityScanSummary `json:"totalVulnerabilities,omitempty"`
 }
 
+// ScanJobResources The IDs of the provider resources of the scanner job of the target.
+// An orchestrator restarted while the job is running uses them to clean
+// up the job once it completes.
+type ScanJobResources struct {
+	// InstanceID The ID of the scanner instance.
+	InstanceID *string `json:"instanceID,omitempty"`
+
+	// InstanceLocation The location of the scanner instance, the region in AWS and
+	// Azure or the zone in GCP.
+	InstanceLocation *string `json:"instanceLocation,omitempty"`
+
+	// Volumes The resources of each scanned volume of the target, the root volume first.
+	Volumes *[]ScanJobVolumeResources `json:"volumes,omitempty"`
+}
+
+// ScanJobVolumeResources defines model for ScanJobVolumeResources.
+type ScanJobVolumeResources struct {
+	// DstSnapshotID The ID of the copy of the snapshot in the scanner region.
+	DstSnapshotID *string `json:"dstSnapshotID,omitempty"`
+
+	// ExistingSnapshot The snapshot of the target volume was provided by the scan config, it isn't deleted with the job.
+	ExistingSnapshot *bool `json:"existingSnapshot,omitempty"`
+
+	// SrcSnapshotID The ID of the snapshot of the target volume.
+	SrcSnapshotID *string `json:"srcSnapshotID,omitempty"`
+
+	// VolumeID The ID of the volume attached to the scanner instance.
+	VolumeID *string `json:"volumeID,omitempty"`
+}
+
+// ScanPlan The plan of a scan, computed without launching any infrastructure.
+type ScanPlan struct {
+	// FamiliesConfig The families configuration YAML the scanning jobs would run
+	// with, where the credentials are redacted.
+	FamiliesConfig *string `json:"familiesConfig,omitempty"`
+
+	// Jobs The number of scanning jobs which would run, one per target.
+	Jobs *int `json:"jobs,omitempty"`
+
+	// ParallelScanners The number of scanning jobs which would run in parallel.
+	ParallelScanners *int `json:"parallelScanners,omitempty"`
+
+	// Targets The targets which match the scope of the scan config.
+	Targets *[]ScanPlanTarget `json:"targets,omitempty"`
+}
+
+// ScanPlanTarget defines model for ScanPlanTarget.
+type ScanPlanTarget struct {
+	InstanceID       *string        `json:"instanceID,omitempty"`
+	InstanceProvider *CloudProvider `json:"instanceProvider,omitempty"`
+	Location         *string        `json:"location,omitempty"`
+}
+
 // ScanRelationship defines model for ScanRelationship.
 type ScanRelationship struct {
 	EndTime            *interface{} `json:"endTime,omitempty"`
@@ -567,6 +1029,16 @@ type ScanRelationship struct {
 	TargetIDs          *interface{} `json:"targetIDs,omitempty"`
 }
 
+// ScanResultDiff The findings of a scan result compared to another scan result of the same target.
+type ScanResultDiff struct {
+	AgainstScanResultID *string                `json:"againstScanResultID,omitempty"`
+	Misconfigurations   *MisconfigurationsDiff `json:"misconfigurations,omitempty"`
+	ScanResultID        *string                `json:"scanResultID,omitempty"`
+	Secrets             *SecretsDiff           `json:"secrets,omitempty"`
+	TargetID            *string                `json:"targetID,omitempty"`
+	Vulnerabilities     *VulnerabilitiesDiff   `json:"vulnerabilities,omitempty"`
+}
+
 // ScanScopeType defines model for ScanScopeType.
 type ScanScopeType struct {
 	union json.RawMessage
@@ -574,14 +1046,16 @@ type ScanScopeType struct {
 
 // ScanSummary defines model for ScanSummary.
 type ScanSummary struct {
-	JobsCompleted          *int `json:"jobsCompleted,omitempty"`
-	JobsLeftToRun          *int `json:"jobsLeftToRun,omitempty"`
-	TotalExploits          *int `json:"totalExploits,omitempty"`
-	TotalMalware           *int `json:"totalMalware,omitempty"`
-	TotalMisconfigurations *int `json:"totalMisconfigurations,omitempty"`
-	TotalPackages          *int `json:"totalPackages,omitempty"`
-	TotalRootkits          *int `json:"totalRootkits,omitempty"`
-	TotalSecrets           *int `json:"totalSecrets,omitempty"`
+	// IgnoredFindings A summary of the scan findings.
+	IgnoredFindings        *ScanFindingsSummary `json:"ignoredFindings,omitempty"`
+	JobsCompleted          *int                 `json:"jobsCompleted,omitempty"`
+	JobsLeftToRun          *int                 `json:"jobsLeftToRun,omitempty"`
+	TotalExploits          *int                 `json:"totalExploits,omitempty"`
+	TotalMalware           *int                 `json:"totalMalware,omitempty"`
+	TotalMisconfigurations *int                 `json:"totalMisconfigurations,omitempty"`
+	TotalPackages          *int                 `json:"totalPackages,omitempty"`
+	TotalRootkits          *int                 `json:"totalRootkits,omitempty"`
+	TotalSecrets           *int                 `json:"totalSecrets,omitempty"`
 
 	// TotalVulnerabilities A summary of number of vulnerabilities found per severity.
 	TotalVulnerabilities *VulnerabilityScanSummary `json:"totalVulnerabilities,omitempty"`
@@ -590,11 +1064,116 @@ type ScanSummary struct {
 // ScanType defines model for ScanType.
 type ScanType string
 
+// ScannedPartition defines model for ScannedPartition.
+type ScannedPartition struct {
+	DeviceName     *string `json:"deviceName,omitempty"`
+	FilesystemType *string `json:"filesystemType,omitempty"`
+	Label          *string `json:"label,omitempty"`
+
+	// MountPoint Where the partition was mounted by the scanner, set only if it was scanned.
+	MountPoint *string `json:"mountPoint,omitempty"`
+
+	// Reason Why the partition was not scanned.
+	Reason  *string `json:"reason,omitempty"`
+	Scanned *bool   `json:"scanned,omitempty"`
+	Uuid    *string `json:"uuid,omitempty"`
+}
+
+// ScannerCommandOptions Extra command line arguments and environment variables of a scanner binary
+type ScannerCommandOptions struct {
+	// Env Environment variables set for the scanner process.
+	Env *map[string]string `json:"env,omitempty"`
+
+	// ExtraArgs Arguments appended to the scanner command line. The arguments are
+	// not interpreted by a shell, and shell metacharacters are rejected.
+	ExtraArgs *[]string `json:"extraArgs,omitempty"`
+}
+
+// ScannerImagePullCredentials The credentials the scanner instance authenticates with to the
+// registry when it pulls the scanner image, for a scanner image in a
+// private registry. The password is write-only, it isn't returned by
+// the API and can't be filtered or selected on.
+type ScannerImagePullCredentials struct {
+	// Method static logs in with the username and password, which are stored
+	// in the scan config. ecrInstanceProfile logs in to ECR with the
+	// credentials of the instance profile of the scanner instance, so
+	// instanceProfileARN must be set and its role must be allowed to
+	// pull the scanner image.
+	Method   ScannerImagePullCredentialsMethod `json:"method"`
+	Password *string                           `json:"password,omitempty"`
+
+	// Registry The registry to authenticate to, such as
+	// <account ID>.dkr.ecr.<region>.amazonaws.com. The registry of the
+	// scanner image if not set.
+	Registry *string `json:"registry,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// ScannerImagePullCredentialsMethod static logs in with the username and password, which are stored
+// in the scan config. ecrInstanceProfile logs in to ECR with the
+// credentials of the instance profile of the scanner instance, so
+// instanceProfileARN must be set and its role must be allowed to
+// pull the scanner image.
+type ScannerImagePullCredentialsMethod string
+
 // ScannerInstanceCreationConfig Configuration of scanner instance
 type ScannerInstanceCreationConfig struct {
-	MaxPrice         *string `json:"maxPrice,omitempty"`
-	RetryMaxAttempts *int    `json:"retryMaxAttempts,omitempty"`
-	UseSpotInstances bool    `json:"useSpotInstances"`
+	// InstanceMetadataHopLimit The number of network hops the responses of the instance metadata
+	// service of the scanner instance may travel, 1 keeps them on the
+	// instance. Only supported by AWS, the provider default is kept if
+	// not set.
+	InstanceMetadataHopLimit *int `json:"instanceMetadataHopLimit,omitempty"`
+
+	// InstanceProfileARN The ARN of the IAM instance profile attached to the scanner
+	// instance, so that the scanner gets its AWS credentials from the
+	// instance metadata service instead of static credentials. Only
+	// supported by AWS, the orchestrator needs the iam:PassRole
+	// permission on the role of the instance profile.
+	InstanceProfileARN *string `json:"instanceProfileARN,omitempty"`
+
+	// InstanceTypesByVolumeSize Instance types of the scanner instance by the size of the scanned
+	// volume. The instance type of the entry with the largest
+	// minVolumeSizeGB which isn't larger than the size of the volume is
+	// used, the default instance type of the provider is used if none
+	// matches or the list is empty.
+	InstanceTypesByVolumeSize *[]ScannerInstanceTypeByVolumeSize `json:"instanceTypesByVolumeSize,omitempty"`
+	MaxPrice                  *string                            `json:"maxPrice,omitempty"`
+
+	// Proxy The proxy the scanner instance egresses through, to pull the scanner
+	// image and to download the scanner updates (grype DB, freshclam...).
+	// Each field which is set overrides the proxy configured on the
+	// orchestrator (SCANNER_HTTP_PROXY, SCANNER_HTTPS_PROXY and
+	// SCANNER_NO_PROXY), the ones which aren't set fall back to it.
+	Proxy *ScannerProxyConfig `json:"proxy,omitempty"`
+
+	// RequireIMDSv2 Require session tokens (IMDSv2) for the requests to the instance
+	// metadata service of the scanner instance. Only supported by AWS,
+	// the provider default is kept if not set.
+	RequireIMDSv2    *bool `json:"requireIMDSv2,omitempty"`
+	RetryMaxAttempts *int  `json:"retryMaxAttempts,omitempty"`
+
+	// ScannerImagePullCredentials The credentials the scanner instance authenticates with to the
+	// registry when it pulls the scanner image, for a scanner image in a
+	// private registry. The password is write-only, it isn't returned by
+	// the API and can't be filtered or selected on.
+	ScannerImagePullCredentials *ScannerImagePullCredentials `json:"scannerImagePullCredentials,omitempty"`
+	UseSpotInstances            bool                         `json:"useSpotInstances"`
+}
+
+// ScannerInstanceTypeByVolumeSize defines model for ScannerInstanceTypeByVolumeSize.
+type ScannerInstanceTypeByVolumeSize struct {
+	// InstanceType The provider specific instance type, machine type or VM size of the scanner instance.
+	InstanceType    string `json:"instanceType"`
+	MinVolumeSizeGB int64  `json:"minVolumeSizeGB"`
+}
+
+// ScannerLogs defines model for ScannerLogs.
+type ScannerLogs struct {
+	// InstanceID The ID of the scanner instance.
+	InstanceID *string `json:"instanceID,omitempty"`
+
+	// Logs The console output of the scanner instance, with the secrets redacted.
+	Logs *string `json:"logs,omitempty"`
 }
 
 // ScannerMetadata defines model for ScannerMetadata.
@@ -603,6 +1182,25 @@ type ScannerMetadata struct {
 	ScannerSummary *ScannerSummary `json:"scannerSummary,omitempty"`
 }
 
+// ScannerProxyConfig The proxy the scanner instance egresses through, to pull the scanner
+// image and to download the scanner updates (grype DB, freshclam...).
+// Each field which is set overrides the proxy configured on the
+// orchestrator (SCANNER_HTTP_PROXY, SCANNER_HTTPS_PROXY and
+// SCANNER_NO_PROXY), the ones which aren't set fall back to it.
+type ScannerProxyConfig struct {
+	// HttpProxy The proxy of the HTTP requests, such as http://proxy:3128 or
+	// socks5://proxy:1080.
+	HttpProxy *string `json:"httpProxy,omitempty"`
+
+	// HttpsProxy The proxy of the HTTPS requests, such as http://proxy:3128 or
+	// socks5://proxy:1080.
+	HttpsProxy *string `json:"httpsProxy,omitempty"`
+
+	// NoProxy Comma separated hosts, domains and CIDRs which are reached
+	// without the proxy, such as the VMClarity server.
+	NoProxy *string `json:"noProxy,omitempty"`
+}
+
 // ScannerSummary defines model for ScannerSummary.
 type ScannerSummary struct {
 	DataRead           *string `json:"DataRead,omitempty"`
@@ -646,6 +1244,9 @@ type Secret struct {
 
 	// Fingerprint Note: this is not unique
 	Fingerprint *string `json:"fingerprint,omitempty"`
+
+	// RuleID The ID of the rule of the secrets scanner which found the secret.
+	RuleID      *string `json:"ruleID,omitempty"`
 	StartColumn *int    `json:"startColumn,omitempty"`
 	StartLine   *int    `json:"startLine,omitempty"`
 }
@@ -662,6 +1263,9 @@ type SecretFindingInfo struct {
 	// Fingerprint Note: this is not unique
 	Fingerprint *string `json:"fingerprint,omitempty"`
 	ObjectType  string  `json:"objectType"`
+
+	// RuleID The ID of the rule of the secrets scanner which found the secret.
+	RuleID      *string `json:"ruleID,omitempty"`
 	StartColumn *int    `json:"startColumn,omitempty"`
 	StartLine   *int    `json:"startLine,omitempty"`
 }
@@ -674,8 +1278,64 @@ type SecretScan struct {
 // SecretsConfig defines model for SecretsConfig.
 type SecretsConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// ScannersList The secrets scanners to run. If not set, the default scanner (gitleaks) will be used.
+	ScannersList *[]string `json:"scannersList,omitempty"`
 }
 
+// SecretsDiff defines model for SecretsDiff.
+type SecretsDiff struct {
+	// Added The secrets found only in the scan result.
+	Added *[]Secret `json:"added,omitempty"`
+
+	// Removed The secrets found only in the scan result compared with.
+	Removed *[]Secret `json:"removed,omitempty"`
+
+	// Unchanged The secrets found in both scan results.
+	Unchanged *[]Secret `json:"unchanged,omitempty"`
+}
+
+// SeverityOverride defines model for SeverityOverride.
+type SeverityOverride struct {
+	Severity VulnerabilitySeverity `json:"severity"`
+
+	// VulnerabilityName The name of the vulnerability to override, for example a CVE ID.
+	VulnerabilityName string `json:"vulnerabilityName"`
+}
+
+// SeverityOverrides Organization defined vulnerability severities which replace the severities assigned by the scanners.
+type SeverityOverrides struct {
+	Overrides *[]SeverityOverride `json:"overrides,omitempty"`
+}
+
+// SnapshotIntegrityCheck The verification of the copy of a snapshot in the scanner region
+// against the snapshot it was copied from.
+type SnapshotIntegrityCheck struct {
+	// Blocks The number of blocks of the source snapshot which were compared.
+	Blocks *int `json:"blocks,omitempty"`
+
+	// DstSnapshotID The ID of the copy of the snapshot in the scanner region.
+	DstSnapshotID *string `json:"dstSnapshotID,omitempty"`
+
+	// MismatchedBlocks The number of blocks which are missing, different or only written in the copy.
+	MismatchedBlocks *int `json:"mismatchedBlocks,omitempty"`
+
+	// SrcSnapshotID The ID of the snapshot of the target volume.
+	SrcSnapshotID *string `json:"srcSnapshotID,omitempty"`
+
+	// State VERIFIED if the checksums of all the blocks of the copy match
+	// the source snapshot, MISMATCH if any block is missing or
+	// different, and UNSUPPORTED if the provider doesn't expose the
+	// checksums of the blocks.
+	State *SnapshotIntegrityCheckState `json:"state,omitempty"`
+}
+
+// SnapshotIntegrityCheckState VERIFIED if the checksums of all the blocks of the copy match
+// the source snapshot, MISMATCH if any block is missing or
+// different, and UNSUPPORTED if the provider doesn't expose the
+// checksums of the blocks.
+type SnapshotIntegrityCheckState string
+
 // SuccessResponse An object that is returned in cases of success that returns nothing.
 type SuccessResponse struct {
 	Message *string `json:"message,omitempty"`
@@ -687,10 +1347,55 @@ type Tag struct {
 	Value string `json:"value"`
 }
 
+// TagSelectorOperator How the tags of a tag selector are combined. AND requires all of the tags, OR requires any of them. Defaults to AND.
+type TagSelectorOperator string
+
+// TaggingRule defines model for TaggingRule.
+type TaggingRule struct {
+	// Conditions The conditions of a tagging rule. All of the set conditions must match for the tag to be set, a rule without conditions matches everything.
+	Conditions TaggingRuleConditions `json:"conditions"`
+
+	// Name The unique name of the rule.
+	Name string `json:"name"`
+
+	// Tag The tag to set on the scan results and findings matching the rule, for example internet-facing.
+	Tag string `json:"tag"`
+}
+
+// TaggingRuleConditions The conditions of a tagging rule. All of the set conditions must match for the tag to be set, a rule without conditions matches everything.
+type TaggingRuleConditions struct {
+	// FindingTypes Matches findings of one of the types, or scan results with findings of one of the types. The types are Package, Vulnerability, Malware, Secret, Misconfiguration, Rootkit and Exploit.
+	FindingTypes             *[]string              `json:"findingTypes,omitempty"`
+	MinVulnerabilitySeverity *VulnerabilitySeverity `json:"minVulnerabilitySeverity,omitempty"`
+
+	// TargetApplication The application of the target as set in the target metadata.
+	TargetApplication *string `json:"targetApplication,omitempty"`
+
+	// TargetAttributes Attributes which must all be set with the given values in the target metadata, for example a publicIP attribute.
+	TargetAttributes *[]Tag `json:"targetAttributes,omitempty"`
+
+	// TargetCriticality The criticality of the target as set in the target metadata.
+	TargetCriticality *string `json:"targetCriticality,omitempty"`
+
+	// TargetLocation The location of the target, for example the region of a VM.
+	TargetLocation *string `json:"targetLocation,omitempty"`
+
+	// TargetOwner The owner of the target as set in the target metadata.
+	TargetOwner *string `json:"targetOwner,omitempty"`
+}
+
+// TaggingRules Organization defined rules which tag scan results and findings when they are reported.
+type TaggingRules struct {
+	Rules *[]TaggingRule `json:"rules,omitempty"`
+}
+
 // Target defines model for Target.
 type Target struct {
 	Id *string `json:"id,omitempty"`
 
+	// Metadata Business attributes of the target imported from an external asset inventory (CMDB).
+	Metadata *TargetMetadata `json:"metadata,omitempty"`
+
 	// ScansCount Total number of scans that have ever run for this target
 	ScansCount *int `json:"scansCount,omitempty"`
 
@@ -701,6 +1406,9 @@ type Target struct {
 
 // TargetCommon defines model for TargetCommon.
 type TargetCommon struct {
+	// Metadata Business attributes of the target imported from an external asset inventory (CMDB).
+	Metadata *TargetMetadata `json:"metadata,omitempty"`
+
 	// ScansCount Total number of scans that have ever run for this target
 	ScansCount *int `json:"scansCount,omitempty"`
 
@@ -718,32 +1426,100 @@ type TargetExists struct {
 	Target *Target `json:"target,omitempty"`
 }
 
+// TargetImport defines model for TargetImport.
+type TargetImport struct {
+	Targets []TargetImportRow `json:"targets"`
+}
+
+// TargetImportReport defines model for TargetImportReport.
+type TargetImportReport struct {
+	Created *int                     `json:"created,omitempty"`
+	Failed  *int                     `json:"failed,omitempty"`
+	Rows    *[]TargetImportRowResult `json:"rows,omitempty"`
+	Skipped *int                     `json:"skipped,omitempty"`
+}
+
+// TargetImportRow A VM target to import.
+type TargetImportRow struct {
+	InstanceID       *string        `json:"instanceID,omitempty"`
+	InstanceProvider *CloudProvider `json:"instanceProvider,omitempty"`
+	Location         *string        `json:"location,omitempty"`
+}
+
+// TargetImportRowResult defines model for TargetImportRowResult.
+type TargetImportRowResult struct {
+	InstanceID *string                      `json:"instanceID,omitempty"`
+	Location   *string                      `json:"location,omitempty"`
+	Message    *string                      `json:"message,omitempty"`
+	Result     *TargetImportRowResultResult `json:"result,omitempty"`
+
+	// Row The number of the row in the import file, starting from 1 and not counting the CSV header.
+	Row *int `json:"row,omitempty"`
+
+	// TargetId The ID of the created or the already existing target.
+	TargetId *string `json:"targetId,omitempty"`
+}
+
+// TargetImportRowResultResult defines model for TargetImportRowResult.Result.
+type TargetImportRowResultResult string
+
+// TargetMetadata Business attributes of the target imported from an external asset inventory (CMDB).
+type TargetMetadata struct {
+	Application *string `json:"application,omitempty"`
+
+	// Attributes Any additional attributes provided by the metadata source.
+	Attributes  *[]Tag  `json:"attributes,omitempty"`
+	Criticality *string `json:"criticality,omitempty"`
+	Owner       *string `json:"owner,omitempty"`
+}
+
 // TargetRelationship defines model for TargetRelationship.
 type TargetRelationship struct {
-	Id         string       `json:"id"`
-	ScansCount *interface{} `json:"scansCount,omitempty"`
-	Summary    *interface{} `json:"summary,omitempty"`
-	TargetInfo *interface{} `json:"targetInfo,omitempty"`
+	Id string `json:"id"`
+
+	// Metadata Business attributes of the target imported from an external asset inventory (CMDB).
+	Metadata   *TargetMetadata `json:"metadata,omitempty"`
+	ScansCount *interface{}    `json:"scansCount,omitempty"`
+	Summary    *interface{}    `json:"summary,omitempty"`
+	TargetInfo *interface{}    `json:"targetInfo,omitempty"`
 }
 
 // TargetScanResult defines model for TargetScanResult.
 type TargetScanResult struct {
-	Exploits          *ExploitScan          `json:"exploits,omitempty"`
-	FindingsProcessed *bool                 `json:"findingsProcessed,omitempty"`
-	Id                *string               `json:"id,omitempty"`
+	Exploits *ExploitScan `json:"exploits,omitempty"`
+
+	// FamiliesConfig The families configuration YAML generated for the scanner job of
+	// the target, with the credentials redacted.
+	FamiliesConfig    *string `json:"familiesConfig,omitempty"`
+	FindingsProcessed *bool   `json:"findingsProcessed,omitempty"`
+	Id                *string `json:"id,omitempty"`
+
+	// JobResources The IDs of the provider resources of the scanner job of the target.
+	// An orchestrator restarted while the job is running uses them to clean
+	// up the job once it completes.
+	JobResources      *ScanJobResources     `json:"jobResources,omitempty"`
 	Malware           *MalwareScan          `json:"malware,omitempty"`
 	Misconfigurations *MisconfigurationScan `json:"misconfigurations,omitempty"`
-	Rootkits          *RootkitScan          `json:"rootkits,omitempty"`
-	Sboms             *SbomScan             `json:"sboms,omitempty"`
+
+	// Partitions The partitions found on the target's volume and whether they were scanned.
+	Partitions *[]ScannedPartition `json:"partitions,omitempty"`
+	Rootkits   *RootkitScan        `json:"rootkits,omitempty"`
+	Sboms      *SbomScan           `json:"sboms,omitempty"`
 
 	// Scan Describes an expandable relationship to Scan object
 	Scan    *ScanRelationship `json:"scan,omitempty"`
 	Secrets *SecretScan       `json:"secrets,omitempty"`
-	Status  *TargetScanStatus `json:"status,omitempty"`
+
+	// SnapshotIntegrity The integrity verifications of the copies of the snapshots of the target's volumes, the root volume first.
+	SnapshotIntegrity *[]SnapshotIntegrityCheck `json:"snapshotIntegrity,omitempty"`
+	Status            *TargetScanStatus         `json:"status,omitempty"`
 
 	// Summary A summary of the scan findings.
 	Summary *ScanFindingsSummary `json:"summary,omitempty"`
 
+	// Tags The tags set by the tagging rules matching the scan result when it was reported. Filter on a tag with contains(tags, '"<tag>"').
+	Tags *[]string `json:"tags,omitempty"`
+
 	// Target Describes a relationship to a target which can be expanded.
 	Target          *TargetRelationship `json:"target,omitempty"`
 	Vulnerabilities *VulnerabilityScan  `json:"vulnerabilities,omitempty"`
@@ -763,6 +1539,13 @@ type TargetScanResults struct {
 
 	// Items List of scan results according to the given filters and page. List length must be lower or equal to pageSize.
 	Items *[]TargetScanResult `json:"items,omitempty"`
+
+	// NextCursor The $skip value to get the next scan results with, set only if truncated.
+	NextCursor *int `json:"nextCursor,omitempty"`
+
+	// Truncated Set when $top was not given and there are more scan results than
+	// the maximum number of scan results returned without pagination.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 // TargetScanState defines model for TargetScanState.
@@ -812,6 +1595,32 @@ type VMInfo struct {
 // VulnerabilitiesConfig defines model for VulnerabilitiesConfig.
 type VulnerabilitiesConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// GrypeServerTimeoutSeconds Timeout of the requests to the remote grype server in seconds,
+	// used when the orchestrator is configured with a grype server. If
+	// not set, the timeout of the orchestrator config will be used.
+	GrypeServerTimeoutSeconds *int `json:"grypeServerTimeoutSeconds,omitempty"`
+
+	// Registry Configuration of the container registries accessed by the scanners, for example to pull private images referenced on a target.
+	Registry *RegistryConfig `json:"registry,omitempty"`
+
+	// ScannersList The vulnerability scanners to run. If not set, the default scanners (grype and trivy) will be used.
+	ScannersList *[]string `json:"scannersList,omitempty"`
+
+	// TrivyTimeoutSeconds Timeout of the trivy vulnerability scanner in seconds. If not set, a default of 300 seconds will be used.
+	TrivyTimeoutSeconds *int `json:"trivyTimeoutSeconds,omitempty"`
+}
+
+// VulnerabilitiesDiff defines model for VulnerabilitiesDiff.
+type VulnerabilitiesDiff struct {
+	// Added The vulnerabilities found only in the scan result.
+	Added *[]Vulnerability `json:"added,omitempty"`
+
+	// Removed The vulnerabilities found only in the scan result compared with.
+	Removed *[]Vulnerability `json:"removed,omitempty"`
+
+	// Unchanged The vulnerabilities found in both scan results.
+	Unchanged *[]Vulnerability `json:"unchanged,omitempty"`
 }
 
 // Vulnerability defines model for Vulnerability.
@@ -820,10 +1629,13 @@ type Vulnerability struct {
 	Description *string              `json:"description,omitempty"`
 
 	// Distro Distro provides information about a detected Linux distribution.
-	Distro            *VulnerabilityDistro   `json:"distro,omitempty"`
-	Fix               *VulnerabilityFix      `json:"fix,omitempty"`
-	LayerId           *string                `json:"layerId,omitempty"`
-	Links             *[]string              `json:"links"`
+	Distro  *VulnerabilityDistro `json:"distro,omitempty"`
+	Fix     *VulnerabilityFix    `json:"fix,omitempty"`
+	LayerId *string              `json:"layerId,omitempty"`
+	Links   *[]string            `json:"links"`
+
+	// OriginalSeverity The severity assigned by the scanner, set only when the severity was remapped by a severity override.
+	OriginalSeverity  *VulnerabilitySeverity `json:"originalSeverity,omitempty"`
 	Package           *Package               `json:"package,omitempty"`
 	Path              *string                `json:"path,omitempty"`
 	Severity          *VulnerabilitySeverity `json:"severity,omitempty"`
@@ -862,11 +1674,14 @@ type VulnerabilityFindingInfo struct {
 	Description *string              `json:"description,omitempty"`
 
 	// Distro Distro provides information about a detected Linux distribution.
-	Distro            *VulnerabilityDistro   `json:"distro,omitempty"`
-	Fix               *VulnerabilityFix      `json:"fix,omitempty"`
-	LayerId           *string                `json:"layerId,omitempty"`
-	Links             *[]string              `json:"links"`
-	ObjectType        string                 `json:"objectType"`
+	Distro     *VulnerabilityDistro `json:"distro,omitempty"`
+	Fix        *VulnerabilityFix    `json:"fix,omitempty"`
+	LayerId    *string              `json:"layerId,omitempty"`
+	Links      *[]string            `json:"links"`
+	ObjectType string               `json:"objectType"`
+
+	// OriginalSeverity The severity assigned by the scanner, set only when the severity was remapped by a severity override.
+	OriginalSeverity  *VulnerabilitySeverity `json:"originalSeverity,omitempty"`
 	Package           *Package               `json:"package,omitempty"`
 	Path              *string                `json:"path,omitempty"`
 	Severity          *VulnerabilitySeverity `json:"severity,omitempty"`
@@ -899,6 +1714,9 @@ type VulnerabilitySeverity string
 // FindingID defines model for findingID.
 type FindingID = string
 
+// IdempotencyKey defines model for idempotencyKey.
+type IdempotencyKey = string
+
 // OdataCount defines model for odataCount.
 type OdataCount = bool
 
@@ -962,6 +1780,15 @@ type GetFindingsFindingIDParams struct {
 	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
 }
 
+// GetProviderInstancesParams defines parameters for GetProviderInstances.
+type GetProviderInstancesParams struct {
+	// Location The location (region on AWS and Azure, zone on GCP) to list the
+	// instances of, all the locations are listed if not set.
+	Location *string    `form:"location,omitempty" json:"location,omitempty"`
+	Top      *OdataTop  `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip     *OdataSkip `form:"$skip,omitempty" json:"$skip,omitempty"`
+}
+
 // GetScanConfigsParams defines parameters for GetScanConfigs.
 type GetScanConfigsParams struct {
 	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
@@ -973,12 +1800,29 @@ type GetScanConfigsParams struct {
 	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
 }
 
+// PostScanConfigsParams defines parameters for PostScanConfigs.
+type PostScanConfigsParams struct {
+	// IdempotencyKey A unique key of the request chosen by the client. If a request with
+	// the same key already created a resource within the idempotency key
+	// window of the server, that resource is returned with a 200 status
+	// instead of creating a new one, so that a request can be safely
+	// retried. A request with the same key and another body is rejected
+	// with a 422 status.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetScanConfigsScanConfigIDParams defines parameters for GetScanConfigsScanConfigID.
 type GetScanConfigsScanConfigIDParams struct {
 	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
 }
 
+// PostScanConfigsScanConfigIDRunParams defines parameters for PostScanConfigsScanConfigIDRun.
+type PostScanConfigsScanConfigIDRunParams struct {
+	// Force Abort the running scans of the scan config instead of rejecting the request.
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
 // GetScanResultsParams defines parameters for GetScanResults.
 type GetScanResultsParams struct {
 	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
@@ -994,8 +1838,32 @@ type GetScanResultsParams struct {
 type GetScanResultsScanResultIDParams struct {
 	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+
+	// Format The format of the scan result. If sarif, the vulnerability,
+	// secret and misconfiguration findings of the scan result are
+	// returned as a SARIF 2.1.0 log, and $select and $expand are
+	// ignored. Defaults to json.
+	Format *GetScanResultsScanResultIDParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetScanResultsScanResultIDParamsFormat defines parameters for GetScanResultsScanResultID.
+type GetScanResultsScanResultIDParamsFormat string
+
+// GetScanResultsScanResultIDDiffParams defines parameters for GetScanResultsScanResultIDDiff.
+type GetScanResultsScanResultIDDiffParams struct {
+	// Against The ID of the scan result to compare with, usually of an earlier scan.
+	Against string `form:"against" json:"against"`
+}
+
+// GetScanResultsScanResultIDSbomParams defines parameters for GetScanResultsScanResultIDSbom.
+type GetScanResultsScanResultIDSbomParams struct {
+	// Format The format of the SBOM. Defaults to cyclonedx.
+	Format *GetScanResultsScanResultIDSbomParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
+// GetScanResultsScanResultIDSbomParamsFormat defines parameters for GetScanResultsScanResultIDSbom.
+type GetScanResultsScanResultIDSbomParamsFormat string
+
 // GetScansParams defines parameters for GetScans.
 type GetScansParams struct {
 	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
@@ -1007,12 +1875,40 @@ type GetScansParams struct {
 	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
 }
 
+// PostScansParams defines parameters for PostScans.
+type PostScansParams struct {
+	// DryRun If true, the scan isn't created and no infrastructure is
+	// launched. The targets matching the scope of the scan config
+	// snapshot (or of the scan config if no snapshot is set) are
+	// discovered and the plan of the scan is returned instead.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IdempotencyKey A unique key of the request chosen by the client. If a request with
+	// the same key already created a resource within the idempotency key
+	// window of the server, that resource is returned with a 200 status
+	// instead of creating a new one, so that a request can be safely
+	// retried. A request with the same key and another body is rejected
+	// with a 422 status.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetScansScanIDParams defines parameters for GetScansScanID.
 type GetScansScanIDParams struct {
 	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
 }
 
+// GetScansScanIDScanResultsParams defines parameters for GetScansScanIDScanResults.
+type GetScansScanIDScanResultsParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	Expand  *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
 // GetTargetsParams defines parameters for GetTargets.
 type GetTargetsParams struct {
 	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
@@ -1024,6 +1920,17 @@ type GetTargetsParams struct {
 	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
 }
 
+// GetTargetsUnscannedParams defines parameters for GetTargetsUnscanned.
+type GetTargetsUnscannedParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	Expand  *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
 // GetTargetsTargetIDParams defines parameters for GetTargetsTargetID.
 type GetTargetsTargetIDParams struct {
 	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
@@ -1042,9 +1949,15 @@ type PatchFindingsFindingIDJSONRequestBody = Finding
 // PutFindingsFindingIDJSONRequestBody defines body for PutFindingsFindingID for application/json ContentType.
 type PutFindingsFindingIDJSONRequestBody = Finding
 
+// PutIgnoreRulesJSONRequestBody defines body for PutIgnoreRules for application/json ContentType.
+type PutIgnoreRulesJSONRequestBody = IgnoreRules
+
 // PostScanConfigsJSONRequestBody defines body for PostScanConfigs for application/json ContentType.
 type PostScanConfigsJSONRequestBody = ScanConfig
 
+// PostScanConfigsValidateJSONRequestBody defines body for PostScanConfigsValidate for application/json ContentType.
+type PostScanConfigsValidateJSONRequestBody = ScanConfig
+
 // PatchScanConfigsScanConfigIDJSONRequestBody defines body for PatchScanConfigsScanConfigID for application/json ContentType.
 type PatchScanConfigsScanConfigIDJSONRequestBody = ScanConfig
 
@@ -1069,9 +1982,18 @@ type PatchScansScanIDJSONRequestBody = Scan
 // PutScansScanIDJSONRequestBody defines body for PutScansScanID for application/json ContentType.
 type PutScansScanIDJSONRequestBody = Scan
 
+// PutSeverityOverridesJSONRequestBody defines body for PutSeverityOverrides for application/json ContentType.
+type PutSeverityOverridesJSONRequestBody = SeverityOverrides
+
+// PutTaggingRulesJSONRequestBody defines body for PutTaggingRules for application/json ContentType.
+type PutTaggingRulesJSONRequestBody = TaggingRules
+
 // PostTargetsJSONRequestBody defines body for PostTargets for application/json ContentType.
 type PostTargetsJSONRequestBody = Target
 
+// PostTargetsImportJSONRequestBody defines body for PostTargetsImport for application/json ContentType.
+type PostTargetsImportJSONRequestBody = TargetImport
+
 // PatchTargetsTargetIDJSONRequestBody defines body for PatchTargetsTargetID for application/json ContentType.
 type PatchTargetsTargetIDJSONRequestBody = Target
 
@@ -1345,6 +2267,62 @@ func (t *ScanScopeType) MergeAwsScanScope(v AwsScanScope) error {
 	return err
 }
 
+// AsAzureScanScope returns the union data inside the ScanScopeType as a AzureScanScope
+func (t ScanScopeType) AsAzureScanScope() (AzureScanScope, error) {
+	var body AzureScanScope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAzureScanScope overwrites any union data inside the ScanScopeType as the provided AzureScanScope
+func (t *ScanScopeType) FromAzureScanScope(v AzureScanScope) error {
+	v.ObjectType = "AzureScanScope"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAzureScanScope performs a merge with any union data inside the ScanScopeType, using the provided AzureScanScope
+func (t *ScanScopeType) MergeAzureScanScope(v AzureScanScope) error {
+	v.ObjectType = "AzureScanScope"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(b, t.union)
+	t.union = merged
+	return err
+}
+
+// AsGcpScanScope returns the union data inside the ScanScopeType as a GcpScanScope
+func (t ScanScopeType) AsGcpScanScope() (GcpScanScope, error) {
+	var body GcpScanScope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromGcpScanScope overwrites any union data inside the ScanScopeType as the provided GcpScanScope
+func (t *ScanScopeType) FromGcpScanScope(v GcpScanScope) error {
+	v.ObjectType = "GcpScanScope"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeGcpScanScope performs a merge with any union data inside the ScanScopeType, using the provided GcpScanScope
+func (t *ScanScopeType) MergeGcpScanScope(v GcpScanScope) error {
+	v.ObjectType = "GcpScanScope"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(b, t.union)
+	t.union = merged
+	return err
+}
+
 func (t ScanScopeType) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"objectType"`
@@ -1361,6 +2339,10 @@ func (t ScanScopeType) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "AwsScanScope":
 		return t.AsAwsScanScope()
+	case "AzureScanScope":
+		return t.AsAzureScanScope()
+	case "GcpScanScope":
+		return t.AsGcpScanScope()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -1404,6 +2386,62 @@ func (t *ScopeType) MergeAwsAccountScope(v AwsAccountScope) error {
 	return err
 }
 
+// AsAzureSubscriptionScope returns the union data inside the ScopeType as a AzureSubscriptionScope
+func (t ScopeType) AsAzureSubscriptionScope() (AzureSubscriptionScope, error) {
+	var body AzureSubscriptionScope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAzureSubscriptionScope overwrites any union data inside the ScopeType as the provided AzureSubscriptionScope
+func (t *ScopeType) FromAzureSubscriptionScope(v AzureSubscriptionScope) error {
+	v.ObjectType = "AzureSubscriptionScope"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAzureSubscriptionScope performs a merge with any union data inside the ScopeType, using the provided AzureSubscriptionScope
+func (t *ScopeType) MergeAzureSubscriptionScope(v AzureSubscriptionScope) error {
+	v.ObjectType = "AzureSubscriptionScope"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(b, t.union)
+	t.union = merged
+	return err
+}
+
+// AsGcpProjectScope returns the union data inside the ScopeType as a GcpProjectScope
+func (t ScopeType) AsGcpProjectScope() (GcpProjectScope, error) {
+	var body GcpProjectScope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromGcpProjectScope overwrites any union data inside the ScopeType as the provided GcpProjectScope
+func (t *ScopeType) FromGcpProjectScope(v GcpProjectScope) error {
+	v.ObjectType = "GcpProjectScope"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeGcpProjectScope performs a merge with any union data inside the ScopeType, using the provided GcpProjectScope
+func (t *ScopeType) MergeGcpProjectScope(v GcpProjectScope) error {
+	v.ObjectType = "GcpProjectScope"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(b, t.union)
+	t.union = merged
+	return err
+}
+
 func (t ScopeType) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"objectType"`
@@ -1420,6 +2458,10 @@ func (t ScopeType) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "AwsAccountScope":
 		return t.AsAwsAccountScope()
+	case "AzureSubscriptionScope":
+		return t.AsAzureSubscriptionScope()
+	case "GcpProjectScope":
+		return t.AsGcpProjectScope()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
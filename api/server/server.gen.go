@@ -45,12 +45,24 @@ type ServerInterface interface {
 	// Update a finding.
 	// (PUT /findings/{findingID})
 	PutFindingsFindingID(ctx echo.Context, findingID FindingID) error
+	// Get the global ignore rules
+	// (GET /ignoreRules)
+	GetIgnoreRules(ctx echo.Context) error
+	// Set the global ignore rules
+	// (PUT /ignoreRules)
+	PutIgnoreRules(ctx echo.Context) error
+	// Get the instances the provider currently discovers.
+	// (GET /provider/instances)
+	GetProviderInstances(ctx echo.Context, params GetProviderInstancesParams) error
 	// Get all scan configs.
 	// (GET /scanConfigs)
 	GetScanConfigs(ctx echo.Context, params GetScanConfigsParams) error
 	// Create a scan config
 	// (POST /scanConfigs)
-	PostScanConfigs(ctx echo.Context) error
+	PostScanConfigs(ctx echo.Context, params PostScanConfigsParams) error
+	// Validate a scan config without creating it.
+	// (POST /scanConfigs/validate)
+	PostScanConfigsValidate(ctx echo.Context) error
 	// Delete a scan config.
 	// (DELETE /scanConfigs/{scanConfigID})
 	DeleteScanConfigsScanConfigID(ctx echo.Context, scanConfigID ScanConfigID) error
@@ -63,6 +75,9 @@ type ServerInterface interface {
 	// Update a scan config.
 	// (PUT /scanConfigs/{scanConfigID})
 	PutScanConfigsScanConfigID(ctx echo.Context, scanConfigID ScanConfigID) error
+	// Run a scan of the scan config now, regardless of its schedule.
+	// (POST /scanConfigs/{scanConfigID}/run)
+	PostScanConfigsScanConfigIDRun(ctx echo.Context, scanConfigID ScanConfigID, params PostScanConfigsScanConfigIDRunParams) error
 	// Get scan results according to the given filters
 	// (GET /scanResults)
 	GetScanResults(ctx echo.Context, params GetScanResultsParams) error
@@ -78,12 +93,21 @@ type ServerInterface interface {
 	// Update a scan result.
 	// (PUT /scanResults/{scanResultID})
 	PutScanResultsScanResultID(ctx echo.Context, scanResultID ScanResultID) error
+	// Compare the findings of a scan result with another scan result of the same target.
+	// (GET /scanResults/{scanResultID}/diff)
+	GetScanResultsScanResultIDDiff(ctx echo.Context, scanResultID ScanResultID, params GetScanResultsScanResultIDDiffParams) error
+	// Get the logs of the scanner instance of a scan result.
+	// (GET /scanResults/{scanResultID}/logs)
+	GetScanResultsScanResultIDLogs(ctx echo.Context, scanResultID ScanResultID) error
+	// Get the SBOM of the target of a scan result.
+	// (GET /scanResults/{scanResultID}/sbom)
+	GetScanResultsScanResultIDSbom(ctx echo.Context, scanResultID ScanResultID, params GetScanResultsScanResultIDSbomParams) error
 	// Get all scans. Each scan contains details about a multi-target scheduled scan.
 	// (GET /scans)
 	GetScans(ctx echo.Context, params GetScansParams) error
 	// Create a multi-target scheduled scan
 	// (POST /scans)
-	PostScans(ctx echo.Context) error
+	PostScans(ctx echo.Context, params PostScansParams) error
 	// Delete a scan.
 	// (DELETE /scans/{scanID})
 	DeleteScansScanID(ctx echo.Context, scanID ScanID) error
@@ -96,12 +120,36 @@ type ServerInterface interface {
 	// Update a scan.
 	// (PUT /scans/{scanID})
 	PutScansScanID(ctx echo.Context, scanID ScanID) error
+	// Abort a scan, cancelling its scan jobs which are still running.
+	// (POST /scans/{scanID}/abort)
+	PostScansScanIDAbort(ctx echo.Context, scanID ScanID) error
+	// Get the scan results of a scan according to the given filters
+	// (GET /scans/{scanID}/scanResults)
+	GetScansScanIDScanResults(ctx echo.Context, scanID ScanID, params GetScansScanIDScanResultsParams) error
+	// Get the vulnerability severity overrides
+	// (GET /severityOverrides)
+	GetSeverityOverrides(ctx echo.Context) error
+	// Set the vulnerability severity overrides
+	// (PUT /severityOverrides)
+	PutSeverityOverrides(ctx echo.Context) error
+	// Get the scan result tagging rules
+	// (GET /taggingRules)
+	GetTaggingRules(ctx echo.Context) error
+	// Set the scan result tagging rules
+	// (PUT /taggingRules)
+	PutTaggingRules(ctx echo.Context) error
 	// Get targets
 	// (GET /targets)
 	GetTargets(ctx echo.Context, params GetTargetsParams) error
 	// Create target
 	// (POST /targets)
 	PostTargets(ctx echo.Context) error
+	// Import targets in bulk.
+	// (POST /targets/import)
+	PostTargetsImport(ctx echo.Context) error
+	// Get the targets which have no scan results.
+	// (GET /targets/unscanned)
+	GetTargetsUnscanned(ctx echo.Context, params GetTargetsUnscannedParams) error
 	// Delete target.
 	// (DELETE /targets/{targetID})
 	DeleteTargetsTargetID(ctx echo.Context, targetID TargetID) error
@@ -311,6 +359,56 @@ func (w *ServerInterfaceWrapper) PutFindingsFindingID(ctx echo.Context) error {
 	return err
 }
 
+// GetIgnoreRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetIgnoreRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetIgnoreRules(ctx)
+	return err
+}
+
+// PutIgnoreRules converts echo context to params.
+func (w *ServerInterfaceWrapper) PutIgnoreRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutIgnoreRules(ctx)
+	return err
+}
+
+// GetProviderInstances converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProviderInstances(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProviderInstancesParams
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", ctx.QueryParams(), &params.Location)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter location: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProviderInstances(ctx, params)
+	return err
+}
+
 // GetScanConfigs converts echo context to params.
 func (w *ServerInterfaceWrapper) GetScanConfigs(ctx echo.Context) error {
 	var err error
@@ -375,8 +473,37 @@ func (w *ServerInterfaceWrapper) GetScanConfigs(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostScanConfigs(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostScanConfigsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PostScanConfigs(ctx)
+	err = w.Handler.PostScanConfigs(ctx, params)
+	return err
+}
+
+// PostScanConfigsValidate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScanConfigsValidate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostScanConfigsValidate(ctx)
 	return err
 }
 
@@ -460,6 +587,31 @@ func (w *ServerInterfaceWrapper) PutScanConfigsScanConfigID(ctx echo.Context) er
 	return err
 }
 
+// PostScanConfigsScanConfigIDRun converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScanConfigsScanConfigIDRun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanConfigID" -------------
+	var scanConfigID ScanConfigID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostScanConfigsScanConfigIDRunParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostScanConfigsScanConfigIDRun(ctx, scanConfigID, params)
+	return err
+}
+
 // GetScanResults converts echo context to params.
 func (w *ServerInterfaceWrapper) GetScanResults(ctx echo.Context) error {
 	var err error
@@ -556,6 +708,13 @@ func (w *ServerInterfaceWrapper) GetScanResultsScanResultID(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
 	}
 
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetScanResultsScanResultID(ctx, scanResultID, params)
 	return err
@@ -593,6 +752,72 @@ func (w *ServerInterfaceWrapper) PutScanResultsScanResultID(ctx echo.Context) er
 	return err
 }
 
+// GetScanResultsScanResultIDDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResultsScanResultIDDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScanResultsScanResultIDDiffParams
+	// ------------- Required query parameter "against" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "against", ctx.QueryParams(), &params.Against)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter against: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScanResultsScanResultIDDiff(ctx, scanResultID, params)
+	return err
+}
+
+// GetScanResultsScanResultIDLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResultsScanResultIDLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScanResultsScanResultIDLogs(ctx, scanResultID)
+	return err
+}
+
+// GetScanResultsScanResultIDSbom converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResultsScanResultIDSbom(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScanResultsScanResultIDSbomParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScanResultsScanResultIDSbom(ctx, scanResultID, params)
+	return err
+}
+
 // GetScans converts echo context to params.
 func (w *ServerInterfaceWrapper) GetScans(ctx echo.Context) error {
 	var err error
@@ -657,8 +882,34 @@ func (w *ServerInterfaceWrapper) GetScans(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostScans(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostScansParams
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PostScans(ctx)
+	err = w.Handler.PostScans(ctx, params)
 	return err
 }
 
@@ -742,6 +993,125 @@ func (w *ServerInterfaceWrapper) PutScansScanID(ctx echo.Context) error {
 	return err
 }
 
+// PostScansScanIDAbort converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScansScanIDAbort(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanID" -------------
+	var scanID ScanID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanID", runtime.ParamLocationPath, ctx.Param("scanID"), &scanID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostScansScanIDAbort(ctx, scanID)
+	return err
+}
+
+// GetScansScanIDScanResults converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScansScanIDScanResults(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanID" -------------
+	var scanID ScanID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanID", runtime.ParamLocationPath, ctx.Param("scanID"), &scanID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScansScanIDScanResultsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScansScanIDScanResults(ctx, scanID, params)
+	return err
+}
+
+// GetSeverityOverrides converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeverityOverrides(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSeverityOverrides(ctx)
+	return err
+}
+
+// PutSeverityOverrides converts echo context to params.
+func (w *ServerInterfaceWrapper) PutSeverityOverrides(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutSeverityOverrides(ctx)
+	return err
+}
+
+// GetTaggingRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaggingRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTaggingRules(ctx)
+	return err
+}
+
+// PutTaggingRules converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTaggingRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutTaggingRules(ctx)
+	return err
+}
+
 // GetTargets converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTargets(ctx echo.Context) error {
 	var err error
@@ -811,6 +1181,75 @@ func (w *ServerInterfaceWrapper) PostTargets(ctx echo.Context) error {
 	return err
 }
 
+// PostTargetsImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTargetsImport(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostTargetsImport(ctx)
+	return err
+}
+
+// GetTargetsUnscanned converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTargetsUnscanned(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTargetsUnscannedParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTargetsUnscanned(ctx, params)
+	return err
+}
+
 // DeleteTargetsTargetID converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteTargetsTargetID(ctx echo.Context) error {
 	var err error
@@ -927,25 +1366,41 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/findings/:findingID", wrapper.GetFindingsFindingID)
 	router.PATCH(baseURL+"/findings/:findingID", wrapper.PatchFindingsFindingID)
 	router.PUT(baseURL+"/findings/:findingID", wrapper.PutFindingsFindingID)
+	router.GET(baseURL+"/ignoreRules", wrapper.GetIgnoreRules)
+	router.PUT(baseURL+"/ignoreRules", wrapper.PutIgnoreRules)
+	router.GET(baseURL+"/provider/instances", wrapper.GetProviderInstances)
 	router.GET(baseURL+"/scanConfigs", wrapper.GetScanConfigs)
 	router.POST(baseURL+"/scanConfigs", wrapper.PostScanConfigs)
+	router.POST(baseURL+"/scanConfigs/validate", wrapper.PostScanConfigsValidate)
 	router.DELETE(baseURL+"/scanConfigs/:scanConfigID", wrapper.DeleteScanConfigsScanConfigID)
 	router.GET(baseURL+"/scanConfigs/:scanConfigID", wrapper.GetScanConfigsScanConfigID)
 	router.PATCH(baseURL+"/scanConfigs/:scanConfigID", wrapper.PatchScanConfigsScanConfigID)
 	router.PUT(baseURL+"/scanConfigs/:scanConfigID", wrapper.PutScanConfigsScanConfigID)
+	router.POST(baseURL+"/scanConfigs/:scanConfigID/run", wrapper.PostScanConfigsScanConfigIDRun)
 	router.GET(baseURL+"/scanResults", wrapper.GetScanResults)
 	router.POST(baseURL+"/scanResults", wrapper.PostScanResults)
 	router.GET(baseURL+"/scanResults/:scanResultID", wrapper.GetScanResultsScanResultID)
 	router.PATCH(baseURL+"/scanResults/:scanResultID", wrapper.PatchScanResultsScanResultID)
 	router.PUT(baseURL+"/scanResults/:scanResultID", wrapper.PutScanResultsScanResultID)
+	router.GET(baseURL+"/scanResults/:scanResultID/diff", wrapper.GetScanResultsScanResultIDDiff)
+	router.GET(baseURL+"/scanResults/:scanResultID/logs", wrapper.GetScanResultsScanResultIDLogs)
+	router.GET(baseURL+"/scanResults/:scanResultID/sbom", wrapper.GetScanResultsScanResultIDSbom)
 	router.GET(baseURL+"/scans", wrapper.GetScans)
 	router.POST(baseURL+"/scans", wrapper.PostScans)
 	router.DELETE(baseURL+"/scans/:scanID", wrapper.DeleteScansScanID)
 	router.GET(baseURL+"/scans/:scanID", wrapper.GetScansScanID)
 	router.PATCH(baseURL+"/scans/:scanID", wrapper.PatchScansScanID)
 	router.PUT(baseURL+"/scans/:scanID", wrapper.PutScansScanID)
+	router.POST(baseURL+"/scans/:scanID/abort", wrapper.PostScansScanIDAbort)
+	router.GET(baseURL+"/scans/:scanID/scanResults", wrapper.GetScansScanIDScanResults)
+	router.GET(baseURL+"/severityOverrides", wrapper.GetSeverityOverrides)
+	router.PUT(baseURL+"/severityOverrides", wrapper.PutSeverityOverrides)
+	router.GET(baseURL+"/taggingRules", wrapper.GetTaggingRules)
+	router.PUT(baseURL+"/taggingRules", wrapper.PutTaggingRules)
 	router.GET(baseURL+"/targets", wrapper.GetTargets)
 	router.POST(baseURL+"/targets", wrapper.PostTargets)
+	router.POST(baseURL+"/targets/import", wrapper.PostTargetsImport)
+	router.GET(baseURL+"/targets/unscanned", wrapper.GetTargetsUnscanned)
 	router.DELETE(baseURL+"/targets/:targetID", wrapper.DeleteTargetsTargetID)
 	router.GET(baseURL+"/targets/:targetID", wrapper.GetTargetsTargetID)
 	router.PATCH(baseURL+"/targets/:targetID", wrapper.PatchTargetsTargetID)
@@ -956,99 +1411,253 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9a2/bOpZ/hdAOsDMDNWln737YfEsdtzVuXrDddgdziwtGom3eSqQuSSXxFvnvC770",
-	"JC3JsZ2kk2+JSR6S530OD6kfQUTTjBJEBA9OfgQZZDBFAjH13wKTGJPl5Ez+g0lwEmRQrIIwIDBFwUml",
-	"PQwY+jPHDMXBiWA5CgMerVAK5UCxzmRnLhgmy+DhIQxoDAUc0ZyIAvCfOWLrEvJfItXqAHNDaYIgKeGM",
-	"7zNIYi8gpJt7LOgDTgRiXkAL3dwD0BWLEXu/9kKisv1mvQlUGNy/WdI3ZoQFaCeYoQRFftxx3dxjpbPv",
-	"OPODkY0OIJgItESshDKnfiCCdsLgESQjShbYz2i1LsN4TQ7dCHcriFPE80RshFt0GQZdQLZEfshF8xCo",
-	"D7IzzyjhSMn1LI8ixNWfESUCaTmEWZbgCApMyfEfnBL5WwnzLwwtgpPgP45LhXGsW/mxgTc1c+gZY8Qj",
-	"hjMJLjixU4IUcQ6XSLLyZ/Kd0DsyZoyynS3lNMOblmHmBEhNqqmpBkq41bEnPxojTwmgN3+gSACxggJg",
-	"DhgSOSMoBpgAmCQgghxxQBdgAXGSM8SPgjDIGM0QE1gj3u7+5EfAEIyvSLK21HNwgv5FzyoRdnrHTyOl",
-	"GGcRzVxr/DoDUULzGEDdD3DVsbkMDXK+1jBaqoehJaZE9cQCpbwT53d8qobIwSRPEniToMa+IGNwHWhO",
-	"tGz7r+pCvrk3bABLnohjLPcJk+vKZhYw4Sh04EFvorV1LUY/ghSTc0SWYhWcvAvbKLjNokH7/3I9Grx5",
-	"tRTPtmcRJAWRB+x8vkKa5pIPIYiUzswZioFUSW2GhEkyLandENkIasY2/BACvAAcCXCHkwTQW8QYjhGA",
-	"ZC1WmCxVEya2t5yrabLDABMuIInQHC7H91GSc0Pc+sxfLoDtyPVshApwg9QmlMQtgFihtdyfgEb8qPqN",
-	"IyDgkoO/oltEin4pFNEKVCbXFpSyvx2ByQKgNBPrUE0i4Hc5jghqZUhupBcbzOGymwdqKLCr6IOBIbs/",
-	"/KaeTqOEAV/RPImVxAiaZSieWMx53MZhGmiGopxhsf7IaJ5toYi4GQ+WCkBTAnHcqY4aS8axb6lSCw1f",
-	"oBy1xarCgFcxM4i4dZwOVZweBIyk5btm9BbH2o1HJE9l/9Ovs8qAcv1nmE3Igqq4p7b7GLNLYydagxKq",
-	"/RJn40YxGMZ54/ssoVi0FxfdIu0dtmavkdbRTnx74jRnETp772wUWCTuYTlL6lRvz9hFVt+2P5jI0pAH",
-	"JsnVIjj512bGsih7CH8McXiG0GUDpaQCalML6cb+0lFuYnvscR0rOVZDJLzYoxdb4AwV2nAg50h0mw4Z",
-	"qkxRouSFr7CS9EWDsmTdg7LXMPoOl6jKFZLIm4Z8yROCGLzBCRbrIQMvYHIH2aC5ZihiSAyaBHPrmyns",
-	"DBk7pVR8x4Omc0iVZOUYS4WRYgKNE5LCLDMEL/RPb4hhYFA3ALNh0MTENhgLA8MgA/gnDAweB6A5DDSl",
-	"+/NBGNT4cAtmtZK31hapqp6kzC5oTuIrhw/9dYUIECvMgZE4cAc5kBSXnjuKwc0aQOVSBhIKS6HcVgwF",
-	"eiNwigKHvcSxU8ljcgsTLEcOWEhlkF4JQXeIDVsPNxp3o2iqTExVBW1QdON7zE0etKbuFqUe3DSXVZcP",
-	"YTXQr2PjTP13I736FY5WICf4zxxJV54LBjERIKLpjZRITAmIYM4Rl569CuUSHCnPfYvcgVmbY3ORzcM2",
-	"IkkqYGJJxoFOJ8jogSkaCqpWtcQyzNKpUV7SqEjvVaKMOvhzzIXKldgJOkH3sp4VEnRby0JdNVGS6gav",
-	"D2jarT/Rw5poeQ11Mq+FjGsoViaCkxtGOsVkwjsOzHRBL0KbCXfkAThUdm93zKL3wO6YmdbtjqUlyXvx",
-	"U7mHzjA0RQLGUMDesGcqnGcXdtxWHt9FnRVbrNo2rz/8OUlH3J6iGPvjHZORuDZc7Wn3B1Mc3SKm7OIw",
-	"d2lmx0mUIC5GUKAlZWt3AIO4OOsIjWQfZ1TlxPkGV6S/dDQJc2gxaaLULS+NXv3jGMf+ujM5ml12HlR6",
-	"2aeSJGj2+YSXq6JfG8QFinGebuhwTu+KVlfyodl/VzFb4Qm37HyGHoXYMEggWeY+VZHgCNnzpe2n8KYo",
-	"spwlbsn1ab5bxLhb3DegbStRtig/sARf09idvto+RRUGGY092npY+qoIrgaZGz3Iay5Mex+/a1rp6iS4",
-	"I7zrTXC7uQMT3Ezr1tQGN/0VdLmJLTTqtE6JQomOL66m/wzC4Nfx9HJ8HoTB6fX1+WR0Op9cXQZh8GEy",
-	"vfh6Oh0HYfD58tfLq6+XTt1ooO9KJU5zIkPIWbRCcZ4oz7CEPCBZbuAAbgCpaBXUtLg6eaEkWQMJS/00",
-	"l0MwBxyJEGBRnOZAwDFZWigWZgwWlKlAoAaghBsxSs4xKUGq4DBnDBEB1PLsBLLht2DBaKp+/y2QkRUX",
-	"kAnVZGaUEVcr9rKTqGlvqIxOatuBJC4XAhkqV7LAjAu9JbUOlhMAhWN4a4u1dWswajsqFqouquiIFgsU",
-	"CXyLgNykDIxTTKpUfNc81rAg2iHYiNGSCADdZwxxbs8x0T1MMykewX+DX8Dfwd/BO1c+orYdR0S9QoCg",
-	"+2JbmIOSFYE+xQKC4eUSMZOaOeqZC3Fx/ez91cWOBGh2Q1O31sm06euvdUpbuYXWsWvop6Vl7zMdWP1w",
-	"Hm91IvFb6M3eQJDmicBvdD1ORXwt2ZyLr6id3lvQY4ZsJAxSeH8NGUwSlMwqPnWMFjBPRHDyj9BViLWj",
-	"3RuN2IGEMxMq16f4gFESc6UDYU06qDnyhkSdhK+gSmEicYdUdhFVOoe/kfKfau5P6Z2cV3RsdQYCM76i",
-	"qoRBrNBvRBHyt3YFSYx5ITz1xeMFUJxs1GuBCamq7Si1BkKN0tcyL3WkUtNYOIsmvNRsapcU3uM0TwHJ",
-	"0xvEAF0AG1GZjBIkajJMQGYAKlQgGK1s6tXACE7+8VbpU/3PO1dqz3+eGEHyAaY4wahiw7sYvTGijAft",
-	"ef6IIUXL/iD9g03ll+LaTk/J6z8oKLTbGy3Kefz+aAnVl4V+0pxytUyzz24tgjZvtZaZ351WrIpofV/+",
-	"UwyPkLWGW6ZvNbiZ3tGtwnWOVsNNjZY+FRAb9TWr6kFBjX9hFZTmG6NcdcU0ijsU+NCjhOp8PU8TPDzZ",
-	"/3ShMmfXCYNSzBlcoiOgRieq8AWkOVeVZwm9kxqVAfRnDhMJQfad4f9DvUup6nrDs7cO78dazaY7F1uX",
-	"s//J2VBZbh7klzBmxnT2h2XkNlDRyMClCyg8znWCFyhaRzKkkp30iYoMk4xLZqPUa6QPicLgrDgODcJg",
-	"ImOHpfT7Zdx6Q5lQP3+AOFF/nFGCnOGqmu3Cp50/5SkkbyS5pU6yBdAAk1hVOJMliJGAOOEA3tBchzoJ",
-	"lEGU2oRgkHAVoR550TFFkLvqKC9gtMIEFZOH4HOWITaCKUpGkCMgZDhSWYmcmylghYsUUaID5P/keln1",
-	"BRU1VgW+JDnjq1y6MlcEXbELypAuBdGYnNOZ9jQs8tcFhj8TdJ+hSMO5pKqstOhui9adFMjTFLJ1LzNs",
-	"ulZK7TcoEOPgTs6MByVjXf2b8SKVi6JiaC59KlFjuqpm2EEaWy7/GTsHfbDv31jbdLYFvJZqsQemxkkE",
-	"CwMA3GHJOHUL1/Liq3VZPSpnKk5p5Qixx8lhZZzrKGXICUplDdVkX48cX9WlvqFpJ6HKzIGu8mSoeypd",
-	"+1KZ6bZS02KQ3rdeqxIEeNnF1A3MSslvVLYCoxSqfFKUG7Qr4YV0UsYVrmg7G6pLpWjA18NFaE/f60r+",
-	"xNNlWqG1p8usJJGnx5ftibGuaU0fPbb35D0+fMWn6evC170ap4Pedlja3ao+iatVbGi58F3waZvqdnvJ",
-	"yq22mqnacWxAjMev3JNmnKAugRgoHtKXgW3ves7aDZeuSsT6dZg+ADcWzfl2UWK/P+82tVCbjf+gN3xE",
-	"0yyRHoNbQGWXc7QQczrNieeOZBc5W9ouM66svgekdR9lABPtZasDD5DlLKMc8SOLhOZ5jrQEQRh8+Xx+",
-	"OZ6evp+cT+b/DMLg4vTcnOLMxqPpeC5/msxGV5cfJh8/T+1hz/Tqav7rRDaO//f6/Goyd7pus64cTyNP",
-	"33QBrPm312Xad+7g/TXDka/ARbD1Bbw/FQKlmU+F5hzNMiqG3CtpDfnm4btqBVDLq+usn9Hts/7ub6W3",
-	"V5fXIdZXJLX1FEG3ApaNGoC7fUyWmKAv3oN5GYMtlP//ASc+o/groXfkC2Y59/UwSzjDTN2uwh39Nsw1",
-	"y3nWtR5pKubwO+pbaSBn3SZ3wg+aNXke6ZJtEyXbGKTa1doeNql+Fbcn2OGWiZqynebdTPl7Uc69bmk9",
-	"lUi0VQ2b0bw5LW0K3lsp1o6COkTiEU3ylLiFBpHYnsO2Gxc4QdfOQlmJtFqhrKmRtbkLHau48iQLTJaI",
-	"ZQy7hOySCnSiw3bM1Y1JHSV7Ei5MbNqa6uDbnB/FWxWiGOocuA5Fz+o+EK5Ei/2k3O5gm3xILeR89CF3",
-	"4xGDgS8AFLf/uXnpQHXSPRRPrTBZ7vpFgDlcbnHhU8B2PuQ7chfP3sIk78FDcrjt/M25UBm/9Gds3X9E",
-	"09RZGbuL83uT2tN9nWcdtUU4fTM+2mS168ewhiNW8BYBdIuYPvZVWVbMzWqcNygGJDfb8YiNHHtYAr1d",
-	"vynQ7c80ASkKBuve4qbtbZfH2IZdwwYHbZMPMFTd/2GhEZb+54QaI7PiHZzNN2N7ZGCtO2gzeNeMSjXr",
-	"1u8bTnmHJG/tnI9O3Ra+7LC8rR3Gb2gPS2qLtLa+GTcw11tMJqDIeT/R0680qP470mzb3UK+fWQ+dJMK",
-	"KZn+WevKumz2I53p32vzW9UHMD30sAUCdtKnjnjbeN4m+q0LmuMiiHr76tFXQbiYFwfBW57g2yzj5dX8",
-	"99no9PJyfBaEweRS5QxP5/PT0Sfzy+/X06uP0/FsJhveX03n6vezq8uxI6fYjZScb2+Omuh9CIMlksoh",
-	"2WJkT3PkGjnUJDlg9LVGjqF9jhFdw/rZF8fIgQq7BcHPFMMSRV8uej24YO/mdPWzT9B05ZuKp2o2g6lc",
-	"Ctq8rjAwG+na5sB8lUbpUNWvDZJD6+9D5dvJdJ3v0+j47TS7JVkrvDCHDJ5ngWxz9WmkTUusv6O0pyeP",
-	"wuqqK1O4kgfu0oDH5nsaD3O0n1ni/bNYNVgjObKHDe1KocaYC0YHTX2mh6gw6X7QyA/4Xtv1NWKT2HPD",
-	"k3x/pNuQlZdTe14SybxXy3teHa+77pV741WLsvbfedzMNyPDJU3/XjAcDeeaCzNO3V61DwM+8mKrd5LW",
-	"qm8gR7OI1mpbdApLZey1f1TEQL5+OM1gJHztnSs8K5i+ERmp30Gm1RKvHmSbukkIYiTUYR04xyS/B0p+",
-	"8E1uSxXru52cnePvjhBM2oTJ2e/nk1/HYIFREgP1xI6tKpPNx0hEx5S/YShBkOuTiEeU+pU15f7DjvaO",
-	"XJ51hTMaz0nqBj808NcU/kGVbVN/HKWYUAYMwL/1u3HmfcWodx6trpMPfKzR0oftlK+NXHyY3/nLBe3E",
-	"Q2tRDs94uM3a0er6VeOVefHG2o2oZYgBq949hXojhgWOnHVtngq4T3i56t/7nN7176zfgujf/xItE7zE",
-	"NwnqMaYb747HLEbTyXwyOj0PwuDT5OOnIAwuxmeTzxdBGJxffQ3C4HL88XzycfL+3BlAK6dRy615/TH4",
-	"cjFKoHrG9PR6Iv3wQtcE747eHr01918JzHBwEvzX0dujd4G23mpXx8VJ9TEvjrRN9q64Niv9juAjEkVh",
-	"uDn9DmufJfCokLLLcfU1f18A1uxuntTv2714kf9b42n1f7x9u7tn1fX2/a+pay/S3Ol0wyoWd1x7bv2h",
-	"mn2VOFePCMNbiJUKAIZI6uENB5GucweRpPJFXLyn8XovKKi/d//wJIg/TRKDG3CH9L13e+C7yJNkvSuK",
-	"zHwUCYP7NxGN0RKRNwbhb25ovLafipB/K1jHi8qLbz5JK16Fe4Yipg/G+vae06z/Qr7j/p3N50WelWIo",
-	"yHY41VAWzqtHYrhLKVBeZah9qIPieb8++uDdfqZtOjYE3dVetowYggLFClG/7JDoHV+2mOgHNYul8FzO",
-	"VKzjf3aNDHO25ViJ6VA5k9oRL6raYASg3eMWyvD4R/HNogftpSZIu/R1Xj5Tv1tu/lD5ztEwPVl+Icmn",
-	"EDZjoyLNv7z95VC8ZCk4OVPFcMor3xURNWZLIh7pE5TN9mknBNiPmbL24QD6vkPd/yQMIi2OWKHiVqx+",
-	"C6TKLRkU0cphf+TPuxfZJ7ZiB+EihTpUNR6lS/vMDNlPweMK31Wu7mfJ/NHYK9tvw/afM/0A+SvbH4bt",
-	"Nb6H87304Hj94RGfx1B9n+Q1qH1JQW2VcoeLa6svxHTEtnXW2k+2q/Jw0kEj3ObMriC39mDQ0we61eXs",
-	"Ldhtvcrl4szKQmDCEIzXAKneu498609abKE7j39Uv6XaIwaucP2s/hXWYcq19gnXlxQMV8m714C49nbi",
-	"hqB4PxR5udHxZt31czKNO0huctCmQHmPcv30hvFQzGXj5rotevogYoNtfBYi8BOaaBvSN17AfVxY/yqk",
-	"OxBSG+W/Cum/vZAWCYgtpNQ60pXbTZs8NNvtNQnxkpIQ7Utsh0lFDLiH1p2kKFlvH2recRvwoKkK9/yN",
-	"0ll0V2BT1enAOEaxRae5zGx85gxFeIEj80rsE5oCveD95TI8t1N9mrjgxqoq1g8WaPxBEpdI232Ww6Cj",
-	"QSU/7bZT4zofov8x+ZAeWn1WGbOVM1YMfsFxdx9BfMLo2/DPvqLvGpf2irZ3zzvfnpOGPyxjze2XRyqa",
-	"Smn6zB5lm/erX5SyfxYS8m9lc2phu55+J1H7q7DvUNhtBA8bsvNMYvhXWX4eslyP7q1lHuYWdsb1rxH9",
-	"yysrOHRBAT8CY/tJrfIz7vXPfHR8QK4zyN9nDcJTVB901B08l4KDvVYadGjUfRcXbGDIoVpUh9W9CwyU",
-	"n7Slh/QSywn2XkfQWUDwWIy/7HKBZ5aqOFyFgM4kd1qejkzGTsT1KU3X/rmpVhnwbCKVJw1R9n2++DTW",
-	"s5pA2M2B/6t0dUpX7Uj/Vbp+XumqhfRDYnlRPkTnc4PsW3Wv8fzLO6E/VERv2WhjOF4y0v4StE9zyu4P",
-	"yu2D4E8flls3dr/H5n71Z06i9hucF58HGKj/jn/YL8f1iMQNH8/NiMGK0U61i3j8mbDRwWz43H5odm+J",
-	"Ab3BjYmB3THAS69qeD4Jgj0yRmngOqP+HauGp7WSh2AWG6EUaqUVozw1B/08NtIECZaVHxuDv/L6znn9",
-	"1Zq/ipxeJEfs1spRzpLgJDiGGQ4evj38fwAAAP//h/f/fYuyAAA=",
+	"H4sIAAAAAAAC/+y9a3PcNrIw/FdQ825VnPPSIyebPXUef5Ml2ZmNdSmN7Ow+Z1JbEImZQcQBuAAoeZLy",
+	"f3+qcSNIgkNydLGS9TdpiEsDaDT63r9PUr4pOCNMycnr3ycFFnhDFBH6vyVlGWWr2TH8Q9nk9aTAaj1J",
+	"JgxvyOR18D2ZCPLvkgqSTV4rUZJkItM12WDoqLYFNJZKULaafP6cTGhGNgVXhKXbn8gW2mREpoIWinKY",
+	"5RCVjP67JOiGbBFfIrUmCMYnUqF0zSVh6Hqrf01zSpiaotkSYd/kjqr1gsFniTdmEJwLgrMtSgXBimS6",
+	"seSlSIluTZkeLYALei3YHWUZv3MgSCJuiUiQWmNV9acSCaJKwUimx0IYff/qFZIKq1IuGGVSEZzBGHpy",
+	"ylYII0buEGckQZKb4SroU8zQNYC+JPl2wQRRgpJsig5r60P15bEMYcbVmgh0zbOtAepXkiqSLZiF6ofv",
+	"v7dQTRdskpgDXROcEVEd6azagpdwNuFBbvCn94St1Hry+vu//S2JHCzPsMJHvGTKY8y/SyK21fh/SfXX",
+	"CH5cc54TzKpxTj4VmGWdAxHzeTem6YHe0lwR0TnQ0nweMNC5yIh4s+0cicP36+2uoZLJp5cr/tL2cAO6",
+	"CeYkJ2n33knzeQCk8xtadA8DHyODUKbIiohqlCvePYjivWPIFLMjzpa0m4LUmowjItB157h7jXhJZJmr",
+	"neP6JuNGV1isSPfI/vOYUT9DY1lwJokm2PMyTYnUf6acKWLuIS6KnKZYUc4OfpWcwW/VmH8RZDl5Pfn/",
+	"DqqX4MB8lQd2vEs7h5mxTqttE7QhUuIVAVT+wG4Yv2MnQnDxYKAcFnQXGHZORPSk5jR1Rxg37Nt+bBji",
+	"10AqDSEOyTllCOc5SrEkEgj4EtO8FEROJ8mkELwgQlGz8W71r3+fwENzzvKtO70IJphfzKywYYd38jDV",
+	"hHGe8iIG489zlOa8zBA27ZDUDZtgmCGvtmaMFukRZEU50y2pIhvZu+d38lJ3gc6szHN8nZPGurAQeDv5",
+	"/DlE2/8NAfklvmA7MOBEllFYJ84vgsUscS5JEtkHs4jW0s01+n2yocw9Ut8l7S24LdJR6/94cTR68RqU",
+	"jmXPU8z8IY9Y+RU899AP8BCjVNPMUpAMAUlqIyTO88vqtBtXNsUGsS0+JIgukSTAWOQ54rdECJoRhNkW",
+	"OKOV/kSZaz2d+JX5JzuZUCYVZim5wquTT2leSnu49Zk/niLXUJrZGFea20kx0zdOc1pbWJ/C9voZ7ksS",
+	"pPBKohfkljDfboNVukbB5OYF5eJbzROSTaG2iZ5E4RvoxxR3dwgWMggNrvCqHweSSQSKITswZvUJ4gLO",
+	"xf66gR6Rac8LIrDiAlGJzi+fxU44kAbM0OryObF36EsQtmQi17zMM31xFS8Kks3cAUYIdS65Pk7Nn9cP",
+	"e03TNcKCIGmGQS8ygvOcp1oi4WzBDn8rBUmQImJDmflVIFnKgrBMN0Hvji6+naIrQBIsCPtGIamwUCQD",
+	"qYQsmGS4kGuupJ7InLTBFSrQLc/LDZEIS4NmWJApgqfK9BUlY3Dda1BjUUNPwB9JlBEgIoz7uDdgTtJS",
+	"ULV9J3hZ7PEUSNsfrfQATRpIs94HoQEyzbpAhXdgPIDQaw+okokMd2YUXtf3dOzT1bUBgJiP83RpJosh",
+	"PQOS5bXv2X7Tvr4xX9+YL/nGGIVPdSUjXBUHalrbf6NakqRSGGliJcMdM7xY7bPtV7sRITGubWcL1q9P",
+	"2pd50jSZDE6sS5xrEbt95bkmSt4DJQJojJJi91PZsxVHIK1eCH5LM6N6I6zcQL/Dn+cTu1OTZPLu6CLo",
+	"XkF7TMWMLTl0rO9IRsWZlfRanTTe2Teh9XHnVo5b28knKhVlq7nFzKhOgdhGyOGvV2RzrizemgfRaH6Q",
+	"4h2inMPc2XF7InhbZ8duaNfS/W9GDgS2ajscVF2DFvbokCxISpc0DaZxfZPaf9CAwo3/ed744EmbbmGf",
+	"ei7qjUBohq/vji5qV7GLU6s2pbaY+HkVOaeqjUzpLYmieoODiXxnXThoVnr8JvpRUZXHu5Uiv9f9/dy9",
+	"7LfWSGOvE87z8+Xk9f/ufiJt38nn5PcxJGnMPdpxUvAwtU+LmI/DueFqEfvvnjTa6Qg0DMbLOgwYreHs",
+	"KbTHwVIS1c+xwEW+JLmmb3JNNWe/bJws2w442Quc3uAVCbHic7K7y8cyZ0Tga5pTtR3T8RTnd1iMmmtO",
+	"UkHUqEmodCKF3p0xfS85Vzd01HSRWwWonFEgGJrHMQzoBheFPXBPfwaPmEzs1o3Y2WTS3Il9diyZWAQZ",
+	"gT/JxO7jiG1OJuakh+NBMqnh4R7I6m7e1nAQIXmCO7vkJcvOIxLlz2sCzC+VyN44dIclghMHXSnJwBiN",
+	"9eM9gVHEBsOyMqzIS0U3JPb80ixK5Cm7xTmFniMACToZSBi5I2IcPAUWiqqoPA3cQEZuaUrMG22ZAN/D",
+	"/aAJWRs6vauIM61k1jaa2PzSUvydpEHb3uokEATWOMjwRWuuraeAwqsVwCTKnEgj08O/8MmDC9tLlQZb",
+	"kIKDEDJFxnqMONOs2spY3630LF8YifmbxWRRvnr111Thlf6DLCbffLtbRut/giz2anZTtl+OZfWk7No2",
+	"OwoMGFip6ht2rP+79iKddb9IOZNKYMoUSvnmmjK99yjFpSRa4IIWy5ymmsfcw/BlYYssLnVOBI2T5Qrn",
+	"7sAk0q20hkFk+jS5hmpFQX9j7PpykrRs08Gx1Id/T6Xm0/0EvUMPYkSCI+g/9XdpcSE4/NchPb47ukCF",
+	"abGf2Gg7d7C+v3FG7suLjhCm3qXFIyoWUbBZX0ahmONrkv8HqxTN+r8qFTvExa+KuGGKuIAujVO76m5N",
+	"Zav+0bbxtPSBtKvjyN9sxbggl2UeIfTmmwx5lIBzqW6ZZnNSzgzhlAnCCuUES4U4Iwvmv6BNKQ3hIkr7",
+	"E5a5cX9EtyHTDNxxgqRmwAEwUPyIBds0pIYrItXs2MBDpDkCD6UGDCsE600QrqZaMN0QowKrte8Miwhh",
+	"oEQ6CKR2bmxOLhfMMJYlAyUVtVjTcMqJQtynRFNEel1dcwSLH8JeOANCrOE0zmSrdeRao1XOryu2Wq3d",
+	"33Y3E7TkApFPeFPkBB3wQh381wFAmWGFpwt2FaKH2Q9coUlGhSZK7tHBEt2RPI/q2DQPJ3lU8tjW0RAu",
+	"C05TUihzW2IWTI8/fVuuscPjsjn1rp023+t7siKMCJq+xAV9eUO2UXhaKB4HKhRxal3qM2J09PEEzY6n",
+	"k0F8bnXLIxTsXKwwo78ZBMvIkgI9NGKKBcS4trnt9oeQBHSfAP8ChHwp+KZ+VAp4Zj8W7GvEgU042AY9",
+	"p9V6hvG0XpvRZPM35kOnSt9+d+/nAGWTbtp52S5q1ysnhko5aQ7Z6YYdqp1wDwWhkXcZERIEjjgiWlDc",
+	"PYBjRKJk6EWa402CtlhgwyzaZ9Po3DOyxGWuXC/T+lvPsZWy720bfJZ7qZVt36dWK9tp42rlTYWbg3C/",
+	"WkMvO7khCgORHjz23Bzbqeu3l+b6tH5nWkfcVhP+3u3NGnkhNiSj3XY2y0Fd2OvX8b3biCfJLRFavzdO",
+	"7Tt3/WBLiFRHWJEVF9s4lhOpjntMPMpzC0NowQ6V6vDb0TyYp74mzS2N35dGq+GvRmR9/WZpS/4e2jjW",
+	"iT6BqbrZ5ke6Wvt27SFOSUbLzY4G7/md/xozejfby8d6Wjq42uqNybeMygTd0FQOeWR08wd+ZZp7cUyX",
+	"y/ZO4Cwj2bBVVqrofOu9a1LMkNDxHINVCTEsbmKtIBt++0CAgeq1wMJGlz0omCVL15itxgJKGbrmah0C",
+	"KR8Qrhg6eKNUS09ckHs6vuSYrcqu1y6nKWHyvlN0egsUpch3XJDIh1siZPzF2rFte71Gtu9TP0J22lPM",
+	"8IqIH6kNTK1jp/4Z4WteKn1duNa4aW+brVRkEwo7IE0ZRxg5RdqC5gjZghVmMgTM1rWL8oGOa8pA0nLf",
+	"NwYaI/amWOGcr0oIrQSfBJpSZW6u0107Y0GgkZ6/OT9FmOF8+xsRXnKjG/CzITKAhCiS6jE4QzmREq7/",
+	"BgRDCjt8XSodgxHRdugGvMN+F3Tu2JuGfJsXlJEEZeSaYpag8rpkqkyQWJM8QXiDf+Msp6z8lIDwrTjX",
+	"+l2RrqdopmRz3xCVSFNqtzEd29uhNQkRosPa1zoorQnMc6PZjC6XM2M3KG4SlBU3qwSJYpOgggsFI8F6",
+	"8mJz33fsgmdxR7b9ndWSScGzDv55nPLR+ec5pXPUkc07lWGJqI6PS0shCAOcr9vAVeA3FolIusU0t8qU",
+	"/8sZ6TCAh75unZ9Dx8JdNKzuhdhwEWxjkQkjAYwB533MMuS05mujSLZq8rjCS2HVoVTSn1pOeoGVObZ7",
+	"bWTbbetuDG+AttanCuwx1pIB2N1AoLGGXP2xCbnsQasOO/Nw6259MsoCw26BV2TwNrWuz+dO4HbsIQQi",
+	"SSW2h2VMYXUkSEaYolaDh52uiggkbMcpOqE68r+URGj9JWBugaW84yLTunvFwVhjjE3eAGDopm2FrXrV",
+	"tIRn7k5QRV4CU6pRabtg1irkw2Pt2RxezHT3FMPXa2Kt49bCpG1ixHiASK7TMmzRGt/CVBYUhFeYMuN+",
+	"AQPiUq3NU1sW2q0l9txBI+4ktvhVhs0x73CwiXpYCYpSLupvXsbTGyKmlHc8Q2anYDrvU+N/bHZIJnr7",
+	"KkyAk4et3b+7O91+ql9tzS878K0SLhsYVxMN7XVpYR0l2jmCSFkhghMj69uqOCrKPEeFoLdYEUQ3eEWA",
+	"9C2JICwlmXOtcb7K7XMermKoXaaIxAOpDz4SQZfbq/fzuPxcSvLj1dXFUMdO7/o2SolmOnUqwez3IWrv",
+	"y6DpLgD3kgHc4p5YBrDTxvVPdm9G4IRfxB56osv6SXjV0Mnp+eU/J8nkp5PLs5P3ENFwcfF+dnR4NTs/",
+	"mySTt7PL058PL08myeTD2U9n5z+fRTU+dvTHUvTYrWrqd4aYDdY3tvPDqnUuS6bohszTNcnKXGvkq7WP",
+	"cP2x4yBpB9KQo7oJFlapdSpWOuTsCrpQadZNlV8ZRpKylRvFjamfiFC8NAOYHasghwEzKvVBIc5SUilw",
+	"qKxcKLiwfjINcMDZcEOBjFYAp4Kz95RVsEI3y3UjvW4HOXxYTIzNj27IYgJHrOe0UOilaHeBpiebm0RP",
+	"q/U5dcD0k+4A0ZYoB8mSCmlwxcABKkOsIt0jqw3gNsPo5RhfgQAo35AslyRV9JZowyag34ayED2+az4Y",
+	"bogYL8Wr00XkUyGIlC6lgX2uJq8nf0M/oP9C/4W+i7EBteV0mJDJJ78sKkNMsQyYEnS10lZ6F/AzzEkX",
+	"fv+Nx1Y2Ozw7NFPCd2PErm0nlYjc4rzUjj+U1V/okxI28OA9ZxmPEIeuQfTHalIewe76xh5uiKApPjgj",
+	"d//6Jxc3w8ysoDnpoo9eodJNA+uKl14KWLV8IbdLZdBY0Nvt/nQw2U3Gi7jCa4BqrtbFZkEA5mcok2R3",
+	"FQCGFcKB8VLNScpZFpM0zXd30LpPfXsBKaTpXt9h7PeXL9FfX71yrVp7uqGMbspNGBQfZpRqI8c138TZ",
+	"hGKIHjGqOrpbc0lQWzV4R2rKP3RNtCN05blTG0fruGpeF/Z5Goc6dtTh3E6ltt2D23FbOYw7hNbHxkz9",
+	"ezTJQe/t/iXpdETHaFPmir600ZHVo+xoZhT4w2suupghY03RQrQ+DgxtXVK9WAYdnTJQjxizkMyJci+6",
+	"eQqx9GkG8bVV65AlF/YdCCbqUBIEROFXfi0vjc9kxyNTbq6JgNXoyaF9DdeMflmjrFT2kWY+yAGameXf",
+	"YQ8ZyXbAtvsW1tm4wchj+oxBoQSSEF5gAardfB7Yhi19mbz+PqYQgif5shz0ZuOAsbkmhpeq3Krq73mN",
+	"p6JKeiydojND+hyGdLGLonIo1zNpH+kNF4GfVpQ3GB1rse9NC4jWjmM/tk4u9SneUpJnUrMauMYGcesv",
+	"bjNdrrV185qoO2Jxs2qcLFj1Txj9o19mp8hp7rGPKjZcyoJpohE3mvinuQ48HBxsbZN8184PYGDWKdsy",
+	"d8AMa2ShKurGTBrB45FX6aQZOi7rAd3ymzCIvK5uWbAVzzPCALWucXpTFtUoob+g97uu0pMqfEPZasFM",
+	"dtIdYetGdVh3B095QbV9xyZetYKk1ajTJWKEZHbHoD1gfEZyAncLLxURfp/NMQ0M8K3vZewBpbtcLy8H",
+	"eFnWHCfNP1RaZEgWTGc0tLkCG+Y/8OvFOTIQGI/OEYvb5WO5gwy2/Qg/AUfVeDCMNkD7PmKmcZYyVNgB",
+	"DT7hdO1iCO0Yk9ffv9rNoummFh6nFf+Rl12wXZcZUJwKpso4soZeCZLlZgN08jZAEP3YLRhfVjBCqEFK",
+	"rHlKE4WygIupEdl10XiXY3B6IFli8FSQDab6XbRXyyOnuyBOjnUCPZyURtsF80+pe1v9LBm3cnVNxrDL",
+	"pXLBSpbTDfWqbWKij27JqdtcQ9Yr2s9LYOSC3X/ld9+c7G4/AxesKa+44/FinLBr1aI3LrzDJqlIENUK",
+	"9SXV2l+9l1Roj1prgNeGpyT85cMHHUgQxpK6LVowIyXkeT20tBYi0rg6vZwzdDvM848G8ojM7Ai8m9at",
+	"ESuFAUXcNQ4xw8JSS+vMbZQLFW0iGRIRO8+CuYnC7B8wuLcGUma5ico7qSMoBpq8xRuaUxIoEfv4rkYP",
+	"O87f+XWvCGglfhADK1mvxnj+amIZ9NW0Ov3WReAiXROpdJDUN9LRSehpVlvNYW7zpI/qyDrJOdKJtjkb",
+	"viPdnW0mWc0R9QrWndpNPQrv1+b7UEjnxn6rjRXugZvBkuNGr1nIr+jXuH2Lqyebhk4RYsHsOw1YqGeE",
+	"e62tctIqEk0Cl2qA6631S9Msg55zTdIbWW5kFRR2nfP0RlZSkIkLc5cI2BFifl8wn2+GfCq4jS7eQJCS",
+	"vR9GKE95sUUZJxIsjTaIUskF86nY4XdHL/QFNKtJvTELmzG0PAbMu75tFc8SSInVzuk3ADhu6WmFXRk3",
+	"mtMgNs7zWFkmrRKQN1ibIValCnW6or+/aCx3mNt7CEq7W7B7qReCX+dkE4t0J3nW9WZVAR0hl667ONcB",
+	"GLWRjCDUf7aJ6NRGIU1DG0vULNxt5du91lCUeUChOZRn6mfYJXq0Wo2Tu1vddzCArbaOX2l9iPErrUbt",
+	"Bz7apP08RptFX79Iy+ApiHy1JL71ZQcZb7QdkpBzpzAvQiFZcYRr98LQBCt5mxIKtQC+KK6WHQyjHthp",
+	"SHTqEVFaKtvQHdSxtErdv0fOiAqujybtyQ53rpCW3/rWAyC0REOOitypU7FeZ6BkokGKwC5KYgKi28Rr",
+	"iPpw966N9dEKsWdgvo17e2gFc/bl4LDOTisyRbp3rrPq+lDnnEMuHCD2/y5xDiNA2zn9bbibV52NG+/g",
+	"5dXkEfN+5lSSwyyA+7y6zZQ51Rhh/sJRr85E3/mRoHe7R+Z0SdJtmtccJan0mn7ndHFBtDA1gfSQzkFw",
+	"kkxmYA9eCSIB9Zy6Ppm8xTTXfxxzRqLeF3q20y4+6sdyg9lLOG54UV19CwQCXWp8zTOiMM1DP/QcS2UX",
+	"oQRmknbGgutGlx3R1qc4XVNG/OQJ+lAURBzhDcmPsCRIgbo6gMSoMmAwrw31Uf/fSANWHSCfjtPvFxxn",
+	"dl6qSTI5Z+RcnHJBTN45s5P2Ja42f+t3+AP4wWs3v0kyOeO6aoBv/kZrPU4+rXEpTQtXpSR6JuVmg/tN",
+	"mFpOsk2D2iq7nD51EzQ7tnovk8MCfrMqZM3qwWZiqTUQNTS8Xz6IKEl4xoz9kN3vXlib4Wpf+TTmZOiU",
+	"gEs7gCvNVXurW091mBZyQOK+QOkRRP4OCPgN+7VY4Qsi9LK3o5WwmhfRK26kGFiJbaHdaBZMm9qdZGm9",
+	"bXz9Ly1kcrdLoRJVS8AL5rcTenJGAn27rhjWMNVbCTcAEEwCBkI3OYfRF6xXLxONFR0THRacViGIzZTZ",
+	"zJ8BYkRWQ35dq8euugrEQVQiMOWDBPga/buk6Y2xDJlG1pUhi2cjWYIPq2nsFXl+DuhlRUbtMY2qUZ0k",
+	"adSBroMpqQJ6B29L0BRpQ8Qq1HdzVqlxzAZYyfZ6a/5IFixEGsWRcTbx5eDswcGrwJ3hymOcPtxqbHOk",
+	"7mnQGzRJJrDySTIJ1xcl3aFj5gB/zOBo5TXf9NKcyg2oUvgd8c0Gs+y88MgV9yEcpABsDNYqMXXySQms",
+	"w7HguHPjFrcqN4TZJDWE3VLBGfyAbrGgsNVSu9hHLGOAq0Jj1g3ZGvHJfTKq8XlZ1IJDFqxyykyQuFmX",
+	"TBGRoBVVOcE3MgH933KZkzVfJajKEpEgE827YBDOqwHl8tbf75pOqiLkfoNhx89vichxhLaZvcK5Udnj",
+	"vELwOoGnDP3z8PQ9MqwixIhpK0hGSPGyifJuF+oj6BQvuGaJN3e3OaWmYPzOiQslq0aURCmTEWWNlQ5n",
+	"0GTPaBzDcIaO5Dj2BvSik2lWIWuDlvT1/1hv3qc7czkU5xXn1CSQlqmq6cmcZaIt++p8MSfBq9qm67pJ",
+	"kNSlq0WM/He0vQgcnTqaXAYEpqPJvDqijhYf9z+MbY3r7DqPv/PrS5vwXHYlPgpoulV7uxzpsskM/Vrl",
+	"hrLmlAU7ZDUTCnS2Spi7tUlqQ3Q/Kr1p0jGDG7gVaU4wW7Cy8C25NZ8662Y0wnR4IvoWJxK7Tu7j+50x",
+	"eS5ir2voxAo/K0tnbOSeTXjn0sxrD9Ud2eWTyW2XndBqkqrT8VZnRrIgkX91Qkkrz792dR6lcPg7vzaK",
+	"zQqXPg+VLCJ923UUpJr3lACojlObTBrJ/xsmJHsC0YMmvfUSQn+S+l66HQQFmL0stXgjT/WpsuYf51Xi",
+	"q+T+yq/jNeukSIfvwU7wpt0Y1T+yXWHT7DzgDnUhwEXeaeLPcaD+TPR9L91u8VJZ/wgtBrMtomwpsFSi",
+	"TFUpSPupWA4Q9TpYAs0P+HV674k750RlyhYHNsJWFJ8gGU69C0WvYAvDD/GlDGAxPpQOIhMyXhARxKr1",
+	"azqLQV46A0EIvXSGTW9A7Ywb1h/tLMaeqsKkurtcl/soGCCh0R/trzYNxmhRsKcMFR947/Y36nWY8wIF",
+	"8VA7XV1FHDV1tbW/7Wahgjf2Ve34ctpVDLet92x/r7jY1realu+BzWbMGsO0eNw0oZkcs2aUzqMHW5NL",
+	"UhShgqEjUDy9j+JeaI+4IhhdT1eQrHHVmDcKWLeNuPfWx+gVRmpl31NYcsOGRbJ3pvAcL0eZKbqubuV0",
+	"M7iSSq2ab1/ZkEYFxb7mtazoffVFaoAMAbZd0HEQ0M1k7UNA31l0o+ssKhownII2xeA2MTV+uFlYc2D8",
+	"oJqNOHI+oHE5E5q8J0t1xa3lvD+G4pekT2gvrI0rICCg+KPM6FSsGqYU2ntq6rayGbcMWjSopPLh/dnJ",
+	"5eGb2fvZFUQxnx6+t9HK85Ojy5Mr+Gk2Pzo/ezt79+HSBTVfnp9f/TSDjyf/uHh/PruKqgHnLgFkUFGk",
+	"IXtoD9DO4PfKZ7QzAY72Lo1+2fCSqQtOY/bsnz0rWRUv0eG30KeVxiDRSlqTlG3pyoIE+cFH506uTxr4",
+	"GE+7TL2sK2awLAcGWCWTuHLz9e8Po9z0yOhVmtu2gYbd7tLM7q69NjmJzqsV4byuMCwET4mUUWcWAss7",
+	"FLEEOofVMgubDL8hgYW7YvT4wdYIsmBMi8OKiEIQ7woj1yTPE713+k+0ISDgYYFT5VKJCfIrqUSY+wTW",
+	"O5+rDV6RizLPg7wxHRa4qkFU3NRZWKBBipU1xdl9Md6iOrmKq5gDCT4awwAgiY39qf2oIyUWzKUDqbLY",
+	"1PLRUFlLQOOl+yD5zIKNyT4TTRBP1JpHHHCkwoqmKOcr7SnrNQnRBDtJvfqCMN7hLdEJkdR7xF0IDkTO",
+	"T6A4Ojm69PMsWFrP+lNLG1XYzp36MMiyQ+szHV6ehcn/NfxUSSR4TvwHrTe3lgo40PZ51u1DZpfgh9bK",
+	"og/D/TPohAHOu1L+8BryIsUhkASOSC6YqdNk64eg2bH+n0yzGzElqZiaz0aZZT+ZbHf4Tk5TvjFo6qcy",
+	"h7BgtW3qKmZRbcXwXD4WRX/ZceU7nd578vo0MadT3+vSUf/Ii/d0Q1WfCoMRdcfFDVrzQlrtrCw4C9Ir",
+	"emR2GbIhAkTouJAOtEYbvEVK4FuIKvkO3RBSWH02tyF+ldX7XFtgQ/OZr0nq9e0uHp1KdEMKhehywWpn",
+	"5mOe/vuHPit3+7LFdwhuoV3e7PC0faM7NIALFt7tKleG/Yy0CgduM6i+Q8rhSgEsWGu/kdvuII7FUr1g",
+	"BLOVCxbfy5oJghGSmcOmePP6Akt5ySEasSBiQ6W0afWMXjwnXUSt47q4ZsAPyjdbo9wGH75IGIQbEQaR",
+	"ndjkWD36WwPjMheeYy46DYdzLQnTb597FXKQWaWCCimsAu3dG/sumHdLNwJ+BbPWzGZCExcmbbmdCkFj",
+	"EHg0duFumuJAsRdfjsXwRjmVGsd17ZsRwYcN2gIbX9v3johEQdPOkmOftgMnvYC2ocOlpoaz0+P57feR",
+	"EE7zGUmTy8UksJPohWn/recSbZC7dJfL7euCte5ElxGrg7AsWO1I2pSlr7KRIEpsT/GnQ6XgnDqsl3I3",
+	"hzfkNGNdzWs0L8ArPUji2FMIvdVlwAPVQqJOla6T+4aUpa7djwRtjOukvS0CikK1L3mPYbJxj2v8CmXq",
+	"v3+Ix2GG4n3tCW8MV6dnu3buPY95az+wGTbnXWlFU86kJtalKkrVNWhSUUJXu8dbZoabrMKqF60V99aM",
+	"MN/nw31Hg9a7QApJURc+ftrGnxii1TXa6i54uVonPhNi/W3XHKN2O+Mo43cs5zirjWiSYEr0wngEHr9J",
+	"0FIQuQYXn+l0+u10wU7AJG0cw9yjY7QXt0QImlkvOANskJTasU61l/zF/Ojw7Ozk8l+QBfFfF5fn//hn",
+	"gsLf5uZHY2V3H87Oza/fJpXfmpeLdFQeSOzgOQdZCUy+jJhItlaquHCPRdd+W0wEYDxd9yw+giFeHxzo",
+	"pq//+t33/6NToEoI2Pub//27V//zqoPbgP5yDAzzRwCC8Q4ItCIHSVJg4w615nrejG8wZUZVczQ7vgx2",
+	"Hwmi2coFc0ZejwwVvKak4FGOBVVb/RIS0VWHv+u2BPevfqhg3bokOG6wgo/zlrKr+n7CVpSRj5157yEA",
+	"YKml/Lc07/If+onxO/aRilJ2tbAgHNtyabSn3Y655qUs+uAB09oVtvlgB+7wPoE78klDdp5HrM6+5uZ9",
+	"DECHRo8wxgZUXvvtG2wLqhUEHmAOqoE1EPpk0gHfuNVEChgPXNZ4UxEv4hVB4XefRHwbCT/kBXGJcHdj",
+	"k4/EjwJgK9i37Ru7K0sRlh0BQ8jitIGwzOWvbH8EKfkiWtruLKhcCK1czmYXH2SYs9hbs6RsBbrrqNHk",
+	"jCvy2gTCUGO1MHEnsYHEI1V77IqgEmrXPuoGXTvZfZ57JUo2XZ86T7KZNZ7/MLC/D6OcbgX7hDPVPJ4f",
+	"OotxA0dGZDF2rvEPm8M4dFkYU5DKreNhylBV5zWq+NQgIPYsOdUNUk+hqTpQ9yov1QVD/CBNMbZzKyXF",
+	"LtGwYoR1L/GgEuF9q84CrjshbkwF2pCstGEIqiz+MmBfhtasrUNup6BeEBSkyLFLj119xFLSFWtXEWh7",
+	"OfEQnoHY0DjhYXjRTNNwBKlt4ifXzC8TukvjHmfpBaul2PFtjauBzain015HBGWTgabPFFPlqYlk8QmT",
+	"hLobH6BT8JQ+pbf4hkqjxM7ejFliJe5qewNbJSijS11jQiEuDKkDk6IizEEDkMYX/Miu4R3B8B9PLmdv",
+	"ZyfHLmtoLaVSO/WQ32y9XwsWOeIEnc7mp4dXRz/CmJhtTXdEpdsmrZfwO2X8FT6czT9cXJxfXlWgVOpt",
+	"m33JRE25wLR66icLY91c7BZnfIs0TLo6gp8qYjSO3k4TPX5pbYrRmlWmtTGVUVk5DVCGUlfmTZpxTCPT",
+	"QvO34Pw+jbgKdPi0DoIZCiqNK3AAtjyF29HONyRe0Rbysg9gMaG7a/xLHNC59png4lwncjfSZSMzAr+z",
+	"eL7ySexW1tdCl4EjNhgXknAdnh0jC4GJnPWXZCUTdH4ZfGSObGym6NjwcprZOzw7rqUsOAMcOr+MOhlc",
+	"4dWKspVOmxlRl9icCEMqYLlhjqpOQcqiNj2w4fjhUw6STlcpr85KXrBi43nWZMuM/sR7EvsS/26qOoOg",
+	"HZIYUS+XOLUovRs3mGENDNYFW9WBJ5H96bIiZFVWSY0r0NVsDjqsMAIWHTTWWiG9Rm/Ds7tzTVxWeRjD",
+	"h66Efa0VFJ7/bceNtvuobcqxFBxmhNBvO6hyABsidVGP2vkAKDu7GKuy/lPfExv1mKAaB5kgG1uZIMPP",
+	"JqjpiJ0gGw2pccJGa45LLQ92qSjfui+za969w6LI6a6IPlw1aLyY2AXGhz8682zHRdJTKlPdMnaQ1TcX",
+	"5gJ4hW12b6Iq65VRVWraKDtgaLLgRXmd03R2gbCb5X419tyCjgRVNMV5Z3mztGrwQHs4LgrTBTqG2xHE",
+	"YeqL/vF0x3Tnd4yI+FwcPt1zVZ9306yhEg3QF4c3+o3rJMYuF/C2lsm1TXWEm30okjiQh4kuVbDUML2V",
+	"aX+kS8w+UhkHe1imbdQ5twZEJB9OZRbuX4o3IlvVkjzaZSKph91ZXlAXJwSyZnK567eHSruOuLQw3PAc",
+	"iSiwMS8D9NFmid0KafP9mSYWUh41+5e4a3mzTWFrfNSXF0Q6Drxb1WiX/C56v0L2yI3/Sw9klyQOXyoI",
+	"VrHEkTGMWprUWoPaCn6396pNCNeQXIVQObEYBlLf2cFut99pcNWxtEJxRHXLaU8Ogi8e9RnfzrGxqjtr",
+	"Qe+sI+nnc8LRkcWyZDK3B+Yz4cVz9tz16VeMz+ade3vNwWgLU2JyfwIzr11Nv7P1MJQxOjvR5Gj+Ea0J",
+	"rpc1boUoz7JevZJZmnNtdLV2XHaBIDhy8MGFLkf1qd+UkjIiZcXZNdJp241wCWl0AKkiguEcYWl4lVvC",
+	"FBdb9OLo9PjNt21cxnVOuXU4eBdby7aoUieEUDazJFTujVo1dF8GNa2zpi2guWPsBh/CflHT+3AuTZZg",
+	"n+hj+0w/ftZen/h8aMJesyNVDHDEEDcuVZ5zprhvlocgd1MjZMsktzGaSydSeHksdGWv53qIWbA1V3Vh",
+	"QsC6bI0dSPFrI2PPgNwstaws4/IIul29dyy2G6hKlt1b1sNZ/QI69o30CUgYeA8SHYCuJRlTPayKURzh",
+	"OR6Ef8YslOMyxbmFQpq4/vldRcARuSxbKXLHxK77yfprNJiIAvu5ZjoK9flB5YaOAlD+zOS9Uw3FTV2R",
+	"M5MKq3Ige6mDuE37B5COVp3ZS1ZGK2AfulCx2NCOhrZtF7NoKt9ZOR291R5qrhT5ylAh60EjXxhl9TeL",
+	"iQkPU3il/yCLyTffjlO6jZGCmmh5e8/UZbse4erZeNbiY/11G4aJtv2gxe+VHN0phJ40O7qb9Et7XLb3",
+	"uV+WhGqLR6WQvEMD+BcQNo0mFmDSIqEr0tjSt9fj9JUoWYoH1rJMJr55vL6nphV/UbzwIftmd22WWGEK",
+	"Y/t6jR4utca2qEw8869v6C2TzohR4JW9KTvTWu3M9V8nwhE7GBGCi7rOYGRS62SSY6mufErxPXPBO6n1",
+	"7PzqXyaSAEx7szOdZOLw6urw6Ef7C0QXvLs8mc/hwxtjL04mx+dnJwPNxq2XaW/uuLm9n5OJ4XDzPXoO",
+	"5B1jPcfyj5ExhjJika5DcvbGug1jrSI9R75+rRG6kWKc1/fHUy0D9nltX/BsULtjKky7Hq9u165nmGTi",
+	"Ju6BK5l8PN3Vzi9zpFf2VaWGHfGOukx0rSf0Md5PNxll7fGf6sHcL0jBHdkzyoWX7OvinIRQB1PE9Ovx",
+	"lMjjHI119NxcBzaNrHTfDB0WZMOVS9BvQqWCaoiJieKuCm3XouuoDAPwtJSBayOBW7NPRWDEO1UHpzae",
+	"K+sU+jcPSMwfZtLYKYHbdq3M6zv8tRtuoCO9tn2co+axoPDB/t7bwN/R2+3I49Z94qsIjrm+EOyXwZfo",
+	"r69euVYt0Hcdy+d+zB/tdN54NR/I+bwmWo72QR8F056+6L0Q9rikx2G8l2t6D0h9px+Jq0xv5XDLX22s",
+	"I+g5gMvvC2XKgDrwUVMfmy5aa/tpVM+39JORPLZEzOKq3Jyym3sKNlxQEMHy0B1qmEmiwzHqlySCX84B",
+	"v8v9PZBq/UPi+xjVFTCHPtmY++R85KdGM6y9y/o22TqhmR4mtKwtsj1KJMQAua2NtjEPEUHT8Rfg1PYD",
+	"6LTratyztjPyeBC4pxVwDQd+LMk85bUCClUpbyuMeu1dVzu6KXCqur73Qnjs729Dp6d/d7ZEGaaZtOXO",
+	"4MVTJrnae8rKT0iTArBBWsf++mpnx+/pTUR5qJMhHf/r/eynE5vAwFBaW/oJPh8QlR5w+VKQnGBpghvv",
+	"UY+ry4c3jJ9sr2iS7MSMhh+/+dA9Gnqxwb9yLUjoP6YbyrhAdsBvh5mwG7Rxj6jF5ov0pMGLLdLeuiFe",
+	"TdS18/ei9L1bGo+rjKgh9nv9HwC6YSVfKtVjnKkpdGkcQ6k7qsE4f9RI8ZSOMis/0tV6eOv3/G5441OS",
+	"0XIzvP0ZWeV0Ra9zMqBP/74HD6F3urmcXc2ODt9PksmPs3cQ1nJ6cjz7AJl235//DDURT969n72bvXkf",
+	"1VZqCd3cW0UVYMSkSsVxeDGTk4DWTL6bvpq+ArB4QRgu6OT15K/TV9PvJub11qs68MHvB9JHyVu7E9dx",
+	"HZQzYKEm74jy9RxtQL2pWbAhWt3SRUKqJgc8wwob+1mntqvZ3ASZDG5+LjIi3hheyicThMV8/+qVDexQ",
+	"hKmGU83BrzYfr7mDg6L9pTmPhinAFqzUH7SY1zWWB+7gA7th/I6dgKpdo5U3g8Keawd0fIupJgHIHhIw",
+	"YGXkkC7KyCFZrcQbnm0fZQsq4u6zbz75xh/qmnvw1TojEOXitqAq3fahTmTedSLJ5NPLlGdkRdhLu+Ev",
+	"r3m2fWl4iAn8rcc6WAZpxbtumk89/gyvmHGKGtr6ihfDAbmhwxufaA+n50UY/LE9HWmoqrMBTeAyRhS4",
+	"DBHqMciBHX4YPfjucaZtFfskd253TJi08QrVG/XDAx76YUF9iGkEkBnTxds9KLKEmTwc/+ehN8N6ZUQg",
+	"sQ0Cb4oHwkXjSoywW+MexPDgd/vX7Piz4VJzokgbl4/17w6b37o+o+mkn62TIOzejeA2//Dqh6fCJXeC",
+	"s2OtUNZc+UMdotnZ6hCnxly9+316kAN4nGfKvQ9PQO97yP2fBEHeWecaV8zeZOwPsaXAKl1H3h/4+eGv",
+	"7Bd+xZ4Eiy5Mfovg8ahY2mf2kP0pcFzvd4jVw16ybmnsK9rvg/YfdCLbr2j/VGhv9ns83gMHZ4pl+Vjp",
+	"Lo5hFjR7RKQKp3kaIUw7BuX8GufIbIVxKw+IQjMNvU5uJbs62roh8Kf2GcUG3ZxLSa08YJAiugosp8Lr",
+	"dKlE1yU15voWaWqeyMMTltZhPB1x6cGDWbDh3QqjL0BlapjwkEqrTjSFO+xyNh3QMLO/vcpt1zhZq4vg",
+	"Q05cqZxm6QkXp0WFyeWzYD5JhK55pbAiCRJkhUWWu8J1bBsWRTJaNpMYXZBbSu4WzOYv91Bg08hOh1fe",
+	"Uc8UxdVhHvVaHdKU9s+p9NGZNcA5MyW87d3Q0GJdKjwjwlaWqi3FDQzZ9hasdeneEeV85ap6CC1+YEdO",
+	"jRcua4avXo50ct3ElC3numz5t7BLsKZ6RRfY1sSTDDekoTF2B5oFKCgA8O/SZMa1hN91nCQB4rcMfo+l",
+	"G3xMYa59NH1i3ZNTB30WqNqaOjPyt1d/fSqAriLeihmV2qly+tCva4XBtduZlkIQpvKtT+Asp4acVaWL",
+	"d7Ik86DZVz37H0nPHp7c06nag/eoT91+H9SiGdkUXBGWbn8i20eT+ioQn96KV5+5TVrCl9+mHsXS53Fw",
+	"6R20P5t7m6vSMnhDULCH6IYYmfEhDQ+7l2BsD7VFfHn7QwjOo9kgqn3pNkPMA0BqqTnMpfrh+++f8hVr",
+	"YEoN0UoZxTJXex1k4Ie3oQTnNEIKD568A33eLpyPx5zvL0tmHlPblPJIAIIRPKUPgxbQycLnUjEEwL6u",
+	"5WSuKuBpnqDK+mDjY8JSgbooqw91SOw4vKgKjroHHsbytYg8I4BZFi+slNpClw7b3HB3JM8X2k8RArrP",
+	"TCZXRCUqiJCaFY5x7w26/tHt8p+XPH/02NF1e6znvUtI7tv/KflRd+L1S1plFdXYBoikpnve3N+rfwbZ",
+	"QQN0nAc9R7Mc4bR/KINo+JY8qlE0LNC8yzD6OCfyx7WQ7maU/pxIEzeUNjFol7H0Ee/1f44g4Wyndcb3",
+	"yxuSdjDiz+IKPDN54CHNurU7eF/T7tdL+gCX1Fl6v17S//hL6o3Qe9zS3Yz0gShZtzBsJG/pbFFCgZTr",
+	"FTjeKmTVzUgSpZwB1kmdC2bATSoN1B3W0ZbwApW5Q3AqzQwgdl5V+QJ0QXdBfjVBcnRZSdmoIWSbESBS",
+	"XJSM6do1JdM2syUXUKKc+vTnLh2ktPnWTXtXfVMQiM4zWUl1Hrh+gTckcpcluz9L21CaATgRUGObwKQi",
+	"OINPZtd8bQuzn9MO25Xeo5jhqkqS9MsjeitXGwjbt1tvGFn1Ha6w509Mibpp0OHgW/GnVD9clmwHYWD8",
+	"rmlDp0p6AhRYxoL8dVEL/2xpEplRGWStUGjDbfmx3YnKFmxHpjJb8sB8dyTQLB2mU2sCydBcpjVbUSrI",
+	"nZZ4XZ/O8KZrh4dD6ikXzGSFkzY/uJKoSiPXYZYP8/p9NQX+kUyB7byMT2MQHJFasd9UWKHeY/D+kQSX",
+	"TxqlE5+/EVNP7qpcjfrKQ6Yat502w7VVpBQkhXS4+gi+qHxgAH48a1pHwtWuB9pjY8if602z+6c5XLdp",
+	"D2+0stvROKXusxvJ29tLcvB79Y9Vku9Sxtpe86DPXpyr7/zIytgkmjNdp9SoPfs2DxPklpJY0GXSLgmb",
+	"LJhJoqgPvpkFsuZJ2hgWHtXgIccgEc0PL2dv0ffT76avUM5X5in+i6nlZ/42OedNX+NjmNXL8wH2d3uW",
+	"mUXW2HMXNg8d4QMsNBYX/5QPjEbIcDgN1f/fHrTJvDY2sKrA3nkMkeT9z0qHbpHlsXTouL4XA3TmD3/Z",
+	"f3lOT/KrJ32STZtGGmV4mgsXlOSLLf6BXudncUP+o5iEmvLdTP8guvevl/0BL7vTw+PG3Xkmmvivd/l5",
+	"3OW6jr7iUu7Pxx9kNmFpVCd1aatut3lcNITFdcEilWSZIJt4FNk61D7LZySz6IL51KJWIDUOgDVGvJEy",
+	"Sg9qy9PrQBETAAM7YjNH23q+Bn5pszpSAUCviCgEZXpVC9ZcVtDWubzBiIpI1R140kExdZrYe8tDO2ve",
+	"B3dHcZek1RZkKGWJ81wnH8MMESxyave1S4ePV5gyqSZNArorHOWxHVzMXuitfDYhI1etiuBCJy30Bfwt",
+	"jZDTP5nkcGQRrBUsWa/tEzryhh8c1sI9dXUC+0hXzlfd6vS3xNQHt3VsJM8J4qUqypo8X/NbFQTUiZ4U",
+	"LRhUPxOukFjsYrVi13SoHCwf5kJ36y3CCxYWUzN1Q72OHmscdXY0B8nUVhWXHhILuVG620pr6CqYGG3w",
+	"1mSjvSGk0KPZPlo+WDC51nY+uiGIw2LD+bTdRLvcZePI2Hu+R6BFhPl7RCLBiNBQPnsRXherZ7yNlmCA",
+	"zHHJvPBHlTW0ffdkQfAkqNUF167rDklvvvJu5jZtskPUP3XI3K6taRHDfgLnSrj08mbzN+enuqrpxnj9",
+	"e7JkuB2XSPR661svmA4N2EaoWmIUj0fbNOeMHP8DfTf9QbNrDM0vjv+Bvp/+Ff19fn62YBlPyw1hahzR",
+	"gAKAj8H71LW1sMi6GjQ1C8o+TcdrQn1f+Fpkn+6vDYVR+rWXwZb7zY5oJ+ua0VuWTT3A/XM0Thr27Y+n",
+	"AEVB4VL3fa1LghlMeOibrm9cvd7wjvvdGw/71fz9x4uEfeoYWDlFJzhde/cTXfnSe7u7pO+bMlf0pXJK",
+	"5NAPbkDwbG9ehJmu4EeS6rJRyb5RPjjUFBhHlC0FlkqUqSoF0Y52joUxfKsr8dSoAsoLEvGyWTBXZxW9",
+	"4CLqibSEWX0r4473rbGJuSg2Cx10LfKGNw8NKv1ZN7dui1kmtsYRb4dDW/Kc4o0fQ/PJGRmQwB5mv8gx",
+	"662yZsD8pYOBa55XUp2WMX9kYotEyZLaM6CVTwt2zxjmBXuMKOYeP8TnErj8qBHLPWrWr0HKLX+PHcR9",
+	"pDbYyhqtcMdm5WD43Ycf29Q/RsJzPp9INwIS3mylfUMX7IKYDGpcoOOKFKeYpSSXiEJo8JLDI6GsH3jS",
+	"iu1cMJ+YyBV1QoeN2WbsQvCVINIHO/e7WlfhnFpC2dOS9UcM3nxMc8qQ+anWbRX2xKaPEjjaGzF630P/",
+	"Y8eHPjOh7ulCQo2XaC+j3OP08iAU44/D7+2DTbVQ0Gdj1P6i1uwvF8bRxVM9tK/Jw0R4fr1dvberFsP5",
+	"9Xb9eW9Xzftjujejf6DZ4e6AzFMsbmQlPWPp+WfDY0vFC207KZzOxourv/JraZwmFMFCoozfBd4c+qta",
+	"Y2O/rEeMIR1/CINV+7dgbmLd3apxl6XQkhVZLkm6M3DS0g498kMz9A+GSga6TkyCr06yJFnsev9nyQvr",
+	"QPReUkbl+gHNd/os7P1KrGSam5RCMkBhcw3aOBy9bF9D/rqNgvaC3if6b6QI9tVQ8geJE/wiFQYAN54X",
+	"4/KQwm/NHa0yUvYFT2qaZmtpntui1bvtmK3Gj/mEtiZ7urIDNU/YdmXvgTUI+kYxRNv/GytK0CwX23gg",
+	"fGmCrSXdxiMnWpUgfniPIEDFz+0JpalBiNM6jWdVrSCCLA9ds6AXx4dLIQqvVpStekuVXIXtHvVJCuZ5",
+	"OqpRc8g2IIwpWVLr0ixWQm5xXmIjqhHWcEBmWeWQG9IBz+EpXHmteXt8NTggvUn+uomSjta5PUYoT/PI",
+	"njKMZze6XIUH86zIRB1lHppCdOPzGNKgvUB2UwXT5Kt/1B+P7X8y8upm2+XeVCHS4wUbfpkUH92+JNa6",
+	"9Qy8SSwkj5yzo1s/a74/lobWOmiYRY6nfwd0U+xUzbpceSrwnHPFgxj4as8/Ii60VzYIcAS8I+A3+Ft7",
+	"QyzYGt8ShNGa4IwIJPhd5fbkPNRnxwmq1VV6wTUAOP+2qmd04evA8LzcgFOivVhOPRbucN21qhpkdqzH",
+	"ryaDR/OGFgVECEqOMENmS+ygBRaKQsTagtkAGnh8rmHYJcm3SJCXomRdOmEL4Mxs8mPefzsFnK0in9RB",
+	"Km/rQ1gX89eTa8qw9ilsepF/gRBlA/UlKTo00ua75Ru/FAGx+KAxukZFHuL+2hW6q0UZui7zm2n9kpY2",
+	"kCsbUKRN1e6EZgoDN9TrUpnfGMhVNlIkg7wxJdyg2BCM1/xdbZ0GbV+30oB2Ul2w6rnX2oKt0xV46H0A",
+	"Ylw/ay/LB7/Yr4zXn4/xenY11B5S0K5fHP3uudvjnAfrF/t388eg0g92f69sj9G3w031EB6Dz4SJezJN",
+	"ueXhHrHghI88Tvrk0QdAgD+u92C32PFl/AcfETEq8bLXKfCBScOXlVGfAlmcA5MnK1/Ox6EDg/48EqrZ",
+	"a4/K93XR+4rrD47rX1/zr1fOACmJuHX3qBT55PXkABcUouP+3wA0lL2PDm4BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
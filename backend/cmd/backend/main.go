@@ -35,6 +35,11 @@ func run(c *cli.Context) {
 	backend.Run()
 }
 
+func migrate(c *cli.Context) {
+	logutils.InitLogs(c, os.Stdout)
+	backend.Migrate()
+}
+
 func versionCommand(_ *cli.Context) {
 	fmt.Printf("Version: %s \nCommit: %s\nBuild Time: %s",
 		version.Version, version.CommitHash, version.BuildTimestamp)
@@ -43,9 +48,22 @@ func versionCommand(_ *cli.Context) {
 func main() {
 	viper.SetDefault(config.HealthCheckAddress, ":8081")
 	viper.SetDefault(config.BackendRestPort, "8888")
+	viper.SetDefault(config.BackendClientTimeout, "30s")
+	viper.SetDefault(config.BackendClientMaxAttempts, "5")
+	viper.SetDefault(config.BackendClientRetryInterval, "1s")
 	viper.SetDefault(config.DatabaseDriver, databaseTypes.DBDriverTypeLocal)
+	viper.SetDefault(config.DBMaxOpenConns, "25")
+	viper.SetDefault(config.DBMaxIdleConns, "10")
+	viper.SetDefault(config.DBConnMaxLifetime, "30m")
 	viper.SetDefault(config.DisableOrchestrator, "false")
+	viper.SetDefault(config.ShutdownDrainTimeout, "5m")
 	viper.SetDefault(config.UISitePath, "/app/site")
+	viper.SetDefault(config.MaxUnpaginatedScanResults, "1000")
+	viper.SetDefault(config.IdempotencyKeyWindow, "24h")
+	viper.SetDefault(config.ScanResultRetentionMaxAge, "0")
+	viper.SetDefault(config.ScanResultRetentionMaxPerTarget, "0")
+	viper.SetDefault(config.ScanResultRetentionInterval, "1h")
+	viper.SetDefault(config.TargetImportConcurrency, "10")
 	viper.AutomaticEnv()
 	app := cli.NewApp()
 	app.Usage = ""
@@ -66,6 +84,20 @@ func main() {
 	}
 	runCommand.UsageText = runCommand.Name
 
+	migrateCommand := cli.Command{
+		Name:   "migrate",
+		Usage:  "Applies the VMClarity database migrations",
+		Action: migrate,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  logutils.LogLevelFlag,
+				Value: logutils.LogLevelDefaultValue,
+				Usage: logutils.LogLevelFlagUsage,
+			},
+		},
+	}
+	migrateCommand.UsageText = migrateCommand.Name
+
 	versionCommand := cli.Command{
 		Name:   "version",
 		Usage:  "VMClarity Version Details",
@@ -75,6 +107,7 @@ func main() {
 
 	app.Commands = []cli.Command{
 		runCommand,
+		migrateCommand,
 		versionCommand,
 	}
 
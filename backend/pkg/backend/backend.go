@@ -19,22 +19,29 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/Portshift/go-utils/healthz"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 
 	_config "github.com/openclarity/vmclarity/backend/pkg/config"
 	"github.com/openclarity/vmclarity/backend/pkg/database"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/readiness"
 	"github.com/openclarity/vmclarity/backend/pkg/rest"
+	"github.com/openclarity/vmclarity/backend/pkg/retention"
 	runtime_scan_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/aws"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/azure"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/gcp"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 	uibackend "github.com/openclarity/vmclarity/ui_backend/pkg/rest"
 )
@@ -49,10 +56,25 @@ func createDatabaseConfig(config *_config.Config) databaseTypes.DBConfig {
 		DBPort:         config.DBPort,
 		DBName:         config.DBName,
 		LocalDBPath:    config.LocalDBPath,
+
+		MaxOpenConns:    config.DBMaxOpenConns,
+		MaxIdleConns:    config.DBMaxIdleConns,
+		ConnMaxLifetime: config.DBConnMaxLifetime,
+
+		DisableMigrations: config.DisableDBMigrations,
+
+		MaxUnpaginatedScanResults: config.MaxUnpaginatedScanResults,
+		IdempotencyKeyWindow:      config.IdempotencyKeyWindow,
 	}
 }
 
-const defaultChanSize = 100
+const (
+	defaultChanSize           = 100
+	circuitBreakersHealthPath = "/healthz/circuitbreakers"
+	readinessDetailsPath      = "/healthz/ready/details"
+	readinessCheckTimeout     = 10 * time.Second
+	metricsPath               = "/metrics"
+)
 
 func Run() {
 	config, err := _config.LoadConfig()
@@ -63,6 +85,9 @@ func Run() {
 	errChan := make(chan struct{}, defaultChanSize)
 
 	healthServer := healthz.NewHealthServer(config.HealthCheckAddress)
+	// The health server serves the default mux, expose the prometheus
+	// metrics of the backend and the orchestrator on it.
+	http.Handle(metricsPath, promhttp.Handler())
 	healthServer.Start()
 
 	healthServer.SetIsReady(false)
@@ -83,25 +108,49 @@ func Run() {
 	}
 
 	backendAddress := fmt.Sprintf("http://%s%s", net.JoinHostPort(config.BackendRestHost, strconv.Itoa(config.BackendRestPort)), rest.BaseURL)
-	backendClient, err := backendclient.Create(backendAddress)
+	backendClient, err := backendclient.Create(backendAddress, backendclient.Config{
+		Timeout:       config.BackendClientTimeout,
+		MaxAttempts:   config.BackendClientMaxAttempts,
+		RetryInterval: config.BackendClientRetryInterval,
+		CABundlePath:  config.CABundlePath,
+	})
 	if err != nil {
 		log.Fatalf("Failed to create a backend client: %v", err)
 	}
 
 	uiBackendServer := uibackend.CreateUIBackedServer(backendClient)
 
-	restServer, err := rest.CreateRESTServer(config.BackendRestPort, dbHandler, config.UISitePath, uiBackendServer)
+	// The orchestrator is created before the REST server which uses it
+	// to plan scans and validate scan configs, but it's started after the
+	// REST server it talks to.
+	orc := createRuntimeScanOrchestratorIfNeeded(ctx, config, backendClient, dbHandler)
+
+	var scanOrchestrator rest.ScanOrchestrator
+	if orc != nil {
+		scanOrchestrator = orc
+	}
+	restServer, err := rest.CreateRESTServer(config.BackendRestPort, dbHandler, config.UISitePath, uiBackendServer, config.TargetImportConcurrency, scanOrchestrator)
 	if err != nil {
 		log.Fatalf("Failed to create REST server: %v", err)
 	}
 	restServer.Start(errChan)
 	defer restServer.Stop()
 
-	startRuntimeScanOrchestratorIfNeeded(ctx, config, backendClient)
+	startRuntimeScanOrchestrator(ctx, orc)
+
+	// The health server serves the default mux, expose the readiness of
+	// each component on it to tell why scans aren't starting.
+	http.Handle(readinessDetailsPath, createReadinessChecker(dbHandler, orc))
 
 	// Background processing must start after rest server was started.
 	uiBackendServer.StartBackgroundProcessing(ctx)
 
+	retention.New(dbHandler, retention.Config{
+		MaxAge:                  config.ScanResultRetentionMaxAge,
+		MaxScanResultsPerTarget: config.ScanResultRetentionMaxPerTarget,
+		Interval:                config.ScanResultRetentionInterval,
+	}).Start(ctx)
+
 	healthServer.SetIsReady(true)
 	log.Info("VMClarity backend is ready")
 
@@ -111,18 +160,75 @@ func Run() {
 
 	select {
 	case <-errChan:
-		cancel()
 		log.Errorf("Received an error - shutting down")
 	case s := <-sig:
-		cancel()
 		log.Warningf("Received a termination signal: %v", s)
 	}
+
+	healthServer.SetIsReady(false)
+	shutdownRuntimeScanOrchestrator(orc, config.ShutdownDrainTimeout, sig)
+	cancel()
+}
+
+// shutdownRuntimeScanOrchestrator gives the running scans up to the drain
+// timeout to clean up the jobs they were running. Another termination signal
+// stops waiting right away.
+func shutdownRuntimeScanOrchestrator(orc orchestrator.Orchestrator, drainTimeout time.Duration, sig <-chan os.Signal) {
+	if orc == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	go func() {
+		select {
+		case s := <-sig:
+			log.Warningf("Received another termination signal: %v - not waiting for the running scans", s)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	log.Infof("Waiting up to %v for the running scans to clean up their jobs", drainTimeout)
+	if err := orc.Shutdown(ctx); err != nil {
+		log.Errorf("Failed to shut down the runtime scan orchestrator gracefully, exiting anyway: %v", err)
+	}
+}
+
+func createReadinessChecker(dbHandler databaseTypes.Database, orc orchestrator.Orchestrator) *readiness.Checker {
+	checker := readiness.New(readinessCheckTimeout)
+	checker.Add("database", dbHandler.Ping)
+	if orc != nil {
+		checker.Add("provider", orc.CheckProvider)
+		checker.Add("orchestrator", func(context.Context) error {
+			// nolint:wrapcheck
+			return orc.CheckAlive()
+		})
+	}
+
+	return checker
+}
+
+// Migrate applies the database migrations, for when they are applied
+// separately from the server.
+func Migrate() {
+	config, err := _config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	if err := database.MigrateDatabase(createDatabaseConfig(config)); err != nil {
+		log.Fatalf("Failed to migrate database: %v", err)
+	}
+
+	log.Info("Database migrations were applied")
 }
 
-func startRuntimeScanOrchestratorIfNeeded(ctx context.Context, config *_config.Config, backendClient *backendclient.BackendClient) {
+func createRuntimeScanOrchestratorIfNeeded(ctx context.Context, config *_config.Config, backendClient *backendclient.BackendClient, dbHandler databaseTypes.Database) orchestrator.Orchestrator {
 	if config.DisableOrchestrator {
 		log.Infof("Runtime orchestrator is disabled")
-		return
+		return nil
 	}
 
 	runtimeScanConfig, err := runtime_scan_config.LoadConfig(config.BackendRestHost, config.BackendRestPort, rest.BaseURL)
@@ -130,21 +236,46 @@ func startRuntimeScanOrchestratorIfNeeded(ctx context.Context, config *_config.C
 		log.Fatalf("Failed to load runtime scan orchestrator config: %v", err)
 	}
 
-	providerClient, err := aws.Create(ctx, runtimeScanConfig.AWSConfig)
+	providerClient, err := createProviderClient(ctx, runtimeScanConfig)
 	if err != nil {
 		log.Fatalf("Failed to create provider client: %v", err)
 	}
 
-	orc, err := createRuntimeScanOrchestrator(providerClient, runtimeScanConfig, backendClient)
+	orc, err := createRuntimeScanOrchestrator(providerClient, runtimeScanConfig, backendClient, dbHandler.ScanConfigsTable())
 	if err != nil {
 		log.Fatalf("Failed to create runtime scan orchestrator: %v", err)
 	}
 
+	return orc
+}
+
+func startRuntimeScanOrchestrator(ctx context.Context, orc orchestrator.Orchestrator) {
+	if orc == nil {
+		return
+	}
+
+	// The health server serves the default mux, expose the circuit breakers
+	// on it so that operators can see which regions are being shed.
+	http.Handle(circuitBreakersHealthPath, orc.CircuitBreakers())
+
 	orc.Start(ctx)
 }
 
-func createRuntimeScanOrchestrator(client provider.Client, config *runtime_scan_config.OrchestratorConfig, backendClient *backendclient.BackendClient) (orchestrator.Orchestrator, error) {
-	orc, err := orchestrator.Create(config, client, backendClient)
+func createProviderClient(ctx context.Context, config *runtime_scan_config.OrchestratorConfig) (provider.Client, error) {
+	switch config.ProviderType {
+	case runtime_scan_config.ProviderTypeAWS:
+		return aws.Create(ctx, config.AWSConfig)
+	case runtime_scan_config.ProviderTypeAzure:
+		return azure.Create(ctx, config.AzureConfig)
+	case runtime_scan_config.ProviderTypeGCP:
+		return gcp.Create(ctx, config.GCPConfig)
+	default:
+		return nil, fmt.Errorf("unsupported provider type: %s", config.ProviderType)
+	}
+}
+
+func createRuntimeScanOrchestrator(client provider.Client, config *runtime_scan_config.OrchestratorConfig, backendClient *backendclient.BackendClient, scanConfigs databaseTypes.ScanConfigsTable) (orchestrator.Orchestrator, error) {
+	orc, err := orchestrator.Create(config, client, backendClient, scanConfigs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create runtime scan orchestrator: %v", err)
 	}
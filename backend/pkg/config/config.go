@@ -18,6 +18,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -29,6 +30,12 @@ const (
 	BackendRestPort       = "BACKEND_REST_PORT"
 	HealthCheckAddress    = "HEALTH_CHECK_ADDRESS"
 
+	BackendClientTimeout       = "BACKEND_CLIENT_TIMEOUT"
+	BackendClientMaxAttempts   = "BACKEND_CLIENT_MAX_ATTEMPTS"
+	BackendClientRetryInterval = "BACKEND_CLIENT_RETRY_INTERVAL"
+
+	CABundlePath = "CA_BUNDLE_PATH"
+
 	DBNameEnvVar     = "DB_NAME"
 	DBUserEnvVar     = "DB_USER"
 	DBPasswordEnvVar = "DB_PASS"
@@ -37,11 +44,31 @@ const (
 	DatabaseDriver   = "DATABASE_DRIVER"
 	EnableDBInfoLogs = "ENABLE_DB_INFO_LOGS"
 
+	DBMaxOpenConns    = "DB_MAX_OPEN_CONNS"
+	DBMaxIdleConns    = "DB_MAX_IDLE_CONNS"
+	DBConnMaxLifetime = "DB_CONN_MAX_LIFETIME"
+
+	DisableDBMigrations = "DISABLE_DB_MIGRATIONS"
+
+	EnableJSONLogs = "ENABLE_JSON_LOGS"
+
 	LocalDBPath = "LOCAL_DB_PATH"
 
+	MaxUnpaginatedScanResults = "MAX_UNPAGINATED_SCAN_RESULTS"
+
+	IdempotencyKeyWindow = "IDEMPOTENCY_KEY_WINDOW"
+
+	ScanResultRetentionMaxAge       = "SCAN_RESULT_RETENTION_MAX_AGE"
+	ScanResultRetentionMaxPerTarget = "SCAN_RESULT_RETENTION_MAX_PER_TARGET"
+	ScanResultRetentionInterval     = "SCAN_RESULT_RETENTION_INTERVAL"
+
+	TargetImportConcurrency = "TARGET_IMPORT_CONCURRENCY"
+
 	FakeDataEnvVar      = "FAKE_DATA"
 	DisableOrchestrator = "DISABLE_ORCHESTRATOR"
 
+	ShutdownDrainTimeout = "SHUTDOWN_DRAIN_TIMEOUT"
+
 	UISitePath = "UI_SITE_PATH" // TODO: UI site should be moved out of the backend to nginx
 
 )
@@ -51,8 +78,22 @@ type Config struct {
 	BackendRestPort    int    `json:"backend-rest-port,omitempty"`
 	HealthCheckAddress string `json:"health-check-address,omitempty"`
 
+	// The requests of the orchestrator and the UI backend to the backend,
+	// which are retried when they fail with a transient error.
+	BackendClientTimeout       time.Duration `json:"backend-client-timeout"`
+	BackendClientMaxAttempts   int           `json:"backend-client-max-attempts"`
+	BackendClientRetryInterval time.Duration `json:"backend-client-retry-interval"`
+
+	// PEM CA bundle trusted on top of the system certificates by the
+	// outbound HTTPS calls, e.g. of a TLS intercepting proxy.
+	CABundlePath string `json:"ca-bundle-path,omitempty"`
+
 	DisableOrchestrator bool `json:"disable_orchestrator"`
 
+	// How long to wait on shutdown for the running scans to clean up their
+	// jobs before exiting anyway.
+	ShutdownDrainTimeout time.Duration `json:"shutdown-drain-timeout"`
+
 	UISitePath string `json:"ui_site_path"`
 
 	// database config
@@ -65,17 +106,59 @@ type Config struct {
 	EnableDBInfoLogs bool   `json:"enable-db-info-logs"`
 	EnableFakeData   bool   `json:"enable-fake-data"`
 
+	// The limits of the database connection pool, the database/sql defaults
+	// are kept for the limits which are not positive.
+	DBMaxOpenConns    int           `json:"db-max-open-conns"`
+	DBMaxIdleConns    int           `json:"db-max-idle-conns"`
+	DBConnMaxLifetime time.Duration `json:"db-conn-max-lifetime"`
+
+	// Don't apply the database migrations on startup, they are applied
+	// separately with the migrate command.
+	DisableDBMigrations bool `json:"disable-db-migrations"`
+
+	// Log in JSON instead of text, the log fields are logged as JSON keys.
+	EnableJSONLogs bool `json:"enable-json-logs"`
+
 	LocalDBPath string `json:"local-db-path,omitempty"`
+
+	// The maximum number of scan results returned when $top is not set.
+	MaxUnpaginatedScanResults int `json:"max-unpaginated-scan-results"`
+
+	// How long a scan or a scan config created with an idempotency key is
+	// returned again when the key is replayed.
+	IdempotencyKeyWindow time.Duration `json:"idempotency-key-window"`
+
+	// The retention policy of the completed scan results, the scan results
+	// older than the max age or beyond the newest max per target are purged
+	// together with their findings. Each limit is disabled if not positive.
+	ScanResultRetentionMaxAge       time.Duration `json:"scan-result-retention-max-age"`
+	ScanResultRetentionMaxPerTarget int           `json:"scan-result-retention-max-per-target"`
+	ScanResultRetentionInterval     time.Duration `json:"scan-result-retention-interval"`
+
+	// The maximum number of targets created concurrently by a bulk target import.
+	TargetImportConcurrency int `json:"target-import-concurrency"`
 }
 
 func LoadConfig() (*Config, error) {
 	config := &Config{}
 
+	// Applied first so that everything is logged in the same format.
+	config.EnableJSONLogs = viper.GetBool(EnableJSONLogs)
+	if config.EnableJSONLogs {
+		log.SetFormatter(&log.JSONFormatter{})
+	}
+
 	config.BackendRestHost = viper.GetString(BackendRestHost)
 	config.BackendRestPort = viper.GetInt(BackendRestPort)
 	config.HealthCheckAddress = viper.GetString(HealthCheckAddress)
 
+	config.BackendClientTimeout = viper.GetDuration(BackendClientTimeout)
+	config.BackendClientMaxAttempts = viper.GetInt(BackendClientMaxAttempts)
+	config.BackendClientRetryInterval = viper.GetDuration(BackendClientRetryInterval)
+	config.CABundlePath = viper.GetString(CABundlePath)
+
 	config.DisableOrchestrator = viper.GetBool(DisableOrchestrator)
+	config.ShutdownDrainTimeout = viper.GetDuration(ShutdownDrainTimeout)
 
 	config.UISitePath = viper.GetString(UISitePath)
 
@@ -87,9 +170,23 @@ func LoadConfig() (*Config, error) {
 	config.DBName = viper.GetString(DBNameEnvVar)
 	config.EnableDBInfoLogs = viper.GetBool(EnableDBInfoLogs)
 	config.EnableFakeData = viper.GetBool(FakeDataEnvVar)
+	config.DBMaxOpenConns = viper.GetInt(DBMaxOpenConns)
+	config.DBMaxIdleConns = viper.GetInt(DBMaxIdleConns)
+	config.DBConnMaxLifetime = viper.GetDuration(DBConnMaxLifetime)
+	config.DisableDBMigrations = viper.GetBool(DisableDBMigrations)
 
 	config.LocalDBPath = viper.GetString(LocalDBPath)
 
+	config.MaxUnpaginatedScanResults = viper.GetInt(MaxUnpaginatedScanResults)
+
+	config.IdempotencyKeyWindow = viper.GetDuration(IdempotencyKeyWindow)
+
+	config.ScanResultRetentionMaxAge = viper.GetDuration(ScanResultRetentionMaxAge)
+	config.ScanResultRetentionMaxPerTarget = viper.GetInt(ScanResultRetentionMaxPerTarget)
+	config.ScanResultRetentionInterval = viper.GetDuration(ScanResultRetentionInterval)
+
+	config.TargetImportConcurrency = viper.GetInt(TargetImportConcurrency)
+
 	configB, err := json.Marshal(config)
 	if err == nil {
 		log.Infof("\n\nconfig=%s\n\n", configB)
@@ -25,8 +25,12 @@ import (
 
 type DBDriver func(config types.DBConfig) (types.Database, error)
 
+// DBMigrator applies the migrations of a database.
+type DBMigrator func(config types.DBConfig) error
+
 var (
 	DBDrivers           map[string]DBDriver
+	DBMigrators         map[string]DBMigrator
 	RegisterDriversOnce sync.Once
 )
 
@@ -38,6 +42,11 @@ func RegisterDrivers() {
 			DBDrivers = map[string]DBDriver{}
 		}
 		DBDrivers[types.DBDriverTypeLocal] = gorm.NewDatabase
+
+		if DBMigrators == nil {
+			DBMigrators = map[string]DBMigrator{}
+		}
+		DBMigrators[types.DBDriverTypeLocal] = gorm.MigrateDatabase
 	})
 }
 
@@ -49,3 +58,14 @@ func InitializeDatabase(config types.DBConfig) (types.Database, error) {
 	}
 	return nil, fmt.Errorf("unknown DB driver %s", config.DriverType)
 }
+
+// MigrateDatabase applies the migrations of the database without initializing
+// it for the server.
+func MigrateDatabase(config types.DBConfig) error {
+	RegisterDrivers()
+
+	if migrator, ok := DBMigrators[config.DriverType]; ok {
+		return migrator(config)
+	}
+	return fmt.Errorf("unknown DB driver %s", config.DriverType)
+}
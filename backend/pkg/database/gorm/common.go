@@ -40,6 +40,21 @@ func getExistingObjByID(db *gorm.DB, schema, objID string, obj interface{}) erro
 	return nil
 }
 
+// getStoredObjByID gets the object with all of its columns, unlike
+// getExistingObjByID which only gets its data, e.g. to get the credentials of
+// a scan config.
+func getStoredObjByID(db *gorm.DB, objID string, obj interface{}) error {
+	jsonQuotedID := fmt.Sprintf("\"%s\"", objID)
+	if err := db.Where("`Data` -> '$.id' = ?", jsonQuotedID).First(obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
 func deleteObjByID(db *gorm.DB, objID string, obj interface{}) error {
 	jsonQuotedID := fmt.Sprintf("\"%s\"", objID)
 	if err := db.Where("`Data` -> '$.id' = ?", jsonQuotedID).Delete(obj).Error; err != nil {
@@ -0,0 +1,156 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+// The credentials of a scan config are write-only. They're stored in the
+// Credentials column of the scan config instead of in its Data, so that the
+// OData queries neither return nor filter on them, and only
+// GetScanConfigWithCredentials merges them back for the orchestrator. The
+// snapshots of the scan configs in the scans don't keep them at all.
+
+// scanConfigCredentials holds the parts of a scan config with credentials. It
+// has its own types so that the fields of the scan config which are always
+// marshaled aren't overwritten once it's merged back into the scan config.
+type scanConfigCredentials struct {
+	ScanFamiliesConfig            *scanFamiliesConfigCredentials            `json:"scanFamiliesConfig,omitempty"`
+	ScannerInstanceCreationConfig *scannerInstanceCreationConfigCredentials `json:"scannerInstanceCreationConfig,omitempty"`
+}
+
+type scanFamiliesConfigCredentials struct {
+	Sbom            *familyConfigCredentials `json:"sbom,omitempty"`
+	Vulnerabilities *familyConfigCredentials `json:"vulnerabilities,omitempty"`
+}
+
+type familyConfigCredentials struct {
+	Registry *registryConfigCredentials `json:"registry,omitempty"`
+}
+
+// registryConfigCredentials keeps the whole auths with the credentials, since
+// the auths are replaced rather than merged.
+type registryConfigCredentials struct {
+	Auths *[]models.RegistryAuth `json:"auths,omitempty"`
+}
+
+type scannerInstanceCreationConfigCredentials struct {
+	ScannerImagePullCredentials *scannerImagePullCredentials `json:"scannerImagePullCredentials,omitempty"`
+}
+
+type scannerImagePullCredentials struct {
+	Password *string `json:"password,omitempty"`
+}
+
+// splitScanConfigCredentials returns the scan config without its credentials
+// and its credentials, which restore them once merged into the scan config.
+// The given scan config isn't modified.
+func splitScanConfigCredentials(scanConfig models.ScanConfig) (models.ScanConfig, scanConfigCredentials) {
+	var credentials scanConfigCredentials
+	scanConfig.ScanFamiliesConfig, credentials.ScanFamiliesConfig = splitScanFamiliesConfigCredentials(scanConfig.ScanFamiliesConfig)
+	scanConfig.ScannerInstanceCreationConfig, credentials.ScannerInstanceCreationConfig = splitScannerInstanceCreationConfigCredentials(scanConfig.ScannerInstanceCreationConfig)
+
+	return scanConfig, credentials
+}
+
+// mergeScanConfigCredentials merges the credentials split by
+// splitScanConfigCredentials back into the data of the scan config.
+func mergeScanConfigCredentials(data, credentials []byte) ([]byte, error) {
+	if len(credentials) == 0 {
+		return data, nil
+	}
+
+	merged, err := patchObject(data, json.RawMessage(credentials))
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge credentials: %w", err)
+	}
+
+	return merged, nil
+}
+
+func redactScanCredentials(scan models.Scan) models.Scan {
+	if scan.ScanConfigSnapshot != nil {
+		snapshot := *scan.ScanConfigSnapshot
+		snapshot.ScanFamiliesConfig, _ = splitScanFamiliesConfigCredentials(snapshot.ScanFamiliesConfig)
+		snapshot.ScannerInstanceCreationConfig, _ = splitScannerInstanceCreationConfigCredentials(snapshot.ScannerInstanceCreationConfig)
+		scan.ScanConfigSnapshot = &snapshot
+	}
+
+	return scan
+}
+
+func splitScanFamiliesConfigCredentials(config *models.ScanFamiliesConfig) (*models.ScanFamiliesConfig, *scanFamiliesConfigCredentials) {
+	if config == nil {
+		return nil, nil
+	}
+
+	redacted := *config
+	var credentials scanFamiliesConfigCredentials
+	if config.Sbom != nil {
+		sbom := *config.Sbom
+		sbom.Registry, credentials.Sbom = splitRegistryConfigCredentials(sbom.Registry)
+		redacted.Sbom = &sbom
+	}
+	if config.Vulnerabilities != nil {
+		vulnerabilities := *config.Vulnerabilities
+		vulnerabilities.Registry, credentials.Vulnerabilities = splitRegistryConfigCredentials(vulnerabilities.Registry)
+		redacted.Vulnerabilities = &vulnerabilities
+	}
+	if credentials.Sbom == nil && credentials.Vulnerabilities == nil {
+		return &redacted, nil
+	}
+
+	return &redacted, &credentials
+}
+
+func splitRegistryConfigCredentials(config *models.RegistryConfig) (*models.RegistryConfig, *familyConfigCredentials) {
+	if config == nil || config.Auths == nil {
+		return config, nil
+	}
+
+	auths := make([]models.RegistryAuth, len(*config.Auths))
+	for i, auth := range *config.Auths {
+		auth.Password = nil
+		auth.Token = nil
+		auths[i] = auth
+	}
+	redacted := *config
+	redacted.Auths = &auths
+
+	return &redacted, &familyConfigCredentials{
+		Registry: &registryConfigCredentials{Auths: config.Auths},
+	}
+}
+
+func splitScannerInstanceCreationConfigCredentials(config *models.ScannerInstanceCreationConfig) (*models.ScannerInstanceCreationConfig, *scannerInstanceCreationConfigCredentials) {
+	if config == nil || config.ScannerImagePullCredentials == nil || config.ScannerImagePullCredentials.Password == nil {
+		return config, nil
+	}
+
+	pullCredentials := *config.ScannerImagePullCredentials
+	password := pullCredentials.Password
+	pullCredentials.Password = nil
+	redacted := *config
+	redacted.ScannerImagePullCredentials = &pullCredentials
+
+	return &redacted, &scannerInstanceCreationConfigCredentials{
+		ScannerImagePullCredentials: &scannerImagePullCredentials{Password: password},
+	}
+}
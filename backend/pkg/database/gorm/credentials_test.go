@@ -0,0 +1,198 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestScanConfigsTableHandler_Credentials(t *testing.T) {
+	db := openTestDataBase(t)
+	if err := db.AutoMigrate(&ScanConfig{}); err != nil {
+		t.Fatalf("failed to create the tables: %v", err)
+	}
+	handler := &Handler{DB: db}
+
+	auths := []models.RegistryAuth{
+		{
+			Authority: "docker.io",
+			Username:  utils.PointerTo("user"),
+			Password:  utils.PointerTo("password"),
+		},
+		{
+			Authority: "ghcr.io",
+			Token:     utils.PointerTo("token"),
+		},
+	}
+	redactedAuths := []models.RegistryAuth{
+		{
+			Authority: "docker.io",
+			Username:  utils.PointerTo("user"),
+		},
+		{
+			Authority: "ghcr.io",
+		},
+	}
+	registryAuths := func(scanConfig models.ScanConfig) []models.RegistryAuth {
+		return *scanConfig.ScanFamiliesConfig.Vulnerabilities.Registry.Auths
+	}
+
+	created, err := handler.ScanConfigsTable().CreateScanConfig(models.ScanConfig{
+		Name: utils.PointerTo("scan-config"),
+		Scheduled: &models.RuntimeScheduleScanConfig{
+			OperationTime: utils.PointerTo(time.Now()),
+		},
+		ScanFamiliesConfig: &models.ScanFamiliesConfig{
+			Vulnerabilities: &models.VulnerabilitiesConfig{
+				Enabled: utils.PointerTo(true),
+				Registry: &models.RegistryConfig{
+					Auths: &auths,
+				},
+			},
+		},
+		ScannerInstanceCreationConfig: &models.ScannerInstanceCreationConfig{
+			UseSpotInstances: true,
+			ScannerImagePullCredentials: &models.ScannerImagePullCredentials{
+				Method:   models.Static,
+				Username: utils.PointerTo("user"),
+				Password: utils.PointerTo("pull-password"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanConfig() error = %v", err)
+	}
+	if diff := cmp.Diff(redactedAuths, registryAuths(created)); diff != "" {
+		t.Errorf("CreateScanConfig() returned the credentials (-want +got):\n%s", diff)
+	}
+	if created.ScannerInstanceCreationConfig.ScannerImagePullCredentials.Password != nil {
+		t.Errorf("CreateScanConfig() returned the scanner image pull password")
+	}
+
+	got, err := handler.ScanConfigsTable().GetScanConfig(*created.Id, models.GetScanConfigsScanConfigIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanConfig() error = %v", err)
+	}
+	if diff := cmp.Diff(redactedAuths, registryAuths(got)); diff != "" {
+		t.Errorf("GetScanConfig() returned the credentials (-want +got):\n%s", diff)
+	}
+	if got.ScannerInstanceCreationConfig.ScannerImagePullCredentials.Password != nil {
+		t.Errorf("GetScanConfig() returned the scanner image pull password")
+	}
+
+	// The credentials aren't in the data the OData queries filter on.
+	var rows int64
+	if err := db.Model(&ScanConfig{}).Where("data LIKE ? OR data LIKE ?", "%password%", "%token%").Count(&rows).Error; err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+	if rows != 0 {
+		t.Errorf("CreateScanConfig() stored the credentials in the data of the scan config")
+	}
+
+	// Patching the scan config keeps its credentials.
+	_, err = handler.ScanConfigsTable().UpdateScanConfig(models.ScanConfig{
+		Id:       created.Id,
+		Disabled: utils.PointerTo(true),
+	})
+	if err != nil {
+		t.Fatalf("UpdateScanConfig() error = %v", err)
+	}
+
+	got, err = handler.ScanConfigsTable().GetScanConfigWithCredentials(*created.Id)
+	if err != nil {
+		t.Fatalf("GetScanConfigWithCredentials() error = %v", err)
+	}
+	if diff := cmp.Diff(auths, registryAuths(got)); diff != "" {
+		t.Errorf("GetScanConfigWithCredentials() credentials mismatch (-want +got):\n%s", diff)
+	}
+	pullCredentials := got.ScannerInstanceCreationConfig.ScannerImagePullCredentials
+	if pullCredentials.Password == nil || *pullCredentials.Password != "pull-password" {
+		t.Errorf("GetScanConfigWithCredentials() didn't return the scanner image pull password")
+	}
+	if !got.ScannerInstanceCreationConfig.UseSpotInstances {
+		t.Errorf("GetScanConfigWithCredentials() overwrote useSpotInstances")
+	}
+	if got.Disabled == nil || !*got.Disabled {
+		t.Errorf("GetScanConfigWithCredentials() didn't return the patched scan config")
+	}
+}
+
+func TestScansTableHandler_Credentials(t *testing.T) {
+	db := openTestDataBase(t)
+	if err := db.AutoMigrate(&Scan{}); err != nil {
+		t.Fatalf("failed to create the tables: %v", err)
+	}
+	handler := &Handler{DB: db}
+
+	auths := []models.RegistryAuth{
+		{
+			Authority: "docker.io",
+			Username:  utils.PointerTo("user"),
+			Password:  utils.PointerTo("password"),
+		},
+	}
+	scan, err := handler.ScansTable().CreateScan(models.Scan{
+		ScanConfigSnapshot: &models.ScanConfigData{
+			ScannerInstanceCreationConfig: &models.ScannerInstanceCreationConfig{
+				ScannerImagePullCredentials: &models.ScannerImagePullCredentials{
+					Method:   models.Static,
+					Username: utils.PointerTo("user"),
+					Password: utils.PointerTo("pull-password"),
+				},
+			},
+			ScanFamiliesConfig: &models.ScanFamiliesConfig{
+				Sbom: &models.SBOMConfig{
+					Enabled: utils.PointerTo(true),
+					Registry: &models.RegistryConfig{
+						Auths: &auths,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateScan() error = %v", err)
+	}
+
+	want := []models.RegistryAuth{
+		{
+			Authority: "docker.io",
+			Username:  utils.PointerTo("user"),
+		},
+	}
+	if diff := cmp.Diff(want, *scan.ScanConfigSnapshot.ScanFamiliesConfig.Sbom.Registry.Auths); diff != "" {
+		t.Errorf("CreateScan() returned the credentials (-want +got):\n%s", diff)
+	}
+	if scan.ScanConfigSnapshot.ScannerInstanceCreationConfig.ScannerImagePullCredentials.Password != nil {
+		t.Errorf("CreateScan() returned the scanner image pull password")
+	}
+	if auths[0].Password == nil {
+		t.Errorf("CreateScan() modified the given scan")
+	}
+	var rows int64
+	if err := db.Model(&Scan{}).Where("data LIKE ?", "%password%").Count(&rows).Error; err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+	if rows != 0 {
+		t.Errorf("CreateScan() stored the credentials of the scan config snapshot")
+	}
+}
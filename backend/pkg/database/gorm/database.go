@@ -16,7 +16,11 @@
 package gorm
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	uuid "github.com/satori/go.uuid"
@@ -32,11 +36,29 @@ func NewDatabase(config types.DBConfig) (types.Database, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to create new GORM database: %w", err)
 	}
-	return &Handler{DB: db}, nil
+	return &Handler{
+		DB:                        db,
+		MaxUnpaginatedScanResults: config.MaxUnpaginatedScanResults,
+		IdempotencyKeyWindow:      config.IdempotencyKeyWindow,
+	}, nil
 }
 
 type Handler struct {
 	DB *gorm.DB
+
+	MaxUnpaginatedScanResults int
+	IdempotencyKeyWindow      time.Duration
+}
+
+func (db *Handler) Ping(ctx context.Context) error {
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get the database connection pool: %w", err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping the database: %w", err)
+	}
+	return nil
 }
 
 // Base contains common columns for all tables.
@@ -53,81 +75,74 @@ func (base *Base) BeforeCreate(db *gorm.DB) error {
 	return nil
 }
 
-// nolint:cyclop
 func initDataBase(config types.DBConfig) (*gorm.DB, error) {
-	dbDriver := config.DriverType
-	dbLogger := logger.Default
-	if config.EnableInfoLogs {
-		dbLogger = dbLogger.LogMode(logger.Info)
-	}
-
-	db, err := initDB(config, dbDriver, dbLogger)
+	db, err := openDataBase(config)
 	if err != nil {
 		return nil, err
 	}
 
-	// this will ensure table is created
-	if err := db.AutoMigrate(
-		Target{},
-		ScanResult{},
-		ScanConfig{},
-		Scan{},
-		Scopes{},
-		Finding{},
-	); err != nil {
-		return nil, fmt.Errorf("failed to run auto migration: %w", err)
+	// The migrations may be applied separately from the server, in that
+	// case don't start with a schema the server doesn't expect.
+	if config.DisableMigrations {
+		if err := checkSchemaVersion(db, migrations); err != nil {
+			return nil, fmt.Errorf("database migrations are disabled: %w", err)
+		}
+		return db, nil
 	}
 
-	// Create indexes for our objects
-	//
-	// First for all objects index the ID field this speeds up anywhere
-	// we're getting a single object out of the DB, including in PATCH/PUT
-	// etc.
-	idb := db.Exec("CREATE INDEX IF NOT EXISTS targets_id_idx ON targets(Data -> 'id')")
-	if idb.Error != nil {
-		return nil, fmt.Errorf("failed to create index targets_id_idx: %w", idb.Error)
+	if err := applyMigrations(db, migrations); err != nil {
+		return nil, err
 	}
 
-	idb = db.Exec("CREATE INDEX IF NOT EXISTS scan_results_id_idx ON scan_results(Data -> 'id')")
-	if idb.Error != nil {
-		return nil, fmt.Errorf("failed to create index scan_results_id_idx: %w", idb.Error)
-	}
+	return db, nil
+}
 
-	idb = db.Exec("CREATE INDEX IF NOT EXISTS scan_configs_id_idx ON scan_configs(Data -> 'id')")
-	if idb.Error != nil {
-		return nil, fmt.Errorf("failed to create index scan_configs_id_idx: %w", idb.Error)
+// MigrateDatabase applies the database migrations which weren't applied yet.
+func MigrateDatabase(config types.DBConfig) error {
+	db, err := openDataBase(config)
+	if err != nil {
+		return fmt.Errorf("unable to open GORM database: %w", err)
 	}
 
-	idb = db.Exec("CREATE INDEX IF NOT EXISTS scans_id_idx ON scans(Data -> 'id')")
-	if idb.Error != nil {
-		return nil, fmt.Errorf("failed to create index scans_id_idx: %w", idb.Error)
+	return applyMigrations(db, migrations)
+}
+
+func openDataBase(config types.DBConfig) (*gorm.DB, error) {
+	dbDriver := config.DriverType
+	dbLogger := logger.Default
+	if config.EnableInfoLogs {
+		dbLogger = dbLogger.LogMode(logger.Info)
 	}
 
-	idb = db.Exec("CREATE INDEX IF NOT EXISTS findings_id_idx ON findings(Data -> 'id')")
-	if idb.Error != nil {
-		return nil, fmt.Errorf("failed to create index findings_id_idx: %w", idb.Error)
+	db, err := initDB(config, dbDriver, dbLogger)
+	if err != nil {
+		return nil, err
 	}
 
-	// For processing scan results to findings we need to find all the scan
-	// results by general status and findingsProcessed, so add an index for
-	// that.
-	idb = db.Exec("CREATE INDEX IF NOT EXISTS scan_results_findings_processed_idx ON scan_results(Data -> 'findingsProcessed', Data -> 'status.general.state')")
-	if idb.Error != nil {
-		return nil, fmt.Errorf("failed to create index scan_results_findings_processed_idx: %w", idb.Error)
+	if err := configureConnectionPool(db, config); err != nil {
+		return nil, err
 	}
 
-	// The UI needs to find all the findings for a specific finding type
-	// and the scan result processor needs to filter that list by a
-	// specific asset. So add a combined index for those cases.
-	idb = db.Exec("CREATE INDEX IF NOT EXISTS findings_by_type_and_asset_idx ON findings(Data -> 'findingInfo.objectType', Data -> 'asset.id')")
-	if idb.Error != nil {
-		return nil, fmt.Errorf("failed to create index findings_by_type_and_asset_idx: %w", idb.Error)
+	return db, nil
+}
+
+func configureConnectionPool(db *gorm.DB, config types.DBConfig) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get the database connection pool: %w", err)
 	}
 
-	// TODO(sambetts) Add indexes for all the uniqueness checks we need to
-	// do for each object
+	if config.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(config.MaxOpenConns)
+	}
+	if config.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(config.MaxIdleConns)
+	}
+	if config.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+	}
 
-	return db, nil
+	return nil
 }
 
 func initDB(config types.DBConfig, dbDriver string, dbLogger logger.Interface) (*gorm.DB, error) {
@@ -139,8 +154,30 @@ func initDB(config types.DBConfig, dbDriver string, dbLogger logger.Interface) (
 	}
 }
 
+// localDBBusyTimeout is how long a connection to the local DB waits for the
+// connection which is writing to it before failing with "database is locked".
+const localDBBusyTimeout = 10 * time.Second
+
+// localDBDSN returns the DSN of the local DB at path. The journal is written
+// ahead so that the readers don't block the writer, and the transactions take
+// the write lock when they begin so that the concurrent writes are serialized
+// by waiting for the lock instead of failing when a transaction which already
+// read tries to write.
+func localDBDSN(path string) string {
+	params := url.Values{}
+	params.Set("_journal_mode", "WAL")
+	params.Set("_busy_timeout", strconv.FormatInt(localDBBusyTimeout.Milliseconds(), 10))
+	params.Set("_txlock", "immediate")
+
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + params.Encode()
+}
+
 func initSqlite(config types.DBConfig, dbLogger logger.Interface) (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open(config.LocalDBPath), &gorm.Config{
+	db, err := gorm.Open(sqlite.Open(localDBDSN(config.LocalDBPath)), &gorm.Config{
 		Logger: dbLogger,
 	})
 	if err != nil {
@@ -0,0 +1,141 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func Test_configureConnectionPool(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           types.DBConfig
+		wantMaxOpenConns int
+	}{
+		{
+			name:             "limits are set",
+			config:           types.DBConfig{MaxOpenConns: 5, MaxIdleConns: 2, ConnMaxLifetime: time.Minute},
+			wantMaxOpenConns: 5,
+		},
+		{
+			name:             "default limits are kept",
+			config:           types.DBConfig{},
+			wantMaxOpenConns: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDataBase(t)
+			if err := configureConnectionPool(db, tt.config); err != nil {
+				t.Fatalf("configureConnectionPool() unexpected error = %v", err)
+			}
+
+			sqlDB, err := db.DB()
+			if err != nil {
+				t.Fatalf("failed to get the connection pool: %v", err)
+			}
+			if got := sqlDB.Stats().MaxOpenConnections; got != tt.wantMaxOpenConns {
+				t.Errorf("configureConnectionPool() max open connections = %v, want %v", got, tt.wantMaxOpenConns)
+			}
+		})
+	}
+}
+
+func Test_localDBDSN(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "path",
+			path: "/data/vmclarity.db",
+			want: "/data/vmclarity.db?_busy_timeout=10000&_journal_mode=WAL&_txlock=immediate",
+		},
+		{
+			name: "path with params",
+			path: "file:/data/vmclarity.db?cache=shared",
+			want: "file:/data/vmclarity.db?cache=shared&_busy_timeout=10000&_journal_mode=WAL&_txlock=immediate",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := localDBDSN(tt.path); got != tt.want {
+				t.Errorf("localDBDSN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_initSqlite_concurrentWrites(t *testing.T) {
+	db, err := initSqlite(types.DBConfig{
+		LocalDBPath: filepath.Join(t.TempDir(), "test.db"),
+	}, logger.Default.LogMode(logger.Silent))
+	if err != nil {
+		t.Fatalf("initSqlite() unexpected error = %v", err)
+	}
+	if err := db.AutoMigrate(&migrationTestTable{}); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	const writers = 20
+	const writesPerWriter = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, writers*writesPerWriter)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(writer int) {
+			defer wg.Done()
+			for j := 0; j < writesPerWriter; j++ {
+				// Read before writing in the same transaction like
+				// the uniqueness checks do, which fails with a
+				// deferred transaction lock under concurrent writes.
+				errs <- db.Transaction(func(tx *gorm.DB) error {
+					var count int64
+					if err := tx.Model(&migrationTestTable{}).Count(&count).Error; err != nil {
+						return err
+					}
+					return tx.Create(&migrationTestTable{Name: fmt.Sprintf("%d-%d", writer, j)}).Error
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent write failed: %v", err)
+		}
+	}
+
+	var rows int64
+	if err := db.Model(&migrationTestTable{}).Count(&rows).Error; err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+	if rows != writers*writesPerWriter {
+		t.Errorf("rows = %v, want %v", rows, writers*writesPerWriter)
+	}
+}
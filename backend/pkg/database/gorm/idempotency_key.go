@@ -0,0 +1,146 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+// IdempotencyKey records the resource which was created by a request with an
+// idempotency key. The keys are scoped by the type of the created resource,
+// so the same key can be used to create resources of different types.
+type IdempotencyKey struct {
+	ResourceType string `gorm:"primaryKey"`
+	Key          string `gorm:"primaryKey;column:idempotency_key"`
+	ResourceID   string
+	// RequestHash is the hash of the body of the request which created
+	// the resource, it's empty for the keys stored before it was recorded.
+	RequestHash string
+	CreatedAt   time.Time `gorm:"index"`
+}
+
+type IdempotencyKeysTableHandler struct {
+	DB *gorm.DB
+
+	// The resources are created with the tables of the handler, using the
+	// transaction which reserves the key.
+	handler *Handler
+
+	// The keys older than the window are expired, they never expire if
+	// the window is not positive.
+	window time.Duration
+}
+
+func (db *Handler) IdempotencyKeysTable() types.IdempotencyKeysTable {
+	return &IdempotencyKeysTableHandler{
+		DB:      db.DB,
+		handler: db,
+		window:  db.IdempotencyKeyWindow,
+	}
+}
+
+func (i *IdempotencyKeysTableHandler) GetIdempotencyKey(resourceType, key string) (string, error) {
+	query := i.DB.Where("resource_type = ? AND idempotency_key = ?", resourceType, key)
+	if i.window > 0 {
+		query = query.Where("created_at >= ?", time.Now().UTC().Add(-i.window))
+	}
+
+	var idempotencyKey IdempotencyKey
+	if err := query.First(&idempotencyKey).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", types.ErrNotFound
+		}
+		return "", fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return idempotencyKey.ResourceID, nil
+}
+
+// CreateWithIdempotencyKey reserves the key with an insert which does nothing
+// if the primary key is already stored, and calls create in the same
+// transaction. Concurrent requests with the key wait for the transaction which
+// reserved it, so only one of them creates the resource and the others get
+// its ID. If create fails the reservation is rolled back with it.
+func (i *IdempotencyKeysTableHandler) CreateWithIdempotencyKey(resourceType, key, requestHash string, create func(db types.Database) (string, error)) (string, bool, error) {
+	var resourceID string
+	var created bool
+	err := i.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+
+		// An expired key may still be stored, delete it so that it
+		// can be reserved again.
+		if i.window > 0 {
+			if err := tx.Where("created_at < ?", now.Add(-i.window)).Delete(&IdempotencyKey{}).Error; err != nil {
+				return fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+			}
+		}
+
+		idempotencyKey := IdempotencyKey{
+			ResourceType: resourceType,
+			Key:          key,
+			RequestHash:  requestHash,
+			CreatedAt:    now,
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&idempotencyKey)
+		if result.Error != nil {
+			return fmt.Errorf("failed to reserve idempotency key: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			if err := tx.Where("resource_type = ? AND idempotency_key = ?", resourceType, key).First(&idempotencyKey).Error; err != nil {
+				return fmt.Errorf("failed to get idempotency key: %w", err)
+			}
+			if idempotencyKey.RequestHash != "" && idempotencyKey.RequestHash != requestHash {
+				return types.ErrIdempotencyKeyReused
+			}
+			resourceID = idempotencyKey.ResourceID
+			return nil
+		}
+
+		txHandler := *i.handler
+		txHandler.DB = tx
+		id, err := create(&txHandler)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&IdempotencyKey{}).
+			Where("resource_type = ? AND idempotency_key = ?", resourceType, key).
+			Update("resource_id", id).Error; err != nil {
+			return fmt.Errorf("failed to save idempotency key: %w", err)
+		}
+		resourceID, created = id, true
+		return nil
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	return resourceID, created, nil
+}
+
+func (i *IdempotencyKeysTableHandler) DeleteIdempotencyKey(resourceType, key, resourceID string) error {
+	if err := i.DB.Where("resource_type = ? AND idempotency_key = ? AND resource_id = ?", resourceType, key, resourceID).Delete(&IdempotencyKey{}).Error; err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,360 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/gorm/logger"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func TestIdempotencyKeysTableHandler(t *testing.T) {
+	expiredCreatedAt := time.Now().UTC().Add(-2 * time.Hour)
+	tests := []struct {
+		name         string
+		window       time.Duration
+		storedKeys   []IdempotencyKey
+		saveKey      string
+		resourceType string
+		key          string
+		want         string
+		wantErr      error
+	}{
+		{
+			name:         "saved key",
+			window:       time.Hour,
+			saveKey:      "key",
+			resourceType: "Scan",
+			key:          "key",
+			want:         "scan-id",
+		},
+		{
+			name:         "unknown key",
+			window:       time.Hour,
+			saveKey:      "key",
+			resourceType: "Scan",
+			key:          "other-key",
+			wantErr:      types.ErrNotFound,
+		},
+		{
+			name:         "key of another resource type",
+			window:       time.Hour,
+			saveKey:      "key",
+			resourceType: "ScanConfig",
+			key:          "key",
+			wantErr:      types.ErrNotFound,
+		},
+		{
+			name:   "expired key",
+			window: time.Hour,
+			storedKeys: []IdempotencyKey{
+				{ResourceType: "Scan", Key: "expired-key", ResourceID: "expired-scan-id", CreatedAt: expiredCreatedAt},
+			},
+			resourceType: "Scan",
+			key:          "expired-key",
+			wantErr:      types.ErrNotFound,
+		},
+		{
+			name:   "keys never expire without a window",
+			window: 0,
+			storedKeys: []IdempotencyKey{
+				{ResourceType: "Scan", Key: "expired-key", ResourceID: "expired-scan-id", CreatedAt: expiredCreatedAt},
+			},
+			resourceType: "Scan",
+			key:          "expired-key",
+			want:         "expired-scan-id",
+		},
+		{
+			name:   "expired key is saved again",
+			window: time.Hour,
+			storedKeys: []IdempotencyKey{
+				{ResourceType: "Scan", Key: "key", ResourceID: "expired-scan-id", CreatedAt: expiredCreatedAt},
+			},
+			saveKey:      "key",
+			resourceType: "Scan",
+			key:          "key",
+			want:         "scan-id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDataBase(t)
+			if err := db.AutoMigrate(&IdempotencyKey{}); err != nil {
+				t.Fatalf("failed to create the idempotency keys table: %v", err)
+			}
+			for _, storedKey := range tt.storedKeys {
+				storedKey := storedKey
+				if err := db.Create(&storedKey).Error; err != nil {
+					t.Fatalf("failed to store idempotency key: %v", err)
+				}
+			}
+
+			handler := &Handler{DB: db, IdempotencyKeyWindow: tt.window}
+			if tt.saveKey != "" {
+				if _, _, err := handler.IdempotencyKeysTable().CreateWithIdempotencyKey("Scan", tt.saveKey, "hash", createResource("scan-id")); err != nil {
+					t.Fatalf("CreateWithIdempotencyKey() error = %v", err)
+				}
+			}
+
+			got, err := handler.IdempotencyKeysTable().GetIdempotencyKey(tt.resourceType, tt.key)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetIdempotencyKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetIdempotencyKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// createResource returns a create function of CreateWithIdempotencyKey which
+// creates a resource with the ID.
+func createResource(resourceID string) func(db types.Database) (string, error) {
+	return func(db types.Database) (string, error) {
+		return resourceID, nil
+	}
+}
+
+func TestIdempotencyKeysTableHandler_CreateWithIdempotencyKey(t *testing.T) {
+	errCreate := errors.New("create failed")
+	tests := []struct {
+		name        string
+		storedKeys  []IdempotencyKey
+		createErr   error
+		want        string
+		wantCreated bool
+		wantErr     error
+		wantStored  string
+	}{
+		{
+			name:        "unused key",
+			want:        "scan-id",
+			wantCreated: true,
+			wantStored:  "scan-id",
+		},
+		{
+			name: "used key",
+			storedKeys: []IdempotencyKey{
+				{ResourceType: "Scan", Key: "key", ResourceID: "existing-scan-id", RequestHash: "hash", CreatedAt: time.Now().UTC()},
+			},
+			want:       "existing-scan-id",
+			wantStored: "existing-scan-id",
+		},
+		{
+			name: "key used by another request",
+			storedKeys: []IdempotencyKey{
+				{ResourceType: "Scan", Key: "key", ResourceID: "existing-scan-id", RequestHash: "other-hash", CreatedAt: time.Now().UTC()},
+			},
+			wantErr:    types.ErrIdempotencyKeyReused,
+			wantStored: "existing-scan-id",
+		},
+		{
+			name: "key used without a request hash",
+			storedKeys: []IdempotencyKey{
+				{ResourceType: "Scan", Key: "key", ResourceID: "existing-scan-id", CreatedAt: time.Now().UTC()},
+			},
+			want:       "existing-scan-id",
+			wantStored: "existing-scan-id",
+		},
+		{
+			name: "key of another resource type",
+			storedKeys: []IdempotencyKey{
+				{ResourceType: "ScanConfig", Key: "key", ResourceID: "scan-config-id", CreatedAt: time.Now().UTC()},
+			},
+			want:        "scan-id",
+			wantCreated: true,
+			wantStored:  "scan-id",
+		},
+		{
+			name: "expired key",
+			storedKeys: []IdempotencyKey{
+				{ResourceType: "Scan", Key: "key", ResourceID: "expired-scan-id", CreatedAt: time.Now().UTC().Add(-2 * time.Hour)},
+			},
+			want:        "scan-id",
+			wantCreated: true,
+			wantStored:  "scan-id",
+		},
+		{
+			name:      "key isn't reserved if create fails",
+			createErr: errCreate,
+			wantErr:   errCreate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDataBase(t)
+			if err := db.AutoMigrate(&IdempotencyKey{}); err != nil {
+				t.Fatalf("failed to create the idempotency keys table: %v", err)
+			}
+			for _, storedKey := range tt.storedKeys {
+				storedKey := storedKey
+				if err := db.Create(&storedKey).Error; err != nil {
+					t.Fatalf("failed to store idempotency key: %v", err)
+				}
+			}
+
+			handler := &Handler{DB: db, IdempotencyKeyWindow: time.Hour}
+			got, created, err := handler.IdempotencyKeysTable().CreateWithIdempotencyKey("Scan", "key", "hash", func(db types.Database) (string, error) {
+				if tt.createErr != nil {
+					return "", tt.createErr
+				}
+				return "scan-id", nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateWithIdempotencyKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want || created != tt.wantCreated {
+				t.Errorf("CreateWithIdempotencyKey() = %v, %v, want %v, %v", got, created, tt.want, tt.wantCreated)
+			}
+
+			stored, err := handler.IdempotencyKeysTable().GetIdempotencyKey("Scan", "key")
+			if err != nil && !errors.Is(err, types.ErrNotFound) {
+				t.Fatalf("GetIdempotencyKey() error = %v", err)
+			}
+			if stored != tt.wantStored {
+				t.Errorf("GetIdempotencyKey() = %v, want %v", stored, tt.wantStored)
+			}
+		})
+	}
+}
+
+func TestIdempotencyKeysTableHandler_CreateWithIdempotencyKeyConcurrent(t *testing.T) {
+	db, err := initSqlite(types.DBConfig{
+		LocalDBPath: filepath.Join(t.TempDir(), "test.db"),
+	}, logger.Default.LogMode(logger.Silent))
+	if err != nil {
+		t.Fatalf("initSqlite() unexpected error = %v", err)
+	}
+	if err := db.AutoMigrate(&IdempotencyKey{}, &migrationTestTable{}); err != nil {
+		t.Fatalf("failed to create tables: %v", err)
+	}
+	handler := &Handler{DB: db, IdempotencyKeyWindow: time.Hour}
+
+	const requests = 20
+	var wg sync.WaitGroup
+	resourceIDs := make([]string, requests)
+	errs := make([]error, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(request int) {
+			defer wg.Done()
+			resourceIDs[request], _, errs[request] = handler.IdempotencyKeysTable().CreateWithIdempotencyKey("Scan", "key", "hash", func(db types.Database) (string, error) {
+				row := migrationTestTable{Name: fmt.Sprintf("request-%d", request)}
+				if err := db.(*Handler).DB.Create(&row).Error; err != nil {
+					return "", err
+				}
+				return row.Name, nil
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < requests; i++ {
+		if errs[i] != nil {
+			t.Fatalf("CreateWithIdempotencyKey() error = %v", errs[i])
+		}
+		if resourceIDs[i] != resourceIDs[0] {
+			t.Errorf("CreateWithIdempotencyKey() = %v, want %v", resourceIDs[i], resourceIDs[0])
+		}
+	}
+
+	var rows int64
+	if err := db.Model(&migrationTestTable{}).Count(&rows).Error; err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+	if rows != 1 {
+		t.Errorf("rows = %v, want 1", rows)
+	}
+}
+
+func TestIdempotencyKeysTableHandler_DeleteIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+		wantStored string
+		wantErr    error
+	}{
+		{
+			name:       "key of the resource",
+			resourceID: "scan-id",
+			wantErr:    types.ErrNotFound,
+		},
+		{
+			name:       "key of another resource",
+			resourceID: "other-scan-id",
+			wantStored: "scan-id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDataBase(t)
+			if err := db.AutoMigrate(&IdempotencyKey{}); err != nil {
+				t.Fatalf("failed to create the idempotency keys table: %v", err)
+			}
+			handler := &Handler{DB: db, IdempotencyKeyWindow: time.Hour}
+			if _, _, err := handler.IdempotencyKeysTable().CreateWithIdempotencyKey("Scan", "key", "hash", createResource("scan-id")); err != nil {
+				t.Fatalf("CreateWithIdempotencyKey() error = %v", err)
+			}
+
+			if err := handler.IdempotencyKeysTable().DeleteIdempotencyKey("Scan", "key", tt.resourceID); err != nil {
+				t.Fatalf("DeleteIdempotencyKey() error = %v", err)
+			}
+
+			got, err := handler.IdempotencyKeysTable().GetIdempotencyKey("Scan", "key")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetIdempotencyKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.wantStored {
+				t.Errorf("GetIdempotencyKey() = %v, want %v", got, tt.wantStored)
+			}
+		})
+	}
+}
+
+func TestIdempotencyKeysTableHandler_CreateWithIdempotencyKeyDeletesExpiredKeys(t *testing.T) {
+	db := openTestDataBase(t)
+	if err := db.AutoMigrate(&IdempotencyKey{}); err != nil {
+		t.Fatalf("failed to create the idempotency keys table: %v", err)
+	}
+	if err := db.Create(&IdempotencyKey{
+		ResourceType: "Scan",
+		Key:          "expired-key",
+		ResourceID:   "expired-scan-id",
+		CreatedAt:    time.Now().UTC().Add(-2 * time.Hour),
+	}).Error; err != nil {
+		t.Fatalf("failed to store idempotency key: %v", err)
+	}
+
+	handler := &Handler{DB: db, IdempotencyKeyWindow: time.Hour}
+	if _, _, err := handler.IdempotencyKeysTable().CreateWithIdempotencyKey("Scan", "key", "hash", createResource("scan-id")); err != nil {
+		t.Fatalf("CreateWithIdempotencyKey() error = %v", err)
+	}
+
+	var count int64
+	if err := db.Model(&IdempotencyKey{}).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count idempotency keys: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("idempotency keys count = %v, want 1", count)
+	}
+}
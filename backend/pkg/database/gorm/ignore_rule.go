@@ -0,0 +1,113 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	ignoreRulesSchemaName = "IgnoreRules"
+
+	// The global ignore rules are a single object, so they are always
+	// stored in the same row.
+	ignoreRulesRowID = 1
+)
+
+type IgnoreRules struct {
+	ODataObject
+}
+
+type IgnoreRulesTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) IgnoreRulesTable() types.IgnoreRulesTable {
+	return &IgnoreRulesTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (i *IgnoreRulesTableHandler) GetIgnoreRules() (models.IgnoreRules, error) {
+	var dbIgnoreRules IgnoreRules
+	err := ODataQuery(i.DB, ignoreRulesSchemaName, nil, nil, nil, nil, nil, nil, false, &dbIgnoreRules)
+	if err != nil {
+		// No ignore rules were set yet.
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.IgnoreRules{Rules: &[]models.IgnoreRule{}}, nil
+		}
+		return models.IgnoreRules{}, err
+	}
+
+	var ignoreRules models.IgnoreRules
+	err = json.Unmarshal(dbIgnoreRules.Data, &ignoreRules)
+	if err != nil {
+		return models.IgnoreRules{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return ignoreRules, nil
+}
+
+func (i *IgnoreRulesTableHandler) SetIgnoreRules(ignoreRules models.IgnoreRules) (models.IgnoreRules, error) {
+	if err := validateIgnoreRules(ignoreRules); err != nil {
+		return models.IgnoreRules{}, &common.BadRequestError{
+			Reason: err.Error(),
+		}
+	}
+
+	marshaled, err := json.Marshal(ignoreRules)
+	if err != nil {
+		return models.IgnoreRules{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	var dbIgnoreRules IgnoreRules
+	dbIgnoreRules.ID = ignoreRulesRowID
+	dbIgnoreRules.Data = marshaled
+
+	if err = i.DB.Save(&dbIgnoreRules).Error; err != nil {
+		return models.IgnoreRules{}, fmt.Errorf("failed to save ignore rules in db: %w", err)
+	}
+
+	var apiIgnoreRules models.IgnoreRules
+	if err = json.Unmarshal(dbIgnoreRules.Data, &apiIgnoreRules); err != nil {
+		return models.IgnoreRules{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return apiIgnoreRules, nil
+}
+
+func validateIgnoreRules(ignoreRules models.IgnoreRules) error {
+	if ignoreRules.Rules == nil {
+		return nil
+	}
+
+	for i, rule := range *ignoreRules.Rules {
+		if err := utils.ValidateIgnoreRule(rule); err != nil {
+			return fmt.Errorf("invalid rule %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
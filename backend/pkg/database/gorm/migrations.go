@@ -0,0 +1,292 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+// SchemaVersion records a migration which was applied to the database.
+type SchemaVersion struct {
+	Version     int `gorm:"primaryKey;autoIncrement:false"`
+	Description string
+	AppliedAt   time.Time
+}
+
+type migration struct {
+	version     int
+	description string
+	migrate     func(tx *gorm.DB) error
+}
+
+// migrations are applied in order, each one in its own transaction together
+// with recording its version, so a failed migration doesn't leave a partial
+// schema behind. Migrations must never be changed once released, schema
+// changes are made by appending a new migration with the next version.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "create the tables and their indexes",
+		migrate:     migrateInitialSchema,
+	},
+	{
+		version:     2,
+		description: "create the ignore rules table",
+		migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(IgnoreRules{})
+		},
+	},
+	{
+		version:     3,
+		description: "create the idempotency keys table",
+		migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(IdempotencyKey{})
+		},
+	},
+	{
+		version:     4,
+		description: "index the targets of the scan results",
+		migrate: func(tx *gorm.DB) error {
+			// The targets are joined to their scan results to
+			// find the targets which were never scanned.
+			return tx.Exec("CREATE INDEX IF NOT EXISTS scan_results_target_id_idx ON scan_results(Data -> '$.target.id')").Error
+		},
+	},
+	{
+		version:     5,
+		description: "move the credentials of the scan configs out of their data",
+		migrate:     migrateCredentialsOutOfData,
+	},
+	{
+		version:     6,
+		description: "record the request hash of the idempotency keys",
+		migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(IdempotencyKey{})
+		},
+	},
+}
+
+// ErrSchemaNotUpToDate is returned when the database schema isn't at the latest
+// version and migrations aren't applied on startup.
+var ErrSchemaNotUpToDate = errors.New("database schema is not up to date")
+
+// applyMigrations applies the migrations which weren't applied to the database yet.
+func applyMigrations(db *gorm.DB, migrations []migration) error {
+	currentVersion, err := getSchemaVersion(db)
+	if err != nil {
+		return err
+	}
+
+	latestVersion := getLatestVersion(migrations)
+	if currentVersion > latestVersion {
+		return fmt.Errorf("database schema version %d is newer than the latest version %d supported by this release", currentVersion, latestVersion)
+	}
+
+	for _, m := range migrations {
+		if m.version <= currentVersion {
+			continue
+		}
+
+		log.Infof("Applying database migration %d: %s", m.version, m.description)
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := m.migrate(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaVersion{
+				Version:     m.version,
+				Description: m.description,
+				AppliedAt:   time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to apply database migration %d (%s), the database was left at schema version %d: %w", m.version, m.description, currentVersion, err)
+		}
+		currentVersion = m.version
+	}
+
+	log.Infof("Database schema is at version %d", currentVersion)
+	return nil
+}
+
+// checkSchemaVersion checks that all the migrations were applied to the
+// database, for when they are applied separately from the server.
+func checkSchemaVersion(db *gorm.DB, migrations []migration) error {
+	currentVersion, err := getSchemaVersion(db)
+	if err != nil {
+		return err
+	}
+
+	if latestVersion := getLatestVersion(migrations); currentVersion != latestVersion {
+		return fmt.Errorf("%w: schema version is %d, expected version %d", ErrSchemaNotUpToDate, currentVersion, latestVersion)
+	}
+
+	return nil
+}
+
+// getSchemaVersion returns the version of the last migration applied to the
+// database, or 0 if none were applied.
+func getSchemaVersion(db *gorm.DB) (int, error) {
+	if err := db.AutoMigrate(&SchemaVersion{}); err != nil {
+		return 0, fmt.Errorf("failed to create the schema versions table: %w", err)
+	}
+
+	var version int
+	if err := db.Model(&SchemaVersion{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("failed to get the database schema version: %w", err)
+	}
+
+	return version, nil
+}
+
+func getLatestVersion(migrations []migration) int {
+	latestVersion := 0
+	for _, m := range migrations {
+		if m.version > latestVersion {
+			latestVersion = m.version
+		}
+	}
+
+	return latestVersion
+}
+
+// migrateInitialSchema creates the schema which databases had before the
+// migrations were versioned, so it's safe to apply to them as well.
+func migrateInitialSchema(tx *gorm.DB) error {
+	// this will ensure table is created
+	if err := tx.AutoMigrate(
+		Target{},
+		ScanResult{},
+		ScanConfig{},
+		Scan{},
+		Scopes{},
+		Finding{},
+		SeverityOverrides{},
+		TaggingRules{},
+	); err != nil {
+		return fmt.Errorf("failed to run auto migration: %w", err)
+	}
+
+	// Create indexes for our objects
+	//
+	// First for all objects index the ID field this speeds up anywhere
+	// we're getting a single object out of the DB, including in PATCH/PUT
+	// etc.
+	idb := tx.Exec("CREATE INDEX IF NOT EXISTS targets_id_idx ON targets(Data -> 'id')")
+	if idb.Error != nil {
+		return fmt.Errorf("failed to create index targets_id_idx: %w", idb.Error)
+	}
+
+	idb = tx.Exec("CREATE INDEX IF NOT EXISTS scan_results_id_idx ON scan_results(Data -> 'id')")
+	if idb.Error != nil {
+		return fmt.Errorf("failed to create index scan_results_id_idx: %w", idb.Error)
+	}
+
+	idb = tx.Exec("CREATE INDEX IF NOT EXISTS scan_configs_id_idx ON scan_configs(Data -> 'id')")
+	if idb.Error != nil {
+		return fmt.Errorf("failed to create index scan_configs_id_idx: %w", idb.Error)
+	}
+
+	idb = tx.Exec("CREATE INDEX IF NOT EXISTS scans_id_idx ON scans(Data -> 'id')")
+	if idb.Error != nil {
+		return fmt.Errorf("failed to create index scans_id_idx: %w", idb.Error)
+	}
+
+	idb = tx.Exec("CREATE INDEX IF NOT EXISTS findings_id_idx ON findings(Data -> 'id')")
+	if idb.Error != nil {
+		return fmt.Errorf("failed to create index findings_id_idx: %w", idb.Error)
+	}
+
+	// For processing scan results to findings we need to find all the scan
+	// results by general status and findingsProcessed, so add an index for
+	// that.
+	idb = tx.Exec("CREATE INDEX IF NOT EXISTS scan_results_findings_processed_idx ON scan_results(Data -> 'findingsProcessed', Data -> 'status.general.state')")
+	if idb.Error != nil {
+		return fmt.Errorf("failed to create index scan_results_findings_processed_idx: %w", idb.Error)
+	}
+
+	// The UI needs to find all the findings for a specific finding type
+	// and the scan result processor needs to filter that list by a
+	// specific asset. So add a combined index for those cases.
+	idb = tx.Exec("CREATE INDEX IF NOT EXISTS findings_by_type_and_asset_idx ON findings(Data -> 'findingInfo.objectType', Data -> 'asset.id')")
+	if idb.Error != nil {
+		return fmt.Errorf("failed to create index findings_by_type_and_asset_idx: %w", idb.Error)
+	}
+
+	// TODO(sambetts) Add indexes for all the uniqueness checks we need to
+	// do for each object
+
+	return nil
+}
+
+// migrateCredentialsOutOfData moves the credentials of the scan configs to
+// their Credentials column, and drops the credentials of the scan config
+// snapshots of the scans.
+func migrateCredentialsOutOfData(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(ScanConfig{}); err != nil {
+		return fmt.Errorf("failed to add the credentials column: %w", err)
+	}
+
+	var dbScanConfigs []ScanConfig
+	if err := tx.Find(&dbScanConfigs).Error; err != nil {
+		return fmt.Errorf("failed to get the scan configs: %w", err)
+	}
+	for _, dbScanConfig := range dbScanConfigs {
+		var scanConfig models.ScanConfig
+		if err := json.Unmarshal(dbScanConfig.Data, &scanConfig); err != nil {
+			return fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		var err error
+		dbScanConfig.Data, dbScanConfig.Credentials, err = marshalScanConfig(scanConfig)
+		if err != nil {
+			return err
+		}
+		if err := tx.Save(&dbScanConfig).Error; err != nil {
+			return fmt.Errorf("failed to save scan config in db: %w", err)
+		}
+	}
+
+	var dbScans []Scan
+	if err := tx.Find(&dbScans).Error; err != nil {
+		return fmt.Errorf("failed to get the scans: %w", err)
+	}
+	for _, dbScan := range dbScans {
+		var scan models.Scan
+		if err := json.Unmarshal(dbScan.Data, &scan); err != nil {
+			return fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		if scan.ScanConfigSnapshot == nil {
+			continue
+		}
+		data, err := json.Marshal(redactScanCredentials(scan))
+		if err != nil {
+			return fmt.Errorf("failed to convert API model to DB model: %w", err)
+		}
+		dbScan.Data = data
+		if err := tx.Save(&dbScan).Error; err != nil {
+			return fmt.Errorf("failed to save scan in db: %w", err)
+		}
+	}
+
+	return nil
+}
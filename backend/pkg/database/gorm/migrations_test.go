@@ -0,0 +1,210 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type migrationTestTable struct {
+	ID   int
+	Name string
+}
+
+func openTestDataBase(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	return db
+}
+
+func Test_applyMigrations(t *testing.T) {
+	createTable := migration{
+		version:     1,
+		description: "create table",
+		migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&migrationTestTable{})
+		},
+	}
+	addRow := migration{
+		version:     2,
+		description: "add row",
+		migrate: func(tx *gorm.DB) error {
+			return tx.Create(&migrationTestTable{ID: 1, Name: "row"}).Error
+		},
+	}
+	failing := migration{
+		version:     2,
+		description: "add row and fail",
+		migrate: func(tx *gorm.DB) error {
+			if err := tx.Create(&migrationTestTable{ID: 1, Name: "row"}).Error; err != nil {
+				return err
+			}
+			return errors.New("migration failed")
+		},
+	}
+
+	tests := []struct {
+		name        string
+		applied     []migration
+		migrations  []migration
+		wantErr     bool
+		wantVersion int
+		wantRows    int64
+	}{
+		{
+			name:        "empty database",
+			migrations:  []migration{createTable, addRow},
+			wantVersion: 2,
+			wantRows:    1,
+		},
+		{
+			name:        "apply only the new migrations",
+			applied:     []migration{createTable, addRow},
+			migrations:  []migration{createTable, addRow},
+			wantVersion: 2,
+			wantRows:    1,
+		},
+		{
+			name:        "failed migration is rolled back",
+			applied:     []migration{createTable},
+			migrations:  []migration{createTable, failing},
+			wantErr:     true,
+			wantVersion: 1,
+			wantRows:    0,
+		},
+		{
+			name:        "database is newer than the migrations",
+			applied:     []migration{createTable, addRow},
+			migrations:  []migration{createTable},
+			wantErr:     true,
+			wantVersion: 2,
+			wantRows:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openTestDataBase(t)
+			if err := applyMigrations(db, tt.applied); err != nil {
+				t.Fatalf("failed to apply the existing migrations: %v", err)
+			}
+
+			if err := applyMigrations(db, tt.migrations); (err != nil) != tt.wantErr {
+				t.Errorf("applyMigrations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			version, err := getSchemaVersion(db)
+			if err != nil {
+				t.Fatalf("failed to get the schema version: %v", err)
+			}
+			if version != tt.wantVersion {
+				t.Errorf("applyMigrations() schema version = %v, want %v", version, tt.wantVersion)
+			}
+
+			var rows int64
+			if err := db.Model(&migrationTestTable{}).Count(&rows).Error; err != nil {
+				t.Fatalf("failed to count rows: %v", err)
+			}
+			if rows != tt.wantRows {
+				t.Errorf("applyMigrations() rows = %v, want %v", rows, tt.wantRows)
+			}
+		})
+	}
+}
+
+func Test_checkSchemaVersion(t *testing.T) {
+	createTable := migration{
+		version:     1,
+		description: "create table",
+		migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&migrationTestTable{})
+		},
+	}
+
+	db := openTestDataBase(t)
+	if err := checkSchemaVersion(db, []migration{createTable}); !errors.Is(err, ErrSchemaNotUpToDate) {
+		t.Errorf("checkSchemaVersion() error = %v, want %v", err, ErrSchemaNotUpToDate)
+	}
+
+	if err := applyMigrations(db, []migration{createTable}); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+	if err := checkSchemaVersion(db, []migration{createTable}); err != nil {
+		t.Errorf("checkSchemaVersion() unexpected error = %v", err)
+	}
+}
+
+func Test_migrateCredentialsOutOfData(t *testing.T) {
+	db := openTestDataBase(t)
+	if err := applyMigrations(db, migrations[:4]); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+
+	familiesConfig := `{"vulnerabilities":{"enabled":true,"registry":{"auths":[{"authority":"docker.io","username":"user","password":"password"}]}}}`
+	scanConfigData := `{"id":"scan-config-1","name":"scan-config","scanFamiliesConfig":` + familiesConfig + `}`
+	scanData := `{"id":"scan-1","scanConfig":{"id":"scan-config-1"},"scanConfigSnapshot":{"scanFamiliesConfig":` + familiesConfig + `}}`
+	if err := db.Exec("INSERT INTO scan_configs (data) VALUES (?)", scanConfigData).Error; err != nil {
+		t.Fatalf("failed to insert scan config: %v", err)
+	}
+	if err := db.Exec("INSERT INTO scans (data) VALUES (?)", scanData).Error; err != nil {
+		t.Fatalf("failed to insert scan: %v", err)
+	}
+
+	if err := applyMigrations(db, migrations); err != nil {
+		t.Fatalf("applyMigrations() error = %v", err)
+	}
+
+	for _, table := range []string{"scan_configs", "scans"} {
+		var rows int64
+		if err := db.Table(table).Where("data LIKE ?", "%password%").Count(&rows).Error; err != nil {
+			t.Fatalf("failed to count rows: %v", err)
+		}
+		if rows != 0 {
+			t.Errorf("applyMigrations() left the credentials in the data of %v", table)
+		}
+	}
+
+	handler := &Handler{DB: db}
+	scanConfig, err := handler.ScanConfigsTable().GetScanConfigWithCredentials("scan-config-1")
+	if err != nil {
+		t.Fatalf("GetScanConfigWithCredentials() error = %v", err)
+	}
+	want := []models.RegistryAuth{
+		{
+			Authority: "docker.io",
+			Username:  utils.PointerTo("user"),
+			Password:  utils.PointerTo("password"),
+		},
+	}
+	if diff := cmp.Diff(want, *scanConfig.ScanFamiliesConfig.Vulnerabilities.Registry.Auths); diff != "" {
+		t.Errorf("applyMigrations() credentials mismatch (-want +got):\n%s", diff)
+	}
+}
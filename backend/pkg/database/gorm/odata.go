@@ -22,6 +22,7 @@ import (
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
+	"github.com/openclarity/vmclarity/backend/pkg/common"
 	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
 )
@@ -83,6 +84,70 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 				ComplexFieldSchemas: []string{"ScanFindingsSummary"},
 			},
 			"findingsProcessed": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"partitions": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScannedPartition"},
+				},
+			},
+			"tags": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"familiesConfig": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"jobResources": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanJobResources"},
+			},
+			"snapshotIntegrity": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"SnapshotIntegrityCheck"},
+				},
+			},
+		},
+	},
+	"ScanJobResources": {
+		Fields: odatasql.Schema{
+			"instanceID":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceLocation": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"volumes": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanJobVolumeResources"},
+				},
+			},
+		},
+	},
+	"ScanJobVolumeResources": {
+		Fields: odatasql.Schema{
+			"srcSnapshotID":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"dstSnapshotID":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"volumeID":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"existingSnapshot": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SnapshotIntegrityCheck": {
+		Fields: odatasql.Schema{
+			"srcSnapshotID":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"dstSnapshotID":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"state":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"blocks":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"mismatchedBlocks": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannedPartition": {
+		Fields: odatasql.Schema{
+			"deviceName":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"label":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"uuid":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"filesystemType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"mountPoint":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanned":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reason":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
 	"SbomScan": {
@@ -94,6 +159,10 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 					ComplexFieldSchemas: []string{"Package"},
 				},
 			},
+			"packageManagerHints": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
 		},
 	},
 	"Package": {
@@ -129,6 +198,7 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 			"vulnerabilityName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"description":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"severity":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"originalSeverity":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"layerId":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"path":              odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"links": odatasql.FieldMeta{
@@ -190,6 +260,7 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 			"startColumn": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"endColumn":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"fingerprint": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"ruleID":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
 	"MisconfigurationScan": {
@@ -311,6 +382,10 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 				FieldType:           odatasql.ComplexFieldType,
 				ComplexFieldSchemas: []string{"VulnerabilityScanSummary"},
 			},
+			"ignoredFindings": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFindingsSummary"},
+			},
 		},
 	},
 	targetSchemaName: {
@@ -327,6 +402,24 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 				FieldType:           odatasql.ComplexFieldType,
 				ComplexFieldSchemas: []string{"ScanFindingsSummary"},
 			},
+			"metadata": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"TargetMetadata"},
+			},
+		},
+	},
+	"TargetMetadata": {
+		Fields: odatasql.Schema{
+			"owner":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"application": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"criticality": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"attributes": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
 		},
 	},
 	"VMInfo": {
@@ -376,16 +469,43 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 			},
 			"scope": odatasql.FieldMeta{
 				FieldType:             odatasql.ComplexFieldType,
-				ComplexFieldSchemas:   []string{"AwsScanScope"},
+				ComplexFieldSchemas:   []string{"AwsScanScope", "AzureScanScope", "GcpScanScope"},
 				DiscriminatorProperty: "objectType",
 			},
 			"maxParallelScanners": odatasql.FieldMeta{
 				FieldType: odatasql.PrimitiveFieldType,
 			},
+			"scanJobTimeoutSeconds": odatasql.FieldMeta{
+				FieldType: odatasql.PrimitiveFieldType,
+			},
+			"maxScannerInstanceHours": odatasql.FieldMeta{
+				FieldType: odatasql.PrimitiveFieldType,
+			},
+			"partitionsToScan": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"scanAllVolumes":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"verifySnapshotIntegrity": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"existingSnapshots": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ExistingSnapshot"},
+				},
+			},
 			"scannerInstanceCreationConfig": odatasql.FieldMeta{
 				FieldType:           odatasql.ComplexFieldType,
 				ComplexFieldSchemas: []string{"ScannerInstanceCreationConfig"},
 			},
+			"ignoreRules": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"IgnoreRule"},
+				},
+			},
+			"nextRunTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
 	"ScanConfigData": {
@@ -402,28 +522,101 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 			},
 			"scope": odatasql.FieldMeta{
 				FieldType:             odatasql.ComplexFieldType,
-				ComplexFieldSchemas:   []string{"AwsScanScope"},
+				ComplexFieldSchemas:   []string{"AwsScanScope", "AzureScanScope", "GcpScanScope"},
 				DiscriminatorProperty: "objectType",
 			},
 			"maxParallelScanners": odatasql.FieldMeta{
 				FieldType: odatasql.PrimitiveFieldType,
 			},
+			"scanJobTimeoutSeconds": odatasql.FieldMeta{
+				FieldType: odatasql.PrimitiveFieldType,
+			},
+			"maxScannerInstanceHours": odatasql.FieldMeta{
+				FieldType: odatasql.PrimitiveFieldType,
+			},
+			"partitionsToScan": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"scanAllVolumes":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"verifySnapshotIntegrity": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"existingSnapshots": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ExistingSnapshot"},
+				},
+			},
 			"scannerInstanceCreationConfig": odatasql.FieldMeta{
 				FieldType:           odatasql.ComplexFieldType,
 				ComplexFieldSchemas: []string{"ScannerInstanceCreationConfig"},
 			},
+			"ignoreRules": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"IgnoreRule"},
+				},
+			},
+		},
+	},
+	"ExistingSnapshot": {
+		Fields: odatasql.Schema{
+			"instanceID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"snapshotID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
 	"ScannerInstanceCreationConfig": {
 		Fields: odatasql.Schema{
-			"useSpotInstances": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
-			"maxPrice":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
-			"retryMaxAttempts": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"useSpotInstances":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"maxPrice":                 odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"retryMaxAttempts":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"requireIMDSv2":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceMetadataHopLimit": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceProfileARN":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerImagePullCredentials": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerImagePullCredentials"},
+			},
+			"proxy": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerProxyConfig"},
+			},
+			"instanceTypesByVolumeSize": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScannerInstanceTypeByVolumeSize"},
+				},
+			},
+		},
+	},
+	// The password is write-only, it's stored apart from the data, see
+	// splitScanConfigCredentials.
+	"ScannerImagePullCredentials": {
+		Fields: odatasql.Schema{
+			"method":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"registry": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"username": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerProxyConfig": {
+		Fields: odatasql.Schema{
+			"httpProxy":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"httpsProxy": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"noProxy":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerInstanceTypeByVolumeSize": {
+		Fields: odatasql.Schema{
+			"minVolumeSizeGB": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceType":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
 	"RuntimeScheduleScanConfig": {
 		Fields: odatasql.Schema{
 			"cronLine":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"timezone":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"operationTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
@@ -457,6 +650,11 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 				FieldType:           odatasql.ComplexFieldType,
 				ComplexFieldSchemas: []string{"VulnerabilitiesConfig"},
 			},
+			"maxParallelScannersPerFamily": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"preset":                       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerConfigOverlay":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			// Free-form map keyed by scanner name, selected as a whole.
+			"scannerCommandOptions": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
 	"ExploitsConfig": {
@@ -467,31 +665,100 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 	"MalwareConfig": {
 		Fields: odatasql.Schema{
 			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannersList": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
 		},
 	},
 	"MisconfigurationsConfig": {
 		Fields: odatasql.Schema{
 			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannersList": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
 		},
 	},
 	"RootkitsConfig": {
 		Fields: odatasql.Schema{
 			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannersList": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
 		},
 	},
 	"SBOMConfig": {
 		Fields: odatasql.Schema{
 			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"analyzersList": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"trivyTimeoutSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"registry": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RegistryConfig"},
+			},
+			"packageManagerHints": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"PackageManagerHints"},
+			},
+		},
+	},
+	"PackageManagerHints": {
+		Fields: odatasql.Schema{
+			"distro": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"packageManagers": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
 		},
 	},
 	"SecretsConfig": {
 		Fields: odatasql.Schema{
 			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannersList": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
 		},
 	},
 	"VulnerabilitiesConfig": {
 		Fields: odatasql.Schema{
 			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannersList": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"trivyTimeoutSeconds":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"grypeServerTimeoutSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"registry": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RegistryConfig"},
+			},
+		},
+	},
+	"RegistryConfig": {
+		Fields: odatasql.Schema{
+			"skipVerifyTLS": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"useHTTP":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"auths": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"RegistryAuth"},
+				},
+			},
+		},
+	},
+	// The password and the token are write-only, they're stored apart
+	// from the data, see splitScanConfigCredentials.
+	"RegistryAuth": {
+		Fields: odatasql.Schema{
+			"authority": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"username":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
 	scopesSchemaName: {
@@ -499,11 +766,92 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 		Fields: odatasql.Schema{
 			"scopeInfo": odatasql.FieldMeta{
 				FieldType:             odatasql.ComplexFieldType,
-				ComplexFieldSchemas:   []string{"AwsAccountScope"},
+				ComplexFieldSchemas:   []string{"AwsAccountScope", "AzureSubscriptionScope", "GcpProjectScope"},
 				DiscriminatorProperty: "objectType",
 			},
 		},
 	},
+	severityOverridesSchemaName: {
+		Table: "severity_overrides",
+		Fields: odatasql.Schema{
+			"overrides": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"SeverityOverride"},
+				},
+			},
+		},
+	},
+	"SeverityOverride": {
+		Fields: odatasql.Schema{
+			"vulnerabilityName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"severity":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	ignoreRulesSchemaName: {
+		Table: "ignore_rules",
+		Fields: odatasql.Schema{
+			"rules": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"IgnoreRule"},
+				},
+			},
+		},
+	},
+	"IgnoreRule": {
+		Fields: odatasql.Schema{
+			"vulnerabilityName":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"path":                   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"secretRuleID":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"misconfigurationTestID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reason":                 odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	taggingRulesSchemaName: {
+		Table: "tagging_rules",
+		Fields: odatasql.Schema{
+			"rules": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"TaggingRule"},
+				},
+			},
+		},
+	},
+	"TaggingRule": {
+		Fields: odatasql.Schema{
+			"name": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"tag":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"conditions": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"TaggingRuleConditions"},
+			},
+		},
+	},
+	"TaggingRuleConditions": {
+		Fields: odatasql.Schema{
+			"targetLocation":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"targetOwner":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"targetApplication": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"targetCriticality": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"targetAttributes": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"findingTypes": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"minVulnerabilitySeverity": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
 	"AwsAccountScope": {
 		Fields: odatasql.Schema{
 			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
@@ -518,9 +866,10 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 	},
 	"AwsScanScope": {
 		Fields: odatasql.Schema{
-			"objectType":                 odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
-			"allRegions":                 odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
-			"shouldScanStoppedInstances": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"allRegions":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"shouldScanStoppedInstances":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceTagSelectorOperator": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"instanceTagExclusion": odatasql.FieldMeta{
 				FieldType: odatasql.CollectionFieldType,
 				CollectionItemMeta: &odatasql.FieldMeta{
@@ -544,6 +893,76 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 			},
 		},
 	},
+	"AzureSubscriptionScope": {
+		Fields: odatasql.Schema{
+			"objectType":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"subscriptionID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"resourceGroups": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"AzureScanScope": {
+		Fields: odatasql.Schema{
+			"objectType":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"shouldScanStoppedInstances":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceTagSelectorOperator": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"resourceGroups": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"instanceTagExclusion": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"instanceTagSelector": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+		},
+	},
+	"GcpProjectScope": {
+		Fields: odatasql.Schema{
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"projectID":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"zones": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"GcpScanScope": {
+		Fields: odatasql.Schema{
+			"objectType":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"shouldScanStoppedInstances":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceTagSelectorOperator": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"zones": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"instanceTagExclusion": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"instanceTagSelector": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+		},
+	},
 	"Tag": {
 		Fields: odatasql.Schema{
 			"key":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
@@ -595,6 +1014,7 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 			},
 			"foundOn":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"invalidatedOn": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"partition":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"findingInfo": odatasql.FieldMeta{
 				FieldType: odatasql.ComplexFieldType,
 				ComplexFieldSchemas: []string{
@@ -617,6 +1037,10 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 					"ExploitFindingInfo":          "Exploit",
 				},
 			},
+			"tags": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
 		},
 	},
 	"PackageFindingInfo": {
@@ -643,6 +1067,7 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 			"vulnerabilityName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"description":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"severity":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"originalSeverity":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"layerId":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"path":              odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"links": odatasql.FieldMeta{
@@ -709,6 +1134,7 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 			"startLine":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"endLine":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 			"fingerprint": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"ruleID":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
 		},
 	},
 	"MisconfigurationFindingInfo": {
@@ -795,6 +1221,12 @@ var schemaMetas = map[string]odatasql.SchemaMeta{
 }
 
 func ODataQuery(db *gorm.DB, schema string, filterString, selectString, expandString, orderby *string, top, skip *int, collection bool, result interface{}) error {
+	return ODataQueryWithAntiJoin(db, schema, nil, filterString, selectString, expandString, orderby, top, skip, collection, result)
+}
+
+// ODataQueryWithAntiJoin is ODataQuery restricted to the objects which have
+// no matching row in the table of the join.
+func ODataQueryWithAntiJoin(db *gorm.DB, schema string, antiJoin *odatasql.AntiJoin, filterString, selectString, expandString, orderby *string, top, skip *int, collection bool, result interface{}) error {
 	// If we're not getting a collection, make sure the result is limited
 	// to 1 item.
 	if !collection {
@@ -804,9 +1236,13 @@ func ODataQuery(db *gorm.DB, schema string, filterString, selectString, expandSt
 
 	// Build the raw SQL query using the odatasql library, this will also
 	// parse and validate the ODATA query params.
-	query, err := odatasql.BuildSQLQuery(schemaMetas, schema, filterString, selectString, expandString, orderby, top, skip)
+	query, err := odatasql.BuildSQLQueryWithAntiJoin(schemaMetas, schema, antiJoin, filterString, selectString, expandString, orderby, top, skip)
 	if err != nil {
-		return fmt.Errorf("failed to build query for DB: %w", err)
+		// The query is built from the user provided ODATA params, so
+		// a failure here means that they are invalid.
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("failed to build query for DB: %v", err),
+		}
 	}
 
 	log.Debugf("Running query - %q", query)
@@ -826,9 +1262,17 @@ func ODataQuery(db *gorm.DB, schema string, filterString, selectString, expandSt
 }
 
 func ODataCount(db *gorm.DB, schema string, filterString *string) (int, error) {
-	query, err := odatasql.BuildCountQuery(schemaMetas, schema, filterString)
+	return ODataCountWithAntiJoin(db, schema, nil, filterString)
+}
+
+// ODataCountWithAntiJoin is ODataCount restricted to the objects which have no
+// matching row in the table of the join.
+func ODataCountWithAntiJoin(db *gorm.DB, schema string, antiJoin *odatasql.AntiJoin, filterString *string) (int, error) {
+	query, err := odatasql.BuildCountQueryWithAntiJoin(schemaMetas, schema, antiJoin, filterString)
 	if err != nil {
-		return 0, fmt.Errorf("failed to build query to count objects: %w", err)
+		return 0, &common.BadRequestError{
+			Reason: fmt.Sprintf("failed to build query to count objects: %v", err),
+		}
 	}
 
 	var count int
@@ -120,7 +120,8 @@ func (s *ScansTableHandler) CreateScan(scan models.Scan) (models.Scan, error) {
 		}
 	}
 
-	marshaled, err := json.Marshal(scan)
+	// The scans don't keep the credentials of their scan config snapshot.
+	marshaled, err := json.Marshal(redactScanCredentials(scan))
 	if err != nil {
 		return models.Scan{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
 	}
@@ -173,7 +174,7 @@ func (s *ScansTableHandler) SaveScan(scan models.Scan) (models.Scan, error) {
 		}
 	}
 
-	marshaled, err := json.Marshal(scan)
+	marshaled, err := json.Marshal(redactScanCredentials(scan))
 	if err != nil {
 		return models.Scan{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
 	}
@@ -214,7 +215,7 @@ func (s *ScansTableHandler) UpdateScan(scan models.Scan) (models.Scan, error) {
 	}
 
 	var err error
-	dbScan.Data, err = patchObject(dbScan.Data, scan)
+	dbScan.Data, err = patchObject(dbScan.Data, redactScanCredentials(scan))
 	if err != nil {
 		return models.Scan{}, fmt.Errorf("failed to apply patch: %w", err)
 	}
@@ -254,7 +255,9 @@ func (s *ScansTableHandler) DeleteScan(scanID models.ScanID) error {
 func (s *ScansTableHandler) checkUniqueness(scan models.Scan) (models.Scan, error) {
 	var scans []Scan
 	// In the case of creating or updating a scan, needs to be checked whether other running scan exists with same scan config id.
-	filter := fmt.Sprintf("id ne '%s' and scanConfig/id eq '%s' and endTime eq null", *scan.Id, scan.ScanConfig.Id)
+	// An aborted scan is being torn down, it doesn't prevent a new scan of its scan config from running.
+	filter := fmt.Sprintf("id ne '%s' and scanConfig/id eq '%s' and endTime eq null and state ne '%s'",
+		*scan.Id, scan.ScanConfig.Id, models.ScanStateAborted)
 	err := ODataQuery(s.DB, scanSchemaName, &filter, nil, nil, nil, nil, nil, true, &scans)
 	if err != nil {
 		return models.Scan{}, err
@@ -19,20 +19,30 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/aptible/supercronic/cronexpr"
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
 	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	familiesMalware "github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	familiesMisconfiguration "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	familiesRootkits "github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
+	familiesSbom "github.com/openclarity/vmclarity/shared/pkg/families/sbom"
+	familiesSecrets "github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+	familiesVulnerabilities "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 type ScanConfig struct {
 	ODataObject
+	// Credentials are the parts of the scan config which hold its
+	// credentials, they're kept out of Data so that they can't be queried.
+	Credentials datatypes.JSON
 }
 
 type ScanConfigsTableHandler struct {
@@ -95,6 +105,25 @@ func (s *ScanConfigsTableHandler) GetScanConfig(scanConfigID models.ScanConfigID
 	return sc, nil
 }
 
+func (s *ScanConfigsTableHandler) GetScanConfigWithCredentials(scanConfigID models.ScanConfigID) (models.ScanConfig, error) {
+	var dbScanConfig ScanConfig
+	if err := getStoredObjByID(s.DB, scanConfigID, &dbScanConfig); err != nil {
+		return models.ScanConfig{}, err
+	}
+
+	data, err := mergeScanConfigCredentials(dbScanConfig.Data, dbScanConfig.Credentials)
+	if err != nil {
+		return models.ScanConfig{}, err
+	}
+
+	var sc models.ScanConfig
+	if err := json.Unmarshal(data, &sc); err != nil {
+		return models.ScanConfig{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return sc, nil
+}
+
 // nolint:cyclop
 func (s *ScanConfigsTableHandler) CreateScanConfig(scanConfig models.ScanConfig) (models.ScanConfig, error) {
 	// Check the user provided the name field
@@ -117,6 +146,12 @@ func (s *ScanConfigsTableHandler) CreateScanConfig(scanConfig models.ScanConfig)
 		}
 	}
 
+	if err := checkScanFamiliesConfig(scanConfig.ScanFamiliesConfig); err != nil {
+		return models.ScanConfig{}, err
+	}
+
+	clearNextRunTime(&scanConfig)
+
 	// Generate a new UUID
 	scanConfig.Id = utils.PointerTo(uuid.New().String())
 
@@ -142,14 +177,12 @@ func (s *ScanConfigsTableHandler) CreateScanConfig(scanConfig models.ScanConfig)
 		return models.ScanConfig{}, fmt.Errorf("failed to check existing scan config: %w", err)
 	}
 
-	marshaled, err := json.Marshal(scanConfig)
+	newScanConfig := ScanConfig{}
+	newScanConfig.Data, newScanConfig.Credentials, err = marshalScanConfig(scanConfig)
 	if err != nil {
-		return models.ScanConfig{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+		return models.ScanConfig{}, err
 	}
 
-	newScanConfig := ScanConfig{}
-	newScanConfig.Data = marshaled
-
 	if err := s.DB.Create(&newScanConfig).Error; err != nil {
 		return models.ScanConfig{}, fmt.Errorf("failed to create scan config in db: %w", err)
 	}
@@ -176,16 +209,23 @@ func validateRuntimeScheduleScanConfig(scheduled *models.RuntimeScheduleScanConf
 			"at least one should be set")
 	}
 
+	if scheduled.CronLine == nil && scheduled.Timezone != nil {
+		return fmt.Errorf("timezone can only be set together with cronLine")
+	}
+
 	if scheduled.CronLine != nil {
-		// validate cron expression
-		expr, err := cronexpr.Parse(*scheduled.CronLine)
+		// validate cron expression and time zone
+		cron, err := utils.ParseScheduleCron(*scheduled.CronLine, scheduled.Timezone)
 		if err != nil {
-			return fmt.Errorf("malformed cron expression: %v", err)
+			return err
 		}
 
 		// set operation time if missing
 		if isEmptyOperationTime(scheduled.OperationTime) {
-			operationTime := expr.Next(time.Now())
+			operationTime := cron.Next(time.Now())
+			if operationTime.IsZero() {
+				return fmt.Errorf("cron expression %q does not match any time in the future", *scheduled.CronLine)
+			}
 			scheduled.OperationTime = &operationTime
 		}
 	}
@@ -193,6 +233,116 @@ func validateRuntimeScheduleScanConfig(scheduled *models.RuntimeScheduleScanConf
 	return nil
 }
 
+// checkScanFamiliesConfig returns a bad request error when the scan families
+// config is invalid.
+func checkScanFamiliesConfig(scanFamiliesConfig *models.ScanFamiliesConfig) error {
+	if err := validateScanFamiliesConfig(scanFamiliesConfig); err != nil {
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("failed to validate scan families config: %v", err),
+		}
+	}
+	return nil
+}
+
+// nolint:cyclop
+func validateScanFamiliesConfig(scanFamiliesConfig *models.ScanFamiliesConfig) error {
+	if scanFamiliesConfig == nil {
+		return nil
+	}
+
+	if sbomConfig := scanFamiliesConfig.Sbom; sbomConfig != nil && sbomConfig.AnalyzersList != nil {
+		for _, analyzer := range *sbomConfig.AnalyzersList {
+			if !utils.Contains(familiesSbom.KnownAnalyzers, analyzer) {
+				return fmt.Errorf("unknown SBOM analyzer %q, supported analyzers are: %s",
+					analyzer, strings.Join(familiesSbom.KnownAnalyzers, ", "))
+			}
+		}
+	}
+
+	if sbomConfig := scanFamiliesConfig.Sbom; sbomConfig != nil && sbomConfig.PackageManagerHints != nil {
+		var distro string
+		if sbomConfig.PackageManagerHints.Distro != nil {
+			distro = *sbomConfig.PackageManagerHints.Distro
+		}
+		var packageManagers []string
+		if sbomConfig.PackageManagerHints.PackageManagers != nil {
+			packageManagers = *sbomConfig.PackageManagerHints.PackageManagers
+		}
+		if _, err := familiesSbom.ResolvePackageManagerHints(distro, packageManagers); err != nil {
+			return fmt.Errorf("invalid package manager hints: %v", err)
+		}
+	}
+
+	if vulnConfig := scanFamiliesConfig.Vulnerabilities; vulnConfig != nil && vulnConfig.ScannersList != nil {
+		for _, scanner := range *vulnConfig.ScannersList {
+			if !utils.Contains(familiesVulnerabilities.KnownScanners, scanner) {
+				return fmt.Errorf("unknown vulnerability scanner %q, supported scanners are: %s",
+					scanner, strings.Join(familiesVulnerabilities.KnownScanners, ", "))
+			}
+		}
+	}
+
+	if secretsConfig := scanFamiliesConfig.Secrets; secretsConfig != nil && secretsConfig.ScannersList != nil {
+		for _, scanner := range *secretsConfig.ScannersList {
+			if !utils.Contains(familiesSecrets.KnownScanners, scanner) {
+				return fmt.Errorf("unknown secrets scanner %q, supported scanners are: %s",
+					scanner, strings.Join(familiesSecrets.KnownScanners, ", "))
+			}
+		}
+	}
+
+	if malwareConfig := scanFamiliesConfig.Malware; malwareConfig != nil && malwareConfig.ScannersList != nil {
+		for _, scanner := range *malwareConfig.ScannersList {
+			if !utils.Contains(familiesMalware.KnownScanners, scanner) {
+				return fmt.Errorf("unknown malware scanner %q, supported scanners are: %s",
+					scanner, strings.Join(familiesMalware.KnownScanners, ", "))
+			}
+		}
+	}
+
+	if misconfigurationConfig := scanFamiliesConfig.Misconfigurations; misconfigurationConfig != nil && misconfigurationConfig.ScannersList != nil {
+		for _, scanner := range *misconfigurationConfig.ScannersList {
+			if !utils.Contains(familiesMisconfiguration.KnownScanners, scanner) {
+				return fmt.Errorf("unknown misconfiguration scanner %q, supported scanners are: %s",
+					scanner, strings.Join(familiesMisconfiguration.KnownScanners, ", "))
+			}
+		}
+	}
+
+	if rootkitsConfig := scanFamiliesConfig.Rootkits; rootkitsConfig != nil && rootkitsConfig.ScannersList != nil {
+		for _, scanner := range *rootkitsConfig.ScannersList {
+			if !utils.Contains(familiesRootkits.KnownScanners, scanner) {
+				return fmt.Errorf("unknown rootkit scanner %q, supported scanners are: %s",
+					scanner, strings.Join(familiesRootkits.KnownScanners, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+// clearNextRunTime drops the nextRunTime of the scan config before it is
+// stored, it is computed from the schedule when the scan config is read.
+// marshalScanConfig returns the data of the scan config without its
+// credentials and its credentials to store.
+func marshalScanConfig(scanConfig models.ScanConfig) ([]byte, []byte, error) {
+	redacted, credentials := splitScanConfigCredentials(scanConfig)
+	data, err := json.Marshal(redacted)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+	marshaledCredentials, err := json.Marshal(credentials)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert API model credentials to DB model: %w", err)
+	}
+
+	return data, marshaledCredentials, nil
+}
+
+func clearNextRunTime(scanConfig *models.ScanConfig) {
+	scanConfig.NextRunTime = nil
+}
+
 func isEmptyOperationTime(operationTime *time.Time) bool {
 	return operationTime == nil || (*operationTime).IsZero()
 }
@@ -218,6 +368,12 @@ func (s *ScanConfigsTableHandler) SaveScanConfig(scanConfig models.ScanConfig) (
 		}
 	}
 
+	if err := checkScanFamiliesConfig(scanConfig.ScanFamiliesConfig); err != nil {
+		return models.ScanConfig{}, err
+	}
+
+	clearNextRunTime(&scanConfig)
+
 	var dbScanConfig ScanConfig
 	if err := getExistingObjByID(s.DB, "ScanConfig", *scanConfig.Id, &dbScanConfig); err != nil {
 		return models.ScanConfig{}, fmt.Errorf("failed to get scan config from db: %w", err)
@@ -233,13 +389,11 @@ func (s *ScanConfigsTableHandler) SaveScanConfig(scanConfig models.ScanConfig) (
 		return models.ScanConfig{}, fmt.Errorf("failed to check existing scan config: %w", err)
 	}
 
-	marshaled, err := json.Marshal(scanConfig)
+	dbScanConfig.Data, dbScanConfig.Credentials, err = marshalScanConfig(scanConfig)
 	if err != nil {
-		return models.ScanConfig{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+		return models.ScanConfig{}, err
 	}
 
-	dbScanConfig.Data = marshaled
-
 	if err := s.DB.Save(&dbScanConfig).Error; err != nil {
 		return models.ScanConfig{}, fmt.Errorf("failed to save scan config in db: %w", err)
 	}
@@ -272,19 +426,31 @@ func (s *ScanConfigsTableHandler) UpdateScanConfig(scanConfig models.ScanConfig)
 		}
 	}
 
+	// ScanFamiliesConfig validation is a no-op when it is not part of the update.
+	if err := checkScanFamiliesConfig(scanConfig.ScanFamiliesConfig); err != nil {
+		return models.ScanConfig{}, err
+	}
+
+	clearNextRunTime(&scanConfig)
+
 	var dbScanConfig ScanConfig
-	if err := getExistingObjByID(s.DB, "ScanConfig", *scanConfig.Id, &dbScanConfig); err != nil {
+	if err := getStoredObjByID(s.DB, *scanConfig.Id, &dbScanConfig); err != nil {
 		return models.ScanConfig{}, fmt.Errorf("failed to get scan config from db: %w", err)
 	}
 
-	var err error
-	dbScanConfig.Data, err = patchObject(dbScanConfig.Data, scanConfig)
+	// The patch is applied to the scan config with its credentials, so
+	// that they're kept unless the patch replaces them.
+	data, err := mergeScanConfigCredentials(dbScanConfig.Data, dbScanConfig.Credentials)
+	if err != nil {
+		return models.ScanConfig{}, err
+	}
+	data, err = patchObject(data, scanConfig)
 	if err != nil {
 		return models.ScanConfig{}, fmt.Errorf("failed to apply patch: %w", err)
 	}
 
 	var sc models.ScanConfig
-	err = json.Unmarshal(dbScanConfig.Data, &sc)
+	err = json.Unmarshal(data, &sc)
 	if err != nil {
 		return models.ScanConfig{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
@@ -299,10 +465,16 @@ func (s *ScanConfigsTableHandler) UpdateScanConfig(scanConfig models.ScanConfig)
 		return models.ScanConfig{}, fmt.Errorf("failed to check existing scan config: %w", err)
 	}
 
+	dbScanConfig.Data, dbScanConfig.Credentials, err = marshalScanConfig(sc)
+	if err != nil {
+		return models.ScanConfig{}, err
+	}
+
 	if err := s.DB.Save(&dbScanConfig).Error; err != nil {
 		return models.ScanConfig{}, fmt.Errorf("failed to save scan config in db: %w", err)
 	}
 
+	sc, _ = splitScanConfigCredentials(sc)
 	return sc, nil
 }
 
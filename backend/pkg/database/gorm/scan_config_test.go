@@ -16,10 +16,13 @@
 package gorm
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -118,6 +121,36 @@ func Test_validateRuntimeScheduleScanConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "operation time is missing - operation time should be set in time zone",
+			args: args{
+				scheduled: &models.RuntimeScheduleScanConfig{
+					CronLine: utils.PointerTo("0 4 * * 1"),
+					Timezone: utils.PointerTo("Asia/Tokyo"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown time zone",
+			args: args{
+				scheduled: &models.RuntimeScheduleScanConfig{
+					CronLine: utils.PointerTo("0 4 * * 1"),
+					Timezone: utils.PointerTo("Not/AZone"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "time zone without cron line",
+			args: args{
+				scheduled: &models.RuntimeScheduleScanConfig{
+					OperationTime: utils.PointerTo(time.Now()),
+					Timezone:      utils.PointerTo("Asia/Tokyo"),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "cron line is missing - do nothing",
 			args: args{
@@ -140,3 +173,198 @@ func Test_validateRuntimeScheduleScanConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateScanFamiliesConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		scanFamiliesConfig *models.ScanFamiliesConfig
+		wantErr            bool
+	}{
+		{
+			name:               "nil scan families config",
+			scanFamiliesConfig: nil,
+			wantErr:            false,
+		},
+		{
+			name: "sbom analyzers list not set",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Sbom: &models.SBOMConfig{
+					Enabled: utils.PointerTo(true),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "known sbom analyzers",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Sbom: &models.SBOMConfig{
+					Enabled:       utils.PointerTo(true),
+					AnalyzersList: &[]string{"syft", "trivy", "gomod"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown sbom analyzer",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Sbom: &models.SBOMConfig{
+					Enabled:       utils.PointerTo(true),
+					AnalyzersList: &[]string{"syft", "not-an-analyzer"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid package manager hints",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Sbom: &models.SBOMConfig{
+					Enabled: utils.PointerTo(true),
+					PackageManagerHints: &models.PackageManagerHints{
+						Distro:          utils.PointerTo("gentoo"),
+						PackageManagers: &[]string{"rpm"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown package manager hint",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Sbom: &models.SBOMConfig{
+					Enabled: utils.PointerTo(true),
+					PackageManagerHints: &models.PackageManagerHints{
+						PackageManagers: &[]string{"not-a-package-manager"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "known vulnerability scanners",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Vulnerabilities: &models.VulnerabilitiesConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"grype"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown vulnerability scanner",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Vulnerabilities: &models.VulnerabilitiesConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"grype", "not-a-scanner"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "known secrets scanners",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Secrets: &models.SecretsConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"gitleaks", "trufflehog"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown secrets scanner",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Secrets: &models.SecretsConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"not-a-scanner"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "known malware scanners",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Malware: &models.MalwareConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"clam", "yara"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown malware scanner",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Malware: &models.MalwareConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"not-a-scanner"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "known misconfiguration scanners",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Misconfigurations: &models.MisconfigurationsConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"lynis", "kics"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown misconfiguration scanner",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Misconfigurations: &models.MisconfigurationsConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"fake"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "known rootkit scanners",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Rootkits: &models.RootkitsConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"chkrootkit", "rkhunter"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown rootkit scanner",
+			scanFamiliesConfig: &models.ScanFamiliesConfig{
+				Rootkits: &models.RootkitsConfig{
+					Enabled:      utils.PointerTo(true),
+					ScannersList: &[]string{"not-a-scanner"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateScanFamiliesConfig(tt.scanFamiliesConfig); (err != nil) != tt.wantErr {
+				t.Errorf("validateScanFamiliesConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_checkScanFamiliesConfig(t *testing.T) {
+	err := checkScanFamiliesConfig(&models.ScanFamiliesConfig{
+		Sbom: &models.SBOMConfig{
+			AnalyzersList: &[]string{"not-an-analyzer"},
+		},
+	})
+
+	var badRequestErr *common.BadRequestError
+	if !errors.As(err, &badRequestErr) {
+		t.Fatalf("checkScanFamiliesConfig() error = %v, want a bad request error", err)
+	}
+	if !strings.HasPrefix(badRequestErr.Reason, "failed to validate scan families config: ") {
+		t.Errorf("checkScanFamiliesConfig() reason = %q", badRequestErr.Reason)
+	}
+
+	if err := checkScanFamiliesConfig(nil); err != nil {
+		t.Errorf("checkScanFamiliesConfig() error = %v, want nil", err)
+	}
+}
@@ -39,21 +39,38 @@ type ScanResult struct {
 
 type ScanResultsTableHandler struct {
 	DB *gorm.DB
+
+	maxUnpaginatedResults int
 }
 
 func (db *Handler) ScanResultsTable() types.ScanResultsTable {
 	return &ScanResultsTableHandler{
-		DB: db.DB,
+		DB:                    db.DB,
+		maxUnpaginatedResults: db.MaxUnpaginatedScanResults,
 	}
 }
 
 func (s *ScanResultsTableHandler) GetScanResults(params models.GetScanResultsParams) (models.TargetScanResults, error) {
+	// As a safety net, limit the number of scan results returned when the
+	// client doesn't paginate. Get one more to know if there are more.
+	top := params.Top
+	unpaginated := params.Top == nil && s.maxUnpaginatedResults > 0
+	if unpaginated {
+		top = utils.PointerTo(s.maxUnpaginatedResults + 1)
+	}
+
 	var scanResults []ScanResult
-	err := ODataQuery(s.DB, targetScanResultsSchemaName, params.Filter, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &scanResults)
+	err := ODataQuery(s.DB, targetScanResultsSchemaName, params.Filter, params.Select, params.Expand, params.OrderBy, top, params.Skip, true, &scanResults)
 	if err != nil {
 		return models.TargetScanResults{}, err
 	}
 
+	var truncated bool
+	if unpaginated && len(scanResults) > s.maxUnpaginatedResults {
+		scanResults = scanResults[:s.maxUnpaginatedResults]
+		truncated = true
+	}
+
 	items := make([]models.TargetScanResult, len(scanResults))
 	for i, scanResult := range scanResults {
 		var tsr models.TargetScanResult
@@ -64,6 +81,14 @@ func (s *ScanResultsTableHandler) GetScanResults(params models.GetScanResultsPar
 	}
 
 	output := models.TargetScanResults{Items: &items}
+	if truncated {
+		nextCursor := s.maxUnpaginatedResults
+		if params.Skip != nil {
+			nextCursor += *params.Skip
+		}
+		output.Truncated = utils.PointerTo(true)
+		output.NextCursor = &nextCursor
+	}
 
 	if params.Count != nil && *params.Count {
 		count, err := ODataCount(s.DB, targetScanResultsSchemaName, params.Filter)
@@ -265,6 +290,36 @@ func (s *ScanResultsTableHandler) UpdateScanResult(scanResult models.TargetScanR
 	return tsr, nil
 }
 
+func (s *ScanResultsTableHandler) DeleteScanResult(scanResultID models.ScanResultID) error {
+	return s.DB.Transaction(func(tx *gorm.DB) error {
+		var dbScanResult ScanResult
+		if err := getExistingObjByID(tx, targetScanResultsSchemaName, scanResultID, &dbScanResult); err != nil {
+			return err
+		}
+
+		var scanResult models.TargetScanResult
+		if err := json.Unmarshal(dbScanResult.Data, &scanResult); err != nil {
+			return fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+
+		// The findings are reported with the scan and the target of the
+		// scan result, delete them first so none are left behind.
+		if scanResult.Scan != nil && scanResult.Target != nil {
+			jsonQuotedScanID := fmt.Sprintf("\"%s\"", scanResult.Scan.Id)
+			jsonQuotedTargetID := fmt.Sprintf("\"%s\"", scanResult.Target.Id)
+			if err := tx.Where("`Data` -> '$.scan.id' = ? AND `Data` -> '$.asset.id' = ?", jsonQuotedScanID, jsonQuotedTargetID).Delete(&Finding{}).Error; err != nil {
+				return fmt.Errorf("failed to delete findings of scan result: %w", err)
+			}
+		}
+
+		if err := deleteObjByID(tx, scanResultID, &ScanResult{}); err != nil {
+			return fmt.Errorf("failed to delete scan result: %w", err)
+		}
+
+		return nil
+	})
+}
+
 func (s *ScanResultsTableHandler) checkUniqueness(scanResult models.TargetScanResult) (models.TargetScanResult, error) {
 	var scanResults []ScanResult
 	// In the case of creating or updating a scan results, needs to be checked whether other scan results exists with same scan id and target id.
@@ -0,0 +1,84 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func TestScanResultsTableHandler_DeleteScanResult(t *testing.T) {
+	db := openTestDataBase(t)
+	if err := db.AutoMigrate(&Target{}, &Scan{}, &ScanResult{}, &Finding{}); err != nil {
+		t.Fatalf("failed to create the tables: %v", err)
+	}
+	handler := &Handler{DB: db}
+
+	createScanResult := func(scanID, targetID string) models.TargetScanResult {
+		scanResult, err := handler.ScanResultsTable().CreateScanResult(models.TargetScanResult{
+			Scan:   &models.ScanRelationship{Id: scanID},
+			Target: &models.TargetRelationship{Id: targetID},
+		})
+		if err != nil {
+			t.Fatalf("failed to create scan result: %v", err)
+		}
+		return scanResult
+	}
+	createFinding := func(scanID, targetID string) models.Finding {
+		finding, err := handler.FindingsTable().CreateFinding(models.Finding{
+			Scan:  &models.ScanRelationship{Id: scanID},
+			Asset: &models.TargetRelationship{Id: targetID},
+		})
+		if err != nil {
+			t.Fatalf("failed to create finding: %v", err)
+		}
+		return finding
+	}
+
+	deletedScanResult := createScanResult("scan-1", "target-1")
+	keptScanResult := createScanResult("scan-2", "target-1")
+	deletedFinding := createFinding("scan-1", "target-1")
+	keptFindings := []models.Finding{
+		createFinding("scan-2", "target-1"),
+		createFinding("scan-1", "target-2"),
+	}
+
+	if err := handler.ScanResultsTable().DeleteScanResult(*deletedScanResult.Id); err != nil {
+		t.Fatalf("DeleteScanResult() error = %v", err)
+	}
+
+	if _, err := handler.ScanResultsTable().GetScanResult(*deletedScanResult.Id, models.GetScanResultsScanResultIDParams{}); !errors.Is(err, types.ErrNotFound) {
+		t.Errorf("GetScanResult() of the deleted scan result error = %v, want %v", err, types.ErrNotFound)
+	}
+	if _, err := handler.ScanResultsTable().GetScanResult(*keptScanResult.Id, models.GetScanResultsScanResultIDParams{}); err != nil {
+		t.Errorf("GetScanResult() of the kept scan result error = %v", err)
+	}
+	if _, err := handler.FindingsTable().GetFinding(*deletedFinding.Id, models.GetFindingsFindingIDParams{}); !errors.Is(err, types.ErrNotFound) {
+		t.Errorf("GetFinding() of the finding of the deleted scan result error = %v, want %v", err, types.ErrNotFound)
+	}
+	for _, finding := range keptFindings {
+		if _, err := handler.FindingsTable().GetFinding(*finding.Id, models.GetFindingsFindingIDParams{}); err != nil {
+			t.Errorf("GetFinding() of a kept finding error = %v", err)
+		}
+	}
+
+	if err := handler.ScanResultsTable().DeleteScanResult(*deletedScanResult.Id); !errors.Is(err, types.ErrNotFound) {
+		t.Errorf("DeleteScanResult() of a deleted scan result error = %v, want %v", err, types.ErrNotFound)
+	}
+}
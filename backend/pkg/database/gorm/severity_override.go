@@ -0,0 +1,117 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+const (
+	severityOverridesSchemaName = "SeverityOverrides"
+
+	// The severity overrides are a single object, so it is always stored
+	// in the same row.
+	severityOverridesRowID = 1
+)
+
+type SeverityOverrides struct {
+	ODataObject
+}
+
+type SeverityOverridesTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) SeverityOverridesTable() types.SeverityOverridesTable {
+	return &SeverityOverridesTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (s *SeverityOverridesTableHandler) GetSeverityOverrides() (models.SeverityOverrides, error) {
+	var dbSeverityOverrides SeverityOverrides
+	err := ODataQuery(s.DB, severityOverridesSchemaName, nil, nil, nil, nil, nil, nil, false, &dbSeverityOverrides)
+	if err != nil {
+		// No severity overrides were set yet.
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.SeverityOverrides{Overrides: &[]models.SeverityOverride{}}, nil
+		}
+		return models.SeverityOverrides{}, err
+	}
+
+	var severityOverrides models.SeverityOverrides
+	err = json.Unmarshal(dbSeverityOverrides.Data, &severityOverrides)
+	if err != nil {
+		return models.SeverityOverrides{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return severityOverrides, nil
+}
+
+func (s *SeverityOverridesTableHandler) SetSeverityOverrides(severityOverrides models.SeverityOverrides) (models.SeverityOverrides, error) {
+	if err := validateSeverityOverrides(severityOverrides); err != nil {
+		return models.SeverityOverrides{}, &common.BadRequestError{
+			Reason: err.Error(),
+		}
+	}
+
+	marshaled, err := json.Marshal(severityOverrides)
+	if err != nil {
+		return models.SeverityOverrides{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	var dbSeverityOverrides SeverityOverrides
+	dbSeverityOverrides.ID = severityOverridesRowID
+	dbSeverityOverrides.Data = marshaled
+
+	if err = s.DB.Save(&dbSeverityOverrides).Error; err != nil {
+		return models.SeverityOverrides{}, fmt.Errorf("failed to save severity overrides in db: %w", err)
+	}
+
+	var apiSeverityOverrides models.SeverityOverrides
+	if err = json.Unmarshal(dbSeverityOverrides.Data, &apiSeverityOverrides); err != nil {
+		return models.SeverityOverrides{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return apiSeverityOverrides, nil
+}
+
+func validateSeverityOverrides(severityOverrides models.SeverityOverrides) error {
+	if severityOverrides.Overrides == nil {
+		return nil
+	}
+
+	vulnerabilityNames := make(map[string]struct{}, len(*severityOverrides.Overrides))
+	for _, override := range *severityOverrides.Overrides {
+		if override.VulnerabilityName == "" {
+			return errors.New("vulnerabilityName must not be empty")
+		}
+		if _, ok := vulnerabilityNames[override.VulnerabilityName]; ok {
+			return fmt.Errorf("duplicate severity override for vulnerability %s", override.VulnerabilityName)
+		}
+		vulnerabilityNames[override.VulnerabilityName] = struct{}{}
+	}
+
+	return nil
+}
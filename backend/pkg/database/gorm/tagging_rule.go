@@ -0,0 +1,141 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	taggingRulesSchemaName = "TaggingRules"
+
+	// The tagging rules are a single object, so they are always stored in
+	// the same row.
+	taggingRulesRowID = 1
+)
+
+// findingTypes are the finding types a tagging rule can match on.
+var findingTypes = []string{
+	"Package",
+	"Vulnerability",
+	"Malware",
+	"Secret",
+	"Misconfiguration",
+	"Rootkit",
+	"Exploit",
+}
+
+type TaggingRules struct {
+	ODataObject
+}
+
+type TaggingRulesTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) TaggingRulesTable() types.TaggingRulesTable {
+	return &TaggingRulesTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (t *TaggingRulesTableHandler) GetTaggingRules() (models.TaggingRules, error) {
+	var dbTaggingRules TaggingRules
+	err := ODataQuery(t.DB, taggingRulesSchemaName, nil, nil, nil, nil, nil, nil, false, &dbTaggingRules)
+	if err != nil {
+		// No tagging rules were set yet.
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.TaggingRules{Rules: &[]models.TaggingRule{}}, nil
+		}
+		return models.TaggingRules{}, err
+	}
+
+	var taggingRules models.TaggingRules
+	err = json.Unmarshal(dbTaggingRules.Data, &taggingRules)
+	if err != nil {
+		return models.TaggingRules{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return taggingRules, nil
+}
+
+func (t *TaggingRulesTableHandler) SetTaggingRules(taggingRules models.TaggingRules) (models.TaggingRules, error) {
+	if err := validateTaggingRules(taggingRules); err != nil {
+		return models.TaggingRules{}, &common.BadRequestError{
+			Reason: err.Error(),
+		}
+	}
+
+	marshaled, err := json.Marshal(taggingRules)
+	if err != nil {
+		return models.TaggingRules{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	var dbTaggingRules TaggingRules
+	dbTaggingRules.ID = taggingRulesRowID
+	dbTaggingRules.Data = marshaled
+
+	if err = t.DB.Save(&dbTaggingRules).Error; err != nil {
+		return models.TaggingRules{}, fmt.Errorf("failed to save tagging rules in db: %w", err)
+	}
+
+	var apiTaggingRules models.TaggingRules
+	if err = json.Unmarshal(dbTaggingRules.Data, &apiTaggingRules); err != nil {
+		return models.TaggingRules{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return apiTaggingRules, nil
+}
+
+func validateTaggingRules(taggingRules models.TaggingRules) error {
+	if taggingRules.Rules == nil {
+		return nil
+	}
+
+	names := make(map[string]struct{}, len(*taggingRules.Rules))
+	for _, rule := range *taggingRules.Rules {
+		if rule.Name == "" {
+			return errors.New("name must not be empty")
+		}
+		if _, ok := names[rule.Name]; ok {
+			return fmt.Errorf("duplicate tagging rule %s", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		if rule.Tag == "" {
+			return fmt.Errorf("tag of tagging rule %s must not be empty", rule.Name)
+		}
+
+		if rule.Conditions.FindingTypes != nil {
+			for _, findingType := range *rule.Conditions.FindingTypes {
+				if !utils.Contains(findingTypes, findingType) {
+					return fmt.Errorf("unknown finding type %s in tagging rule %s, must be one of %v", findingType, rule.Name, findingTypes)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql"
 	"github.com/openclarity/vmclarity/backend/pkg/database/types"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
@@ -77,6 +78,43 @@ func (t *TargetsTableHandler) GetTargets(params models.GetTargetsParams) (models
 	return output, nil
 }
 
+// unscannedTargetsJoin keeps the targets which no scan result refers to.
+var unscannedTargetsJoin = &odatasql.AntiJoin{
+	Table: "scan_results",
+	Alias: "target_scan_results",
+	On:    "target_scan_results.Data -> '$.target.id' = targets.Data -> '$.id'",
+}
+
+func (t *TargetsTableHandler) GetUnscannedTargets(params models.GetTargetsUnscannedParams) (models.Targets, error) {
+	var targets []Target
+	err := ODataQueryWithAntiJoin(t.DB, targetSchemaName, unscannedTargetsJoin, params.Filter, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &targets)
+	if err != nil {
+		return models.Targets{}, err
+	}
+
+	items := make([]models.Target, len(targets))
+	for i, tr := range targets {
+		var target models.Target
+		err = json.Unmarshal(tr.Data, &target)
+		if err != nil {
+			return models.Targets{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items[i] = target
+	}
+
+	output := models.Targets{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCountWithAntiJoin(t.DB, targetSchemaName, unscannedTargetsJoin, params.Filter)
+		if err != nil {
+			return models.Targets{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
 func (t *TargetsTableHandler) GetTarget(targetID models.TargetID, params models.GetTargetsTargetIDParams) (models.Target, error) {
 	var dbTarget Target
 	filter := fmt.Sprintf("id eq '%s'", targetID)
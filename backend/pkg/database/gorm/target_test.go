@@ -0,0 +1,106 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestTargetsTableHandler_GetUnscannedTargets(t *testing.T) {
+	db := openTestDataBase(t)
+	if err := db.AutoMigrate(&Target{}, &ScanResult{}); err != nil {
+		t.Fatalf("failed to create the tables: %v", err)
+	}
+
+	for _, targetID := range []string{"scanned", "unscanned-1", "unscanned-2"} {
+		target := Target{}
+		target.Data = []byte(fmt.Sprintf(`{"id":%q}`, targetID))
+		if err := db.Create(&target).Error; err != nil {
+			t.Fatalf("failed to create target: %v", err)
+		}
+	}
+	// A target scanned twice must not be listed, nor counted twice.
+	for _, scanID := range []string{"scan-1", "scan-2"} {
+		scanResult := ScanResult{}
+		scanResult.Data = []byte(fmt.Sprintf(`{"id":%q,"scan":{"id":%q},"target":{"id":"scanned"}}`, scanID+"-result", scanID))
+		if err := db.Create(&scanResult).Error; err != nil {
+			t.Fatalf("failed to create scan result: %v", err)
+		}
+	}
+	handler := &Handler{DB: db}
+
+	tests := []struct {
+		name      string
+		params    models.GetTargetsUnscannedParams
+		wantIDs   []string
+		wantCount *int
+	}{
+		{
+			name:    "all unscanned targets",
+			params:  models.GetTargetsUnscannedParams{},
+			wantIDs: []string{"unscanned-1", "unscanned-2"},
+		},
+		{
+			name: "filtered unscanned targets",
+			params: models.GetTargetsUnscannedParams{
+				Filter: utils.PointerTo("id eq 'unscanned-2'"),
+			},
+			wantIDs: []string{"unscanned-2"},
+		},
+		{
+			name: "filter matching a scanned target",
+			params: models.GetTargetsUnscannedParams{
+				Filter: utils.PointerTo("id eq 'scanned'"),
+			},
+			wantIDs: []string{},
+		},
+		{
+			name: "counted and paginated unscanned targets",
+			params: models.GetTargetsUnscannedParams{
+				Count:   utils.PointerTo(true),
+				Top:     utils.PointerTo(1),
+				OrderBy: utils.PointerTo("id desc"),
+			},
+			wantIDs:   []string{"unscanned-2"},
+			wantCount: utils.PointerTo(2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := handler.TargetsTable().GetUnscannedTargets(tt.params)
+			if err != nil {
+				t.Fatalf("GetUnscannedTargets() error = %v", err)
+			}
+
+			gotIDs := []string{}
+			for _, target := range *got.Items {
+				gotIDs = append(gotIDs, *target.Id)
+			}
+			if diff := cmp.Diff(tt.wantIDs, gotIDs); diff != "" {
+				t.Errorf("GetUnscannedTargets() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantCount, got.Count); diff != "" {
+				t.Errorf("GetUnscannedTargets() count mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
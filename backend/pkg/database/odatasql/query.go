@@ -27,8 +27,53 @@ import (
 
 var fixSelectToken sync.Once
 
-// nolint:cyclop
+// AntiJoin restricts a query to the objects which have no matching row in
+// another table, by LEFT JOINing the table and keeping the objects which
+// weren't joined to any row.
+type AntiJoin struct {
+	// Table is the joined table.
+	Table string
+	// Alias is the name the joined table is referred to by in the join
+	// condition, so that it doesn't clash with the tables of the
+	// subqueries selecting the expanded relationships.
+	Alias string
+	// On is the join condition, such as "alias.Data -> '$.target.id' =
+	// targets.Data -> '$.id'".
+	On string
+}
+
+// from returns the FROM clause of a query of the table with the join.
+func (j *AntiJoin) from(table string) string {
+	if j == nil {
+		return table
+	}
+	return fmt.Sprintf("%s LEFT JOIN %s AS %s ON %s", table, j.Table, j.Alias, j.On)
+}
+
+// where returns the WHERE clause of a query with the $filter conditions and
+// the join.
+func (j *AntiJoin) where(filterConditions string) string {
+	var conditions []string
+	if filterConditions != "" {
+		conditions = append(conditions, fmt.Sprintf("(%s)", filterConditions))
+	}
+	if j != nil {
+		conditions = append(conditions, fmt.Sprintf("%s.ID IS NULL", j.Alias))
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("WHERE %s", strings.Join(conditions, " AND "))
+}
+
 func BuildCountQuery(schemaMetas map[string]SchemaMeta, schema string, filterString *string) (string, error) {
+	return BuildCountQueryWithAntiJoin(schemaMetas, schema, nil, filterString)
+}
+
+// BuildCountQueryWithAntiJoin builds the query counting the objects matching
+// the filter which have no matching row in the table of the join.
+// nolint:cyclop
+func BuildCountQueryWithAntiJoin(schemaMetas map[string]SchemaMeta, schema string, antiJoin *AntiJoin, filterString *string) (string, error) {
 	table := schemaMetas[schema].Table
 	if table == "" {
 		return "", fmt.Errorf("trying to query complex type schema %s with no source table", schema)
@@ -40,7 +85,7 @@ func BuildCountQuery(schemaMetas map[string]SchemaMeta, schema string, filterStr
 	rootObject := FieldMeta{FieldType: ComplexFieldType, ComplexFieldSchemas: []string{schema}}
 
 	// Parse top level $filter and create the top level "WHERE"
-	var where string
+	var filterConditions string
 	if filterString != nil && *filterString != "" {
 		filterQuery, err := godata.ParseFilterString(context.TODO(), *filterString)
 		if err != nil {
@@ -53,14 +98,20 @@ func BuildCountQuery(schemaMetas map[string]SchemaMeta, schema string, filterStr
 			return "", fmt.Errorf("failed to build DB query from $filter: %w", err)
 		}
 
-		where = fmt.Sprintf("WHERE %s", conditions)
+		filterConditions = conditions
 	}
 
-	return fmt.Sprintf("SELECT COUNT(*) FROM %s %s", table, where), nil
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s %s", antiJoin.from(table), antiJoin.where(filterConditions)), nil
 }
 
-// nolint:cyclop,gocognit
 func BuildSQLQuery(schemaMetas map[string]SchemaMeta, schema string, filterString, selectString, expandString, orderbyString *string, top, skip *int) (string, error) {
+	return BuildSQLQueryWithAntiJoin(schemaMetas, schema, nil, filterString, selectString, expandString, orderbyString, top, skip)
+}
+
+// BuildSQLQueryWithAntiJoin builds the query of the objects matching the
+// filter which have no matching row in the table of the join.
+// nolint:cyclop,gocognit
+func BuildSQLQueryWithAntiJoin(schemaMetas map[string]SchemaMeta, schema string, antiJoin *AntiJoin, filterString, selectString, expandString, orderbyString *string, top, skip *int) (string, error) {
 	// Fix GlobalExpandTokenizer so that it allows for `-` characters in the Literal tokens
 	fixSelectToken.Do(func() {
 		godata.GlobalExpandTokenizer.Add("^[a-zA-Z0-9_\\'\\.:\\$ \\*-]+", godata.ExpandTokenLiteral)
@@ -77,7 +128,7 @@ func BuildSQLQuery(schemaMetas map[string]SchemaMeta, schema string, filterStrin
 	rootObject := FieldMeta{FieldType: ComplexFieldType, ComplexFieldSchemas: []string{schema}}
 
 	// Parse top level $filter and create the top level "WHERE"
-	var where string
+	var filterConditions string
 	if filterString != nil && *filterString != "" {
 		filterQuery, err := godata.ParseFilterString(context.TODO(), *filterString)
 		if err != nil {
@@ -90,7 +141,7 @@ func BuildSQLQuery(schemaMetas map[string]SchemaMeta, schema string, filterStrin
 			return "", fmt.Errorf("failed to build DB query from $filter: %w", err)
 		}
 
-		where = fmt.Sprintf("WHERE %s", conditions)
+		filterConditions = conditions
 	}
 
 	var orderby string
@@ -127,7 +178,7 @@ func BuildSQLQuery(schemaMetas map[string]SchemaMeta, schema string, filterStrin
 		}
 	}
 
-	return fmt.Sprintf("SELECT ID, %s AS Data FROM %s %s %s %s", selectFields, table, where, orderby, limitStm), nil
+	return fmt.Sprintf("SELECT %s.ID, %s AS Data FROM %s %s %s %s", table, selectFields, antiJoin.from(table), antiJoin.where(filterConditions), orderby, limitStm), nil
 }
 
 func buildSelectFieldsFromSelectAndExpand(schemaMetas map[string]SchemaMeta, rootObject FieldMeta, identifier string, source string, selectString, expandString *string) (string, error) {
@@ -611,6 +662,10 @@ func buildOrderByFromOdata(schemaMetas map[string]SchemaMeta, field FieldMeta, i
 			return "", fmt.Errorf("failed to convert odata path to json path: %w", err)
 		}
 
+		if err := validateOrderByPath(schemaMetas, field, queryPath); err != nil {
+			return "", fmt.Errorf("invalid $orderby field %s: %w", strings.ReplaceAll(queryPath, ".", "/"), err)
+		}
+
 		fieldSource, err := sourceFromQueryPath(schemaMetas, field, identifier, source, queryPath)
 		if err != nil {
 			return "", fmt.Errorf("unable to build source for filter %w", err)
@@ -621,3 +676,56 @@ func buildOrderByFromOdata(schemaMetas map[string]SchemaMeta, field FieldMeta, i
 
 	return strings.Join(conditions, ", "), nil
 }
+
+// validateOrderByPath checks that the JSON path (e.g. "Engine.Options.Name")
+// resolves through the schema to a primitive field which can be sorted on.
+// nolint:cyclop
+func validateOrderByPath(schemaMetas map[string]SchemaMeta, field FieldMeta, path string) error {
+	switch field.FieldType {
+	case PrimitiveFieldType:
+		if path != "" {
+			return fmt.Errorf("can not navigate into primitive field to reach %s", path)
+		}
+		return nil
+	case CollectionFieldType:
+		return fmt.Errorf("can not order by collection field")
+	case RelationshipFieldType:
+		if path == "" {
+			return fmt.Errorf("can not order by relationship field")
+		}
+		schema, ok := schemaMetas[field.RelationshipSchema]
+		if !ok {
+			return fmt.Errorf("unknown schema %s", field.RelationshipSchema)
+		}
+		fieldName, pathRemainder, _ := strings.Cut(path, ".")
+		newField, ok := schema.Fields[fieldName]
+		if !ok {
+			return fmt.Errorf("unknown field %s", fieldName)
+		}
+		return validateOrderByPath(schemaMetas, newField, pathRemainder)
+	case ComplexFieldType:
+		if path == "" {
+			return fmt.Errorf("can not order by complex field")
+		}
+		fieldName, pathRemainder, _ := strings.Cut(path, ".")
+		var lastErr error
+		for _, schemaName := range field.ComplexFieldSchemas {
+			newField, ok := schemaMetas[schemaName].Fields[fieldName]
+			if !ok {
+				continue
+			}
+			// The field may exist in more than one of the possible
+			// schemas (e.g. discriminated unions), it's valid as
+			// long as one of them can be sorted on.
+			if lastErr = validateOrderByPath(schemaMetas, newField, pathRemainder); lastErr == nil {
+				return nil
+			}
+		}
+		if lastErr != nil {
+			return lastErr
+		}
+		return fmt.Errorf("unknown field %s", fieldName)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.FieldType)
+	}
+}
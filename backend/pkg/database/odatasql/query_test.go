@@ -509,6 +509,7 @@ func TestBuildSQLQuery(t *testing.T) {
 		orderbyString *string
 		top           *int
 		skip          *int
+		antiJoin      *AntiJoin
 	}
 	tests := []struct {
 		name    string
@@ -1118,10 +1119,67 @@ func TestBuildSQLQuery(t *testing.T) {
 				car2,
 			},
 		},
+		{
+			name: "orderby unknown field",
+			args: args{
+				orderbyString: PointerTo("Colour desc"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "orderby unknown sub-object field",
+			args: args{
+				orderbyString: PointerTo("Engine/Options/Turbo asc"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "orderby complex field",
+			args: args{
+				orderbyString: PointerTo("Engine/Options"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "orderby known and unknown fields",
+			args: args{
+				orderbyString: PointerTo("ModelName desc, Colour asc"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "anti join",
+			args: args{
+				antiJoin: &AntiJoin{
+					Table: "car_rows",
+					Alias: "newer_models",
+					On:    "newer_models.Data -> '$.ModelName' > car_rows.Data -> '$.ModelName'",
+				},
+			},
+			want: []Car{
+				car4,
+			},
+		},
+		{
+			name: "anti join with filter",
+			args: args{
+				filterString: PointerTo("Seats eq 2"),
+				antiJoin: &AntiJoin{
+					Table: "car_rows",
+					Alias: "newer_models",
+					On: "newer_models.Data -> '$.Manufacturer.Id' = car_rows.Data -> '$.Manufacturer.Id' AND " +
+						"newer_models.Data -> '$.ModelName' > car_rows.Data -> '$.ModelName'",
+				},
+			},
+			want: []Car{
+				car3,
+				car4,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query, err := BuildSQLQuery(carSchemaMetas, "Car", tt.args.filterString, tt.args.selectString, tt.args.expandString, tt.args.orderbyString, tt.args.top, tt.args.skip)
+			query, err := BuildSQLQueryWithAntiJoin(carSchemaMetas, "Car", tt.args.antiJoin, tt.args.filterString, tt.args.selectString, tt.args.expandString, tt.args.orderbyString, tt.args.top, tt.args.skip)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("BuildSQLQuery() error = %v, wantErr %v", err, tt.wantErr)
 				return
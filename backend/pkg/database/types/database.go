@@ -16,7 +16,9 @@
 package types
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/openclarity/vmclarity/api/models"
 )
@@ -27,6 +29,10 @@ const (
 
 var ErrNotFound = errors.New("not found")
 
+// ErrIdempotencyKeyReused is returned when an idempotency key is used again by
+// a request with another body.
+var ErrIdempotencyKeyReused = errors.New("idempotency key was already used by a request with another body")
+
 type DBConfig struct {
 	EnableInfoLogs bool   `json:"enable-info-logs"`
 	DriverType     string `json:"driver-type,omitempty"`
@@ -37,6 +43,30 @@ type DBConfig struct {
 	DBName         string `json:"db-name,omitempty"`
 
 	LocalDBPath string `json:"local-db-path,omitempty"`
+
+	// The limits of the connection pool of the database, the database/sql
+	// defaults are kept for the limits which are not positive. For a
+	// database server such as Postgres each open connection is a server
+	// connection, so MaxOpenConns must be below the server's limit. The
+	// local driver is a SQLite file which serializes the writes no matter
+	// how many connections are open, so the pool mostly helps concurrent
+	// reads there.
+	MaxOpenConns    int           `json:"max-open-conns"`
+	MaxIdleConns    int           `json:"max-idle-conns"`
+	ConnMaxLifetime time.Duration `json:"conn-max-lifetime"`
+
+	// Don't apply the migrations when the database is initialized, they
+	// are applied separately. The database must be at the latest schema
+	// version.
+	DisableMigrations bool `json:"disable-migrations"`
+
+	// The maximum number of scan results returned when $top is not set,
+	// unlimited if not positive.
+	MaxUnpaginatedScanResults int `json:"max-unpaginated-scan-results"`
+
+	// How long the idempotency keys of the create requests are kept, they
+	// are never expired if not positive.
+	IdempotencyKeyWindow time.Duration `json:"idempotency-key-window"`
 }
 
 type Database interface {
@@ -46,6 +76,12 @@ type Database interface {
 	TargetsTable() TargetsTable
 	ScopesTable() ScopesTable
 	FindingsTable() FindingsTable
+	SeverityOverridesTable() SeverityOverridesTable
+	IgnoreRulesTable() IgnoreRulesTable
+	TaggingRulesTable() TaggingRulesTable
+	IdempotencyKeysTable() IdempotencyKeysTable
+	// Ping checks that the database is reachable.
+	Ping(ctx context.Context) error
 }
 
 type ScansTable interface {
@@ -67,12 +103,18 @@ type ScanResultsTable interface {
 	UpdateScanResult(scanResults models.TargetScanResult) (models.TargetScanResult, error)
 	SaveScanResult(scanResults models.TargetScanResult) (models.TargetScanResult, error)
 
-	// DeleteScanResult(scanResultID models.ScanResultID) error
+	// DeleteScanResult deletes the scan result together with the findings
+	// its scan found on its target, so that no findings are left behind
+	// without the scan result which reported them.
+	DeleteScanResult(scanResultID models.ScanResultID) error
 }
 
 type ScanConfigsTable interface {
 	GetScanConfigs(params models.GetScanConfigsParams) (models.ScanConfigs, error)
 	GetScanConfig(scanConfigID models.ScanConfigID, params models.GetScanConfigsScanConfigIDParams) (models.ScanConfig, error)
+	// GetScanConfigWithCredentials returns the scan config with its
+	// credentials, which the other operations don't return.
+	GetScanConfigWithCredentials(scanConfigID models.ScanConfigID) (models.ScanConfig, error)
 
 	CreateScanConfig(scanConfig models.ScanConfig) (models.ScanConfig, error)
 	UpdateScanConfig(scanConfig models.ScanConfig) (models.ScanConfig, error)
@@ -84,6 +126,8 @@ type ScanConfigsTable interface {
 type TargetsTable interface {
 	GetTargets(params models.GetTargetsParams) (models.Targets, error)
 	GetTarget(targetID models.TargetID, params models.GetTargetsTargetIDParams) (models.Target, error)
+	// GetUnscannedTargets returns the targets which have no scan results.
+	GetUnscannedTargets(params models.GetTargetsUnscannedParams) (models.Targets, error)
 
 	CreateTarget(target models.Target) (models.Target, error)
 	UpdateTarget(target models.Target) (models.Target, error)
@@ -97,6 +141,41 @@ type ScopesTable interface {
 	SetScopes(scopes models.Scopes) (models.Scopes, error)
 }
 
+type SeverityOverridesTable interface {
+	GetSeverityOverrides() (models.SeverityOverrides, error)
+	SetSeverityOverrides(severityOverrides models.SeverityOverrides) (models.SeverityOverrides, error)
+}
+
+type IgnoreRulesTable interface {
+	GetIgnoreRules() (models.IgnoreRules, error)
+	SetIgnoreRules(ignoreRules models.IgnoreRules) (models.IgnoreRules, error)
+}
+
+type TaggingRulesTable interface {
+	GetTaggingRules() (models.TaggingRules, error)
+	SetTaggingRules(taggingRules models.TaggingRules) (models.TaggingRules, error)
+}
+
+// IdempotencyKeysTable records the resources created by the requests with an
+// idempotency key, so that a retried request returns the resource which was
+// already created.
+type IdempotencyKeysTable interface {
+	// GetIdempotencyKey returns the ID of the resource of the type created
+	// with the key, or ErrNotFound if the key wasn't used within the window.
+	GetIdempotencyKey(resourceType, key string) (string, error)
+	// CreateWithIdempotencyKey reserves the key of the resource type with
+	// the hash of the request body and calls create with the database of
+	// the same transaction, create returns the ID of the resource it
+	// created. If the key was already used within the window, create isn't
+	// called and the ID of the resource created with the key is returned
+	// with created false, or ErrIdempotencyKeyReused if the key was used
+	// with another request hash.
+	CreateWithIdempotencyKey(resourceType, key, requestHash string, create func(db Database) (string, error)) (resourceID string, created bool, err error)
+	// DeleteIdempotencyKey releases the key if it's recorded for the
+	// resource.
+	DeleteIdempotencyKey(resourceType, key, resourceID string) error
+}
+
 type FindingsTable interface {
 	GetFindings(params models.GetFindingsParams) (models.Findings, error)
 	GetFinding(findingID models.FindingID, params models.GetFindingsFindingIDParams) (models.Finding, error)
@@ -0,0 +1,141 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// CheckFunc checks whether a component is ready, it returns the reason when
+// it isn't.
+type CheckFunc func(ctx context.Context) error
+
+// ComponentStatus is the readiness of a single component.
+type ComponentStatus struct {
+	Name  string `json:"name"`
+	Ready bool   `json:"ready"`
+	Error string `json:"error,omitempty"`
+}
+
+// Status is the readiness of all the components, which is ready only when all
+// of them are ready.
+type Status struct {
+	Ready      bool              `json:"ready"`
+	Components []ComponentStatus `json:"components"`
+}
+
+type check struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker runs the readiness checks of the components and serves their status
+// as JSON.
+type Checker struct {
+	// timeout limits each check, a check which doesn't return in time
+	// isn't ready.
+	timeout time.Duration
+	checks  []check
+}
+
+func New(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+	}
+}
+
+// Add adds the readiness check of a component. Checks must be added before
+// the checker is used.
+func (c *Checker) Add(name string, checkFunc CheckFunc) {
+	c.checks = append(c.checks, check{
+		name:  name,
+		check: checkFunc,
+	})
+}
+
+// Check runs all the checks concurrently and returns the status of the
+// components in the order their checks were added.
+func (c *Checker) Check(ctx context.Context) Status {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	components := make([]ComponentStatus, len(c.checks))
+	var wg sync.WaitGroup
+	for i := range c.checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			components[i] = runCheck(ctx, c.checks[i])
+		}(i)
+	}
+	wg.Wait()
+
+	status := Status{
+		Ready:      true,
+		Components: components,
+	}
+	for _, component := range components {
+		if !component.Ready {
+			status.Ready = false
+		}
+	}
+
+	return status
+}
+
+func runCheck(ctx context.Context, check check) ComponentStatus {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- check.check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	status := ComponentStatus{
+		Name:  check.name,
+		Ready: err == nil,
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+// ServeHTTP writes the status of the components as JSON, with 503 status code
+// if any of them isn't ready.
+func (c *Checker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status := c.Check(r.Context())
+
+	w.Header().Set("Content-Type", "application/json")
+	if !status.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		log.Errorf("Failed to write readiness status: %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestChecker_ServeHTTP(t *testing.T) {
+	ready := func(context.Context) error {
+		return nil
+	}
+	failing := func(context.Context) error {
+		return errors.New("connection refused")
+	}
+	stuck := func(context.Context) error {
+		<-make(chan struct{})
+		return nil
+	}
+
+	tests := []struct {
+		name           string
+		checks         map[string]CheckFunc
+		order          []string
+		wantStatusCode int
+		want           Status
+	}{
+		{
+			name:           "no checks",
+			wantStatusCode: http.StatusOK,
+			want: Status{
+				Ready:      true,
+				Components: []ComponentStatus{},
+			},
+		},
+		{
+			name: "all ready",
+			checks: map[string]CheckFunc{
+				"database": ready,
+				"provider": ready,
+			},
+			order:          []string{"database", "provider"},
+			wantStatusCode: http.StatusOK,
+			want: Status{
+				Ready: true,
+				Components: []ComponentStatus{
+					{Name: "database", Ready: true},
+					{Name: "provider", Ready: true},
+				},
+			},
+		},
+		{
+			name: "one failing",
+			checks: map[string]CheckFunc{
+				"database": ready,
+				"provider": failing,
+			},
+			order:          []string{"database", "provider"},
+			wantStatusCode: http.StatusServiceUnavailable,
+			want: Status{
+				Ready: false,
+				Components: []ComponentStatus{
+					{Name: "database", Ready: true},
+					{Name: "provider", Ready: false, Error: "connection refused"},
+				},
+			},
+		},
+		{
+			name: "one timed out",
+			checks: map[string]CheckFunc{
+				"orchestrator": stuck,
+				"database":     ready,
+			},
+			order:          []string{"orchestrator", "database"},
+			wantStatusCode: http.StatusServiceUnavailable,
+			want: Status{
+				Ready: false,
+				Components: []ComponentStatus{
+					{Name: "orchestrator", Ready: false, Error: context.DeadlineExceeded.Error()},
+					{Name: "database", Ready: true},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := New(50 * time.Millisecond)
+			for _, name := range tt.order {
+				checker.Add(name, tt.checks[name])
+			}
+
+			rec := httptest.NewRecorder()
+			checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz/ready/details", nil))
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("ServeHTTP() status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+			var got Status
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("failed to unmarshal the status: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ServeHTTP() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
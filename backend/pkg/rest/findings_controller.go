@@ -31,6 +31,10 @@ import (
 func (s *ServerImpl) GetFindings(ctx echo.Context, params models.GetFindingsParams) error {
 	findings, err := s.dbHandler.FindingsTable().GetFindings(params)
 	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get findings from db: %v", err))
 	}
 	return sendResponse(ctx, http.StatusOK, findings)
@@ -54,6 +58,10 @@ func (s *ServerImpl) PostFindings(ctx echo.Context) error {
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
 	}
 
+	if err = s.applyFindingTags(&finding, nil); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply tagging rules: %v", err))
+	}
+
 	createdFinding, err := s.dbHandler.FindingsTable().CreateFinding(finding)
 	if err != nil {
 		var conflictErr *common.ConflictError
@@ -103,6 +111,19 @@ func (s *ServerImpl) PatchFindingsFindingID(ctx echo.Context, findingID models.F
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("id in body %s does not match object %s to be updated", *finding.Id, findingID))
 	}
 	finding.Id = &findingID
+
+	existingFinding, err := s.dbHandler.FindingsTable().GetFinding(findingID, models.GetFindingsFindingIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Finding with ID %v not found", findingID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get finding from db. findingID=%v: %v", findingID, err))
+	}
+
+	if err = s.applyFindingTags(&finding, &existingFinding); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply tagging rules: %v", err))
+	}
+
 	updatedFinding, err := s.dbHandler.FindingsTable().UpdateFinding(finding)
 	if err != nil {
 		var validationErr *common.BadRequestError
@@ -133,6 +154,10 @@ func (s *ServerImpl) PutFindingsFindingID(ctx echo.Context, findingID models.Fin
 	}
 	finding.Id = &findingID
 
+	if err = s.applyFindingTags(&finding, nil); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply tagging rules: %v", err))
+	}
+
 	updatedFinding, err := s.dbHandler.FindingsTable().SaveFinding(finding)
 	if err != nil {
 		var validationErr *common.BadRequestError
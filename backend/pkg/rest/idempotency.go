@@ -0,0 +1,89 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+// The types of the resources which can be created with an idempotency key.
+const (
+	idempotencyKeyResourceScan       = "Scan"
+	idempotencyKeyResourceScanConfig = "ScanConfig"
+)
+
+// createWithIdempotencyKey creates the resource of the type requested by the
+// request body with create, and returns whether it was created. If the key is
+// set, it's reserved with the hash of the request body in the same transaction
+// as the resource is created, so that the concurrent requests with the key
+// create a single resource, and the requests which find the key already used
+// by the same request body load the resource created with it with get
+// instead. A key used by another request body is rejected with
+// ErrIdempotencyKeyReused. A key of a resource which was deleted since is
+// released, and the resource is created again.
+func (s *ServerImpl) createWithIdempotencyKey(resourceType string, key *models.IdempotencyKey, request interface{}, create func(db databaseTypes.Database) (string, error), get func(resourceID string) error) (bool, error) {
+	if key == nil || *key == "" {
+		_, err := create(s.dbHandler)
+		return err == nil, err
+	}
+
+	requestHash, err := hashRequest(request)
+	if err != nil {
+		return false, err
+	}
+
+	idempotencyKeysTable := s.dbHandler.IdempotencyKeysTable()
+	for attempt := 0; ; attempt++ {
+		resourceID, created, err := idempotencyKeysTable.CreateWithIdempotencyKey(resourceType, *key, requestHash, create)
+		if err != nil || created {
+			return created, err
+		}
+
+		err = get(resourceID)
+		switch {
+		case err == nil:
+			return false, nil
+		case errors.Is(err, databaseTypes.ErrNotFound) && attempt == 0:
+			// The resource was deleted since, so release the key and
+			// create it again.
+			if err := idempotencyKeysTable.DeleteIdempotencyKey(resourceType, *key, resourceID); err != nil {
+				return false, fmt.Errorf("failed to delete idempotency key from db: %w", err)
+			}
+		default:
+			return false, fmt.Errorf("failed to get %s %s from db: %w", resourceType, resourceID, err)
+		}
+	}
+}
+
+// hashRequest returns the hash of the request body which is stored with the
+// idempotency key. The body is hashed once bound, so that the formatting of
+// the JSON doesn't matter.
+func hashRequest(request interface{}) (string, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+	hash := sha256.Sum256(body)
+
+	return hex.EncodeToString(hash[:]), nil
+}
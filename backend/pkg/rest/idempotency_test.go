@@ -0,0 +1,77 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func TestServerImpl_PostScanConfigsIdempotencyKey(t *testing.T) {
+	dbHandler, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "test.db"),
+	})
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	server := &ServerImpl{dbHandler: dbHandler}
+
+	postScanConfig := func(body string) (int, models.ScanConfig) {
+		req := httptest.NewRequest(http.MethodPost, "/scanConfigs", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+
+		key := models.IdempotencyKey("key")
+		if err := server.PostScanConfigs(ctx, models.PostScanConfigsParams{IdempotencyKey: &key}); err != nil {
+			t.Fatalf("PostScanConfigs() error = %v", err)
+		}
+		var scanConfig models.ScanConfig
+		_ = json.Unmarshal(rec.Body.Bytes(), &scanConfig)
+		return rec.Code, scanConfig
+	}
+
+	body := `{"name":"scan-config","scheduled":{"operationTime":"2023-01-01T00:00:00Z"}}`
+	code, created := postScanConfig(body)
+	if code != http.StatusCreated {
+		t.Fatalf("PostScanConfigs() status = %v, want %v", code, http.StatusCreated)
+	}
+
+	// The same request formatted differently is a replay of the first one.
+	code, replayed := postScanConfig(`{ "scheduled": {"operationTime": "2023-01-01T00:00:00Z"}, "name": "scan-config" }`)
+	if code != http.StatusOK {
+		t.Errorf("PostScanConfigs() replay status = %v, want %v", code, http.StatusOK)
+	}
+	if replayed.Id == nil || *replayed.Id != *created.Id {
+		t.Errorf("PostScanConfigs() replay = %v, want scan config %v", replayed.Id, *created.Id)
+	}
+
+	code, _ = postScanConfig(`{"name":"other-scan-config","scheduled":{"operationTime":"2023-01-01T00:00:00Z"}}`)
+	if code != http.StatusUnprocessableEntity {
+		t.Errorf("PostScanConfigs() with another body status = %v, want %v", code, http.StatusUnprocessableEntity)
+	}
+}
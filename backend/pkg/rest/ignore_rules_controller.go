@@ -0,0 +1,55 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+)
+
+func (s *ServerImpl) GetIgnoreRules(ctx echo.Context) error {
+	ignoreRules, err := s.dbHandler.IgnoreRulesTable().GetIgnoreRules()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get ignore rules from db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, ignoreRules)
+}
+
+func (s *ServerImpl) PutIgnoreRules(ctx echo.Context) error {
+	var ignoreRules models.IgnoreRules
+	err := ctx.Bind(&ignoreRules)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	updatedIgnoreRules, err := s.dbHandler.IgnoreRulesTable().SetIgnoreRules(ignoreRules)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set ignore rules in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedIgnoreRules)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func (s *ServerImpl) GetProviderInstances(ctx echo.Context, params models.GetProviderInstancesParams) error {
+	if s.scanOrchestrator == nil {
+		return sendError(ctx, http.StatusServiceUnavailable, "provider instances are not available when the orchestrator is disabled")
+	}
+	top, skip := utils.ValueOrZero(params.Top), utils.ValueOrZero(params.Skip)
+	if top < 0 || skip < 0 {
+		return sendError(ctx, http.StatusBadRequest, "$top and $skip must not be negative")
+	}
+
+	instances, err := s.scanOrchestrator.ListProviderInstances(ctx.Request().Context(), utils.ValueOrZero(params.Location))
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to list provider instances: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, pageProviderInstances(instances, params.Top, skip))
+}
+
+// pageProviderInstances orders the instances by location and instance ID so
+// that the pages are stable across requests, and returns the page of the
+// instances. All the instances after skip are returned if top isn't set.
+func pageProviderInstances(instances []models.ProviderInstance, top *int, skip int) models.ProviderInstances {
+	sort.SliceStable(instances, func(i, j int) bool {
+		li, lj := utils.ValueOrZero(instances[i].Location), utils.ValueOrZero(instances[j].Location)
+		if li != lj {
+			return li < lj
+		}
+		return utils.ValueOrZero(instances[i].InstanceID) < utils.ValueOrZero(instances[j].InstanceID)
+	})
+
+	count := len(instances)
+	start := skip
+	if start > count {
+		start = count
+	}
+	end := count
+	if top != nil && start+*top < end {
+		end = start + *top
+	}
+	items := make([]models.ProviderInstance, 0, end-start)
+	items = append(items, instances[start:end]...)
+
+	return models.ProviderInstances{
+		Count: &count,
+		Items: &items,
+	}
+}
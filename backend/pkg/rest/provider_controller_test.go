@@ -0,0 +1,106 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_pageProviderInstances(t *testing.T) {
+	newInstance := func(location, id string) models.ProviderInstance {
+		return models.ProviderInstance{
+			InstanceID: utils.PointerTo(id),
+			Location:   utils.PointerTo(location),
+		}
+	}
+	newInstances := func() []models.ProviderInstance {
+		return []models.ProviderInstance{
+			newInstance("us-east-2", "i-1"),
+			newInstance("us-east-1", "i-3"),
+			newInstance("us-east-1", "i-2"),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		instances []models.ProviderInstance
+		top       *int
+		skip      int
+		wantItems []models.ProviderInstance
+	}{
+		{
+			name:      "all instances ordered by location and id",
+			instances: newInstances(),
+			wantItems: []models.ProviderInstance{
+				newInstance("us-east-1", "i-2"),
+				newInstance("us-east-1", "i-3"),
+				newInstance("us-east-2", "i-1"),
+			},
+		},
+		{
+			name:      "first page",
+			instances: newInstances(),
+			top:       utils.PointerTo(2),
+			wantItems: []models.ProviderInstance{
+				newInstance("us-east-1", "i-2"),
+				newInstance("us-east-1", "i-3"),
+			},
+		},
+		{
+			name:      "last partial page",
+			instances: newInstances(),
+			top:       utils.PointerTo(2),
+			skip:      2,
+			wantItems: []models.ProviderInstance{
+				newInstance("us-east-2", "i-1"),
+			},
+		},
+		{
+			name:      "skip past the end",
+			instances: newInstances(),
+			top:       utils.PointerTo(2),
+			skip:      5,
+			wantItems: []models.ProviderInstance{},
+		},
+		{
+			name:      "zero top",
+			instances: newInstances(),
+			top:       utils.PointerTo(0),
+			wantItems: []models.ProviderInstance{},
+		},
+		{
+			name:      "no instances",
+			instances: nil,
+			wantItems: []models.ProviderInstance{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pageProviderInstances(tt.instances, tt.top, tt.skip)
+			if diff := cmp.Diff(len(tt.instances), utils.ValueOrZero(got.Count)); diff != "" {
+				t.Errorf("pageProviderInstances() count mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantItems, utils.ValueOrZero(got.Items)); diff != "" {
+				t.Errorf("pageProviderInstances() items mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
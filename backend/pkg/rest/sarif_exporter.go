@@ -0,0 +1,309 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+const (
+	sarifVersion     = "2.1.0"
+	sarifSchema      = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifContentType = "application/sarif+json"
+	sarifToolName    = "VMClarity"
+
+	sarifLevelError   = "error"
+	sarifLevelWarning = "warning"
+	sarifLevelNote    = "note"
+
+	secretRuleID = "secret"
+)
+
+// The subset of the SARIF 2.1.0 object model which the findings of a scan
+// result are exported with.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string                 `json:"id"`
+	ShortDescription *sarifMessage          `json:"shortDescription,omitempty"`
+	FullDescription  *sarifMessage          `json:"fullDescription,omitempty"`
+	Help             *sarifMessage          `json:"help,omitempty"`
+	HelpURI          string                 `json:"helpUri,omitempty"`
+	Properties       map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// sarifExporter converts the vulnerability, secret and misconfiguration
+// findings of a scan result into a SARIF log with a single run, with a rule
+// per vulnerability, misconfiguration test and one for the secrets.
+type sarifExporter struct {
+	rules   []sarifRule
+	ruleIDs map[string]struct{}
+	results []sarifResult
+}
+
+func exportScanResultToSarif(scanResult *models.TargetScanResult) *sarifLog {
+	e := &sarifExporter{
+		rules:   []sarifRule{},
+		ruleIDs: map[string]struct{}{},
+		results: []sarifResult{},
+	}
+
+	if scanResult.Vulnerabilities != nil {
+		for _, vulnerability := range utils.ValueOrZero(scanResult.Vulnerabilities.Vulnerabilities) {
+			e.addVulnerability(vulnerability)
+		}
+	}
+	if scanResult.Secrets != nil {
+		for _, secret := range utils.ValueOrZero(scanResult.Secrets.Secrets) {
+			e.addSecret(secret)
+		}
+	}
+	if scanResult.Misconfigurations != nil {
+		for _, misconfiguration := range utils.ValueOrZero(scanResult.Misconfigurations.Misconfigurations) {
+			e.addMisconfiguration(misconfiguration)
+		}
+	}
+
+	return &sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:  sarifToolName,
+						Rules: e.rules,
+					},
+				},
+				Results: e.results,
+			},
+		},
+	}
+}
+
+// addRule adds the rule unless a rule with its ID was already added.
+func (e *sarifExporter) addRule(rule sarifRule) {
+	if _, ok := e.ruleIDs[rule.ID]; ok {
+		return
+	}
+	e.ruleIDs[rule.ID] = struct{}{}
+	e.rules = append(e.rules, rule)
+}
+
+func (e *sarifExporter) addVulnerability(vulnerability models.Vulnerability) {
+	name := utils.ValueOrZero(vulnerability.VulnerabilityName)
+	if name == "" {
+		return
+	}
+
+	rule := sarifRule{
+		ID:               name,
+		ShortDescription: &sarifMessage{Text: name},
+		FullDescription:  newSarifMessage(utils.ValueOrZero(vulnerability.Description)),
+	}
+	if links := utils.ValueOrZero(vulnerability.Links); len(links) > 0 {
+		rule.HelpURI = links[0]
+	}
+	severity := utils.ValueOrZero(vulnerability.Severity)
+	if severity != "" {
+		rule.Properties = map[string]interface{}{"severity": severity}
+	}
+	e.addRule(rule)
+
+	message := name
+	if vulnerability.Package != nil {
+		message = fmt.Sprintf("%s in package %s %s", name, utils.ValueOrZero(vulnerability.Package.Name), utils.ValueOrZero(vulnerability.Package.Version))
+	}
+	if vulnerability.Fix != nil {
+		if versions := utils.ValueOrZero(vulnerability.Fix.Versions); len(versions) > 0 {
+			message = fmt.Sprintf("%s, fixed in %s", message, strings.Join(versions, ", "))
+		}
+	}
+
+	e.results = append(e.results, sarifResult{
+		RuleID:    name,
+		Level:     vulnerabilitySeverityToSarifLevel(severity),
+		Message:   sarifMessage{Text: message},
+		Locations: newSarifLocations(utils.ValueOrZero(vulnerability.Path), nil),
+	})
+}
+
+func (e *sarifExporter) addSecret(secret models.Secret) {
+	e.addRule(sarifRule{
+		ID:               secretRuleID,
+		ShortDescription: &sarifMessage{Text: "Secret found"},
+	})
+
+	message := utils.ValueOrZero(secret.Description)
+	if message == "" {
+		message = "Secret found"
+	}
+	result := sarifResult{
+		RuleID:  secretRuleID,
+		Level:   sarifLevelError,
+		Message: sarifMessage{Text: message},
+		Locations: newSarifLocations(utils.ValueOrZero(secret.FilePath), &sarifRegion{
+			StartLine:   utils.ValueOrZero(secret.StartLine),
+			StartColumn: utils.ValueOrZero(secret.StartColumn),
+			EndLine:     utils.ValueOrZero(secret.EndLine),
+			EndColumn:   utils.ValueOrZero(secret.EndColumn),
+		}),
+	}
+	if fingerprint := utils.ValueOrZero(secret.Fingerprint); fingerprint != "" {
+		result.PartialFingerprints = map[string]string{"secretFingerprint": fingerprint}
+	}
+	e.results = append(e.results, result)
+}
+
+func (e *sarifExporter) addMisconfiguration(misconfiguration models.Misconfiguration) {
+	testID := utils.ValueOrZero(misconfiguration.TestID)
+	if testID == "" {
+		return
+	}
+
+	rule := sarifRule{
+		ID:               testID,
+		ShortDescription: newSarifMessage(utils.ValueOrZero(misconfiguration.TestDescription)),
+		Help:             newSarifMessage(utils.ValueOrZero(misconfiguration.Remediation)),
+	}
+	properties := map[string]interface{}{}
+	if category := utils.ValueOrZero(misconfiguration.TestCategory); category != "" {
+		properties["category"] = category
+	}
+	if scannerName := utils.ValueOrZero(misconfiguration.ScannerName); scannerName != "" {
+		properties["scanner"] = scannerName
+	}
+	if len(properties) > 0 {
+		rule.Properties = properties
+	}
+	e.addRule(rule)
+
+	message := utils.ValueOrZero(misconfiguration.Message)
+	if message == "" {
+		message = testID
+	}
+	e.results = append(e.results, sarifResult{
+		RuleID:    testID,
+		Level:     misconfigurationSeverityToSarifLevel(utils.ValueOrZero(misconfiguration.Severity)),
+		Message:   sarifMessage{Text: message},
+		Locations: newSarifLocations(utils.ValueOrZero(misconfiguration.ScannedPath), nil),
+	})
+}
+
+func newSarifMessage(text string) *sarifMessage {
+	if text == "" {
+		return nil
+	}
+	return &sarifMessage{Text: text}
+}
+
+func newSarifLocations(path string, region *sarifRegion) []sarifLocation {
+	if path == "" {
+		return nil
+	}
+	if region != nil && region.StartLine == 0 {
+		// A region must start at a line.
+		region = nil
+	}
+	return []sarifLocation{
+		{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: path},
+				Region:           region,
+			},
+		},
+	}
+}
+
+func vulnerabilitySeverityToSarifLevel(severity models.VulnerabilitySeverity) string {
+	switch severity {
+	case models.CRITICAL, models.HIGH:
+		return sarifLevelError
+	case models.MEDIUM:
+		return sarifLevelWarning
+	case models.LOW, models.NEGLIGIBLE:
+		return sarifLevelNote
+	default:
+		return sarifLevelWarning
+	}
+}
+
+func misconfigurationSeverityToSarifLevel(severity models.MisconfigurationSeverity) string {
+	switch severity {
+	case models.MisconfigurationHighSeverity:
+		return sarifLevelError
+	case models.MisconfigurationMediumSeverity:
+		return sarifLevelWarning
+	case models.MisconfigurationLowSeverity:
+		return sarifLevelNote
+	default:
+		return sarifLevelWarning
+	}
+}
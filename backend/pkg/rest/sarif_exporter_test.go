@@ -0,0 +1,174 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_exportScanResultToSarif(t *testing.T) {
+	tests := []struct {
+		name       string
+		scanResult *models.TargetScanResult
+		wantRules  []sarifRule
+		want       []sarifResult
+	}{
+		{
+			name:       "no findings",
+			scanResult: &models.TargetScanResult{},
+			wantRules:  []sarifRule{},
+			want:       []sarifResult{},
+		},
+		{
+			name: "vulnerabilities, secrets and misconfigurations",
+			scanResult: &models.TargetScanResult{
+				Vulnerabilities: &models.VulnerabilityScan{
+					Vulnerabilities: &[]models.Vulnerability{
+						{
+							VulnerabilityName: utils.StringPtr("CVE-2023-1"),
+							Description:       utils.StringPtr("buffer overflow"),
+							Links:             &[]string{"https://nvd.nist.gov/vuln/detail/CVE-2023-1"},
+							Severity:          utils.PointerTo(models.CRITICAL),
+							Package: &models.Package{
+								Name:    utils.StringPtr("openssl"),
+								Version: utils.StringPtr("1.1.1"),
+							},
+							Fix: &models.VulnerabilityFix{
+								Versions: &[]string{"1.1.2"},
+							},
+							Path: utils.StringPtr("/usr/lib/libssl.so"),
+						},
+						{
+							VulnerabilityName: utils.StringPtr("CVE-2023-1"),
+							Severity:          utils.PointerTo(models.CRITICAL),
+						},
+						{
+							Description: utils.StringPtr("vulnerability without a name"),
+						},
+					},
+				},
+				Secrets: &models.SecretScan{
+					Secrets: &[]models.Secret{
+						{
+							Description: utils.StringPtr("AWS access key"),
+							FilePath:    utils.StringPtr("/home/user/.aws/credentials"),
+							StartLine:   utils.PointerTo(2),
+							EndLine:     utils.PointerTo(2),
+							Fingerprint: utils.StringPtr("credentials:aws-access-key:2"),
+						},
+					},
+				},
+				Misconfigurations: &models.MisconfigurationScan{
+					Misconfigurations: &[]models.Misconfiguration{
+						{
+							TestID:          utils.StringPtr("SSH-7408"),
+							TestDescription: utils.StringPtr("SSH configuration"),
+							TestCategory:    utils.StringPtr("ssh"),
+							ScannerName:     utils.StringPtr("lynis"),
+							Message:         utils.StringPtr("PermitRootLogin is enabled"),
+							Remediation:     utils.StringPtr("Disable PermitRootLogin"),
+							Severity:        utils.PointerTo(models.MisconfigurationLowSeverity),
+							ScannedPath:     utils.StringPtr("/etc/ssh/sshd_config"),
+						},
+					},
+				},
+			},
+			wantRules: []sarifRule{
+				{
+					ID:               "CVE-2023-1",
+					ShortDescription: &sarifMessage{Text: "CVE-2023-1"},
+					FullDescription:  &sarifMessage{Text: "buffer overflow"},
+					HelpURI:          "https://nvd.nist.gov/vuln/detail/CVE-2023-1",
+					Properties:       map[string]interface{}{"severity": models.CRITICAL},
+				},
+				{
+					ID:               secretRuleID,
+					ShortDescription: &sarifMessage{Text: "Secret found"},
+				},
+				{
+					ID:               "SSH-7408",
+					ShortDescription: &sarifMessage{Text: "SSH configuration"},
+					Help:             &sarifMessage{Text: "Disable PermitRootLogin"},
+					Properties:       map[string]interface{}{"category": "ssh", "scanner": "lynis"},
+				},
+			},
+			want: []sarifResult{
+				{
+					RuleID:  "CVE-2023-1",
+					Level:   sarifLevelError,
+					Message: sarifMessage{Text: "CVE-2023-1 in package openssl 1.1.1, fixed in 1.1.2"},
+					Locations: []sarifLocation{
+						{
+							PhysicalLocation: sarifPhysicalLocation{
+								ArtifactLocation: sarifArtifactLocation{URI: "/usr/lib/libssl.so"},
+							},
+						},
+					},
+				},
+				{
+					RuleID:  "CVE-2023-1",
+					Level:   sarifLevelError,
+					Message: sarifMessage{Text: "CVE-2023-1"},
+				},
+				{
+					RuleID:  secretRuleID,
+					Level:   sarifLevelError,
+					Message: sarifMessage{Text: "AWS access key"},
+					Locations: []sarifLocation{
+						{
+							PhysicalLocation: sarifPhysicalLocation{
+								ArtifactLocation: sarifArtifactLocation{URI: "/home/user/.aws/credentials"},
+								Region:           &sarifRegion{StartLine: 2, EndLine: 2},
+							},
+						},
+					},
+					PartialFingerprints: map[string]string{"secretFingerprint": "credentials:aws-access-key:2"},
+				},
+				{
+					RuleID:  "SSH-7408",
+					Level:   sarifLevelNote,
+					Message: sarifMessage{Text: "PermitRootLogin is enabled"},
+					Locations: []sarifLocation{
+						{
+							PhysicalLocation: sarifPhysicalLocation{
+								ArtifactLocation: sarifArtifactLocation{URI: "/etc/ssh/sshd_config"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := exportScanResultToSarif(tt.scanResult)
+			if got.Version != sarifVersion || len(got.Runs) != 1 {
+				t.Fatalf("exportScanResultToSarif() version = %v, runs = %v, want a single run of version %v", got.Version, len(got.Runs), sarifVersion)
+			}
+			if diff := cmp.Diff(tt.wantRules, got.Runs[0].Tool.Driver.Rules); diff != "" {
+				t.Errorf("exportScanResultToSarif() rules mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want, got.Runs[0].Results); diff != "" {
+				t.Errorf("exportScanResultToSarif() results mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+const (
+	cycloneDXContentType = "application/vnd.cyclonedx+json"
+	spdxContentType      = "application/spdx+json"
+
+	sbomToolVendor = "OpenClarity"
+	sbomToolName   = "VMClarity"
+
+	// syftLanguageProperty is the CycloneDX component property with the
+	// language of the package, which the SBOM family reads it from.
+	syftLanguageProperty = "syft:package:language"
+
+	spdxVersion       = "SPDX-2.3"
+	spdxDataLicense   = "CC0-1.0"
+	spdxDocumentID    = "SPDXRef-DOCUMENT"
+	spdxNoAssertion   = "NOASSERTION"
+	spdxNamespaceBase = "https://openclarity.io/vmclarity/spdx"
+)
+
+// exportScanResultToCycloneDX assembles a CycloneDX BOM from the packages
+// found by the SBOM family of the scan result.
+func exportScanResultToCycloneDX(scanResultID string, packages []models.Package) *cdx.BOM {
+	bom := cdx.NewBOM()
+	bom.SerialNumber = fmt.Sprintf("urn:uuid:%s", uuid.NewString())
+	bom.Metadata = &cdx.Metadata{
+		Tools: &[]cdx.Tool{
+			{
+				Vendor: sbomToolVendor,
+				Name:   sbomToolName,
+			},
+		},
+		Properties: &[]cdx.Property{
+			{
+				Name:  "vmclarity:scanResultID",
+				Value: scanResultID,
+			},
+		},
+	}
+
+	components := make([]cdx.Component, 0, len(packages))
+	for i, pkg := range packages {
+		components = append(components, packageToCycloneDXComponent(i, pkg))
+	}
+	bom.Components = &components
+
+	return bom
+}
+
+func packageToCycloneDXComponent(index int, pkg models.Package) cdx.Component {
+	component := cdx.Component{
+		BOMRef:     fmt.Sprintf("package-%d", index),
+		Type:       cdx.ComponentTypeLibrary,
+		Name:       utils.ValueOrZero(pkg.Name),
+		Version:    utils.ValueOrZero(pkg.Version),
+		PackageURL: utils.ValueOrZero(pkg.Purl),
+	}
+	if pkgType := utils.ValueOrZero(pkg.Type); pkgType != "" {
+		component.Type = cdx.ComponentType(pkgType)
+	}
+	if cpes := nonEmptyStrings(utils.ValueOrZero(pkg.Cpes)); len(cpes) > 0 {
+		component.CPE = cpes[0]
+	}
+	if licenses := nonEmptyStrings(utils.ValueOrZero(pkg.Licenses)); len(licenses) > 0 {
+		choices := make(cdx.Licenses, 0, len(licenses))
+		for _, license := range licenses {
+			choices = append(choices, cdx.LicenseChoice{License: &cdx.License{Name: license}})
+		}
+		component.Licenses = &choices
+	}
+	if language := utils.ValueOrZero(pkg.Language); language != "" {
+		component.Properties = &[]cdx.Property{
+			{
+				Name:  syftLanguageProperty,
+				Value: language,
+			},
+		}
+	}
+
+	return component
+}
+
+// The subset of the SPDX 2.3 JSON document model which the packages of a
+// scan result are exported with.
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// exportScanResultToSPDX converts the packages found by the SBOM family of
+// the scan result into an SPDX document which describes all of them.
+func exportScanResultToSPDX(scanResultID string, packages []models.Package, created time.Time) *spdxDocument {
+	doc := &spdxDocument{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       spdxDataLicense,
+		SPDXID:            spdxDocumentID,
+		Name:              fmt.Sprintf("vmclarity-scan-result-%s", scanResultID),
+		DocumentNamespace: fmt.Sprintf("%s/%s-%s", spdxNamespaceBase, scanResultID, uuid.NewString()),
+		CreationInfo: spdxCreationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{fmt.Sprintf("Organization: %s", sbomToolVendor), fmt.Sprintf("Tool: %s", sbomToolName)},
+		},
+		Packages:      make([]spdxPackage, 0, len(packages)),
+		Relationships: make([]spdxRelationship, 0, len(packages)),
+	}
+
+	for i, pkg := range packages {
+		spdxPkg := packageToSPDXPackage(i, pkg)
+		doc.Packages = append(doc.Packages, spdxPkg)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      spdxDocumentID,
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: spdxPkg.SPDXID,
+		})
+	}
+
+	return doc
+}
+
+func packageToSPDXPackage(index int, pkg models.Package) spdxPackage {
+	spdxPkg := spdxPackage{
+		SPDXID:           fmt.Sprintf("SPDXRef-Package-%d", index),
+		Name:             utils.ValueOrZero(pkg.Name),
+		VersionInfo:      utils.ValueOrZero(pkg.Version),
+		DownloadLocation: spdxNoAssertion,
+		LicenseConcluded: spdxNoAssertion,
+		LicenseDeclared:  spdxNoAssertion,
+	}
+	if licenses := nonEmptyStrings(utils.ValueOrZero(pkg.Licenses)); len(licenses) > 0 {
+		spdxPkg.LicenseDeclared = strings.Join(licenses, " AND ")
+	}
+	if purl := utils.ValueOrZero(pkg.Purl); purl != "" {
+		spdxPkg.ExternalRefs = append(spdxPkg.ExternalRefs, spdxExternalRef{
+			ReferenceCategory: "PACKAGE-MANAGER",
+			ReferenceType:     "purl",
+			ReferenceLocator:  purl,
+		})
+	}
+	for _, cpe := range nonEmptyStrings(utils.ValueOrZero(pkg.Cpes)) {
+		referenceType := "cpe22Type"
+		if strings.HasPrefix(cpe, "cpe:2.3:") {
+			referenceType = "cpe23Type"
+		}
+		spdxPkg.ExternalRefs = append(spdxPkg.ExternalRefs, spdxExternalRef{
+			ReferenceCategory: "SECURITY",
+			ReferenceType:     referenceType,
+			ReferenceLocator:  cpe,
+		})
+	}
+
+	return spdxPkg
+}
+
+func nonEmptyStrings(values []string) []string {
+	ret := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			ret = append(ret, value)
+		}
+	}
+	return ret
+}
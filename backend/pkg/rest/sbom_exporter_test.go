@@ -0,0 +1,130 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+var testSbomPackages = []models.Package{
+	{
+		Name:     utils.StringPtr("openssl"),
+		Version:  utils.StringPtr("1.1.1"),
+		Purl:     utils.StringPtr("pkg:deb/ubuntu/openssl@1.1.1"),
+		Cpes:     &[]string{"cpe:2.3:a:openssl:openssl:1.1.1:*:*:*:*:*:*:*", ""},
+		Licenses: &[]string{"Apache-2.0"},
+		Type:     utils.StringPtr("library"),
+	},
+	{
+		Name:     utils.StringPtr("requests"),
+		Version:  utils.StringPtr("2.28.0"),
+		Language: utils.StringPtr("python"),
+		Licenses: &[]string{"Apache-2.0", "MIT"},
+		Cpes:     &[]string{"cpe:/a:python:requests:2.28.0"},
+	},
+}
+
+func Test_exportScanResultToCycloneDX(t *testing.T) {
+	want := []cdx.Component{
+		{
+			BOMRef:     "package-0",
+			Type:       cdx.ComponentTypeLibrary,
+			Name:       "openssl",
+			Version:    "1.1.1",
+			PackageURL: "pkg:deb/ubuntu/openssl@1.1.1",
+			CPE:        "cpe:2.3:a:openssl:openssl:1.1.1:*:*:*:*:*:*:*",
+			Licenses: &cdx.Licenses{
+				{License: &cdx.License{Name: "Apache-2.0"}},
+			},
+		},
+		{
+			BOMRef:  "package-1",
+			Type:    cdx.ComponentTypeLibrary,
+			Name:    "requests",
+			Version: "2.28.0",
+			CPE:     "cpe:/a:python:requests:2.28.0",
+			Licenses: &cdx.Licenses{
+				{License: &cdx.License{Name: "Apache-2.0"}},
+				{License: &cdx.License{Name: "MIT"}},
+			},
+			Properties: &[]cdx.Property{
+				{Name: syftLanguageProperty, Value: "python"},
+			},
+		},
+	}
+
+	bom := exportScanResultToCycloneDX("scan-result-1", testSbomPackages)
+	if bom.SpecVersion != cdx.SpecVersion1_4 {
+		t.Errorf("exportScanResultToCycloneDX() spec version = %v, want %v", bom.SpecVersion, cdx.SpecVersion1_4)
+	}
+	if bom.Components == nil {
+		t.Fatalf("exportScanResultToCycloneDX() components are not set")
+	}
+	if diff := cmp.Diff(want, *bom.Components); diff != "" {
+		t.Errorf("exportScanResultToCycloneDX() components mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_exportScanResultToSPDX(t *testing.T) {
+	created := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	wantPackages := []spdxPackage{
+		{
+			SPDXID:           "SPDXRef-Package-0",
+			Name:             "openssl",
+			VersionInfo:      "1.1.1",
+			DownloadLocation: spdxNoAssertion,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  "Apache-2.0",
+			ExternalRefs: []spdxExternalRef{
+				{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: "pkg:deb/ubuntu/openssl@1.1.1"},
+				{ReferenceCategory: "SECURITY", ReferenceType: "cpe23Type", ReferenceLocator: "cpe:2.3:a:openssl:openssl:1.1.1:*:*:*:*:*:*:*"},
+			},
+		},
+		{
+			SPDXID:           "SPDXRef-Package-1",
+			Name:             "requests",
+			VersionInfo:      "2.28.0",
+			DownloadLocation: spdxNoAssertion,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  "Apache-2.0 AND MIT",
+			ExternalRefs: []spdxExternalRef{
+				{ReferenceCategory: "SECURITY", ReferenceType: "cpe22Type", ReferenceLocator: "cpe:/a:python:requests:2.28.0"},
+			},
+		},
+	}
+	wantRelationships := []spdxRelationship{
+		{SPDXElementID: spdxDocumentID, RelationshipType: "DESCRIBES", RelatedSPDXElement: "SPDXRef-Package-0"},
+		{SPDXElementID: spdxDocumentID, RelationshipType: "DESCRIBES", RelatedSPDXElement: "SPDXRef-Package-1"},
+	}
+
+	doc := exportScanResultToSPDX("scan-result-1", testSbomPackages, created)
+	if doc.SPDXVersion != spdxVersion || doc.CreationInfo.Created != "2023-05-01T12:00:00Z" {
+		t.Errorf("exportScanResultToSPDX() version = %v, created = %v", doc.SPDXVersion, doc.CreationInfo.Created)
+	}
+	if diff := cmp.Diff(wantPackages, doc.Packages); diff != "" {
+		t.Errorf("exportScanResultToSPDX() packages mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantRelationships, doc.Relationships); diff != "" {
+		t.Errorf("exportScanResultToSPDX() relationships mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -26,13 +27,24 @@ import (
 	"github.com/openclarity/vmclarity/backend/pkg/common"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+	sharedUtils "github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 func (s *ServerImpl) GetScanConfigs(ctx echo.Context, params models.GetScanConfigsParams) error {
 	scanConfigs, err := s.dbHandler.ScanConfigsTable().GetScanConfigs(params)
 	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan configs from db: %v", err))
 	}
+	if scanConfigs.Items != nil {
+		now := time.Now()
+		for i := range *scanConfigs.Items {
+			setNextRunTime(&(*scanConfigs.Items)[i], now)
+		}
+	}
 	return sendResponse(ctx, http.StatusOK, scanConfigs)
 }
 
@@ -44,21 +56,39 @@ func (s *ServerImpl) GetScanConfigsScanConfigID(ctx echo.Context, scanConfigID m
 		}
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan config from db. scanConfigID=%v: %v", scanConfigID, err))
 	}
+	setNextRunTime(&sc, time.Now())
 	return sendResponse(ctx, http.StatusOK, sc)
 }
 
-func (s *ServerImpl) PostScanConfigs(ctx echo.Context) error {
+func (s *ServerImpl) PostScanConfigs(ctx echo.Context, params models.PostScanConfigsParams) error {
 	var scanConfig models.ScanConfig
 	err := ctx.Bind(&scanConfig)
 	if err != nil {
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
 	}
 
-	createdScanConfig, err := s.dbHandler.ScanConfigsTable().CreateScanConfig(scanConfig)
+	var createdScanConfig models.ScanConfig
+	created, err := s.createWithIdempotencyKey(idempotencyKeyResourceScanConfig, params.IdempotencyKey, scanConfig,
+		func(db databaseTypes.Database) (string, error) {
+			var err error
+			createdScanConfig, err = db.ScanConfigsTable().CreateScanConfig(scanConfig)
+			if err != nil {
+				return "", err
+			}
+			return *createdScanConfig.Id, nil
+		},
+		func(scanConfigID string) error {
+			var err error
+			createdScanConfig, err = s.dbHandler.ScanConfigsTable().GetScanConfig(scanConfigID, models.GetScanConfigsScanConfigIDParams{})
+			return err
+		},
+	)
 	if err != nil {
 		var conflictErr *common.ConflictError
 		var validationErr *common.BadRequestError
 		switch true {
+		case errors.Is(err, databaseTypes.ErrIdempotencyKeyReused):
+			return sendError(ctx, http.StatusUnprocessableEntity, err.Error())
 		case errors.As(err, &conflictErr):
 			existResponse := &models.ScanConfigExists{
 				Message:    utils.StringPtr(conflictErr.Reason),
@@ -72,9 +102,83 @@ func (s *ServerImpl) PostScanConfigs(ctx echo.Context) error {
 		}
 	}
 
+	setNextRunTime(&createdScanConfig, time.Now())
+	// A retried request gets the scan config created by the first one.
+	if !created {
+		return sendResponse(ctx, http.StatusOK, createdScanConfig)
+	}
 	return sendResponse(ctx, http.StatusCreated, createdScanConfig)
 }
 
+func (s *ServerImpl) PostScanConfigsValidate(ctx echo.Context) error {
+	var scanConfig models.ScanConfig
+	err := ctx.Bind(&scanConfig)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	if s.scanOrchestrator == nil {
+		return sendError(ctx, http.StatusServiceUnavailable, "scan config validation is not available when the orchestrator is disabled")
+	}
+
+	validation := s.scanOrchestrator.ValidateScanConfig(ctx.Request().Context(), scanConfigToScanConfigData(scanConfig))
+
+	return sendResponse(ctx, http.StatusOK, validation)
+}
+
+func (s *ServerImpl) PostScanConfigsScanConfigIDRun(ctx echo.Context, scanConfigID models.ScanConfigID, params models.PostScanConfigsScanConfigIDRunParams) error {
+	if s.scanOrchestrator == nil {
+		return sendError(ctx, http.StatusServiceUnavailable, "running a scan config is not available when the orchestrator is disabled")
+	}
+
+	sc, err := s.dbHandler.ScanConfigsTable().GetScanConfigWithCredentials(scanConfigID)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfig with ID %v not found", scanConfigID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan config from db. id=%v: %v", scanConfigID, err))
+	}
+
+	// Running scans are the scans which are not finished, the same way the
+	// scheduler finds them.
+	filter := fmt.Sprintf("scanConfig/id eq '%s' and state ne '%s' and state ne '%s'",
+		scanConfigID, models.ScanStateDone, models.ScanStateFailed)
+	runningScans, err := s.dbHandler.ScansTable().GetScans(models.GetScansParams{
+		Filter: &filter,
+	})
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scans from db: %v", err))
+	}
+	if runningScans.Items != nil && len(*runningScans.Items) > 0 {
+		if !utils.ValueOrZero(params.Force) {
+			return sendResponse(ctx, http.StatusConflict, &models.ScanExists{
+				Message: utils.StringPtr(fmt.Sprintf("Running scan exists with same scanConfigID=%q", scanConfigID)),
+				Scan:    &(*runningScans.Items)[0],
+			})
+		}
+		for _, scan := range *runningScans.Items {
+			if state, ok := scan.GetState(); ok && state == models.ScanStateAborted {
+				continue
+			}
+			_, err = s.dbHandler.ScansTable().UpdateScan(models.Scan{
+				Id:           scan.Id,
+				State:        utils.PointerTo(models.ScanStateAborted),
+				StateMessage: utils.StringPtr("Scan aborted to run its scan config now"),
+			})
+			if err != nil {
+				return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to abort scan in db. scanID=%v: %v", *scan.Id, err))
+			}
+		}
+	}
+
+	scanID, err := s.scanOrchestrator.RunScanConfig(sc)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to run scan config. id=%v: %v", scanConfigID, err))
+	}
+
+	return sendResponse(ctx, http.StatusCreated, &models.ScanConfigRun{ScanID: &scanID})
+}
+
 func (s *ServerImpl) DeleteScanConfigsScanConfigID(ctx echo.Context, scanConfigID models.ScanConfigID) error {
 	success := models.Success{
 		Message: utils.StringPtr(fmt.Sprintf("scan config %v deleted", scanConfigID)),
@@ -124,6 +228,7 @@ func (s *ServerImpl) PatchScanConfigsScanConfigID(ctx echo.Context, scanConfigID
 		}
 	}
 
+	setNextRunTime(&updatedScanConfig, time.Now())
 	return sendResponse(ctx, http.StatusOK, updatedScanConfig)
 }
 
@@ -161,5 +266,35 @@ func (s *ServerImpl) PutScanConfigsScanConfigID(ctx echo.Context, scanConfigID m
 		}
 	}
 
+	setNextRunTime(&updatedScanConfig, time.Now())
 	return sendResponse(ctx, http.StatusOK, updatedScanConfig)
 }
+
+// setNextRunTime sets the computed next run time of the scan config according
+// to its schedule. Disabled scan configs don't start scans so they have none.
+func setNextRunTime(scanConfig *models.ScanConfig, now time.Time) {
+	scanConfig.NextRunTime = nil
+	if utils.ValueOrZero(scanConfig.Disabled) {
+		return
+	}
+	scanConfig.NextRunTime = sharedUtils.GetScheduleNextRunTime(scanConfig.Scheduled, now)
+}
+
+// scanConfigToScanConfigData returns the fields of the scan config shared with
+// the scan config snapshot of its scans.
+func scanConfigToScanConfigData(scanConfig models.ScanConfig) models.ScanConfigData {
+	return models.ScanConfigData{
+		Disabled:                      scanConfig.Disabled,
+		MaxParallelScanners:           scanConfig.MaxParallelScanners,
+		MaxScannerInstanceHours:       scanConfig.MaxScannerInstanceHours,
+		Name:                          scanConfig.Name,
+		PartitionsToScan:              scanConfig.PartitionsToScan,
+		ScanAllVolumes:                scanConfig.ScanAllVolumes,
+		ExistingSnapshots:             scanConfig.ExistingSnapshots,
+		ScanFamiliesConfig:            scanConfig.ScanFamiliesConfig,
+		ScanJobTimeoutSeconds:         scanConfig.ScanJobTimeoutSeconds,
+		ScannerInstanceCreationConfig: scanConfig.ScannerInstanceCreationConfig,
+		Scheduled:                     scanConfig.Scheduled,
+		Scope:                         scanConfig.Scope,
+	}
+}
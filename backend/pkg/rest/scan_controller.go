@@ -31,24 +31,49 @@ import (
 func (s *ServerImpl) GetScans(ctx echo.Context, params models.GetScansParams) error {
 	scans, err := s.dbHandler.ScansTable().GetScans(params)
 	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scans from db: %v", err))
 	}
 
 	return sendResponse(ctx, http.StatusOK, scans)
 }
 
-func (s *ServerImpl) PostScans(ctx echo.Context) error {
+func (s *ServerImpl) PostScans(ctx echo.Context, params models.PostScansParams) error {
 	var scan models.Scan
 	err := ctx.Bind(&scan)
 	if err != nil {
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
 	}
 
-	createdScan, err := s.dbHandler.ScansTable().CreateScan(scan)
+	if params.DryRun != nil && *params.DryRun {
+		return s.planScan(ctx, scan)
+	}
+
+	var createdScan models.Scan
+	created, err := s.createWithIdempotencyKey(idempotencyKeyResourceScan, params.IdempotencyKey, scan,
+		func(db databaseTypes.Database) (string, error) {
+			var err error
+			createdScan, err = db.ScansTable().CreateScan(scan)
+			if err != nil {
+				return "", err
+			}
+			return *createdScan.Id, nil
+		},
+		func(scanID string) error {
+			var err error
+			createdScan, err = s.dbHandler.ScansTable().GetScan(scanID, models.GetScansScanIDParams{})
+			return err
+		},
+	)
 	if err != nil {
 		var conflictErr *common.ConflictError
 		var validationErr *common.BadRequestError
 		switch true {
+		case errors.Is(err, databaseTypes.ErrIdempotencyKeyReused):
+			return sendError(ctx, http.StatusUnprocessableEntity, err.Error())
 		case errors.As(err, &conflictErr):
 			existResponse := &models.ScanExists{
 				Message: utils.StringPtr(conflictErr.Reason),
@@ -62,10 +87,68 @@ func (s *ServerImpl) PostScans(ctx echo.Context) error {
 		}
 	}
 
+	// A retried request gets the scan created by the first one.
+	if !created {
+		return sendResponse(ctx, http.StatusOK, createdScan)
+	}
 	return sendResponse(ctx, http.StatusCreated, createdScan)
 }
 
+// planScan returns the plan of the scan without creating it, the targets are
+// discovered using the scan config snapshot of the scan, or its scan config if
+// no snapshot is set.
+func (s *ServerImpl) planScan(ctx echo.Context, scan models.Scan) error {
+	if s.scanOrchestrator == nil {
+		return sendError(ctx, http.StatusServiceUnavailable, "scan dry run is not available when the orchestrator is disabled")
+	}
+
+	var scanConfig models.ScanConfigData
+	switch {
+	case scan.ScanConfigSnapshot != nil:
+		scanConfig = *scan.ScanConfigSnapshot
+	case scan.ScanConfig != nil:
+		sc, err := s.dbHandler.ScanConfigsTable().GetScanConfigWithCredentials(scan.ScanConfig.Id)
+		if err != nil {
+			if errors.Is(err, databaseTypes.ErrNotFound) {
+				return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfig with ID %v not found", scan.ScanConfig.Id))
+			}
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan config from db. id=%v: %v", scan.ScanConfig.Id, err))
+		}
+		scanConfig = scanConfigToScanConfigData(sc)
+	default:
+		return sendError(ctx, http.StatusBadRequest, "scan config or scan config snapshot must be set for a dry run")
+	}
+
+	if scanConfig.Scope == nil || scanConfig.ScanFamiliesConfig == nil {
+		return sendError(ctx, http.StatusBadRequest, "scope and scan families config must be set for a dry run")
+	}
+
+	plan, err := s.scanOrchestrator.PlanScan(ctx.Request().Context(), scanConfig)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to plan scan: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, plan)
+}
+
 func (s *ServerImpl) DeleteScansScanID(ctx echo.Context, scanID models.ScanID) error {
+	scan, err := s.dbHandler.ScansTable().GetScan(scanID, models.GetScansScanIDParams{
+		Select: utils.StringPtr("id,state"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Scan with ID %v not found", scanID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan from db. id=%v: %v", scanID, err))
+	}
+
+	// A pending scan is canceled by deleting it, the orchestrator doesn't
+	// start it once it's gone. A running scan has scan jobs which must be
+	// torn down by aborting it first.
+	if state, ok := scan.GetState(); ok && state == models.ScanStateInProgress {
+		return sendError(ctx, http.StatusConflict, fmt.Sprintf("Scan with ID %v is in progress, abort it before deleting it", scanID))
+	}
+
 	success := models.Success{
 		Message: utils.StringPtr(fmt.Sprintf("scan %v deleted", scanID)),
 	}
@@ -164,3 +247,112 @@ func (s *ServerImpl) PutScansScanID(ctx echo.Context, scanID models.ScanID) erro
 
 	return sendResponse(ctx, http.StatusOK, updatedScan)
 }
+
+func (s *ServerImpl) PostScansScanIDAbort(ctx echo.Context, scanID models.ScanID) error {
+	scan, err := s.dbHandler.ScansTable().GetScan(scanID, models.GetScansScanIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Scan with ID %v not found", scanID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan from db. id=%v: %v", scanID, err))
+	}
+
+	state, ok := scan.GetState()
+	if !ok {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("cannot determine state of scan. id=%v", scanID))
+	}
+	switch state {
+	case models.ScanStateDone, models.ScanStateFailed:
+		return sendError(ctx, http.StatusConflict, fmt.Sprintf("Scan with ID %v has already finished", scanID))
+	case models.ScanStatePending, models.ScanStateDiscovered, models.ScanStateInProgress, models.ScanStateAborted:
+	}
+
+	jobsRunning, err := s.countRunningScanJobs(scanID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to count running scan jobs. scanID=%v: %v", scanID, err))
+	}
+
+	// Aborting is idempotent, the scan is already being torn down.
+	if state == models.ScanStateAborted {
+		return sendResponse(ctx, http.StatusOK, &models.ScanAbort{
+			AlreadyAborted: utils.BoolPtr(true),
+			JobsRunning:    &jobsRunning,
+		})
+	}
+
+	_, err = s.dbHandler.ScansTable().UpdateScan(models.Scan{
+		Id:           &scanID,
+		State:        utils.PointerTo(models.ScanStateAborted),
+		StateMessage: utils.StringPtr("Scan abort requested"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Scan with ID %v not found", scanID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to abort scan in db. scanID=%v: %v", scanID, err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, &models.ScanAbort{
+		AlreadyAborted: utils.BoolPtr(false),
+		JobsRunning:    &jobsRunning,
+	})
+}
+
+func (s *ServerImpl) GetScansScanIDScanResults(ctx echo.Context, scanID models.ScanID, params models.GetScansScanIDScanResultsParams) error {
+	_, err := s.dbHandler.ScansTable().GetScan(scanID, models.GetScansScanIDParams{
+		Select: utils.StringPtr("id"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Scan with ID %v not found", scanID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan from db. id=%v: %v", scanID, err))
+	}
+
+	scanResults, err := s.dbHandler.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+		Filter:  utils.StringPtr(scanResultsOfScanFilter(scanID, params.Filter)),
+		Select:  params.Select,
+		Count:   params.Count,
+		Top:     params.Top,
+		Skip:    params.Skip,
+		Expand:  params.Expand,
+		OrderBy: params.OrderBy,
+	})
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan results from db. scanID=%v: %v", scanID, err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, scanResults)
+}
+
+// scanResultsOfScanFilter returns the filter of the scan results of the scan,
+// narrowed down by the given filter if set.
+func scanResultsOfScanFilter(scanID models.ScanID, filter *string) string {
+	scanFilter := fmt.Sprintf("scan/id eq '%s'", scanID)
+	if filter == nil || *filter == "" {
+		return scanFilter
+	}
+	return fmt.Sprintf("%s and (%s)", scanFilter, *filter)
+}
+
+// countRunningScanJobs returns the number of scan results of the scan which
+// have not completed yet.
+func (s *ServerImpl) countRunningScanJobs(scanID models.ScanID) (int, error) {
+	filter := fmt.Sprintf("scan/id eq '%s' and status/general/state ne '%s' and status/general/state ne '%s'",
+		scanID, models.DONE, models.NOTSCANNED)
+	scanResults, err := s.dbHandler.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+		Filter: &filter,
+		Select: utils.StringPtr("id"),
+		Count:  utils.BoolPtr(true),
+		Top:    utils.IntPtr(1),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get scan results from db: %v", err)
+	}
+
+	return utils.ValueOrZero(scanResults.Count), nil
+}
@@ -0,0 +1,53 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_scanResultsOfScanFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *string
+		want   string
+	}{
+		{
+			name:   "no filter",
+			filter: nil,
+			want:   "scan/id eq 'scan-1'",
+		},
+		{
+			name:   "empty filter",
+			filter: utils.StringPtr(""),
+			want:   "scan/id eq 'scan-1'",
+		},
+		{
+			name:   "filter is combined with the scan",
+			filter: utils.StringPtr("target/id eq 'target-1' or target/id eq 'target-2'"),
+			want:   "scan/id eq 'scan-1' and (target/id eq 'target-1' or target/id eq 'target-2')",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scanResultsOfScanFilter("scan-1", tt.filter); got != tt.want {
+				t.Errorf("scanResultsOfScanFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
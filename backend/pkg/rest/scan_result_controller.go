@@ -16,21 +16,29 @@
 package rest
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
 )
 
 func (s *ServerImpl) GetScanResults(ctx echo.Context, params models.GetScanResultsParams) error {
 	dbScanResults, err := s.dbHandler.ScanResultsTable().GetScanResults(params)
 	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scans results from db: %v", err))
 	}
 
@@ -44,6 +52,14 @@ func (s *ServerImpl) PostScanResults(ctx echo.Context) error {
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
 	}
 
+	if err = s.applySeverityOverrides(&scanResult); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply severity overrides: %v", err))
+	}
+
+	if err = s.applyScanResultTags(&scanResult, nil); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply tagging rules: %v", err))
+	}
+
 	createdScanResult, err := s.dbHandler.ScanResultsTable().CreateScanResult(scanResult)
 	if err != nil {
 		var conflictErr *common.ConflictError
@@ -61,6 +77,12 @@ func (s *ServerImpl) PostScanResults(ctx echo.Context) error {
 }
 
 func (s *ServerImpl) GetScanResultsScanResultID(ctx echo.Context, scanResultID models.ScanResultID, params models.GetScanResultsScanResultIDParams) error {
+	format := utils.ValueOrZero(params.Format)
+	if format == models.Sarif {
+		// All the findings are exported regardless of the select and expand.
+		params = models.GetScanResultsScanResultIDParams{}
+	}
+
 	dbScanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, params)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -69,9 +91,85 @@ func (s *ServerImpl) GetScanResultsScanResultID(ctx echo.Context, scanResultID m
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result from db. scanResultID=%v: %v", scanResultID, err))
 	}
 
+	if format == models.Sarif {
+		sarif, err := json.Marshal(exportScanResultToSarif(&dbScanResult))
+		if err != nil {
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to export scan result to SARIF. scanResultID=%v: %v", scanResultID, err))
+		}
+		return ctx.Blob(http.StatusOK, sarifContentType, sarif) // nolint:wrapcheck
+	}
+
 	return sendResponse(ctx, http.StatusOK, dbScanResult)
 }
 
+func (s *ServerImpl) GetScanResultsScanResultIDLogs(ctx echo.Context, scanResultID models.ScanResultID) error {
+	if s.scanOrchestrator == nil {
+		return sendError(ctx, http.StatusServiceUnavailable, "scanner logs are not available when the orchestrator is disabled")
+	}
+
+	dbScanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.PointerTo("id,jobResources"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result from db. scanResultID=%v: %v", scanResultID, err))
+	}
+	if dbScanResult.JobResources == nil || utils.ValueOrZero(dbScanResult.JobResources.InstanceID) == "" {
+		return sendError(ctx, http.StatusNotFound, fmt.Sprintf("no scanner instance was launched for the scan result. scanResultID=%v", scanResultID))
+	}
+	instanceID := *dbScanResult.JobResources.InstanceID
+
+	logs, err := s.scanOrchestrator.GetScannerLogs(ctx.Request().Context(), utils.ValueOrZero(dbScanResult.JobResources.InstanceLocation), instanceID)
+	if err != nil {
+		if errors.Is(err, provider.ErrNotSupported) {
+			return sendError(ctx, http.StatusNotImplemented, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scanner logs. scanResultID=%v: %v", scanResultID, err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, models.ScannerLogs{
+		InstanceID: &instanceID,
+		Logs:       &logs,
+	})
+}
+
+func (s *ServerImpl) GetScanResultsScanResultIDSbom(ctx echo.Context, scanResultID models.ScanResultID, params models.GetScanResultsScanResultIDSbomParams) error {
+	dbScanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.PointerTo("id,sboms"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result from db. scanResultID=%v: %v", scanResultID, err))
+	}
+	if dbScanResult.Sboms == nil || dbScanResult.Sboms.Packages == nil {
+		return sendError(ctx, http.StatusNotFound, fmt.Sprintf("scan result has no SBOM. scanResultID=%v", scanResultID))
+	}
+	packages := *dbScanResult.Sboms.Packages
+
+	var sbom interface{}
+	var contentType string
+	switch utils.ValueOrZero(params.Format) {
+	case models.Spdx:
+		sbom = exportScanResultToSPDX(scanResultID, packages, time.Now())
+		contentType = spdxContentType
+	case models.Cyclonedx, "":
+		sbom = exportScanResultToCycloneDX(scanResultID, packages)
+		contentType = cycloneDXContentType
+	default:
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("unsupported SBOM format %v", *params.Format))
+	}
+	b, err := json.Marshal(sbom)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to export SBOM. scanResultID=%v: %v", scanResultID, err))
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, b) // nolint:wrapcheck
+}
+
 func (s *ServerImpl) PatchScanResultsScanResultID(ctx echo.Context, scanResultID models.ScanResultID) error {
 	// TODO: check that the provided scan and target IDs are valid
 	var scanResult models.TargetScanResult
@@ -81,7 +179,7 @@ func (s *ServerImpl) PatchScanResultsScanResultID(ctx echo.Context, scanResultID
 	}
 
 	// check that a scan result with that id exists.
-	_, err = s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{})
+	existingScanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{})
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("scan result was not found. scanResultID=%v: %v", scanResultID, err))
@@ -96,6 +194,14 @@ func (s *ServerImpl) PatchScanResultsScanResultID(ctx echo.Context, scanResultID
 	}
 	scanResult.Id = &scanResultID
 
+	if err = s.applySeverityOverrides(&scanResult); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply severity overrides: %v", err))
+	}
+
+	if err = s.applyScanResultTags(&scanResult, &existingScanResult); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply tagging rules: %v", err))
+	}
+
 	updatedScanResult, err := s.dbHandler.ScanResultsTable().UpdateScanResult(scanResult)
 	if err != nil {
 		var validationErr *common.BadRequestError
@@ -141,6 +247,14 @@ func (s *ServerImpl) PutScanResultsScanResultID(ctx echo.Context, scanResultID m
 	}
 	scanResult.Id = &scanResultID
 
+	if err = s.applySeverityOverrides(&scanResult); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply severity overrides: %v", err))
+	}
+
+	if err = s.applyScanResultTags(&scanResult, nil); err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to apply tagging rules: %v", err))
+	}
+
 	updatedScanResult, err := s.dbHandler.ScanResultsTable().SaveScanResult(scanResult)
 	if err != nil {
 		var validationErr *common.BadRequestError
@@ -0,0 +1,67 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+// fakeScanOrchestrator is an orchestrator which is enabled, its methods must
+// not be called.
+type fakeScanOrchestrator struct {
+	ScanOrchestrator
+}
+
+func TestServerImpl_GetScanResultsScanResultIDLogs(t *testing.T) {
+	server := &ServerImpl{
+		dbHandler:        &fakeDatabase{},
+		scanOrchestrator: fakeScanOrchestrator{},
+	}
+
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+	err := server.GetScanResultsScanResultIDLogs(ctx, "unknown")
+	if err != nil {
+		t.Fatalf("GetScanResultsScanResultIDLogs() error = %v", err)
+	}
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetScanResultsScanResultIDLogs() status = %v, want %v: %s", rec.Code, http.StatusNotFound, rec.Body.String())
+	}
+}
+
+func TestServerImpl_GetScanResultsScanResultIDSbom(t *testing.T) {
+	server := &ServerImpl{
+		dbHandler: &fakeDatabase{},
+	}
+
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+	err := server.GetScanResultsScanResultIDSbom(ctx, "unknown", models.GetScanResultsScanResultIDSbomParams{})
+	if err != nil {
+		t.Fatalf("GetScanResultsScanResultIDSbom() error = %v", err)
+	}
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetScanResultsScanResultIDSbom() status = %v, want %v: %s", rec.Code, http.StatusNotFound, rec.Body.String())
+	}
+}
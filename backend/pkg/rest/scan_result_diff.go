@@ -0,0 +1,170 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// scanResultDiffSelect selects the fields of the scan results which are
+// compared.
+const scanResultDiffSelect = "id,target,vulnerabilities,secrets,misconfigurations"
+
+func (s *ServerImpl) GetScanResultsScanResultIDDiff(ctx echo.Context, scanResultID models.ScanResultID, params models.GetScanResultsScanResultIDDiffParams) error {
+	scanResults := make([]models.TargetScanResult, 0, 2)
+	for _, id := range []models.ScanResultID{scanResultID, params.Against} {
+		dbScanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(id, models.GetScanResultsScanResultIDParams{
+			Select: utils.PointerTo(scanResultDiffSelect),
+		})
+		if err != nil {
+			if errors.Is(err, databaseTypes.ErrNotFound) {
+				return sendError(ctx, http.StatusNotFound, fmt.Sprintf("scan result was not found. scanResultID=%v: %v", id, err))
+			}
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result from db. scanResultID=%v: %v", id, err))
+		}
+		scanResults = append(scanResults, dbScanResult)
+	}
+
+	diff, err := diffScanResults(&scanResults[0], &scanResults[1])
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, diff)
+}
+
+// diffScanResults compares the findings of the scan result with the findings
+// of the scan result against, which must be of the same target.
+func diffScanResults(scanResult, against *models.TargetScanResult) (*models.ScanResultDiff, error) {
+	targetID := scanResultTargetID(scanResult)
+	if againstTargetID := scanResultTargetID(against); targetID != againstTargetID {
+		return nil, fmt.Errorf("scan results are of different targets. targetID=%v, againstTargetID=%v", targetID, againstTargetID)
+	}
+
+	var vulnerabilities, againstVulnerabilities []models.Vulnerability
+	if scanResult.Vulnerabilities != nil {
+		vulnerabilities = utils.ValueOrZero(scanResult.Vulnerabilities.Vulnerabilities)
+	}
+	if against.Vulnerabilities != nil {
+		againstVulnerabilities = utils.ValueOrZero(against.Vulnerabilities.Vulnerabilities)
+	}
+	var secrets, againstSecrets []models.Secret
+	if scanResult.Secrets != nil {
+		secrets = utils.ValueOrZero(scanResult.Secrets.Secrets)
+	}
+	if against.Secrets != nil {
+		againstSecrets = utils.ValueOrZero(against.Secrets.Secrets)
+	}
+	var misconfigurations, againstMisconfigurations []models.Misconfiguration
+	if scanResult.Misconfigurations != nil {
+		misconfigurations = utils.ValueOrZero(scanResult.Misconfigurations.Misconfigurations)
+	}
+	if against.Misconfigurations != nil {
+		againstMisconfigurations = utils.ValueOrZero(against.Misconfigurations.Misconfigurations)
+	}
+
+	vulnerabilitiesAdded, vulnerabilitiesRemoved, vulnerabilitiesUnchanged := diffFindings(vulnerabilities, againstVulnerabilities, vulnerabilityDiffKey)
+	secretsAdded, secretsRemoved, secretsUnchanged := diffFindings(secrets, againstSecrets, secretDiffKey)
+	misconfigurationsAdded, misconfigurationsRemoved, misconfigurationsUnchanged := diffFindings(misconfigurations, againstMisconfigurations, misconfigurationDiffKey)
+
+	return &models.ScanResultDiff{
+		ScanResultID:        scanResult.Id,
+		AgainstScanResultID: against.Id,
+		TargetID:            &targetID,
+		Vulnerabilities: &models.VulnerabilitiesDiff{
+			Added:     &vulnerabilitiesAdded,
+			Removed:   &vulnerabilitiesRemoved,
+			Unchanged: &vulnerabilitiesUnchanged,
+		},
+		Secrets: &models.SecretsDiff{
+			Added:     &secretsAdded,
+			Removed:   &secretsRemoved,
+			Unchanged: &secretsUnchanged,
+		},
+		Misconfigurations: &models.MisconfigurationsDiff{
+			Added:     &misconfigurationsAdded,
+			Removed:   &misconfigurationsRemoved,
+			Unchanged: &misconfigurationsUnchanged,
+		},
+	}, nil
+}
+
+func scanResultTargetID(scanResult *models.TargetScanResult) string {
+	if scanResult.Target == nil {
+		return ""
+	}
+	return scanResult.Target.Id
+}
+
+// diffFindings splits the findings into the ones without a finding with the
+// same key in against (added) and the ones with such a finding (unchanged),
+// and returns the findings of against without a finding with the same key in
+// findings (removed). The order of the findings is kept.
+func diffFindings[T any](findings, against []T, key func(T) string) (added, removed, unchanged []T) {
+	keys := make(map[string]struct{}, len(findings))
+	for _, finding := range findings {
+		keys[key(finding)] = struct{}{}
+	}
+	againstKeys := make(map[string]struct{}, len(against))
+	for _, finding := range against {
+		againstKeys[key(finding)] = struct{}{}
+	}
+
+	added, removed, unchanged = []T{}, []T{}, []T{}
+	for _, finding := range findings {
+		if _, ok := againstKeys[key(finding)]; ok {
+			unchanged = append(unchanged, finding)
+		} else {
+			added = append(added, finding)
+		}
+	}
+	for _, finding := range against {
+		if _, ok := keys[key(finding)]; !ok {
+			removed = append(removed, finding)
+		}
+	}
+
+	return added, removed, unchanged
+}
+
+// vulnerabilityDiffKey identifies a vulnerability by its name (e.g. the CVE
+// ID) and the name of the vulnerable package, so that a vulnerability which is
+// still found after the package was upgraded is unchanged.
+func vulnerabilityDiffKey(vulnerability models.Vulnerability) string {
+	var packageName string
+	if vulnerability.Package != nil {
+		packageName = utils.ValueOrZero(vulnerability.Package.Name)
+	}
+	return fmt.Sprintf("%s.%s", utils.ValueOrZero(vulnerability.VulnerabilityName), packageName)
+}
+
+func secretDiffKey(secret models.Secret) string {
+	return utils.ValueOrZero(secret.Fingerprint)
+}
+
+// misconfigurationDiffKey identifies a misconfiguration by the rule which
+// found it, which is the test of the scanner.
+func misconfigurationDiffKey(misconfiguration models.Misconfiguration) string {
+	return fmt.Sprintf("%s.%s", utils.ValueOrZero(misconfiguration.ScannerName), utils.ValueOrZero(misconfiguration.TestID))
+}
@@ -0,0 +1,261 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// fakeDatabase is a database with only the scan results table, which gets the
+// scan results from a map.
+type fakeDatabase struct {
+	databaseTypes.Database
+	scanResults fakeScanResultsTable
+}
+
+func (db *fakeDatabase) ScanResultsTable() databaseTypes.ScanResultsTable {
+	return db.scanResults
+}
+
+type fakeScanResultsTable struct {
+	databaseTypes.ScanResultsTable
+	scanResults map[models.ScanResultID]models.TargetScanResult
+}
+
+func (t fakeScanResultsTable) GetScanResult(scanResultID models.ScanResultID, _ models.GetScanResultsScanResultIDParams) (models.TargetScanResult, error) {
+	scanResult, ok := t.scanResults[scanResultID]
+	if !ok {
+		return models.TargetScanResult{}, databaseTypes.ErrNotFound
+	}
+	return scanResult, nil
+}
+
+func TestServerImpl_GetScanResultsScanResultIDDiff(t *testing.T) {
+	target := &models.TargetRelationship{Id: "target-1"}
+	server := &ServerImpl{
+		dbHandler: &fakeDatabase{
+			scanResults: fakeScanResultsTable{
+				scanResults: map[models.ScanResultID]models.TargetScanResult{
+					"scan-result-1": {Id: utils.StringPtr("scan-result-1"), Target: target},
+					"scan-result-2": {Id: utils.StringPtr("scan-result-2"), Target: target},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		scanResultID models.ScanResultID
+		against      models.ScanResultID
+		want         int
+	}{
+		{
+			name:         "diff",
+			scanResultID: "scan-result-1",
+			against:      "scan-result-2",
+			want:         http.StatusOK,
+		},
+		{
+			name:         "unknown scan result",
+			scanResultID: "unknown",
+			against:      "scan-result-2",
+			want:         http.StatusNotFound,
+		},
+		{
+			name:         "unknown scan result against",
+			scanResultID: "scan-result-1",
+			against:      "unknown",
+			want:         http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			err := server.GetScanResultsScanResultIDDiff(ctx, tt.scanResultID, models.GetScanResultsScanResultIDDiffParams{Against: tt.against})
+			if err != nil {
+				t.Fatalf("GetScanResultsScanResultIDDiff() error = %v", err)
+			}
+			if rec.Code != tt.want {
+				t.Errorf("GetScanResultsScanResultIDDiff() status = %v, want %v: %s", rec.Code, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func Test_diffScanResults(t *testing.T) {
+	newVulnerability := func(name, packageName, packageVersion string) models.Vulnerability {
+		return models.Vulnerability{
+			VulnerabilityName: utils.StringPtr(name),
+			Package: &models.Package{
+				Name:    utils.StringPtr(packageName),
+				Version: utils.StringPtr(packageVersion),
+			},
+		}
+	}
+	newSecret := func(fingerprint string) models.Secret {
+		return models.Secret{Fingerprint: utils.StringPtr(fingerprint)}
+	}
+	newMisconfiguration := func(scannerName, testID string) models.Misconfiguration {
+		return models.Misconfiguration{
+			ScannerName: utils.StringPtr(scannerName),
+			TestID:      utils.StringPtr(testID),
+		}
+	}
+	target := &models.TargetRelationship{Id: "target-1"}
+
+	tests := []struct {
+		name       string
+		scanResult *models.TargetScanResult
+		against    *models.TargetScanResult
+		want       *models.ScanResultDiff
+		wantErr    bool
+	}{
+		{
+			name: "no findings",
+			scanResult: &models.TargetScanResult{
+				Id:     utils.StringPtr("scan-result-2"),
+				Target: target,
+			},
+			against: &models.TargetScanResult{
+				Id:     utils.StringPtr("scan-result-1"),
+				Target: target,
+			},
+			want: &models.ScanResultDiff{
+				ScanResultID:        utils.StringPtr("scan-result-2"),
+				AgainstScanResultID: utils.StringPtr("scan-result-1"),
+				TargetID:            utils.StringPtr("target-1"),
+				Vulnerabilities: &models.VulnerabilitiesDiff{
+					Added:     &[]models.Vulnerability{},
+					Removed:   &[]models.Vulnerability{},
+					Unchanged: &[]models.Vulnerability{},
+				},
+				Secrets: &models.SecretsDiff{
+					Added:     &[]models.Secret{},
+					Removed:   &[]models.Secret{},
+					Unchanged: &[]models.Secret{},
+				},
+				Misconfigurations: &models.MisconfigurationsDiff{
+					Added:     &[]models.Misconfiguration{},
+					Removed:   &[]models.Misconfiguration{},
+					Unchanged: &[]models.Misconfiguration{},
+				},
+			},
+		},
+		{
+			name: "added, removed and unchanged findings",
+			scanResult: &models.TargetScanResult{
+				Id:     utils.StringPtr("scan-result-2"),
+				Target: target,
+				Vulnerabilities: &models.VulnerabilityScan{
+					Vulnerabilities: &[]models.Vulnerability{
+						newVulnerability("CVE-2023-1", "openssl", "1.1.2"),
+						newVulnerability("CVE-2023-2", "openssl", "1.1.2"),
+						newVulnerability("CVE-2023-3", "zlib", "1.2.13"),
+					},
+				},
+				Secrets: &models.SecretScan{
+					Secrets: &[]models.Secret{newSecret("fingerprint-2")},
+				},
+				Misconfigurations: &models.MisconfigurationScan{
+					Misconfigurations: &[]models.Misconfiguration{
+						newMisconfiguration("lynis", "SSH-7408"),
+						newMisconfiguration("lynis", "AUTH-9262"),
+					},
+				},
+			},
+			against: &models.TargetScanResult{
+				Id:     utils.StringPtr("scan-result-1"),
+				Target: target,
+				Vulnerabilities: &models.VulnerabilityScan{
+					Vulnerabilities: &[]models.Vulnerability{
+						newVulnerability("CVE-2023-1", "openssl", "1.1.1"),
+						newVulnerability("CVE-2023-3", "openssl", "1.1.1"),
+					},
+				},
+				Secrets: &models.SecretScan{
+					Secrets: &[]models.Secret{newSecret("fingerprint-1")},
+				},
+				Misconfigurations: &models.MisconfigurationScan{
+					Misconfigurations: &[]models.Misconfiguration{
+						newMisconfiguration("lynis", "SSH-7408"),
+					},
+				},
+			},
+			want: &models.ScanResultDiff{
+				ScanResultID:        utils.StringPtr("scan-result-2"),
+				AgainstScanResultID: utils.StringPtr("scan-result-1"),
+				TargetID:            utils.StringPtr("target-1"),
+				Vulnerabilities: &models.VulnerabilitiesDiff{
+					Added: &[]models.Vulnerability{
+						newVulnerability("CVE-2023-2", "openssl", "1.1.2"),
+						newVulnerability("CVE-2023-3", "zlib", "1.2.13"),
+					},
+					Removed: &[]models.Vulnerability{
+						newVulnerability("CVE-2023-3", "openssl", "1.1.1"),
+					},
+					Unchanged: &[]models.Vulnerability{
+						newVulnerability("CVE-2023-1", "openssl", "1.1.2"),
+					},
+				},
+				Secrets: &models.SecretsDiff{
+					Added:     &[]models.Secret{newSecret("fingerprint-2")},
+					Removed:   &[]models.Secret{newSecret("fingerprint-1")},
+					Unchanged: &[]models.Secret{},
+				},
+				Misconfigurations: &models.MisconfigurationsDiff{
+					Added:     &[]models.Misconfiguration{newMisconfiguration("lynis", "AUTH-9262")},
+					Removed:   &[]models.Misconfiguration{},
+					Unchanged: &[]models.Misconfiguration{newMisconfiguration("lynis", "SSH-7408")},
+				},
+			},
+		},
+		{
+			name: "different targets",
+			scanResult: &models.TargetScanResult{
+				Id:     utils.StringPtr("scan-result-2"),
+				Target: target,
+			},
+			against: &models.TargetScanResult{
+				Id:     utils.StringPtr("scan-result-1"),
+				Target: &models.TargetRelationship{Id: "target-2"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffScanResults(tt.scanResult, tt.against)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("diffScanResults() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("diffScanResults() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,10 +21,12 @@ import (
 	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/api/server"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
@@ -40,6 +42,21 @@ const (
 
 type ServerImpl struct {
 	dbHandler databaseTypes.Database
+	// The maximum number of targets created concurrently by a bulk import.
+	targetImportConcurrency int
+	// scanOrchestrator is nil when the runtime scan orchestrator is disabled.
+	scanOrchestrator ScanOrchestrator
+}
+
+// ScanOrchestrator plans scans, validates scan configs, runs scan configs on
+// demand, fetches the logs of the scanner instances and lists the instances
+// the provider discovers, it is implemented by the runtime scan orchestrator.
+type ScanOrchestrator interface {
+	PlanScan(ctx context.Context, scanConfig models.ScanConfigData) (*models.ScanPlan, error)
+	ValidateScanConfig(ctx context.Context, scanConfig models.ScanConfigData) *models.ScanConfigValidation
+	RunScanConfig(scanConfig models.ScanConfig) (string, error)
+	GetScannerLogs(ctx context.Context, location, instanceID string) (string, error)
+	ListProviderInstances(ctx context.Context, location string) ([]models.ProviderInstance, error)
 }
 
 type Server struct {
@@ -47,8 +64,8 @@ type Server struct {
 	echoServer *echo.Echo
 }
 
-func CreateRESTServer(port int, dbHandler databaseTypes.Database, uiSitePath string, uiBackendAPIImpl *uirest.ServerImpl) (*Server, error) {
-	e, err := createEchoServer(dbHandler, uiSitePath, uiBackendAPIImpl)
+func CreateRESTServer(port int, dbHandler databaseTypes.Database, uiSitePath string, uiBackendAPIImpl *uirest.ServerImpl, targetImportConcurrency int, scanOrchestrator ScanOrchestrator) (*Server, error) {
+	e, err := createEchoServer(dbHandler, uiSitePath, uiBackendAPIImpl, targetImportConcurrency, scanOrchestrator)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rest server: %v", err)
 	}
@@ -58,7 +75,7 @@ func CreateRESTServer(port int, dbHandler databaseTypes.Database, uiSitePath str
 	}, nil
 }
 
-func createEchoServer(dbHandler databaseTypes.Database, uiSitePath string, uiBackendAPIImpl *uirest.ServerImpl) (*echo.Echo, error) {
+func createEchoServer(dbHandler databaseTypes.Database, uiSitePath string, uiBackendAPIImpl *uirest.ServerImpl, targetImportConcurrency int, scanOrchestrator ScanOrchestrator) (*echo.Echo, error) {
 	swagger, err := server.GetSwagger()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load swagger spec: %v", err)
@@ -76,11 +93,18 @@ func createEchoServer(dbHandler databaseTypes.Database, uiSitePath string, uiBac
 	apiGroup := e.Group(BaseURL)
 
 	// Use oapi-codegen validation middleware to validate
-	// the API group against the OpenAPI schema.
+	// the API group against the OpenAPI schema. Target import files can
+	// be uploaded as CSV which the validator doesn't decode by default.
+	openapi3filter.RegisterBodyDecoder(csvContentType, openapi3filter.FileBodyDecoder)
 	apiGroup.Use(middleware.OapiRequestValidator(swagger))
 
+	if targetImportConcurrency < 1 {
+		targetImportConcurrency = 1
+	}
 	apiImpl := &ServerImpl{
-		dbHandler: dbHandler,
+		dbHandler:               dbHandler,
+		targetImportConcurrency: targetImportConcurrency,
+		scanOrchestrator:        scanOrchestrator,
 	}
 	// Register paths with the backend implementation
 	server.RegisterHandlers(apiGroup, apiImpl)
@@ -0,0 +1,78 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetSeverityOverrides(ctx echo.Context) error {
+	severityOverrides, err := s.dbHandler.SeverityOverridesTable().GetSeverityOverrides()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get severity overrides from db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, severityOverrides)
+}
+
+func (s *ServerImpl) PutSeverityOverrides(ctx echo.Context) error {
+	var severityOverrides models.SeverityOverrides
+	err := ctx.Bind(&severityOverrides)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	updatedSeverityOverrides, err := s.dbHandler.SeverityOverridesTable().SetSeverityOverrides(severityOverrides)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set severity overrides in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedSeverityOverrides)
+}
+
+// applySeverityOverrides remaps the severities of the reported
+// vulnerabilities according to the organization's severity overrides and
+// recalculates the vulnerabilities summary accordingly.
+func (s *ServerImpl) applySeverityOverrides(scanResult *models.TargetScanResult) error {
+	if scanResult.Vulnerabilities == nil || scanResult.Vulnerabilities.Vulnerabilities == nil {
+		return nil
+	}
+
+	severityOverrides, err := s.dbHandler.SeverityOverridesTable().GetSeverityOverrides()
+	if err != nil {
+		return fmt.Errorf("failed to get severity overrides from db: %w", err)
+	}
+
+	utils.ApplyVulnerabilitySeverityOverrides(scanResult.Vulnerabilities.Vulnerabilities, severityOverrides)
+
+	if scanResult.Summary != nil {
+		scanResult.Summary.TotalVulnerabilities = utils.GetVulnerabilityTotalsPerSeverity(scanResult.Vulnerabilities.Vulnerabilities)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetTaggingRules(ctx echo.Context) error {
+	taggingRules, err := s.dbHandler.TaggingRulesTable().GetTaggingRules()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get tagging rules from db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, taggingRules)
+}
+
+func (s *ServerImpl) PutTaggingRules(ctx echo.Context) error {
+	var taggingRules models.TaggingRules
+	err := ctx.Bind(&taggingRules)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	updatedTaggingRules, err := s.dbHandler.TaggingRulesTable().SetTaggingRules(taggingRules)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set tagging rules in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedTaggingRules)
+}
+
+// applyScanResultTags sets the tags of the tagging rules matching the
+// reported scan result. For a PATCH the rules are evaluated on the existing
+// scan result with the patch applied, so existing must be set.
+func (s *ServerImpl) applyScanResultTags(scanResult *models.TargetScanResult, existing *models.TargetScanResult) error {
+	reported := *scanResult
+	if existing != nil {
+		if err := mergePatch(*existing, *scanResult, &reported); err != nil {
+			return err
+		}
+	}
+
+	taggingRules, err := s.dbHandler.TaggingRulesTable().GetTaggingRules()
+	if err != nil {
+		return fmt.Errorf("failed to get tagging rules from db: %w", err)
+	}
+
+	var targetID string
+	if reported.Target != nil {
+		targetID = reported.Target.Id
+	}
+	target, err := s.getTaggedTarget(targetID)
+	if err != nil {
+		return err
+	}
+
+	scanResult.Tags = utils.PointerTo(utils.GetScanResultTags(taggingRules, target, reported))
+
+	return nil
+}
+
+// applyFindingTags sets the tags of the tagging rules matching the reported
+// finding. For a PATCH the rules are evaluated on the existing finding with
+// the patch applied, so existing must be set.
+func (s *ServerImpl) applyFindingTags(finding *models.Finding, existing *models.Finding) error {
+	reported := *finding
+	if existing != nil {
+		if err := mergePatch(*existing, *finding, &reported); err != nil {
+			return err
+		}
+	}
+
+	taggingRules, err := s.dbHandler.TaggingRulesTable().GetTaggingRules()
+	if err != nil {
+		return fmt.Errorf("failed to get tagging rules from db: %w", err)
+	}
+
+	var targetID string
+	if reported.Asset != nil {
+		targetID = reported.Asset.Id
+	}
+	target, err := s.getTaggedTarget(targetID)
+	if err != nil {
+		return err
+	}
+
+	finding.Tags = utils.PointerTo(utils.GetFindingTags(taggingRules, target, reported))
+
+	return nil
+}
+
+// getTaggedTarget returns the target the tagging rules are evaluated on. An
+// unknown target is returned empty so that only rules without target
+// conditions match.
+func (s *ServerImpl) getTaggedTarget(targetID string) (models.Target, error) {
+	if targetID == "" {
+		return models.Target{}, nil
+	}
+
+	target, err := s.dbHandler.TargetsTable().GetTarget(targetID, models.GetTargetsTargetIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return models.Target{}, nil
+		}
+		return models.Target{}, fmt.Errorf("failed to get target from db. targetID=%v: %w", targetID, err)
+	}
+
+	return target, nil
+}
+
+// mergePatch applies patch to original as a JSON merge patch, the same way
+// the patch is applied in the DB, and stores the result in merged.
+func mergePatch(original, patch, merged interface{}) error {
+	originalBytes, err := json.Marshal(original)
+	if err != nil {
+		return fmt.Errorf("failed to marshal original object: %w", err)
+	}
+
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+
+	mergedBytes, err := jsonpatch.MergePatch(originalBytes, patchBytes)
+	if err != nil {
+		return fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	if err = json.Unmarshal(mergedBytes, merged); err != nil {
+		return fmt.Errorf("failed to unmarshal patched object: %w", err)
+	}
+
+	return nil
+}
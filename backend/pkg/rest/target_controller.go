@@ -31,12 +31,29 @@ import (
 func (s *ServerImpl) GetTargets(ctx echo.Context, params models.GetTargetsParams) error {
 	dbTargets, err := s.dbHandler.TargetsTable().GetTargets(params)
 	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get targets from db: %v", err))
 	}
 
 	return sendResponse(ctx, http.StatusOK, dbTargets)
 }
 
+func (s *ServerImpl) GetTargetsUnscanned(ctx echo.Context, params models.GetTargetsUnscannedParams) error {
+	dbTargets, err := s.dbHandler.TargetsTable().GetUnscannedTargets(params)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get unscanned targets from db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, dbTargets)
+}
+
 // nolint:cyclop
 func (s *ServerImpl) PostTargets(ctx echo.Context) error {
 	var target models.Target
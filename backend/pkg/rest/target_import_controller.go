@@ -0,0 +1,230 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+const (
+	csvContentType = "text/csv"
+
+	csvInstanceIDColumn       = "instanceid"
+	csvLocationColumn         = "location"
+	csvInstanceProviderColumn = "instanceprovider"
+)
+
+func (s *ServerImpl) PostTargetsImport(ctx echo.Context) error {
+	var rows []models.TargetImportRow
+	var err error
+	if strings.HasPrefix(ctx.Request().Header.Get(echo.HeaderContentType), csvContentType) {
+		rows, err = parseTargetImportCSV(ctx.Request().Body)
+		if err != nil {
+			return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to parse CSV: %v", err))
+		}
+	} else {
+		var targetImport models.TargetImport
+		if err = ctx.Bind(&targetImport); err != nil {
+			return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+		}
+		rows = targetImport.Targets
+	}
+
+	return sendResponse(ctx, http.StatusOK, s.importTargets(rows))
+}
+
+// importTargets creates the targets of the rows with a bounded concurrency.
+// Rows of already existing targets are skipped so that re-running an import
+// only creates the targets which failed before.
+func (s *ServerImpl) importTargets(rows []models.TargetImportRow) *models.TargetImportReport {
+	results := make([]models.TargetImportRowResult, len(rows))
+
+	// Duplicate rows are resolved before creating the targets, otherwise
+	// they would race on the target uniqueness check.
+	firstRow := make(map[string]int, len(rows))
+	sem := make(chan struct{}, s.targetImportConcurrency)
+	var wg sync.WaitGroup
+	for i, row := range rows {
+		results[i] = models.TargetImportRowResult{
+			Row:        utils.IntPtr(i + 1),
+			InstanceID: row.InstanceID,
+			Location:   row.Location,
+		}
+
+		if err := validateTargetImportRow(row); err != nil {
+			setTargetImportRowResult(&results[i], models.Failed, nil, err.Error())
+			continue
+		}
+
+		key := *row.InstanceID + "/" + *row.Location
+		if first, ok := firstRow[key]; ok {
+			setTargetImportRowResult(&results[i], models.Skipped, nil, fmt.Sprintf("duplicate of row %d", first+1))
+			continue
+		}
+		firstRow[key] = i
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *models.TargetImportRowResult, row models.TargetImportRow) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			s.importTarget(result, row)
+		}(&results[i], row)
+	}
+	wg.Wait()
+
+	report := &models.TargetImportReport{
+		Created: utils.IntPtr(0),
+		Skipped: utils.IntPtr(0),
+		Failed:  utils.IntPtr(0),
+		Rows:    &results,
+	}
+	for _, result := range results {
+		switch *result.Result {
+		case models.Created:
+			*report.Created++
+		case models.Skipped:
+			*report.Skipped++
+		case models.Failed:
+			*report.Failed++
+		}
+	}
+
+	return report
+}
+
+func (s *ServerImpl) importTarget(result *models.TargetImportRowResult, row models.TargetImportRow) {
+	info := models.TargetType{}
+	err := info.FromVMInfo(models.VMInfo{
+		InstanceID:       *row.InstanceID,
+		InstanceProvider: row.InstanceProvider,
+		Location:         *row.Location,
+	})
+	if err != nil {
+		setTargetImportRowResult(result, models.Failed, nil, fmt.Sprintf("failed to create VMInfo: %v", err))
+		return
+	}
+
+	createdTarget, err := s.dbHandler.TargetsTable().CreateTarget(models.Target{
+		TargetInfo: &info,
+	})
+	if err != nil {
+		var conflictErr *common.ConflictError
+		var validationErr *common.BadRequestError
+		switch true {
+		case errors.As(err, &conflictErr):
+			setTargetImportRowResult(result, models.Skipped, createdTarget.Id, conflictErr.Reason)
+		case errors.As(err, &validationErr):
+			setTargetImportRowResult(result, models.Failed, nil, err.Error())
+		default:
+			setTargetImportRowResult(result, models.Failed, nil, fmt.Sprintf("failed to create target in db: %v", err))
+		}
+		return
+	}
+
+	setTargetImportRowResult(result, models.Created, createdTarget.Id, "")
+}
+
+func setTargetImportRowResult(result *models.TargetImportRowResult, status models.TargetImportRowResultResult, targetID *string, message string) {
+	result.Result = &status
+	result.TargetId = targetID
+	if message != "" {
+		result.Message = &message
+	}
+}
+
+func validateTargetImportRow(row models.TargetImportRow) error {
+	if row.InstanceID == nil || *row.InstanceID == "" {
+		return errors.New("instanceID is required")
+	}
+	if row.Location == nil || *row.Location == "" {
+		return errors.New("location is required")
+	}
+	if row.InstanceProvider != nil {
+		switch *row.InstanceProvider {
+		case models.AWS, models.Azure, models.GCP:
+		default:
+			return fmt.Errorf("invalid instanceProvider %q", *row.InstanceProvider)
+		}
+	}
+
+	return nil
+}
+
+// parseTargetImportCSV parses a CSV with a header row naming the columns,
+// the columns can be in any order and their names are case-insensitive.
+func parseTargetImportCSV(r io.Reader) ([]models.TargetImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{csvInstanceIDColumn, csvLocationColumn} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing %s column", required)
+		}
+	}
+
+	column := func(record []string, name string) *string {
+		i, ok := columns[name]
+		if !ok || strings.TrimSpace(record[i]) == "" {
+			return nil
+		}
+		return utils.StringPtr(strings.TrimSpace(record[i]))
+	}
+
+	var rows []models.TargetImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read row %d: %w", len(rows)+1, err)
+		}
+
+		row := models.TargetImportRow{
+			InstanceID: column(record, csvInstanceIDColumn),
+			Location:   column(record, csvLocationColumn),
+		}
+		if provider := column(record, csvInstanceProviderColumn); provider != nil {
+			row.InstanceProvider = utils.PointerTo(models.CloudProvider(*provider))
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
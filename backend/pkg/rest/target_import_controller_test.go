@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_parseTargetImportCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		want    []models.TargetImportRow
+		wantErr bool
+	}{
+		{
+			name: "columns in any order",
+			csv: "Location, instanceID ,InstanceProvider\n" +
+				"us-east-1,i-1,AWS\n" +
+				"us-east-2, i-2 ,\n",
+			want: []models.TargetImportRow{
+				{
+					InstanceID:       utils.StringPtr("i-1"),
+					Location:         utils.StringPtr("us-east-1"),
+					InstanceProvider: utils.PointerTo(models.AWS),
+				},
+				{
+					InstanceID: utils.StringPtr("i-2"),
+					Location:   utils.StringPtr("us-east-2"),
+				},
+			},
+		},
+		{
+			name: "empty values",
+			csv: "instanceID,location\n" +
+				",us-east-1\n",
+			want: []models.TargetImportRow{
+				{
+					Location: utils.StringPtr("us-east-1"),
+				},
+			},
+		},
+		{
+			name:    "missing location column",
+			csv:     "instanceID,instanceProvider\ni-1,AWS\n",
+			wantErr: true,
+		},
+		{
+			name:    "wrong number of fields",
+			csv:     "instanceID,location\ni-1\n",
+			wantErr: true,
+		},
+		{
+			name:    "empty file",
+			csv:     "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTargetImportCSV(strings.NewReader(tt.csv))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTargetImportCSV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseTargetImportCSV() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_validateTargetImportRow(t *testing.T) {
+	tests := []struct {
+		name    string
+		row     models.TargetImportRow
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			row: models.TargetImportRow{
+				InstanceID:       utils.StringPtr("i-1"),
+				Location:         utils.StringPtr("us-east-1"),
+				InstanceProvider: utils.PointerTo(models.GCP),
+			},
+		},
+		{
+			name: "missing instance id",
+			row: models.TargetImportRow{
+				Location: utils.StringPtr("us-east-1"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing location",
+			row: models.TargetImportRow{
+				InstanceID: utils.StringPtr("i-1"),
+				Location:   utils.StringPtr(""),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid provider",
+			row: models.TargetImportRow{
+				InstanceID:       utils.StringPtr("i-1"),
+				Location:         utils.StringPtr("us-east-1"),
+				InstanceProvider: utils.PointerTo(models.CloudProvider("OpenStack")),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTargetImportRow(tt.row); (err != nil) != tt.wantErr {
+				t.Errorf("validateTargetImportRow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
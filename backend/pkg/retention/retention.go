@@ -0,0 +1,176 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	// purgeBatchSize is the number of scan results deleted per query.
+	purgeBatchSize = 100
+	// defaultInterval is used when the interval isn't positive.
+	defaultInterval = time.Hour
+)
+
+// Only the scan results which completed are purged, the results which are
+// DONE must also have been processed to findings already.
+const completedScanResultsFilter = "((status/general/state eq 'DONE' and findingsProcessed eq true) or " +
+	"status/general/state eq 'ABORTED' or status/general/state eq 'NOT_SCANNED')"
+
+// Config is the retention policy of the scan results, each limit is disabled
+// if it's not positive.
+type Config struct {
+	// The completed scan results older than MaxAge are purged.
+	MaxAge time.Duration
+	// Only the newest MaxScanResultsPerTarget completed scan results of
+	// each target are kept.
+	MaxScanResultsPerTarget int
+	// How often the policy is enforced.
+	Interval time.Duration
+}
+
+func (c Config) enabled() bool {
+	return c.MaxAge > 0 || c.MaxScanResultsPerTarget > 0
+}
+
+// Purger enforces the retention policy by deleting the scan results which
+// are out of it, together with their findings.
+type Purger struct {
+	dbHandler types.Database
+	config    Config
+}
+
+func New(dbHandler types.Database, config Config) *Purger {
+	if config.Interval <= 0 {
+		config.Interval = defaultInterval
+	}
+	return &Purger{
+		dbHandler: dbHandler,
+		config:    config,
+	}
+}
+
+// Start enforces the retention policy every interval until the context is
+// done. Nothing is started if the policy has no limits.
+func (p *Purger) Start(ctx context.Context) {
+	if !p.config.enabled() {
+		log.Infof("Scan results retention policy is disabled")
+		return
+	}
+
+	go func() {
+		for {
+			if err := p.Purge(ctx, time.Now()); err != nil {
+				log.Errorf("Failed to enforce the scan results retention policy: %v", err)
+			}
+
+			select {
+			case <-time.After(p.config.Interval):
+			case <-ctx.Done():
+				log.Infof("Stop enforcing the scan results retention policy")
+				return
+			}
+		}
+	}()
+}
+
+// Purge deletes the scan results which are out of the retention policy at
+// the given time.
+func (p *Purger) Purge(ctx context.Context, now time.Time) error {
+	if p.config.MaxAge > 0 {
+		cutoff := now.Add(-p.config.MaxAge).UTC()
+		filter := fmt.Sprintf("%s and status/general/lastTransitionTime lt %s", completedScanResultsFilter, cutoff.Format(time.RFC3339))
+		deleted, err := p.purgeScanResults(ctx, filter, 0)
+		if err != nil {
+			return fmt.Errorf("failed to purge the scan results older than %v: %w", p.config.MaxAge, err)
+		}
+		if deleted > 0 {
+			log.Infof("Purged %d scan results older than %v", deleted, p.config.MaxAge)
+		}
+	}
+
+	if p.config.MaxScanResultsPerTarget > 0 {
+		if err := p.purgeExcessScanResults(ctx); err != nil {
+			return fmt.Errorf("failed to purge the scan results beyond %d per target: %w", p.config.MaxScanResultsPerTarget, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Purger) purgeExcessScanResults(ctx context.Context) error {
+	targets, err := p.dbHandler.TargetsTable().GetTargets(models.GetTargetsParams{
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get targets: %w", err)
+	}
+
+	for _, target := range *targets.Items {
+		filter := fmt.Sprintf("target/id eq '%s' and %s", *target.Id, completedScanResultsFilter)
+		deleted, err := p.purgeScanResults(ctx, filter, p.config.MaxScanResultsPerTarget)
+		if err != nil {
+			return fmt.Errorf("failed to purge the scan results of target %s: %w", *target.Id, err)
+		}
+		if deleted > 0 {
+			log.Infof("Purged %d scan results of target %s beyond the newest %d", deleted, *target.Id, p.config.MaxScanResultsPerTarget)
+		}
+	}
+
+	return nil
+}
+
+// purgeScanResults deletes the scan results matching the filter, except for
+// the newest keep ones. It returns the number of deleted scan results.
+func (p *Purger) purgeScanResults(ctx context.Context, filter string, keep int) (int, error) {
+	var deleted int
+	for {
+		if err := ctx.Err(); err != nil {
+			return deleted, fmt.Errorf("purge was canceled: %w", err)
+		}
+
+		scanResults, err := p.dbHandler.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+			Filter:  &filter,
+			Select:  utils.PointerTo("id"),
+			OrderBy: utils.PointerTo("status/general/lastTransitionTime desc"),
+			Top:     utils.PointerTo(purgeBatchSize),
+			Skip:    &keep,
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to get scan results: %w", err)
+		}
+
+		for _, scanResult := range *scanResults.Items {
+			if err := p.dbHandler.ScanResultsTable().DeleteScanResult(*scanResult.Id); err != nil {
+				return deleted, fmt.Errorf("failed to delete scan result %s: %w", *scanResult.Id, err)
+			}
+			deleted++
+		}
+
+		if len(*scanResults.Items) < purgeBatchSize {
+			return deleted, nil
+		}
+	}
+}
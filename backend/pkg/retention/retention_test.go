@@ -0,0 +1,119 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+// fakeScanResultsTable ignores the filter and the order of the queries, its
+// scan results are ordered from the newest.
+type fakeScanResultsTable struct {
+	types.ScanResultsTable
+
+	ids []string
+}
+
+func (f *fakeScanResultsTable) GetScanResults(params models.GetScanResultsParams) (models.TargetScanResults, error) {
+	items := []models.TargetScanResult{}
+	for i := *params.Skip; i < len(f.ids) && len(items) < *params.Top; i++ {
+		id := f.ids[i]
+		items = append(items, models.TargetScanResult{Id: &id})
+	}
+	return models.TargetScanResults{Items: &items}, nil
+}
+
+func (f *fakeScanResultsTable) DeleteScanResult(scanResultID models.ScanResultID) error {
+	for i, id := range f.ids {
+		if id == scanResultID {
+			f.ids = append(f.ids[:i], f.ids[i+1:]...)
+			return nil
+		}
+	}
+	return types.ErrNotFound
+}
+
+type fakeDatabase struct {
+	types.Database
+
+	scanResultsTable *fakeScanResultsTable
+}
+
+func (f *fakeDatabase) ScanResultsTable() types.ScanResultsTable {
+	return f.scanResultsTable
+}
+
+func TestPurger_purgeScanResults(t *testing.T) {
+	tests := []struct {
+		name        string
+		scanResults int
+		keep        int
+		wantDeleted int
+	}{
+		{
+			name:        "no scan results",
+			scanResults: 0,
+			keep:        0,
+			wantDeleted: 0,
+		},
+		{
+			name:        "all scan results are deleted over several batches",
+			scanResults: 2*purgeBatchSize + 1,
+			keep:        0,
+			wantDeleted: 2*purgeBatchSize + 1,
+		},
+		{
+			name:        "the newest scan results are kept",
+			scanResults: purgeBatchSize + 5,
+			keep:        3,
+			wantDeleted: purgeBatchSize + 2,
+		},
+		{
+			name:        "fewer scan results than kept",
+			scanResults: 2,
+			keep:        3,
+			wantDeleted: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := make([]string, tt.scanResults)
+			for i := range ids {
+				ids[i] = fmt.Sprintf("scan-result-%d", i)
+			}
+			scanResultsTable := &fakeScanResultsTable{ids: append([]string{}, ids...)}
+			p := New(&fakeDatabase{scanResultsTable: scanResultsTable}, Config{})
+
+			deleted, err := p.purgeScanResults(context.Background(), "filter", tt.keep)
+			if err != nil {
+				t.Fatalf("purgeScanResults() error = %v", err)
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("purgeScanResults() deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+			if diff := cmp.Diff(ids[:tt.scanResults-tt.wantDeleted], scanResultsTable.ids); diff != "" {
+				t.Errorf("purgeScanResults() kept scan results mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
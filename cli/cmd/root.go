@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -42,7 +43,12 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
 )
 
-const DefaultWatcherInterval = 2 * time.Minute
+const (
+	DefaultWatcherInterval = 2 * time.Minute
+	// Checking the abort file of a local scan is cheap, so it's checked
+	// more often than the state of the scan result on the VMClarity server.
+	LocalWatcherInterval = 5 * time.Second
+)
 
 var (
 	cfgFile string
@@ -51,9 +57,21 @@ var (
 	output  string
 
 	server                string
+	serverTimeout         time.Duration
+	serverMaxAttempts     int
+	serverRetryInterval   time.Duration
+	caBundle              string
 	scanResultID          string
 	mountVolume           bool
+	partitions            []string
 	waitForServerAttached bool
+	directReadSnapshots   []string
+	directReadDevices     []string
+	directReadRegion      string
+	progressFormat        string
+	progressOutput        string
+	abortFile             string
+	gatingPolicyFile      string
 )
 
 // rootCmd represents the base command when called without any subcommands.
@@ -80,7 +98,11 @@ var rootCmd = &cobra.Command{
 		defer cancel()
 
 		// Start watching for abort event
-		cli.WatchForAbort(ctx, cancel, DefaultWatcherInterval)
+		watcherInterval := DefaultWatcherInterval
+		if server == "" {
+			watcherInterval = LocalWatcherInterval
+		}
+		cli.WatchForAbort(ctx, cancel, watcherInterval)
 
 		if waitForServerAttached {
 			if err := cli.WaitForVolumeAttachment(abortCtx); err != nil {
@@ -92,8 +114,18 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
+		if len(directReadSnapshots) > 0 {
+			if err := cli.ReadSnapshotsDirectly(abortCtx, directReadRegion, directReadSnapshots, directReadDevices); err != nil {
+				err = fmt.Errorf("failed to read snapshots directly: %w", err)
+				if e := cli.MarkDone(ctx, []error{err}); e != nil {
+					logger.Errorf("Failed to update scan result stat to completed with errors: %v", e)
+				}
+				return err
+			}
+		}
+
 		if mountVolume {
-			mountPoints, err := cli.MountVolumes(abortCtx)
+			mountPoints, scannedPartitions, err := cli.MountVolumes(abortCtx, partitions)
 			if err != nil {
 				err = fmt.Errorf("failed to mount attached volume: %w", err)
 				if e := cli.MarkDone(ctx, []error{err}); e != nil {
@@ -101,6 +133,9 @@ var rootCmd = &cobra.Command{
 				}
 				return err
 			}
+			if err := cli.SetScannedPartitions(ctx, scannedPartitions); err != nil {
+				logger.Errorf("Failed to report the scanned partitions: %v", err)
+			}
 			setMountPointsForFamiliesInput(mountPoints, config)
 		}
 
@@ -114,12 +149,18 @@ var rootCmd = &cobra.Command{
 
 		logger.Infof("Exporting results...")
 		errs := cli.ExportResults(abortCtx, res, familiesErr)
+		if err := cli.SetResults(ctx, res); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set results: %w", err))
+		}
 
 		if len(familiesErr) > 0 {
 			errs = append(errs, fmt.Errorf("at least one family failed to run"))
 		}
 
 		err = cli.MarkDone(ctx, errs)
+		if errors.Is(err, state.ErrGatingPolicyViolated) {
+			return err
+		}
 		if err != nil {
 			return fmt.Errorf("failed to inform the server %v the scan was completed: %w", server, err)
 		}
@@ -151,14 +192,27 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.vmclarity.yaml)")
 	rootCmd.PersistentFlags().StringVar(&output, "output", "", "set output directory path. Stdout is used if not set.")
 	rootCmd.PersistentFlags().StringVar(&server, "server", "", "VMClarity server to export scan results to, for example: http://localhost:9999/api")
+	rootCmd.PersistentFlags().DurationVar(&serverTimeout, "server-timeout", 30*time.Second, "timeout of a single request to the VMClarity server")
+	rootCmd.PersistentFlags().IntVar(&serverMaxAttempts, "server-max-attempts", 5, "number of attempts of a request to the VMClarity server which fails with a transient error")
+	rootCmd.PersistentFlags().DurationVar(&serverRetryInterval, "server-retry-interval", time.Second, "initial interval between the attempts of a request to the VMClarity server, increased exponentially")
+	rootCmd.PersistentFlags().StringVar(&caBundle, "ca-bundle", "", "PEM CA bundle trusted on top of the system certificates by the requests to the VMClarity server and the scanners downloading over HTTPS, e.g. of a TLS intercepting proxy")
 	rootCmd.PersistentFlags().StringVar(&scanResultID, "scan-result-id", "", "the ScanResult ID to export the scan results to")
 	rootCmd.PersistentFlags().BoolVar(&mountVolume, "mount-attached-volume", false, "discover for an attached volume and mount it before the scan")
+	rootCmd.PersistentFlags().StringSliceVar(&partitions, "partitions", nil, "filesystem labels, filesystem UUIDs or device names of the partitions of the attached volume to mount, all of them if not set")
 	rootCmd.PersistentFlags().BoolVar(&waitForServerAttached, "wait-for-server-attached", false, "wait for the VMClarity server to attach the volume")
+	rootCmd.PersistentFlags().StringSliceVar(&directReadSnapshots, "direct-read-snapshots", nil, "IDs of the EBS snapshots to read through the EBS direct APIs to the empty scratch volumes attached with the direct read devices instead of attached volumes, the root volume first")
+	rootCmd.PersistentFlags().StringSliceVar(&directReadDevices, "direct-read-devices", nil, "device names the scratch volumes of the snapshots to read directly are attached with, in the order of the snapshots")
+	rootCmd.PersistentFlags().StringVar(&directReadRegion, "direct-read-region", "", "AWS region of the snapshots to read directly")
+	rootCmd.PersistentFlags().StringVar(&progressFormat, "progress-format", string(state.ProgressFormatText), "format of the scan progress reported when no VMClarity server is set (text or json)")
+	rootCmd.PersistentFlags().StringVar(&progressOutput, "progress-output", "", "file to write the json scan progress events to. Stdout is used if not set.")
+	rootCmd.PersistentFlags().StringVar(&abortFile, "abort-file", "", "abort the scan once this file is created when no VMClarity server is set")
+	rootCmd.PersistentFlags().StringVar(&gatingPolicyFile, "gating-policy", "", "YAML file with the maximum numbers of findings per family and severity, the scan fails with a non-zero exit code when they are exceeded and no VMClarity server is set")
 
 	// TODO(sambetts) we may have to change this to our own validation when
 	// we add the CI/CD scenario and there isn't an existing scan-result-id
 	// in the backend to PATCH
 	rootCmd.MarkFlagsRequiredTogether("server", "scan-result-id")
+	rootCmd.MarkFlagsRequiredTogether("direct-read-snapshots", "direct-read-region")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -189,10 +243,16 @@ func initConfig() {
 	config = &families.Config{}
 	err = viper.Unmarshal(config)
 	cobra.CheckErr(err)
+	if caBundle != "" {
+		config.SetCABundlePath(caBundle)
+	}
 
 	if logrus.IsLevelEnabled(logrus.InfoLevel) {
 		configB, err := yaml.Marshal(config)
 		cobra.CheckErr(err)
+		// Don't leak credentials (registry auths...) to the logs.
+		configB, err = families.RedactConfigYAML(configB)
+		cobra.CheckErr(err)
 		logrus.Infof("Using config file (%s):\n%s", viper.ConfigFileUsed(), string(configB))
 	}
 }
@@ -216,7 +276,12 @@ func newCli() (*cli.CLI, error) {
 		var client *backendclient.BackendClient
 		var p presenter.Presenter
 
-		client, err = backendclient.Create(server)
+		client, err = backendclient.Create(server, backendclient.Config{
+			Timeout:       serverTimeout,
+			MaxAttempts:   serverMaxAttempts,
+			RetryInterval: serverRetryInterval,
+			CABundlePath:  caBundle,
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create VMClarity API client: %w", err)
 		}
@@ -232,7 +297,21 @@ func newCli() (*cli.CLI, error) {
 		}
 		presenters = append(presenters, p)
 	} else {
-		manager, err = state.NewLocalState()
+		progressWriter := io.Writer(os.Stdout)
+		if progressOutput != "" {
+			progressWriter, err = os.OpenFile(progressOutput, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open progress output file: %w", err)
+			}
+		}
+		var gatingPolicy state.GatingPolicy
+		if gatingPolicyFile != "" {
+			gatingPolicy, err = state.LoadGatingPolicy(gatingPolicyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load gating policy: %w", err)
+			}
+		}
+		manager, err = state.NewLocalState(progressWriter, state.ProgressFormat(progressFormat), abortFile, gatingPolicy)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create local state: %w", err)
 		}
@@ -19,16 +19,25 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	uuid "github.com/satori/go.uuid"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/cli/pkg/directread"
 	"github.com/openclarity/vmclarity/cli/pkg/mount"
 	"github.com/openclarity/vmclarity/cli/pkg/presenter"
 	"github.com/openclarity/vmclarity/cli/pkg/state"
 	"github.com/openclarity/vmclarity/shared/pkg/families"
 	"github.com/openclarity/vmclarity/shared/pkg/families/results"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 const (
@@ -43,30 +52,119 @@ type CLI struct {
 	FamiliesConfig *families.Config
 }
 
-func (c *CLI) MountVolumes(ctx context.Context) ([]string, error) {
+// MountVolumes mounts the partitions of the attached volume. If partitions is
+// not empty, only the partitions matching one of its filesystem labels,
+// filesystem UUIDs or device names are mounted. It returns the mount points
+// and the partitions found on the attached volume, noting which of them were
+// not scanned.
+func (c *CLI) MountVolumes(ctx context.Context, partitions []string) ([]string, []models.ScannedPartition, error) {
 	var mountPoints []string
+	var scannedPartitions []models.ScannedPartition
 
 	devices, err := mount.ListBlockDevices()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list block devices: %v", err)
+		return nil, nil, fmt.Errorf("failed to list block devices: %v", err)
 	}
 	for _, device := range devices {
-		// if the device is not mounted and of a supported filesystem type,
-		// we assume it belongs to the attached volume, so we mount it.
-		if device.MountPoint == "" && isSupportedFS(device.FilesystemType) {
+		// if the device is not mounted and has a filesystem, we assume it
+		// belongs to the attached volume.
+		if device.MountPoint != "" || device.FilesystemType == "" {
+			continue
+		}
+
+		scannedPartition := models.ScannedPartition{
+			DeviceName:     utils.PointerTo(device.DeviceName),
+			Label:          utils.PointerTo(device.Label),
+			Uuid:           utils.PointerTo(device.UUID),
+			FilesystemType: utils.PointerTo(device.FilesystemType),
+			Scanned:        utils.PointerTo(false),
+		}
+
+		switch {
+		case !isSupportedFS(device.FilesystemType):
+			scannedPartition.Reason = utils.PointerTo(fmt.Sprintf("unsupported filesystem type %s", device.FilesystemType))
+		case !isSelectedPartition(device, partitions):
+			scannedPartition.Reason = utils.PointerTo("partition was not selected for scanning")
+		default:
 			mountDir := "/mnt/snapshot" + uuid.NewV4().String()
 
 			if err := device.Mount(mountDir); err != nil {
-				return nil, fmt.Errorf("failed to mount device: %v", err)
+				return nil, nil, fmt.Errorf("failed to mount device: %v", err)
 			}
 			log.Infof("Device %v on %v is mounted", device.DeviceName, mountDir)
 			mountPoints = append(mountPoints, mountDir)
+			scannedPartition.MountPoint = utils.PointerTo(mountDir)
+			scannedPartition.Scanned = utils.PointerTo(true)
 		}
+		if !*scannedPartition.Scanned {
+			log.Infof("Device %v is not scanned: %s", device.DeviceName, *scannedPartition.Reason)
+		}
+		scannedPartitions = append(scannedPartitions, scannedPartition)
+
 		if ctx.Err() != nil {
-			return mountPoints, fmt.Errorf("failed to mount block devices: %w", ctx.Err())
+			return mountPoints, scannedPartitions, fmt.Errorf("failed to mount block devices: %w", ctx.Err())
 		}
 	}
-	return mountPoints, nil
+	return mountPoints, scannedPartitions, nil
+}
+
+// ReadSnapshotsDirectly reads the blocks of the snapshots in the region
+// through the EBS direct APIs to the scratch volumes of the scanner instance
+// attached with the device names, so that MountVolumes mounts their partitions
+// the same way as the partitions of an attached volume.
+func (c *CLI) ReadSnapshotsDirectly(ctx context.Context, region string, snapshotIDs, deviceNames []string) error {
+	if len(deviceNames) != len(snapshotIDs) {
+		return fmt.Errorf("%d device names are set for %d snapshots", len(deviceNames), len(snapshotIDs))
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+	if err != nil {
+		return fmt.Errorf("failed to load aws config: %v", err)
+	}
+	client := ebs.NewFromConfig(cfg)
+
+	// The volume IDs are the serials of the NVMe devices of the volumes, the
+	// devices of the volumes are named after their device names otherwise.
+	volumeIDs, err := findScratchVolumeIDs(ctx, cfg)
+	if err != nil {
+		log.Warningf("Matching the scratch volumes by their device names only: %v", err)
+	}
+	devices, err := directread.FindScratchDevices()
+	if err != nil {
+		return fmt.Errorf("failed to find scratch devices: %w", err)
+	}
+	for i, snapshotID := range snapshotIDs {
+		volume := directread.ScratchVolume{
+			DeviceName: deviceNames[i],
+			VolumeID:   volumeIDs[deviceNames[i]],
+		}
+		device, err := directread.ReadSnapshot(ctx, client, snapshotID, devices, volume, directread.DefaultConcurrency)
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot %v: %w", snapshotID, err)
+		}
+		if err := directread.RereadPartitions(device); err != nil {
+			return fmt.Errorf("failed to read the partitions of snapshot %v: %w", snapshotID, err)
+		}
+		log.Infof("Snapshot %v is read to %v", snapshotID, device)
+	}
+
+	return nil
+}
+
+// findScratchVolumeIDs returns the IDs of the volumes attached to the scanner
+// instance by their device name.
+func findScratchVolumeIDs(ctx context.Context, cfg aws.Config) (map[string]string, error) {
+	out, err := imds.NewFromConfig(cfg).GetMetadata(ctx, &imds.GetMetadataInput{Path: "instance-id"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the instance ID: %w", err)
+	}
+	defer out.Content.Close()
+	instanceID, err := io.ReadAll(out.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the instance ID: %w", err)
+	}
+
+	return directread.FindVolumeIDs(ctx, ec2.NewFromConfig(cfg), string(instanceID))
 }
 
 //nolint:cyclop
@@ -152,6 +250,24 @@ func (c *CLI) WatchForAbort(ctx context.Context, cancel context.CancelFunc, inte
 	}()
 }
 
+// isSelectedPartition returns true if partitions is empty, or if one of them
+// matches the filesystem label, the filesystem UUID or the device name of the
+// device.
+func isSelectedPartition(device mount.BlockDevice, partitions []string) bool {
+	if len(partitions) == 0 {
+		return true
+	}
+	for _, partition := range partitions {
+		if partition == "" {
+			continue
+		}
+		if partition == device.Label || partition == device.UUID || partition == device.DeviceName {
+			return true
+		}
+	}
+	return false
+}
+
 func isSupportedFS(fs string) bool {
 	switch fs {
 	case fsTypeExt4, fsTypeXFS:
@@ -17,6 +17,8 @@ package cli
 
 import (
 	"testing"
+
+	"github.com/openclarity/vmclarity/cli/pkg/mount"
 )
 
 func Test_isSupportedFS(t *testing.T) {
@@ -58,3 +60,63 @@ func Test_isSupportedFS(t *testing.T) {
 		})
 	}
 }
+
+func Test_isSelectedPartition(t *testing.T) {
+	device := mount.BlockDevice{
+		DeviceName:     "xvdf1",
+		Label:          "cloudimg-rootfs",
+		UUID:           "8b7a9b5d-4c3e-4b8e-9d3a-1f2e3d4c5b6a",
+		FilesystemType: fsTypeExt4,
+	}
+	type args struct {
+		partitions []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "all partitions are selected when not set",
+			args: args{
+				partitions: nil,
+			},
+			want: true,
+		},
+		{
+			name: "selected by label",
+			args: args{
+				partitions: []string{"data", "cloudimg-rootfs"},
+			},
+			want: true,
+		},
+		{
+			name: "selected by uuid",
+			args: args{
+				partitions: []string{"8b7a9b5d-4c3e-4b8e-9d3a-1f2e3d4c5b6a"},
+			},
+			want: true,
+		},
+		{
+			name: "selected by device name",
+			args: args{
+				partitions: []string{"xvdf1"},
+			},
+			want: true,
+		},
+		{
+			name: "not selected",
+			args: args{
+				partitions: []string{"data", "xvdf2"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSelectedPartition(device, tt.args.partitions); got != tt.want {
+				t.Errorf("isSelectedPartition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
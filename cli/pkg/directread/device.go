@@ -0,0 +1,220 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directread
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+var pairsRE = regexp.MustCompile(`([A-Z]+)=(?:"(.*?)")`)
+
+// ScratchDevices are the empty disks of the scanner instance which the
+// snapshots are read to. The scanner instance is launched with a scratch
+// volume of the size of each snapshotted volume, they are told apart from the
+// other disks by having no partitions and no filesystem, and not being
+// mounted.
+type ScratchDevices struct {
+	// dir holds the device files, it's /dev unless in the tests.
+	dir     string
+	devices []scratchDevice
+}
+
+type scratchDevice struct {
+	name string
+	// serial is the ID of the EBS volume without its dash, such as
+	// vol0123456789abcdef0, on the Nitro instances which attach the volumes
+	// as NVMe devices.
+	serial string
+	size   uint64
+}
+
+// ScratchVolume is the scratch volume a snapshot is read to.
+type ScratchVolume struct {
+	// DeviceName is the device name the volume is attached with, such as
+	// xvdh.
+	DeviceName string
+	// VolumeID is the ID of the EBS volume, the volume is only matched by
+	// its device name if not set.
+	VolumeID string
+}
+
+// EC2Client describes the scanner instance, it is implemented by *ec2.Client.
+type EC2Client interface {
+	DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+}
+
+// FindVolumeIDs returns the IDs of the EBS volumes attached to the instance by
+// their device name.
+func FindVolumeIDs(ctx context.Context, client EC2Client, instanceID string) (map[string]string, error) {
+	out, err := client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe instance %s: %w", instanceID, err)
+	}
+
+	volumeIDs := make(map[string]string)
+	for _, reservation := range out.Reservations {
+		for _, instance := range reservation.Instances {
+			for _, mapping := range instance.BlockDeviceMappings {
+				if mapping.DeviceName == nil || mapping.Ebs == nil || mapping.Ebs.VolumeId == nil {
+					continue
+				}
+				volumeIDs[*mapping.DeviceName] = *mapping.Ebs.VolumeId
+			}
+		}
+	}
+
+	return volumeIDs, nil
+}
+
+// FindScratchDevices lists the empty disks of the scanner instance.
+func FindScratchDevices() (*ScratchDevices, error) {
+	//nolint: gosec
+	output, err := exec.Command(
+		"lsblk",
+		"-b", // output size in bytes
+		"-P", // output fields as key=value pairs
+		"-o", "NAME,SERIAL,SIZE,TYPE,FSTYPE,PKNAME,MOUNTPOINT",
+	).Output()
+	if err != nil {
+		return nil, fmt.Errorf("cannot list block devices: %v", err)
+	}
+
+	devices, err := parseScratchDevices(output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScratchDevices{
+		dir:     "/dev",
+		devices: devices,
+	}, nil
+}
+
+// Take returns the name of the scratch device of the volume, which must be of
+// the size in bytes, the device isn't returned again. The device is the NVMe
+// device with the serial of the volume ID on the Nitro instances, and the
+// device with the Xen name of the device name of the volume on the others.
+func (d *ScratchDevices) Take(volume ScratchVolume, size uint64) (string, error) {
+	serial := strings.Replace(volume.VolumeID, "vol-", "vol", 1)
+	name := xenDeviceName(volume.DeviceName)
+	for i, device := range d.devices {
+		if (serial == "" || device.serial != serial) && device.name != name {
+			continue
+		}
+		if device.size != size {
+			return "", fmt.Errorf("empty disk %s of volume %s is of %d bytes instead of %d", device.name, volume.DeviceName, device.size, size)
+		}
+		d.devices = append(d.devices[:i], d.devices[i+1:]...)
+		return device.name, nil
+	}
+
+	return "", fmt.Errorf("no empty disk of volume %s (%s) is left", volume.DeviceName, volume.VolumeID)
+}
+
+// xenDeviceName returns the name the Xen instances give to the device of the
+// device name, such as xvdh for /dev/sdh.
+func xenDeviceName(deviceName string) string {
+	name := strings.TrimPrefix(deviceName, "/dev/")
+	if strings.HasPrefix(name, "sd") {
+		name = "xvd" + strings.TrimPrefix(name, "sd")
+	}
+
+	return name
+}
+
+// parseScratchDevices returns the disks of the lsblk output which have no
+// partitions and no filesystem, and aren't mounted.
+func parseScratchDevices(output []byte) ([]scratchDevice, error) {
+	type blockDevice struct {
+		scratchDevice
+		deviceType     string
+		filesystemType string
+		parent         string
+		mountPoint     string
+	}
+
+	var blockDevices []blockDevice
+	s := bufio.NewScanner(bytes.NewReader(output))
+	for s.Scan() {
+		var dev blockDevice
+		for _, pair := range pairsRE.FindAllStringSubmatch(s.Text(), -1) {
+			switch pair[1] {
+			case "NAME":
+				dev.name = pair[2]
+			case "SERIAL":
+				dev.serial = pair[2]
+			case "SIZE":
+				size, err := strconv.ParseUint(pair[2], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid size %q of device %s from lsblk: %v", pair[2], dev.name, err)
+				}
+				dev.size = size
+			case "TYPE":
+				dev.deviceType = pair[2]
+			case "FSTYPE":
+				dev.filesystemType = pair[2]
+			case "PKNAME":
+				dev.parent = pair[2]
+			case "MOUNTPOINT":
+				dev.mountPoint = pair[2]
+			}
+		}
+		blockDevices = append(blockDevices, dev)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("cannot parse lsblk output: %v", err)
+	}
+
+	parents := make(map[string]bool)
+	for _, dev := range blockDevices {
+		if dev.parent != "" {
+			parents[dev.parent] = true
+		}
+	}
+	var devices []scratchDevice
+	for _, dev := range blockDevices {
+		if dev.deviceType != "disk" || dev.filesystemType != "" || dev.mountPoint != "" || parents[dev.name] {
+			continue
+		}
+		devices = append(devices, dev.scratchDevice)
+	}
+
+	return devices, nil
+}
+
+// RereadPartitions makes the kernel read the partition table of the snapshot
+// which was read to the device, so that its partitions are listed as block
+// devices and mounted as the partitions of an attached volume.
+func RereadPartitions(device string) error {
+	//nolint: gosec
+	output, err := exec.Command("blockdev", "--rereadpt", "/dev/"+device).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to read the partition table of %s: %v: %s", device, err, bytes.TrimSpace(output))
+	}
+
+	return nil
+}
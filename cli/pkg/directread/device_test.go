@@ -0,0 +1,214 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directread
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_parseScratchDevices(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    []scratchDevice
+		wantErr bool
+	}{
+		{
+			name: "empty disks",
+			output: `NAME="loop0" SERIAL="" SIZE="58363904" TYPE="loop" FSTYPE="squashfs" PKNAME="" MOUNTPOINT="/snap/core18/2708"
+NAME="nvme0n1" SERIAL="vol0123456789abcdef0" SIZE="8589934592" TYPE="disk" FSTYPE="" PKNAME="" MOUNTPOINT=""
+NAME="nvme0n1p1" SERIAL="" SIZE="8475630080" TYPE="part" FSTYPE="ext4" PKNAME="nvme0n1" MOUNTPOINT="/"
+NAME="nvme0n1p15" SERIAL="" SIZE="111149056" TYPE="part" FSTYPE="vfat" PKNAME="nvme0n1" MOUNTPOINT="/boot/efi"
+NAME="nvme1n1" SERIAL="vol0123456789abcdef1" SIZE="8589934592" TYPE="disk" FSTYPE="" PKNAME="" MOUNTPOINT=""
+NAME="nvme2n1" SERIAL="vol0123456789abcdef2" SIZE="21474836480" TYPE="disk" FSTYPE="" PKNAME="" MOUNTPOINT=""
+NAME="nvme3n1" SERIAL="vol0123456789abcdef3" SIZE="10737418240" TYPE="disk" FSTYPE="xfs" PKNAME="" MOUNTPOINT=""
+NAME="nvme4n1" SERIAL="vol0123456789abcdef4" SIZE="10737418240" TYPE="disk" FSTYPE="" PKNAME="" MOUNTPOINT="[SWAP]"
+`,
+			want: []scratchDevice{
+				{name: "nvme1n1", serial: "vol0123456789abcdef1", size: 8589934592},
+				{name: "nvme2n1", serial: "vol0123456789abcdef2", size: 21474836480},
+			},
+		},
+		{
+			name: "xen disks",
+			output: `NAME="xvda" SERIAL="" SIZE="8589934592" TYPE="disk" FSTYPE="" PKNAME="" MOUNTPOINT=""
+NAME="xvda1" SERIAL="" SIZE="8588869120" TYPE="part" FSTYPE="ext4" PKNAME="xvda" MOUNTPOINT="/"
+NAME="xvdh" SERIAL="" SIZE="8589934592" TYPE="disk" FSTYPE="" PKNAME="" MOUNTPOINT=""
+`,
+			want: []scratchDevice{
+				{name: "xvdh", size: 8589934592},
+			},
+		},
+		{
+			name: "no empty disk",
+			output: `NAME="nvme0n1" SIZE="8589934592" TYPE="disk" FSTYPE="" PKNAME="" MOUNTPOINT=""
+NAME="nvme0n1p1" SIZE="8475630080" TYPE="part" FSTYPE="ext4" PKNAME="nvme0n1" MOUNTPOINT="/"
+`,
+		},
+		{
+			name:    "invalid size",
+			output:  `NAME="nvme1n1" SIZE="8G" TYPE="disk" FSTYPE="" PKNAME="" MOUNTPOINT=""`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseScratchDevices([]byte(tt.output))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseScratchDevices() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(scratchDevice{})); diff != "" {
+				t.Errorf("parseScratchDevices() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScratchDevices_Take(t *testing.T) {
+	devices := &ScratchDevices{
+		devices: []scratchDevice{
+			{name: "nvme1n1", serial: "vol0123456789abcdef0", size: 8 * gibToByte},
+			{name: "nvme2n1", serial: "vol0123456789abcdef1", size: 8 * gibToByte},
+			{name: "xvdh", size: 20 * gibToByte},
+			{name: "xvdi", size: 8 * gibToByte},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		volume  ScratchVolume
+		size    uint64
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "nvme device of the volume ID",
+			volume: ScratchVolume{DeviceName: "xvdh", VolumeID: "vol-0123456789abcdef1"},
+			size:   8 * gibToByte,
+			want:   "nvme2n1",
+		},
+		{
+			name:    "device already taken",
+			volume:  ScratchVolume{DeviceName: "xvdj", VolumeID: "vol-0123456789abcdef1"},
+			size:    8 * gibToByte,
+			wantErr: true,
+		},
+		{
+			name:   "xen device of the device name",
+			volume: ScratchVolume{DeviceName: "/dev/sdh"},
+			size:   20 * gibToByte,
+			want:   "xvdh",
+		},
+		{
+			name:    "device of another size",
+			volume:  ScratchVolume{DeviceName: "xvdi"},
+			size:    20 * gibToByte,
+			wantErr: true,
+		},
+		{
+			name:    "no device of the volume",
+			volume:  ScratchVolume{DeviceName: "xvdk", VolumeID: "vol-0123456789abcdef2"},
+			size:    8 * gibToByte,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := devices.Take(tt.volume, tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Take() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Take() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeEC2Client struct {
+	instances map[string]ec2types.Instance
+}
+
+func (c *fakeEC2Client) DescribeInstances(_ context.Context, params *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	instance, ok := c.instances[params.InstanceIds[0]]
+	if !ok {
+		return nil, fmt.Errorf("instance %s not found", params.InstanceIds[0])
+	}
+	return &ec2.DescribeInstancesOutput{
+		Reservations: []ec2types.Reservation{
+			{Instances: []ec2types.Instance{instance}},
+		},
+	}, nil
+}
+
+func TestFindVolumeIDs(t *testing.T) {
+	client := &fakeEC2Client{
+		instances: map[string]ec2types.Instance{
+			"i-1": {
+				BlockDeviceMappings: []ec2types.InstanceBlockDeviceMapping{
+					{
+						DeviceName: utils.PointerTo("/dev/xvda"),
+						Ebs:        &ec2types.EbsInstanceBlockDevice{VolumeId: utils.PointerTo("vol-1")},
+					},
+					{
+						DeviceName: utils.PointerTo("xvdh"),
+						Ebs:        &ec2types.EbsInstanceBlockDevice{VolumeId: utils.PointerTo("vol-2")},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		instanceID string
+		want       map[string]string
+		wantErr    bool
+	}{
+		{
+			name:       "volumes by device name",
+			instanceID: "i-1",
+			want: map[string]string{
+				"/dev/xvda": "vol-1",
+				"xvdh":      "vol-2",
+			},
+		},
+		{
+			name:       "unknown instance",
+			instanceID: "i-2",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindVolumeIDs(context.Background(), client, tt.instanceID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindVolumeIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FindVolumeIDs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
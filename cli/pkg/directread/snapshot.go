@@ -0,0 +1,167 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package directread reads the blocks of the snapshots through the EBS direct
+// APIs to the scratch volumes of the scanner instance, so that a volume created
+// from the snapshot doesn't need to be attached to the instance.
+package directread
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
+	ebstypes "github.com/aws/aws-sdk-go-v2/service/ebs/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/ebsblocks"
+)
+
+const (
+	// DefaultConcurrency is the number of blocks read concurrently.
+	DefaultConcurrency = 32
+
+	gibToByte               = 1024 * 1024 * 1024
+	checksumAlgorithmSHA256 = "SHA256"
+)
+
+// EBSClient reads the blocks of a snapshot, it is implemented by *ebs.Client.
+type EBSClient interface {
+	ebsblocks.Client
+	GetSnapshotBlock(ctx context.Context, params *ebs.GetSnapshotBlockInput, optFns ...func(*ebs.Options)) (*ebs.GetSnapshotBlockOutput, error)
+}
+
+// ReadSnapshot writes the blocks of the snapshot at their offsets on the
+// scratch device of the volume, which must be of the size of the snapshotted
+// volume, and returns the name of the device. Only the blocks which hold data
+// are listed by the EBS direct APIs, the others are left zeroed as on the new
+// volume.
+func ReadSnapshot(ctx context.Context, client EBSClient, snapshotID string, devices *ScratchDevices, volume ScratchVolume, concurrency int) (string, error) {
+	snapshotBlocks, err := ebsblocks.ListSnapshotBlocks(ctx, client, snapshotID)
+	if err != nil {
+		return "", err
+	}
+	if snapshotBlocks.BlockSize <= 0 || snapshotBlocks.VolumeSize <= 0 {
+		return "", fmt.Errorf("block size or volume size of snapshot %s is not set", snapshotID)
+	}
+
+	device, err := devices.Take(volume, uint64(snapshotBlocks.VolumeSize)*gibToByte)
+	if err != nil {
+		return "", fmt.Errorf("failed to find a scratch device for snapshot %s: %w", snapshotID, err)
+	}
+	log.Infof("Reading %d blocks of %d bytes of snapshot %s to %s", len(snapshotBlocks.Blocks), snapshotBlocks.BlockSize, snapshotID, device)
+
+	file, err := os.OpenFile(filepath.Join(devices.dir, device), os.O_WRONLY, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to open scratch device %s: %v", device, err)
+	}
+	defer file.Close()
+
+	if err := readBlocks(ctx, client, snapshotID, snapshotBlocks, file, concurrency); err != nil {
+		return "", err
+	}
+	if err := file.Sync(); err != nil {
+		return "", fmt.Errorf("failed to flush scratch device %s: %v", device, err)
+	}
+
+	return device, nil
+}
+
+// readBlocks reads the blocks of the snapshot concurrently, and writes them at
+// their offsets to the device.
+func readBlocks(ctx context.Context, client EBSClient, snapshotID string, snapshotBlocks ebsblocks.SnapshotBlocks, device io.WriterAt, concurrency int) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	blocksChan := make(chan ebstypes.Block)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for block := range blocksChan {
+				if err := readBlock(ctx, client, snapshotID, block, snapshotBlocks.BlockSize, device); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+send:
+	for _, block := range snapshotBlocks.Blocks {
+		select {
+		case blocksChan <- block:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(blocksChan)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to read the blocks of snapshot %s: %w", snapshotID, err)
+	}
+
+	return nil
+}
+
+// readBlock reads the block and writes it at its offset on the device, after
+// verifying its checksum.
+func readBlock(ctx context.Context, client EBSClient, snapshotID string, block ebstypes.Block, blockSize int64, device io.WriterAt) error {
+	index := *block.BlockIndex
+
+	out, err := client.GetSnapshotBlock(ctx, &ebs.GetSnapshotBlockInput{
+		SnapshotId: &snapshotID,
+		BlockIndex: block.BlockIndex,
+		BlockToken: block.BlockToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get block %d of snapshot %s: %v", index, snapshotID, err)
+	}
+	defer out.BlockData.Close()
+
+	data, err := io.ReadAll(out.BlockData)
+	if err != nil {
+		return fmt.Errorf("failed to read block %d of snapshot %s: %v", index, snapshotID, err)
+	}
+	if out.Checksum != nil && string(out.ChecksumAlgorithm) == checksumAlgorithmSHA256 {
+		sum := sha256.Sum256(data)
+		if checksum := base64.StdEncoding.EncodeToString(sum[:]); checksum != *out.Checksum {
+			return fmt.Errorf("checksum mismatch of block %d of snapshot %s", index, snapshotID)
+		}
+	}
+
+	if _, err := device.WriteAt(data, int64(index)*blockSize); err != nil {
+		return fmt.Errorf("failed to write block %d of snapshot %s: %v", index, snapshotID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directread
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
+	ebstypes "github.com/aws/aws-sdk-go-v2/service/ebs/types"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+const testBlockSize = 4
+
+// fakeEBSClient serves the blocks of a 1 GiB snapshot, listing one block per
+// page.
+type fakeEBSClient struct {
+	blocks       map[int32][]byte
+	badChecksums map[int32]bool
+}
+
+func (c *fakeEBSClient) ListSnapshotBlocks(_ context.Context, params *ebs.ListSnapshotBlocksInput, _ ...func(*ebs.Options)) (*ebs.ListSnapshotBlocksOutput, error) {
+	var start int32
+	if params.NextToken != nil {
+		if _, err := fmt.Sscan(*params.NextToken, &start); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &ebs.ListSnapshotBlocksOutput{
+		BlockSize:  utils.PointerTo(int32(testBlockSize)),
+		VolumeSize: utils.PointerTo(int64(1)),
+	}
+	for index := start; index < 1000; index++ {
+		if _, ok := c.blocks[index]; !ok {
+			continue
+		}
+		out.Blocks = append(out.Blocks, ebstypes.Block{
+			BlockIndex: utils.PointerTo(index),
+			BlockToken: utils.PointerTo(fmt.Sprintf("token-%d", index)),
+		})
+		out.NextToken = utils.PointerTo(fmt.Sprint(index + 1))
+		break
+	}
+	return out, nil
+}
+
+func (c *fakeEBSClient) GetSnapshotBlock(_ context.Context, params *ebs.GetSnapshotBlockInput, _ ...func(*ebs.Options)) (*ebs.GetSnapshotBlockOutput, error) {
+	index := *params.BlockIndex
+	if *params.BlockToken != fmt.Sprintf("token-%d", index) {
+		return nil, fmt.Errorf("unexpected block token %s", *params.BlockToken)
+	}
+	data := c.blocks[index]
+
+	sum := sha256.Sum256(data)
+	if c.badChecksums[index] {
+		sum = sha256.Sum256([]byte("other"))
+	}
+	return &ebs.GetSnapshotBlockOutput{
+		BlockData:         io.NopCloser(bytes.NewReader(data)),
+		Checksum:          utils.PointerTo(base64.StdEncoding.EncodeToString(sum[:])),
+		ChecksumAlgorithm: checksumAlgorithmSHA256,
+		DataLength:        utils.PointerTo(int32(len(data))),
+	}, nil
+}
+
+func TestReadSnapshot(t *testing.T) {
+	blocks := map[int32][]byte{
+		0: []byte("boot"),
+		2: []byte("data"),
+		7: []byte("tail"),
+	}
+
+	tests := []struct {
+		name         string
+		devices      []scratchDevice
+		badChecksums map[int32]bool
+		want         string
+		wantErr      bool
+	}{
+		{
+			name: "blocks written at their offsets",
+			devices: []scratchDevice{
+				{name: "nvme1n1", serial: "vol1", size: gibToByte},
+				{name: "nvme2n1", serial: "vol2", size: gibToByte},
+			},
+			want: "nvme2n1",
+		},
+		{
+			name: "scratch device not of the volume size",
+			devices: []scratchDevice{
+				{name: "nvme2n1", serial: "vol2", size: 2 * gibToByte},
+			},
+			wantErr: true,
+		},
+		{
+			name: "checksum mismatch",
+			devices: []scratchDevice{
+				{name: "nvme2n1", serial: "vol2", size: gibToByte},
+			},
+			badChecksums: map[int32]bool{2: true},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeEBSClient{
+				blocks:       blocks,
+				badChecksums: tt.badChecksums,
+			}
+			// The scratch devices are sparse files of their sizes.
+			dir := t.TempDir()
+			for _, device := range tt.devices {
+				file, err := os.Create(filepath.Join(dir, device.name))
+				if err != nil {
+					t.Fatalf("failed to create device: %v", err)
+				}
+				if err := file.Truncate(int64(device.size)); err != nil {
+					t.Fatalf("failed to set the size of the device: %v", err)
+				}
+				file.Close()
+			}
+			devices := &ScratchDevices{
+				dir:     dir,
+				devices: tt.devices,
+			}
+
+			volume := ScratchVolume{DeviceName: "xvdi", VolumeID: "vol-2"}
+			got, err := ReadSnapshot(context.Background(), client, "snap-1", devices, volume, 2)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ReadSnapshot() = %v, want %v", got, tt.want)
+			}
+
+			device, err := os.Open(filepath.Join(dir, got))
+			if err != nil {
+				t.Fatalf("failed to open device: %v", err)
+			}
+			defer device.Close()
+			for index := int32(0); index < 8; index++ {
+				want, ok := blocks[index]
+				if !ok {
+					want = make([]byte, testBlockSize)
+				}
+				got := make([]byte, testBlockSize)
+				if _, err := device.ReadAt(got, int64(index)*testBlockSize); err != nil {
+					t.Fatalf("failed to read block %d: %v", index, err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("block %d = %q, want %q", index, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/openclarity/vmclarity/api/models"
+	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
+	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/results"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// GatingPolicy fails a local scan once the findings of a family exceed its
+// thresholds, for example to fail a CI pipeline on any critical
+// vulnerability:
+//
+//	vulnerabilities:
+//	  maxFindingsPerSeverity:
+//	    CRITICAL: 0
+//	    HIGH: 5
+//	secrets:
+//	  maxFindings: 0
+type GatingPolicy map[types.FamilyType]FamilyGatingPolicy
+
+// FamilyGatingPolicy is the thresholds of the findings of a family.
+type FamilyGatingPolicy struct {
+	// MaxFindings is the maximum number of findings of the family,
+	// regardless of their severity. Not limited if not set.
+	MaxFindings *int `json:"maxFindings,omitempty"`
+	// MaxFindingsPerSeverity is the maximum number of findings of the
+	// family per severity. Only the vulnerabilities (CRITICAL, HIGH, MEDIUM,
+	// LOW, NEGLIGIBLE) and misconfiguration (HIGH, MEDIUM, LOW) families
+	// have severities.
+	MaxFindingsPerSeverity map[string]int `json:"maxFindingsPerSeverity,omitempty"`
+}
+
+var familySeverities = map[types.FamilyType][]string{
+	types.Vulnerabilities: {
+		string(models.CRITICAL),
+		string(models.HIGH),
+		string(models.MEDIUM),
+		string(models.LOW),
+		string(models.NEGLIGIBLE),
+	},
+	types.Misconfiguration: {"HIGH", "MEDIUM", "LOW"},
+	types.Secrets:          nil,
+	types.Malware:          nil,
+	types.Rootkits:         nil,
+	types.Exploits:         nil,
+}
+
+// LoadGatingPolicy reads a gating policy from a YAML file.
+func LoadGatingPolicy(path string) (GatingPolicy, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gating policy file: %w", err)
+	}
+
+	var policy GatingPolicy
+	if err := yaml.Unmarshal(b, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse gating policy: %w", err)
+	}
+
+	if err := policy.validate(); err != nil {
+		return nil, fmt.Errorf("invalid gating policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+func (p GatingPolicy) validate() error {
+	for family, familyPolicy := range p {
+		severities, ok := familySeverities[family]
+		if !ok {
+			return fmt.Errorf("unsupported family %q", family)
+		}
+		if familyPolicy.MaxFindings != nil && *familyPolicy.MaxFindings < 0 {
+			return fmt.Errorf("max findings of family %s must not be negative", family)
+		}
+		for severity, maxFindings := range familyPolicy.MaxFindingsPerSeverity {
+			if !utils.Contains(severities, severity) {
+				return fmt.Errorf("unsupported severity %q of family %s", severity, family)
+			}
+			if maxFindings < 0 {
+				return fmt.Errorf("max %s findings of family %s must not be negative", severity, family)
+			}
+		}
+	}
+	return nil
+}
+
+// Violations returns a description of each threshold of the policy exceeded
+// by the findings, in a stable order.
+func (p GatingPolicy) Violations(findings FindingsCounts) []string {
+	var violations []string
+	for family, familyPolicy := range p {
+		counts := findings[family]
+		if familyPolicy.MaxFindings != nil && counts.Total > *familyPolicy.MaxFindings {
+			violations = append(violations, fmt.Sprintf("%d %s findings exceed the maximum of %d",
+				counts.Total, family, *familyPolicy.MaxFindings))
+		}
+		for severity, maxFindings := range familyPolicy.MaxFindingsPerSeverity {
+			if count := counts.PerSeverity[severity]; count > maxFindings {
+				violations = append(violations, fmt.Sprintf("%d %s %s findings exceed the maximum of %d",
+					count, severity, family, maxFindings))
+			}
+		}
+	}
+	sort.Strings(violations)
+
+	return violations
+}
+
+// FamilyFindingsCount is the number of findings of a family.
+type FamilyFindingsCount struct {
+	Total       int
+	PerSeverity map[string]int
+}
+
+// FindingsCounts is the number of findings of each family.
+type FindingsCounts map[types.FamilyType]FamilyFindingsCount
+
+// Add adds the findings of the families found in res. The families which
+// failed have no results, so they are skipped.
+func (c FindingsCounts) Add(res *results.Results) error {
+	if vulnerabilitiesResults, err := results.GetResult[*vulnerabilities.Results](res); err == nil {
+		for _, vulnerability := range *cliutils.ConvertVulnResultToAPIModel(vulnerabilitiesResults).Vulnerabilities {
+			var severity string
+			if vulnerability.Severity != nil {
+				severity = string(*vulnerability.Severity)
+			}
+			c.add(types.Vulnerabilities, severity, 1)
+		}
+	}
+	if misconfigurationResults, err := results.GetResult[*misconfiguration.Results](res); err == nil {
+		for _, misconfig := range misconfigurationResults.Misconfigurations {
+			severity, err := misconfigurationSeverity(misconfig.Severity)
+			if err != nil {
+				return err
+			}
+			c.add(types.Misconfiguration, severity, 1)
+		}
+	}
+	if secretsResults, err := results.GetResult[*secrets.Results](res); err == nil {
+		if found := cliutils.ConvertSecretsResultToAPIModel(secretsResults).Secrets; found != nil {
+			c.add(types.Secrets, "", len(*found))
+		}
+	}
+	if malwareResults, err := results.GetResult[*malware.MergedResults](res); err == nil {
+		if found := cliutils.ConvertMalwareResultToAPIModel(malwareResults).Malware; found != nil {
+			c.add(types.Malware, "", len(*found))
+		}
+	}
+	if rootkitsResults, err := results.GetResult[*rootkits.Results](res); err == nil {
+		if found := cliutils.ConvertRootkitsResultToAPIModel(rootkitsResults).Rootkits; found != nil {
+			c.add(types.Rootkits, "", len(*found))
+		}
+	}
+	if exploitsResults, err := results.GetResult[*exploits.Results](res); err == nil {
+		if found := cliutils.ConvertExploitsResultToAPIModel(exploitsResults).Exploits; found != nil {
+			c.add(types.Exploits, "", len(*found))
+		}
+	}
+
+	return nil
+}
+
+func (c FindingsCounts) add(family types.FamilyType, severity string, count int) {
+	counts := c[family]
+	counts.Total += count
+	if severity != "" {
+		if counts.PerSeverity == nil {
+			counts.PerSeverity = map[string]int{}
+		}
+		counts.PerSeverity[severity] += count
+	}
+	c[family] = counts
+}
+
+func misconfigurationSeverity(severity misconfigurationTypes.Severity) (string, error) {
+	switch severity {
+	case misconfigurationTypes.HighSeverity, misconfigurationTypes.MediumSeverity, misconfigurationTypes.LowSeverity:
+		return strings.ToUpper(strings.TrimSuffix(string(severity), "Severity")), nil
+	default:
+		return "", fmt.Errorf("unknown misconfiguration severity %v", severity)
+	}
+}
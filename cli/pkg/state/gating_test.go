@@ -0,0 +1,227 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
+	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/results"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newMisconfigurationResults(severities ...misconfigurationTypes.Severity) *results.Results {
+	misconfigurationResults := misconfiguration.NewResults()
+	for _, severity := range severities {
+		misconfigurationResults.Misconfigurations = append(misconfigurationResults.Misconfigurations, misconfiguration.FlattenedMisconfiguration{
+			ScannerName:      "lynis",
+			Misconfiguration: misconfigurationTypes.Misconfiguration{Severity: severity},
+		})
+	}
+	res := results.New()
+	res.SetResults(misconfigurationResults)
+	return res
+}
+
+func TestLoadGatingPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		want    GatingPolicy
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			policy: `
+vulnerabilities:
+  maxFindingsPerSeverity:
+    CRITICAL: 0
+    HIGH: 5
+secrets:
+  maxFindings: 0
+`,
+			want: GatingPolicy{
+				types.Vulnerabilities: {
+					MaxFindingsPerSeverity: map[string]int{"CRITICAL": 0, "HIGH": 5},
+				},
+				types.Secrets: {
+					MaxFindings: utils.PointerTo(0),
+				},
+			},
+		},
+		{
+			name: "unsupported family",
+			policy: `
+sbom:
+  maxFindings: 0
+`,
+			wantErr: true,
+		},
+		{
+			name: "severity of a family without severities",
+			policy: `
+secrets:
+  maxFindingsPerSeverity:
+    HIGH: 0
+`,
+			wantErr: true,
+		},
+		{
+			name: "negative max findings",
+			policy: `
+misconfiguration:
+  maxFindingsPerSeverity:
+    HIGH: -1
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "policy.yaml")
+			if err := os.WriteFile(path, []byte(tt.policy), 0o600); err != nil {
+				t.Fatalf("failed to write gating policy: %v", err)
+			}
+
+			got, err := LoadGatingPolicy(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadGatingPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("LoadGatingPolicy() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGatingPolicy_Violations(t *testing.T) {
+	findings := FindingsCounts{
+		types.Vulnerabilities: {
+			Total:       8,
+			PerSeverity: map[string]int{"CRITICAL": 1, "HIGH": 5, "LOW": 2},
+		},
+		types.Secrets: {
+			Total: 2,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		policy GatingPolicy
+		want   []string
+	}{
+		{
+			name:   "no policy",
+			policy: nil,
+			want:   nil,
+		},
+		{
+			name: "thresholds not exceeded",
+			policy: GatingPolicy{
+				types.Vulnerabilities: {
+					MaxFindingsPerSeverity: map[string]int{"CRITICAL": 1, "HIGH": 5},
+				},
+				types.Malware: {
+					MaxFindings: utils.PointerTo(0),
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "thresholds exceeded",
+			policy: GatingPolicy{
+				types.Vulnerabilities: {
+					MaxFindings:            utils.PointerTo(10),
+					MaxFindingsPerSeverity: map[string]int{"CRITICAL": 0, "HIGH": 4, "MEDIUM": 0},
+				},
+				types.Secrets: {
+					MaxFindings: utils.PointerTo(0),
+				},
+			},
+			want: []string{
+				"1 CRITICAL vulnerabilities findings exceed the maximum of 0",
+				"2 secrets findings exceed the maximum of 0",
+				"5 HIGH vulnerabilities findings exceed the maximum of 4",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.Violations(findings)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Violations() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindingsCounts_Add(t *testing.T) {
+	findings := FindingsCounts{}
+	res := newMisconfigurationResults(misconfigurationTypes.HighSeverity, misconfigurationTypes.HighSeverity, misconfigurationTypes.LowSeverity)
+	if err := findings.Add(res); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	want := FindingsCounts{
+		types.Misconfiguration: {
+			Total:       3,
+			PerSeverity: map[string]int{"HIGH": 2, "LOW": 1},
+		},
+	}
+	if diff := cmp.Diff(want, findings); diff != "" {
+		t.Errorf("Add() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLocalState_MarkDone_gatingPolicy(t *testing.T) {
+	var buf bytes.Buffer
+	policy := GatingPolicy{
+		types.Misconfiguration: {
+			MaxFindingsPerSeverity: map[string]int{"HIGH": 0},
+		},
+	}
+	l, err := NewLocalState(&buf, ProgressFormatJSON, "", policy)
+	if err != nil {
+		t.Fatalf("NewLocalState() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if err := l.SetResults(ctx, newMisconfigurationResults(misconfigurationTypes.HighSeverity)); err != nil {
+		t.Fatalf("SetResults() error = %v", err)
+	}
+	if err := l.MarkDone(ctx, nil); !errors.Is(err, ErrGatingPolicyViolated) {
+		t.Fatalf("MarkDone() error = %v, want %v", err, ErrGatingPolicyViolated)
+	}
+
+	var event ProgressEvent
+	if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+		t.Fatalf("failed to unmarshal progress event %q: %v", buf.String(), err)
+	}
+	want := []string{"1 HIGH misconfiguration findings exceed the maximum of 0"}
+	if diff := cmp.Diff(want, event.GatingViolations); diff != "" {
+		t.Errorf("progress event gating violations mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -17,34 +17,209 @@ package state
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/families/results"
+)
+
+// ErrGatingPolicyViolated is returned by MarkDone once the findings of the
+// scan exceed the thresholds of the gating policy.
+var ErrGatingPolicyViolated = errors.New("scan findings violate the gating policy")
+
+// ProgressFormat is the format the LocalState reports the progress of the
+// scan in.
+type ProgressFormat string
+
+const (
+	// ProgressFormatText logs the progress of the scan.
+	ProgressFormatText ProgressFormat = "text"
+	// ProgressFormatJSON writes a JSON progress event per line for each
+	// state transition of the scan, for scripts wrapping the CLI.
+	ProgressFormatJSON ProgressFormat = "json"
+)
+
+// ProgressState is the state of the scan reported by a progress event.
+type ProgressState string
+
+const (
+	ProgressStateInProgress ProgressState = "InProgress"
+	ProgressStateDone       ProgressState = "Done"
+	ProgressStateAborted    ProgressState = "Aborted"
 )
 
-type LocalState struct{}
+// ProgressEvent is a state transition of the scan reported in the JSON
+// progress format.
+type ProgressEvent struct {
+	State     ProgressState `json:"state"`
+	Timestamp time.Time     `json:"timestamp"`
+	Errors    []string      `json:"errors,omitempty"`
+	// GatingViolations are the thresholds of the gating policy exceeded
+	// by the findings of the scan, reported once it's done.
+	GatingViolations []string `json:"gatingViolations,omitempty"`
+}
+
+type LocalState struct {
+	progressFormat ProgressFormat
+	progressWriter io.Writer
+
+	// The scan is aborted once this file exists, empty if the scan can't be
+	// aborted.
+	abortFile string
+	// Reports the aborted state once.
+	abortOnce sync.Once
+
+	// The scan fails once its findings exceed the thresholds of the
+	// policy, nil if it never fails because of its findings.
+	gatingPolicy GatingPolicy
+	findings     FindingsCounts
+
+	// Serializes the progress events written to progressWriter.
+	mu sync.Mutex
+}
 
 func (l *LocalState) WaitForVolumeAttachment(context.Context) error {
 	return nil
 }
 
+func (l *LocalState) SetScannedPartitions(_ context.Context, partitions []models.ScannedPartition) error {
+	for _, partition := range partitions {
+		if partition.Scanned != nil && *partition.Scanned {
+			log.Infof("Partition %v is scanned", *partition.DeviceName)
+			continue
+		}
+		log.Infof("Partition %v is not scanned: %v", *partition.DeviceName, *partition.Reason)
+	}
+	return nil
+}
+
 func (l *LocalState) MarkInProgress(context.Context) error {
+	if l.progressFormat == ProgressFormatJSON {
+		return l.writeProgressEvent(l.newProgressEvent(ProgressStateInProgress, nil))
+	}
+
 	log.Info("Scanning is in progress")
 	return nil
 }
 
+func (l *LocalState) SetResults(_ context.Context, res *results.Results) error {
+	if err := l.findings.Add(res); err != nil {
+		return fmt.Errorf("failed to count findings: %w", err)
+	}
+	return nil
+}
+
+// MarkDone reports that the scan is done, and returns ErrGatingPolicyViolated
+// if the findings of the scan exceed the thresholds of the gating policy.
 func (l *LocalState) MarkDone(_ context.Context, errs []error) error {
-	if len(errs) > 0 {
-		log.Errorf("scan has been completed with errors: %v", errs)
-		return nil
+	violations := l.gatingPolicy.Violations(l.findings)
+
+	if l.progressFormat == ProgressFormatJSON {
+		event := l.newProgressEvent(ProgressStateDone, errs)
+		event.GatingViolations = violations
+		if err := l.writeProgressEvent(event); err != nil {
+			return err
+		}
+	} else {
+		if len(errs) > 0 {
+			log.Errorf("scan has been completed with errors: %v", errs)
+		} else {
+			log.Info("Scan has been completed")
+		}
+		for _, violation := range violations {
+			log.Errorf("Gating policy violation: %s", violation)
+		}
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("%w: %s", ErrGatingPolicyViolated, strings.Join(violations, ", "))
 	}
-	log.Info("Scan has been completed")
 	return nil
 }
 
 func (l *LocalState) IsAborted(context.Context) (bool, error) {
-	return false, nil
+	if l.abortFile == "" {
+		return false, nil
+	}
+
+	if _, err := os.Stat(l.abortFile); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check abort file: %w", err)
+	}
+
+	var err error
+	l.abortOnce.Do(func() {
+		if l.progressFormat == ProgressFormatJSON {
+			err = l.writeProgressEvent(l.newProgressEvent(ProgressStateAborted, nil))
+			return
+		}
+		log.Infof("Scan has been aborted, abort file %v exists", l.abortFile)
+	})
+
+	return true, err
 }
 
-func NewLocalState() (*LocalState, error) {
-	return &LocalState{}, nil
+func (l *LocalState) newProgressEvent(state ProgressState, errs []error) ProgressEvent {
+	event := ProgressEvent{
+		State:     state,
+		Timestamp: time.Now().UTC(),
+	}
+	for _, err := range errs {
+		event.Errors = append(event.Errors, err.Error())
+	}
+	return event
+}
+
+func (l *LocalState) writeProgressEvent(event ProgressEvent) error {
+	eventB, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal progress event: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// The event is written at once so that it isn't interleaved with other
+	// output of the writer.
+	if _, err := l.progressWriter.Write(append(eventB, '\n')); err != nil {
+		return fmt.Errorf("failed to write progress event: %w", err)
+	}
+
+	return nil
+}
+
+// NewLocalState creates a LocalState which reports the progress of the scan in
+// progressFormat. The progress events of the JSON format are written to
+// progressWriter, which is ignored by the text format. The scan is aborted
+// once abortFile is created, if it's set. The scan fails once its findings
+// exceed the thresholds of gatingPolicy, if it's set.
+func NewLocalState(progressWriter io.Writer, progressFormat ProgressFormat, abortFile string, gatingPolicy GatingPolicy) (*LocalState, error) {
+	switch progressFormat {
+	case ProgressFormatText:
+	case ProgressFormatJSON:
+		if progressWriter == nil {
+			return nil, errors.New("a progress writer is required for the JSON progress format")
+		}
+	default:
+		return nil, fmt.Errorf("unknown progress format %q", progressFormat)
+	}
+
+	return &LocalState{
+		progressFormat: progressFormat,
+		progressWriter: progressWriter,
+		abortFile:      abortFile,
+		gatingPolicy:   gatingPolicy,
+		findings:       FindingsCounts{},
+	}, nil
 }
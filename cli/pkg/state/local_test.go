@@ -0,0 +1,151 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestLocalState_jsonProgress(t *testing.T) {
+	var buf bytes.Buffer
+	l, err := NewLocalState(&buf, ProgressFormatJSON, "", nil)
+	if err != nil {
+		t.Fatalf("NewLocalState() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if err := l.MarkInProgress(ctx); err != nil {
+		t.Fatalf("MarkInProgress() error = %v", err)
+	}
+	if err := l.MarkDone(ctx, []error{errors.New("sbom failed")}); err != nil {
+		t.Fatalf("MarkDone() error = %v", err)
+	}
+
+	var got []ProgressEvent
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var event ProgressEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("failed to unmarshal progress event %q: %v", line, err)
+		}
+		if event.Timestamp.IsZero() {
+			t.Errorf("progress event %q has no timestamp", line)
+		}
+		got = append(got, event)
+	}
+
+	want := []ProgressEvent{
+		{
+			State: ProgressStateInProgress,
+		},
+		{
+			State:  ProgressStateDone,
+			Errors: []string{"sbom failed"},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(ProgressEvent{}, "Timestamp")); diff != "" {
+		t.Errorf("progress events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewLocalState(t *testing.T) {
+	tests := []struct {
+		name           string
+		progressWriter io.Writer
+		progressFormat ProgressFormat
+		wantErr        bool
+	}{
+		{
+			name:           "text without writer",
+			progressFormat: ProgressFormatText,
+		},
+		{
+			name:           "json with writer",
+			progressWriter: &bytes.Buffer{},
+			progressFormat: ProgressFormatJSON,
+		},
+		{
+			name:           "json without writer",
+			progressFormat: ProgressFormatJSON,
+			wantErr:        true,
+		},
+		{
+			name:           "unknown format",
+			progressWriter: &bytes.Buffer{},
+			progressFormat: "xml",
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewLocalState(tt.progressWriter, tt.progressFormat, "", nil); (err != nil) != tt.wantErr {
+				t.Errorf("NewLocalState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocalState_IsAborted(t *testing.T) {
+	var buf bytes.Buffer
+	abortFile := filepath.Join(t.TempDir(), "abort")
+	l, err := NewLocalState(&buf, ProgressFormatJSON, abortFile, nil)
+	if err != nil {
+		t.Fatalf("NewLocalState() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if aborted, err := l.IsAborted(ctx); err != nil || aborted {
+		t.Fatalf("IsAborted() = %v, %v, want false before the abort file is created", aborted, err)
+	}
+
+	if err := os.WriteFile(abortFile, nil, 0o600); err != nil {
+		t.Fatalf("failed to create abort file: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if aborted, err := l.IsAborted(ctx); err != nil || !aborted {
+			t.Fatalf("IsAborted() = %v, %v, want true once the abort file is created", aborted, err)
+		}
+	}
+
+	// The aborted state is reported once.
+	var event ProgressEvent
+	if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+		t.Fatalf("failed to unmarshal progress event %q: %v", buf.String(), err)
+	}
+	if event.State != ProgressStateAborted {
+		t.Errorf("progress event state = %v, want %v", event.State, ProgressStateAborted)
+	}
+}
+
+func TestLocalState_IsAborted_noAbortFile(t *testing.T) {
+	l, err := NewLocalState(nil, ProgressFormatText, "", nil)
+	if err != nil {
+		t.Fatalf("NewLocalState() error = %v", err)
+	}
+	if aborted, err := l.IsAborted(context.Background()); err != nil || aborted {
+		t.Errorf("IsAborted() = %v, %v, want false", aborted, err)
+	}
+}
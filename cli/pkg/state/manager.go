@@ -17,11 +17,16 @@ package state
 
 import (
 	"context"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/families/results"
 )
 
 type Manager interface {
 	WaitForVolumeAttachment(context.Context) error
+	SetScannedPartitions(context.Context, []models.ScannedPartition) error
 	MarkInProgress(context.Context) error
+	SetResults(context.Context, *results.Results) error
 	MarkDone(context.Context, []error) error
 	IsAborted(ctx context.Context) (bool, error)
 }
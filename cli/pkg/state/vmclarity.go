@@ -23,6 +23,7 @@ import (
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -67,6 +68,19 @@ func (v *VMClarityState) WaitForVolumeAttachment(ctx context.Context) error {
 	}
 }
 
+func (v *VMClarityState) SetScannedPartitions(ctx context.Context, partitions []models.ScannedPartition) error {
+	scanResult := models.TargetScanResult{
+		Partitions: &partitions,
+	}
+
+	err := v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
+	if err != nil {
+		return fmt.Errorf("failed to patch scan result: %w", err)
+	}
+
+	return nil
+}
+
 func (v *VMClarityState) MarkInProgress(ctx context.Context) error {
 	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
 	if err != nil {
@@ -92,6 +106,12 @@ func (v *VMClarityState) MarkInProgress(ctx context.Context) error {
 	return nil
 }
 
+// SetResults is a no-op, the results are exported to the scan result by the
+// VMClarity presenter.
+func (v *VMClarityState) SetResults(context.Context, *results.Results) error {
+	return nil
+}
+
 func (v *VMClarityState) MarkDone(ctx context.Context, errors []error) error {
 	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
 	if err != nil {
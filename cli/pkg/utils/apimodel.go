@@ -47,9 +47,14 @@ func ConvertSBOMResultToAPIModel(sbomResults *sbom.Results) *models.SbomScan {
 		}
 	}
 
-	return &models.SbomScan{
+	sbomScan := &models.SbomScan{
 		Packages: &packages,
 	}
+	if len(sbomResults.PackageManagerHints) > 0 {
+		sbomScan.PackageManagerHints = utils.PointerTo(sbomResults.PackageManagerHints)
+	}
+
+	return sbomScan
 }
 
 func ConvertPackageInfoToAPIModel(component cdx.Component) *models.Package {
@@ -67,12 +72,15 @@ func ConvertPackageInfoToAPIModel(component cdx.Component) *models.Package {
 func ConvertVulnResultToAPIModel(vulnerabilitiesResults *vulnerabilities.Results) *models.VulnerabilityScan {
 	// nolint:prealloc
 	var vuls []models.Vulnerability
+	// index of the vulnerability in vuls by canonical ID and package.
+	vulIndexByKey := make(map[string]int)
 	for _, vulCandidates := range vulnerabilitiesResults.MergedResults.MergedVulnerabilitiesByKey {
 		if len(vulCandidates) < 1 {
 			continue
 		}
 
 		vulCandidate := vulCandidates[0]
+		vulName := vulnerabilitiesResults.Aliases.Canonical(vulCandidate.Vulnerability.ID)
 
 		vul := models.Vulnerability{
 			Cvss:              ConvertVulnCvssToAPIModel(vulCandidate.Vulnerability.CVSS),
@@ -84,8 +92,20 @@ func ConvertVulnResultToAPIModel(vulnerabilitiesResults *vulnerabilities.Results
 			Package:           ConvertVulnPackageToAPIModel(vulCandidate.Vulnerability.Package),
 			Path:              utils.PointerTo(vulCandidate.Vulnerability.Path),
 			Severity:          ConvertVulnSeverityToAPIModel(vulCandidate.Vulnerability.Severity),
-			VulnerabilityName: utils.PointerTo(vulCandidate.Vulnerability.ID),
+			VulnerabilityName: utils.PointerTo(vulName),
+		}
+
+		// Different scanners can report the same vulnerability under
+		// different aliases, collapse them into a single vulnerability
+		// preferring the one that was reported with the canonical ID.
+		key := fmt.Sprintf("%s.%s.%s", vulName, vulCandidate.Vulnerability.Package.Name, vulCandidate.Vulnerability.Package.Version)
+		if i, ok := vulIndexByKey[key]; ok {
+			if vulCandidate.Vulnerability.ID == vulName {
+				vuls[i] = vul
+			}
+			continue
 		}
+		vulIndexByKey[key] = len(vuls)
 		vuls = append(vuls, vul)
 	}
 
@@ -222,6 +242,7 @@ func ConvertSecretsResultToAPIModel(secretsResults *secrets.Results) *models.Sec
 				EndLine:     &finding.EndLine,
 				FilePath:    &finding.File,
 				Fingerprint: &finding.Fingerprint,
+				RuleID:      &finding.RuleID,
 				StartLine:   &finding.StartLine,
 				StartColumn: &finding.StartColumn,
 				EndColumn:   &finding.EndColumn,
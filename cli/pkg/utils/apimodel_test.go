@@ -142,6 +142,23 @@ func Test_ConvertSBOMResultToAPIModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Package manager hints",
+			args: args{
+				result: &sbom.Results{
+					SBOM: &cdx.BOM{
+						Components: nil,
+					},
+					PackageManagerHints: []string{"apk"},
+				},
+			},
+			want: returns{
+				sbomScan: &models.SbomScan{
+					Packages:            &[]models.Package{},
+					PackageManagerHints: &[]string{"apk"},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -378,6 +395,80 @@ func Test_ConvertVulnResultToAPIModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Aliases are collapsed into the canonical vulnerability",
+			args: args{
+				result: &vulnerabilities.Results{
+					MergedResults: &scanner.MergedResults{
+						MergedVulnerabilitiesByKey: map[scanner.VulnerabilityKey][]scanner.MergedVulnerability{
+							"vulkey1": {
+								{
+									ID: "id1",
+									Vulnerability: scanner.Vulnerability{
+										ID:          "GHSA-jfh8-c2jp-5v3q",
+										Description: "ghsa description",
+										Severity:    string(models.CRITICAL),
+										Package: scanner.Package{
+											Name:    "log4j-core",
+											Version: "2.14.1",
+										},
+									},
+								},
+							},
+							"vulkey2": {
+								{
+									ID: "id2",
+									Vulnerability: scanner.Vulnerability{
+										ID:          "CVE-2021-44228",
+										Description: "cve description",
+										Severity:    string(models.CRITICAL),
+										Package: scanner.Package{
+											Name:    "log4j-core",
+											Version: "2.14.1",
+										},
+									},
+								},
+							},
+						},
+					},
+					Aliases: vulnerabilities.Aliases{
+						"GHSA-JFH8-C2JP-5V3Q": "CVE-2021-44228",
+					},
+				},
+			},
+			want: returns{
+				vulScan: &models.VulnerabilityScan{
+					Vulnerabilities: &[]models.Vulnerability{
+						{
+							Description: utils.PointerTo("cve description"),
+							Distro: &models.VulnerabilityDistro{
+								IDLike:  utils.PointerTo[[]string](nil),
+								Name:    utils.PointerTo(""),
+								Version: utils.PointerTo(""),
+							},
+							Fix: &models.VulnerabilityFix{
+								State:    utils.PointerTo(""),
+								Versions: utils.PointerTo[[]string](nil),
+							},
+							LayerId: utils.PointerTo(""),
+							Links:   utils.PointerTo[[]string](nil),
+							Package: &models.Package{
+								Cpes:     utils.PointerTo[[]string](nil),
+								Language: utils.PointerTo(""),
+								Licenses: utils.PointerTo[[]string](nil),
+								Name:     utils.PointerTo("log4j-core"),
+								Purl:     utils.PointerTo(""),
+								Type:     utils.PointerTo(""),
+								Version:  utils.PointerTo("2.14.1"),
+							},
+							Path:              utils.PointerTo(""),
+							Severity:          utils.PointerTo[models.VulnerabilitySeverity](models.CRITICAL),
+							VulnerabilityName: utils.PointerTo("CVE-2021-44228"),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -399,6 +490,7 @@ func Test_ConvertSecretsResultToAPIModel(t *testing.T) {
 		EndColumn:   111,
 		File:        "File1",
 		Fingerprint: "Fingerprint1",
+		RuleID:      "RuleID1",
 	}
 	finding2 := common.Findings{
 		Description: "Description2",
@@ -408,6 +500,7 @@ func Test_ConvertSecretsResultToAPIModel(t *testing.T) {
 		EndColumn:   122,
 		File:        "File2",
 		Fingerprint: "Fingerprint2",
+		RuleID:      "RuleID2",
 	}
 	finding3 := common.Findings{
 		Description: "Description3",
@@ -417,6 +510,7 @@ func Test_ConvertSecretsResultToAPIModel(t *testing.T) {
 		EndColumn:   133,
 		File:        "File3",
 		Fingerprint: "Fingerprint3",
+		RuleID:      "RuleID3",
 	}
 	type args struct {
 		secretsResults *secrets.Results
@@ -484,6 +578,7 @@ func Test_ConvertSecretsResultToAPIModel(t *testing.T) {
 						EndLine:     &finding1.EndLine,
 						FilePath:    &finding1.File,
 						Fingerprint: &finding1.Fingerprint,
+						RuleID:      &finding1.RuleID,
 						StartLine:   &finding1.StartLine,
 						StartColumn: &finding1.StartColumn,
 						EndColumn:   &finding1.EndColumn,
@@ -493,6 +588,7 @@ func Test_ConvertSecretsResultToAPIModel(t *testing.T) {
 						EndLine:     &finding2.EndLine,
 						FilePath:    &finding2.File,
 						Fingerprint: &finding2.Fingerprint,
+						RuleID:      &finding2.RuleID,
 						StartLine:   &finding2.StartLine,
 						StartColumn: &finding2.StartColumn,
 						EndColumn:   &finding2.EndColumn,
@@ -502,6 +598,7 @@ func Test_ConvertSecretsResultToAPIModel(t *testing.T) {
 						EndLine:     &finding3.EndLine,
 						FilePath:    &finding3.File,
 						Fingerprint: &finding3.Fingerprint,
+						RuleID:      &finding3.RuleID,
 						StartLine:   &finding3.StartLine,
 						StartColumn: &finding3.StartColumn,
 						EndColumn:   &finding3.EndColumn,
@@ -0,0 +1,231 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type State string
+
+const (
+	// StateClosed lets all the calls through.
+	StateClosed State = "Closed"
+	// StateOpen fails all the calls fast.
+	StateOpen State = "Open"
+	// StateHalfOpen lets a single probe call through, its result decides
+	// whether the breaker is closed or opened again.
+	StateHalfOpen State = "HalfOpen"
+)
+
+// ErrOpen is returned by Allow when the breaker doesn't let calls through.
+var ErrOpen = errors.New("circuit breaker is open")
+
+type Config struct {
+	// FailureThreshold is the number of consecutive failures which opens
+	// the breaker. The breaker is disabled when it is not positive.
+	FailureThreshold int
+	// OpenDuration is the time the breaker stays open before a probe call
+	// is let through.
+	OpenDuration time.Duration
+}
+
+// Status is a snapshot of the state of a breaker.
+type Status struct {
+	State               State      `json:"state"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+	OpenedAt            *time.Time `json:"openedAt,omitempty"`
+}
+
+// Breaker is a circuit breaker which opens after a number of consecutive
+// failures and closes again once a probe call succeeds.
+type Breaker struct {
+	name   string
+	config Config
+	now    func() time.Time
+
+	state               State
+	consecutiveFailures int
+	openedAt            time.Time
+	probeInFlight       bool
+
+	mu sync.Mutex
+}
+
+func newBreaker(name string, config Config, now func() time.Time) *Breaker {
+	return &Breaker{
+		name:   name,
+		config: config,
+		now:    now,
+		state:  StateClosed,
+	}
+}
+
+// Allow returns ErrOpen if the call must fail fast. Otherwise the result of
+// the call must be reported with Done.
+func (b *Breaker) Allow() error {
+	if b.config.FailureThreshold <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateClosed:
+		return nil
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.config.OpenDuration {
+			return ErrOpen
+		}
+		log.Infof("Circuit breaker %s is half open, letting a probe call through", b.name)
+		b.state = StateHalfOpen
+		b.probeInFlight = true
+		return nil
+	case StateHalfOpen:
+		if b.probeInFlight {
+			return ErrOpen
+		}
+		b.probeInFlight = true
+		return nil
+	}
+
+	return nil
+}
+
+// Done reports the result of a call which was allowed by Allow.
+func (b *Breaker) Done(err error) {
+	if b.config.FailureThreshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateHalfOpen {
+		b.probeInFlight = false
+	}
+
+	if err == nil {
+		if b.state != StateClosed {
+			log.Infof("Circuit breaker %s is closed", b.name)
+		}
+		b.state = StateClosed
+		b.consecutiveFailures = 0
+		return
+	}
+
+	b.consecutiveFailures++
+	if b.state == StateHalfOpen || b.consecutiveFailures >= b.config.FailureThreshold {
+		if b.state != StateOpen {
+			log.Warnf("Circuit breaker %s is open after %d consecutive failures: %v", b.name, b.consecutiveFailures, err)
+		}
+		b.state = StateOpen
+		b.openedAt = b.now()
+	}
+}
+
+// Cancel reports that a call which was allowed by Allow didn't complete, for
+// example because it was canceled. It doesn't change the state of the
+// breaker, but lets another probe through if the call was a probe.
+func (b *Breaker) Cancel() {
+	if b.config.FailureThreshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probeInFlight = false
+}
+
+func (b *Breaker) Status() Status {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status := Status{
+		State:               b.state,
+		ConsecutiveFailures: b.consecutiveFailures,
+	}
+	if b.state != StateClosed {
+		openedAt := b.openedAt
+		status.OpenedAt = &openedAt
+	}
+
+	return status
+}
+
+// Registry holds a breaker per key, for example per provider region.
+type Registry struct {
+	config   Config
+	now      func() time.Time
+	breakers map[string]*Breaker
+
+	mu sync.Mutex
+}
+
+func NewRegistry(config Config) *Registry {
+	return &Registry{
+		config:   config,
+		now:      time.Now,
+		breakers: make(map[string]*Breaker),
+	}
+}
+
+// Get returns the breaker of the key, creating it if needed.
+func (r *Registry) Get(key string) *Breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	breaker, ok := r.breakers[key]
+	if !ok {
+		breaker = newBreaker(key, r.config, r.now)
+		r.breakers[key] = breaker
+	}
+
+	return breaker
+}
+
+// Statuses returns the status of all the breakers by key.
+func (r *Registry) Statuses() map[string]Status {
+	r.mu.Lock()
+	breakers := make(map[string]*Breaker, len(r.breakers))
+	for key, breaker := range r.breakers {
+		breakers[key] = breaker
+	}
+	r.mu.Unlock()
+
+	statuses := make(map[string]Status, len(breakers))
+	for key, breaker := range breakers {
+		statuses[key] = breaker.Status()
+	}
+
+	return statuses
+}
+
+// ServeHTTP writes the status of all the breakers as JSON.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(r.Statuses()); err != nil {
+		log.Errorf("Failed to write circuit breakers status: %v", err)
+	}
+}
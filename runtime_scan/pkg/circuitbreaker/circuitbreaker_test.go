@@ -0,0 +1,141 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var errFailure = errors.New("failure")
+
+func TestBreaker(t *testing.T) {
+	type call struct {
+		// advance is the time passed before the call.
+		advance time.Duration
+		// allowErr is the error expected from Allow.
+		allowErr error
+		// err is the result of the call if it was allowed.
+		err error
+		// wantState is the state of the breaker after the call.
+		wantState State
+	}
+	tests := []struct {
+		name   string
+		config Config
+		calls  []call
+	}{
+		{
+			name: "disabled",
+			config: Config{
+				FailureThreshold: 0,
+				OpenDuration:     time.Minute,
+			},
+			calls: []call{
+				{err: errFailure, wantState: StateClosed},
+				{err: errFailure, wantState: StateClosed},
+				{err: nil, wantState: StateClosed},
+			},
+		},
+		{
+			name: "opens after consecutive failures",
+			config: Config{
+				FailureThreshold: 2,
+				OpenDuration:     time.Minute,
+			},
+			calls: []call{
+				{err: errFailure, wantState: StateClosed},
+				{err: nil, wantState: StateClosed},
+				{err: errFailure, wantState: StateClosed},
+				{err: errFailure, wantState: StateOpen},
+				{allowErr: ErrOpen, wantState: StateOpen},
+			},
+		},
+		{
+			name: "closes after a successful probe",
+			config: Config{
+				FailureThreshold: 1,
+				OpenDuration:     time.Minute,
+			},
+			calls: []call{
+				{err: errFailure, wantState: StateOpen},
+				{advance: 30 * time.Second, allowErr: ErrOpen, wantState: StateOpen},
+				{advance: 30 * time.Second, err: nil, wantState: StateClosed},
+				{err: nil, wantState: StateClosed},
+			},
+		},
+		{
+			name: "opens again after a failed probe",
+			config: Config{
+				FailureThreshold: 3,
+				OpenDuration:     time.Minute,
+			},
+			calls: []call{
+				{err: errFailure, wantState: StateClosed},
+				{err: errFailure, wantState: StateClosed},
+				{err: errFailure, wantState: StateOpen},
+				{advance: time.Minute, err: errFailure, wantState: StateOpen},
+				{allowErr: ErrOpen, wantState: StateOpen},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			breaker := newBreaker("test", tt.config, func() time.Time { return now })
+			for i, c := range tt.calls {
+				now = now.Add(c.advance)
+				err := breaker.Allow()
+				if !errors.Is(err, c.allowErr) {
+					t.Fatalf("call %d: Allow() error = %v, want %v", i, err, c.allowErr)
+				}
+				if err == nil {
+					breaker.Done(c.err)
+				}
+				if state := breaker.Status().State; state != c.wantState {
+					t.Fatalf("call %d: state = %v, want %v", i, state, c.wantState)
+				}
+			}
+		})
+	}
+}
+
+func TestBreaker_HalfOpenAllowsSingleProbe(t *testing.T) {
+	now := time.Now()
+	breaker := newBreaker("test", Config{FailureThreshold: 1, OpenDuration: time.Minute}, func() time.Time { return now })
+
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	breaker.Done(errFailure)
+
+	now = now.Add(time.Minute)
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("Allow() probe error = %v", err)
+	}
+	if err := breaker.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("Allow() during probe error = %v, want %v", err, ErrOpen)
+	}
+	breaker.Cancel()
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("Allow() after canceled probe error = %v", err)
+	}
+	breaker.Done(nil)
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("Allow() after probe error = %v", err)
+	}
+}
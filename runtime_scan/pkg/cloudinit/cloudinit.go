@@ -17,17 +17,132 @@ package cloudinit
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
 )
 
 type Data struct {
-	ScannerCLIConfig string // Scanner families configuration file yaml
-	ScannerImage     string // Scanner container image to use
-	ServerAddress    string // IP address of VMClarity backend for export
-	ScanResultID     string // ScanResult ID to export the results to
+	ScannerCLIConfig            string                              // Scanner families configuration file yaml
+	ScannerImage                string                              // Scanner container image to use
+	ScannerImagePullCredentials *models.ScannerImagePullCredentials // Credentials to pull the scanner image with, pulled anonymously if not set
+	ServerAddress               string                              // IP address of VMClarity backend for export
+	ScanResultID                string                              // ScanResult ID to export the results to
+	PartitionsToScan            []string                            // Partitions of the attached volume to scan, all of them if empty
+	CABundle                    string                              // PEM CA bundle trusted by the scanner instance and the scanner, not written if empty
+	ScannerProxy                *models.ScannerProxyConfig          // Proxy the scanner instance and the scanner egress through, no proxy is used if not set
+	DirectReadSnapshots         []string                            // Snapshots the scanner reads directly instead of the attached volume, the volume is mounted if empty
+	DirectReadDevices           []string                            // Device names of the scratch volumes the snapshots read directly are read to, in the order of the snapshots
+	DirectReadRegion            string                              // Region of the snapshots read directly
+}
+
+type templateData struct {
+	Data
+	DockerConfig        string   // Config of the docker client with the credentials of the registry, not written if empty
+	ECRCredentialHelper bool     // Whether the ECR credential helper must be installed
+	HTTPProxy           string   // Proxy of the HTTP requests of the package manager, not set if empty
+	HTTPSProxy          string   // Proxy of the HTTPS requests of the package manager, not set if empty
+	ProxyEnv            []string // Proxy environment variables of the docker daemon and the scanner
+}
+
+const (
+	dockerHubRegistry = "docker.io"
+	// The docker client stores the credentials of Docker Hub under its
+	// legacy index address.
+	dockerHubAuthKey = "https://index.docker.io/v1/"
+)
+
+// dockerClientConfig is the config.json of the docker client.
+type dockerClientConfig struct {
+	Auths       map[string]dockerAuth `json:"auths,omitempty"`
+	CredHelpers map[string]string     `json:"credHelpers,omitempty"`
+}
+
+type dockerAuth struct {
+	Auth string `json:"auth"`
+}
+
+// imageRegistry returns the registry of the image, Docker Hub if the image
+// name doesn't start with a registry host.
+func imageRegistry(image string) string {
+	host, _, found := strings.Cut(image, "/")
+	if !found || (!strings.ContainsAny(host, ".:") && host != "localhost") {
+		return dockerHubRegistry
+	}
+	return host
+}
+
+// dockerConfig returns the config of the docker client which pulls the image
+// with the credentials, and whether it needs the ECR credential helper.
+func dockerConfig(image string, credentials *models.ScannerImagePullCredentials) (string, bool, error) {
+	if credentials == nil {
+		return "", false, nil
+	}
+
+	registry := imageRegistry(image)
+	if credentials.Registry != nil && *credentials.Registry != "" {
+		registry = *credentials.Registry
+	}
+
+	var config dockerClientConfig
+	switch credentials.Method {
+	case models.Static:
+		if credentials.Username == nil || *credentials.Username == "" || credentials.Password == nil || *credentials.Password == "" {
+			return "", false, fmt.Errorf("username and password are required to pull the scanner image with static credentials")
+		}
+		authKey := registry
+		if registry == dockerHubRegistry {
+			authKey = dockerHubAuthKey
+		}
+		config.Auths = map[string]dockerAuth{
+			authKey: {Auth: base64.StdEncoding.EncodeToString([]byte(*credentials.Username + ":" + *credentials.Password))},
+		}
+	case models.EcrInstanceProfile:
+		config.CredHelpers = map[string]string{
+			registry: "ecr-login",
+		}
+	default:
+		return "", false, fmt.Errorf("unsupported scanner image pull credentials method %q", credentials.Method)
+	}
+
+	b, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", false, fmt.Errorf("failed to marshal docker config: %v", err)
+	}
+
+	return string(b), credentials.Method == models.EcrInstanceProfile, nil
+}
+
+// proxyEnv returns the environment variables of the proxy. They are set in
+// both cases since some tools, such as curl, only read the lower case ones.
+func proxyEnv(proxy *models.ScannerProxyConfig) []string {
+	if proxy == nil {
+		return nil
+	}
+
+	var env []string
+	for _, v := range []struct {
+		name  string
+		value *string
+	}{
+		{name: "HTTP_PROXY", value: proxy.HttpProxy},
+		{name: "HTTPS_PROXY", value: proxy.HttpsProxy},
+		{name: "NO_PROXY", value: proxy.NoProxy},
+	} {
+		if v.value == nil || *v.value == "" {
+			continue
+		}
+		env = append(env, v.name+"="+*v.value, strings.ToLower(v.name)+"="+*v.value)
+	}
+
+	return env
 }
 
 func GenerateCloudInit(data Data) (string, error) {
@@ -37,9 +152,24 @@ func GenerateCloudInit(data Data) (string, error) {
 		return "", fmt.Errorf("failed to parse cloud-init template: %v", err)
 	}
 
+	dockerConfig, ecrCredentialHelper, err := dockerConfig(data.ScannerImage, data.ScannerImagePullCredentials)
+	if err != nil {
+		return "", fmt.Errorf("failed to create docker config: %v", err)
+	}
+
 	// execute template using data
 	var tmplExB bytes.Buffer
-	if err := tmpl.Execute(&tmplExB, data); err != nil {
+	tmplData := templateData{
+		Data:                data,
+		DockerConfig:        dockerConfig,
+		ECRCredentialHelper: ecrCredentialHelper,
+		ProxyEnv:            proxyEnv(data.ScannerProxy),
+	}
+	if data.ScannerProxy != nil {
+		tmplData.HTTPProxy = utils.ValueOrZero(data.ScannerProxy.HttpProxy)
+		tmplData.HTTPSProxy = utils.ValueOrZero(data.ScannerProxy.HttpsProxy)
+	}
+	if err := tmpl.Execute(&tmplExB, tmplData); err != nil {
 		return "", fmt.Errorf("failed to execute cloud-init template: %v", err)
 	}
 	return tmplExB.String(), nil
@@ -19,11 +19,50 @@ const cloudInitTmpl string = `#cloud-config
 package_upgrade: true
 packages:
   - docker.io
+{{- if .ECRCredentialHelper }}
+  - amazon-ecr-credential-helper
+{{- end }}
+{{- if or .HTTPProxy .HTTPSProxy }}
+apt:
+{{- if .HTTPProxy }}
+  http_proxy: {{ .HTTPProxy | quote }}
+{{- end }}
+{{- if .HTTPSProxy }}
+  https_proxy: {{ .HTTPSProxy | quote }}
+{{- end }}
+{{- end }}
+{{- if .CABundle }}
+ca_certs:
+  trusted:
+    - |
+{{ .CABundle | indent 6 }}
+{{- end }}
 write_files:
   - path: /opt/vmclarity/scanconfig.yaml
     permissions: "0644"
     content: |
 {{ .ScannerCLIConfig | indent 6 }}
+{{- if .CABundle }}
+  - path: /opt/vmclarity/ca/ca-bundle.pem
+    permissions: "0644"
+    content: |
+{{ .CABundle | indent 6 }}
+{{- end }}
+{{- if .ProxyEnv }}
+  - path: /etc/systemd/system/docker.service.d/vmclarity-proxy.conf
+    permissions: "0644"
+    content: |
+      [Service]
+{{- range .ProxyEnv }}
+      Environment={{ . | quote }}
+{{- end }}
+{{- end }}
+{{- if .DockerConfig }}
+  - path: /etc/vmclarity/docker/config.json
+    permissions: "0600"
+    content: |
+{{ .DockerConfig | indent 6 }}
+{{- end }}
   - path: /etc/systemd/system/vmclarity-scanner.service
     permissions: "0644"
     content: |
@@ -35,17 +74,37 @@ write_files:
       [Service]
       Type=oneshot
       WorkingDirectory=/opt/vmclarity
+{{- if .DockerConfig }}
+      Environment=DOCKER_CONFIG=/etc/vmclarity/docker
+{{- end }}
       ExecStartPre=mkdir -p /var/opt/vmclarity
       ExecStartPre=docker pull {{ .ScannerImage }}
       ExecStart=docker run --rm --name %n --privileged \
           -v /opt/vmclarity:/opt/vmclarity \
           -v /run:/run \
           -v /var/opt/vmclarity:/var/opt/vmclarity \
+{{- if .CABundle }}
+          -e SSL_CERT_DIR=/opt/vmclarity/ca \
+{{- end }}
+{{- range .ProxyEnv }}
+          -e {{ . | quote }} \
+{{- end }}
           {{ .ScannerImage }} \
           --config /opt/vmclarity/scanconfig.yaml \
+{{- if .CABundle }}
+          --ca-bundle /opt/vmclarity/ca/ca-bundle.pem \
+{{- end }}
           --server {{ .ServerAddress }} \
+{{- if .DirectReadSnapshots }}
+          --direct-read-snapshots {{ join "," .DirectReadSnapshots | quote }} \
+          --direct-read-devices {{ join "," .DirectReadDevices | quote }} \
+          --direct-read-region {{ .DirectReadRegion }} \
+{{- end }}
           --wait-for-server-attached \
           --mount-attached-volume \
+{{- if .PartitionsToScan }}
+          --partitions {{ join "," .PartitionsToScan | quote }} \
+{{- end }}
           --scan-result-id {{ .ScanResultID }} \
           --output /var/opt/vmclarity
 
@@ -53,6 +112,11 @@ write_files:
       WantedBy=multi-user.target
 runcmd:
   - [ systemctl, daemon-reload ]
+{{- if .ProxyEnv }}
+  # The docker daemon started by the package install doesn't use the proxy yet.
+  - [ systemctl, restart, docker.service ]
+{{- else }}
   - [ systemctl, start, docker.service ]
+{{- end }}
   - [ systemctl, start, vmclarity-scanner.service ]
 `
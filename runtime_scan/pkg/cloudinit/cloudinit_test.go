@@ -0,0 +1,239 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_imageRegistry(t *testing.T) {
+	tests := []struct {
+		name  string
+		image string
+		want  string
+	}{
+		{
+			name:  "official image",
+			image: "ubuntu:22.04",
+			want:  "docker.io",
+		},
+		{
+			name:  "Docker Hub image",
+			image: "openclarity/vmclarity-cli:latest",
+			want:  "docker.io",
+		},
+		{
+			name:  "ECR image",
+			image: "123456789012.dkr.ecr.us-east-1.amazonaws.com/vmclarity-cli:latest",
+			want:  "123456789012.dkr.ecr.us-east-1.amazonaws.com",
+		},
+		{
+			name:  "registry with a port",
+			image: "registry:5000/vmclarity-cli",
+			want:  "registry:5000",
+		},
+		{
+			name:  "localhost registry",
+			image: "localhost/vmclarity-cli",
+			want:  "localhost",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := imageRegistry(tt.image); got != tt.want {
+				t.Errorf("imageRegistry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_dockerConfig(t *testing.T) {
+	type args struct {
+		image       string
+		credentials *models.ScannerImagePullCredentials
+	}
+	tests := []struct {
+		name                    string
+		args                    args
+		want                    string
+		wantECRCredentialHelper bool
+		wantErr                 bool
+	}{
+		{
+			name: "no credentials",
+			args: args{
+				image: "ghcr.io/openclarity/vmclarity-cli:latest",
+			},
+			want: "",
+		},
+		{
+			name: "static credentials",
+			args: args{
+				image: "ghcr.io/openclarity/vmclarity-cli:latest",
+				credentials: &models.ScannerImagePullCredentials{
+					Method:   models.Static,
+					Username: utils.PointerTo("user"),
+					Password: utils.PointerTo("pass"),
+				},
+			},
+			want: `{
+  "auths": {
+    "ghcr.io": {
+      "auth": "dXNlcjpwYXNz"
+    }
+  }
+}`,
+		},
+		{
+			name: "static credentials of Docker Hub",
+			args: args{
+				image: "openclarity/vmclarity-cli:latest",
+				credentials: &models.ScannerImagePullCredentials{
+					Method:   models.Static,
+					Username: utils.PointerTo("user"),
+					Password: utils.PointerTo("pass"),
+				},
+			},
+			want: `{
+  "auths": {
+    "https://index.docker.io/v1/": {
+      "auth": "dXNlcjpwYXNz"
+    }
+  }
+}`,
+		},
+		{
+			name: "static credentials without password",
+			args: args{
+				image: "ghcr.io/openclarity/vmclarity-cli:latest",
+				credentials: &models.ScannerImagePullCredentials{
+					Method:   models.Static,
+					Username: utils.PointerTo("user"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ECR instance profile",
+			args: args{
+				image: "123456789012.dkr.ecr.us-east-1.amazonaws.com/vmclarity-cli:latest",
+				credentials: &models.ScannerImagePullCredentials{
+					Method: models.EcrInstanceProfile,
+				},
+			},
+			want: `{
+  "credHelpers": {
+    "123456789012.dkr.ecr.us-east-1.amazonaws.com": "ecr-login"
+  }
+}`,
+			wantECRCredentialHelper: true,
+		},
+		{
+			name: "ECR instance profile with a registry",
+			args: args{
+				image: "vmclarity-cli:latest",
+				credentials: &models.ScannerImagePullCredentials{
+					Method:   models.EcrInstanceProfile,
+					Registry: utils.PointerTo("123456789012.dkr.ecr.us-east-1.amazonaws.com"),
+				},
+			},
+			want: `{
+  "credHelpers": {
+    "123456789012.dkr.ecr.us-east-1.amazonaws.com": "ecr-login"
+  }
+}`,
+			wantECRCredentialHelper: true,
+		},
+		{
+			name: "unknown method",
+			args: args{
+				image: "ghcr.io/openclarity/vmclarity-cli:latest",
+				credentials: &models.ScannerImagePullCredentials{
+					Method: "unknown",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotECRCredentialHelper, err := dockerConfig(tt.args.image, tt.args.credentials)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dockerConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("dockerConfig() mismatch (-want +got):\n%s", diff)
+			}
+			if gotECRCredentialHelper != tt.wantECRCredentialHelper {
+				t.Errorf("dockerConfig() ECR credential helper = %v, want %v", gotECRCredentialHelper, tt.wantECRCredentialHelper)
+			}
+		})
+	}
+}
+
+func Test_proxyEnv(t *testing.T) {
+	tests := []struct {
+		name  string
+		proxy *models.ScannerProxyConfig
+		want  []string
+	}{
+		{
+			name:  "no proxy",
+			proxy: nil,
+			want:  nil,
+		},
+		{
+			name: "all the fields",
+			proxy: &models.ScannerProxyConfig{
+				HttpProxy:  utils.PointerTo("http://proxy:3128"),
+				HttpsProxy: utils.PointerTo("socks5://proxy:1080"),
+				NoProxy:    utils.PointerTo("10.0.0.1,.internal"),
+			},
+			want: []string{
+				"HTTP_PROXY=http://proxy:3128",
+				"http_proxy=http://proxy:3128",
+				"HTTPS_PROXY=socks5://proxy:1080",
+				"https_proxy=socks5://proxy:1080",
+				"NO_PROXY=10.0.0.1,.internal",
+				"no_proxy=10.0.0.1,.internal",
+			},
+		},
+		{
+			name: "empty fields are skipped",
+			proxy: &models.ScannerProxyConfig{
+				HttpProxy:  utils.PointerTo(""),
+				HttpsProxy: utils.PointerTo("http://proxy:3128"),
+			},
+			want: []string{
+				"HTTPS_PROXY=http://proxy:3128",
+				"https_proxy=http://proxy:3128",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := proxyEnv(tt.proxy)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("proxyEnv() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
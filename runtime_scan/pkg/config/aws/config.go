@@ -15,27 +15,91 @@
 
 package aws
 
-import "github.com/spf13/viper"
+import (
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
 
 const (
 	AWSSubnetID            = "AWS_SUBNET_ID"
 	AWSJobImageID          = "AWS_JOB_IMAGE_ID"
 	AWSSecurityGroupID     = "AWS_SECURITY_GROUP_ID"
 	AWSInstanceType        = "AWS_INSTANCE_TYPE"
+	AWSDescribeCacheTTL    = "AWS_DESCRIBE_CACHE_TTL"
+	AWSScanIDTagKey        = "AWS_SCAN_ID_TAG_KEY"
+	AWSScanResultIDTagKey  = "AWS_SCAN_RESULT_ID_TAG_KEY"
+	AWSTargetIDTagKey      = "AWS_TARGET_ID_TAG_KEY"
 	defaultAWSJobImageID   = "ami-0568773882d492fc8" // ubuntu server 22.04 LTS (HVM), SSD volume type
 	defaultAWSInstanceType = "t2.large"
+	// The keys of the tags of the scanning job resources with the IDs of
+	// the Scan, ScanResult and Target of their job. The ScanResult ID is
+	// used to find leaked resources.
+	defaultAWSScanIDTagKey       = "VMClarity.ScanID"
+	defaultAWSScanResultIDTagKey = "VMClarity.ScanResultID"
+	defaultAWSTargetIDTagKey     = "VMClarity.TargetID"
+	// Shorter than the resource ready check interval so that the waiters
+	// don't miss state changes.
+	defaultAWSDescribeCacheTTL = "2s"
+
+	AWSFastSnapshotRestoreEnabled = "AWS_FAST_SNAPSHOT_RESTORE_ENABLED"
+	AWSFastSnapshotRestoreTimeout = "AWS_FAST_SNAPSHOT_RESTORE_TIMEOUT"
+	// Optimizing a snapshot for fast restore takes longer for large volumes,
+	// after the timeout the volume is created without waiting any further.
+	defaultAWSFastSnapshotRestoreTimeout = "10m"
+
+	AWSScannerKMSKeyID = "AWS_SCANNER_KMS_KEY_ID"
+
+	// AWSScannerRegionNetworks is a comma separated list of
+	// region:subnetID:securityGroupID of the regions where the scanners
+	// aren't launched in the scanner subnet and security group.
+	AWSScannerRegionNetworks = "AWS_SCANNER_REGION_NETWORKS"
 )
 
+// RegionNetwork is the network the scanners of a region are launched in.
+type RegionNetwork struct {
+	SubnetID        string
+	SecurityGroupID string
+}
+
 type Config struct {
 	AmiID           string // image id of a scanner job
 	SubnetID        string // the scanner's subnet ID
 	SecurityGroupID string // the scanner's security group
 	InstanceType    string // the scanner's instance type
+
+	ScanIDTagKey       string // the key of the tag of the scanning job resources with the ID of their Scan
+	ScanResultIDTagKey string // the key of the tag of the scanning job resources with the ID of their ScanResult
+	TargetIDTagKey     string // the key of the tag of the scanning job resources with the ID of their Target
+
+	DescribeCacheTTL time.Duration // TTL of cached describe results, 0 disables the cache
+
+	FastSnapshotRestoreEnabled bool          // enable fast snapshot restore on the scanner availability zone before creating a volume
+	FastSnapshotRestoreTimeout time.Duration // how long to wait for fast snapshot restore to be enabled before creating the volume
+
+	// The ID, ARN or alias of a KMS key in the scanner region which the
+	// encrypted snapshots of the targets are re-encrypted with when they
+	// are copied to the scanner region, and the volumes created from them
+	// are encrypted with. This allows scanning volumes encrypted with
+	// customer managed keys which are not available in the scanner
+	// region or account. If not set, the key of the target is kept.
+	ScannerKMSKeyID string
+
+	// The networks of the scanners by region, the scanners of the other
+	// regions are launched in SubnetID with SecurityGroupID.
+	RegionNetworks map[string]RegionNetwork
 }
 
 func setConfigDefaults() {
 	viper.SetDefault(AWSJobImageID, defaultAWSJobImageID)
 	viper.SetDefault(AWSInstanceType, defaultAWSInstanceType)
+	viper.SetDefault(AWSDescribeCacheTTL, defaultAWSDescribeCacheTTL)
+	viper.SetDefault(AWSScanIDTagKey, defaultAWSScanIDTagKey)
+	viper.SetDefault(AWSScanResultIDTagKey, defaultAWSScanResultIDTagKey)
+	viper.SetDefault(AWSTargetIDTagKey, defaultAWSTargetIDTagKey)
+	viper.SetDefault(AWSFastSnapshotRestoreTimeout, defaultAWSFastSnapshotRestoreTimeout)
 
 	viper.AutomaticEnv()
 }
@@ -48,7 +112,55 @@ func LoadConfig() *Config {
 		SubnetID:        viper.GetString(AWSSubnetID),
 		SecurityGroupID: viper.GetString(AWSSecurityGroupID),
 		InstanceType:    viper.GetString(AWSInstanceType),
+
+		ScanIDTagKey:       viper.GetString(AWSScanIDTagKey),
+		ScanResultIDTagKey: viper.GetString(AWSScanResultIDTagKey),
+		TargetIDTagKey:     viper.GetString(AWSTargetIDTagKey),
+
+		DescribeCacheTTL: viper.GetDuration(AWSDescribeCacheTTL),
+
+		FastSnapshotRestoreEnabled: viper.GetBool(AWSFastSnapshotRestoreEnabled),
+		FastSnapshotRestoreTimeout: viper.GetDuration(AWSFastSnapshotRestoreTimeout),
+
+		ScannerKMSKeyID: viper.GetString(AWSScannerKMSKeyID),
+
+		RegionNetworks: parseRegionNetworks(viper.GetString(AWSScannerRegionNetworks)),
 	}
 
 	return config
 }
+
+// parseRegionNetworks parses a comma separated list of
+// region:subnetID:securityGroupID, the invalid entries are skipped.
+func parseRegionNetworks(s string) map[string]RegionNetwork {
+	ret := make(map[string]RegionNetwork)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" { // nolint:gomnd
+			log.Errorf("Invalid scanner region network %q, expected region:subnetID:securityGroupID", entry)
+			continue
+		}
+		ret[parts[0]] = RegionNetwork{
+			SubnetID:        parts[1],
+			SecurityGroupID: parts[2],
+		}
+	}
+
+	return ret
+}
+
+// GetRegionNetwork returns the network the scanners of the region are launched in.
+func (c *Config) GetRegionNetwork(region string) RegionNetwork {
+	if network, ok := c.RegionNetworks[region]; ok {
+		return network
+	}
+
+	return RegionNetwork{
+		SubnetID:        c.SubnetID,
+		SecurityGroupID: c.SecurityGroupID,
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import "github.com/spf13/viper"
+
+const (
+	AzureSubscriptionID               = "AZURE_SUBSCRIPTION_ID"
+	AzureScannerLocation              = "AZURE_SCANNER_LOCATION"
+	AzureScannerResourceGroup         = "AZURE_SCANNER_RESOURCE_GROUP"
+	AzureScannerSubnetID              = "AZURE_SCANNER_SUBNET_ID"
+	AzureScannerSecurityGroupID       = "AZURE_SCANNER_SECURITY_GROUP_ID"
+	AzureScannerVMSize                = "AZURE_SCANNER_VM_SIZE"
+	AzureScannerImagePublisher        = "AZURE_SCANNER_IMAGE_PUBLISHER"
+	AzureScannerImageOffer            = "AZURE_SCANNER_IMAGE_OFFER"
+	AzureScannerImageSKU              = "AZURE_SCANNER_IMAGE_SKU"
+	AzureScannerImageVersion          = "AZURE_SCANNER_IMAGE_VERSION"
+	AzureScannerPublicKey             = "AZURE_SCANNER_PUBLIC_KEY"
+	AzureScanIDTagKey                 = "AZURE_SCAN_ID_TAG_KEY"
+	AzureScanResultIDTagKey           = "AZURE_SCAN_RESULT_ID_TAG_KEY"
+	AzureTargetIDTagKey               = "AZURE_TARGET_ID_TAG_KEY"
+	defaultAzureScannerVMSize         = "Standard_D2s_v3"
+	defaultAzureScannerImagePublisher = "Canonical"
+	defaultAzureScannerImageOffer     = "0001-com-ubuntu-server-jammy" // ubuntu server 22.04 LTS
+	defaultAzureScannerImageSKU       = "22_04-lts-gen2"
+	defaultAzureScannerImageVersion   = "latest"
+	defaultAzureScanIDTagKey          = "VMClarity.ScanID"
+	defaultAzureScanResultIDTagKey    = "VMClarity.ScanResultID"
+	defaultAzureTargetIDTagKey        = "VMClarity.TargetID"
+)
+
+type Config struct {
+	SubscriptionID         string // the subscription to discover and scan virtual machines in
+	ScannerLocation        string // the location (region) of the scanner virtual machines
+	ScannerResourceGroup   string // the resource group to create the scanner resources in
+	ScannerSubnetID        string // the scanner's subnet resource ID
+	ScannerSecurityGroupID string // the scanner's network security group resource ID, optional
+	ScannerVMSize          string // the scanner's virtual machine size
+	ScannerImagePublisher  string // marketplace image publisher of a scanner job
+	ScannerImageOffer      string // marketplace image offer of a scanner job
+	ScannerImageSKU        string // marketplace image SKU of a scanner job
+	ScannerImageVersion    string // marketplace image version of a scanner job
+	ScannerPublicKey       string // the SSH public key to set on the scanner virtual machine
+	ScanIDTagKey           string // the key of the tag of the scanning job resources with the ID of their Scan
+	ScanResultIDTagKey     string // the key of the tag of the scanning job resources with the ID of their ScanResult
+	TargetIDTagKey         string // the key of the tag of the scanning job resources with the ID of their Target
+}
+
+func setConfigDefaults() {
+	viper.SetDefault(AzureScannerVMSize, defaultAzureScannerVMSize)
+	viper.SetDefault(AzureScannerImagePublisher, defaultAzureScannerImagePublisher)
+	viper.SetDefault(AzureScannerImageOffer, defaultAzureScannerImageOffer)
+	viper.SetDefault(AzureScannerImageSKU, defaultAzureScannerImageSKU)
+	viper.SetDefault(AzureScannerImageVersion, defaultAzureScannerImageVersion)
+	viper.SetDefault(AzureScanIDTagKey, defaultAzureScanIDTagKey)
+	viper.SetDefault(AzureScanResultIDTagKey, defaultAzureScanResultIDTagKey)
+	viper.SetDefault(AzureTargetIDTagKey, defaultAzureTargetIDTagKey)
+
+	viper.AutomaticEnv()
+}
+
+func LoadConfig() *Config {
+	setConfigDefaults()
+
+	config := &Config{
+		SubscriptionID:         viper.GetString(AzureSubscriptionID),
+		ScannerLocation:        viper.GetString(AzureScannerLocation),
+		ScannerResourceGroup:   viper.GetString(AzureScannerResourceGroup),
+		ScannerSubnetID:        viper.GetString(AzureScannerSubnetID),
+		ScannerSecurityGroupID: viper.GetString(AzureScannerSecurityGroupID),
+		ScannerVMSize:          viper.GetString(AzureScannerVMSize),
+		ScannerImagePublisher:  viper.GetString(AzureScannerImagePublisher),
+		ScannerImageOffer:      viper.GetString(AzureScannerImageOffer),
+		ScannerImageSKU:        viper.GetString(AzureScannerImageSKU),
+		ScannerImageVersion:    viper.GetString(AzureScannerImageVersion),
+		ScannerPublicKey:       viper.GetString(AzureScannerPublicKey),
+		ScanIDTagKey:           viper.GetString(AzureScanIDTagKey),
+		ScanResultIDTagKey:     viper.GetString(AzureScanResultIDTagKey),
+		TargetIDTagKey:         viper.GetString(AzureTargetIDTagKey),
+	}
+
+	return config
+}
@@ -18,6 +18,7 @@ package config
 import (
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 	"time"
 
@@ -25,37 +26,99 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/aws"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/azure"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/gcp"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/targetmetadata"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
 )
 
 const (
+	ProviderTypeEnv                 = "PROVIDER_TYPE"
 	ScannerAWSRegion                = "SCANNER_AWS_REGION"
 	defaultScannerAWSRegion         = "us-east-1"
 	JobResultTimeout                = "JOB_RESULT_TIMEOUT"
 	JobResultsPollingInterval       = "JOB_RESULT_POLLING_INTERVAL"
+	JobResultsPollingMaxInterval    = "JOB_RESULT_POLLING_MAX_INTERVAL"
+	JobResultsPollingMultiplier     = "JOB_RESULT_POLLING_MULTIPLIER"
 	DeleteJobPolicy                 = "DELETE_JOB_POLICY"
 	ScannerContainerImage           = "SCANNER_CONTAINER_IMAGE"
 	ScannerKeyPairName              = "SCANNER_KEY_PAIR_NAME"
+	ScannerHTTPProxy                = "SCANNER_HTTP_PROXY"
+	ScannerHTTPSProxy               = "SCANNER_HTTPS_PROXY"
+	ScannerNoProxy                  = "SCANNER_NO_PROXY"
 	GitleaksBinaryPath              = "GITLEAKS_BINARY_PATH"
+	TrufflehogBinaryPath            = "TRUFFLEHOG_BINARY_PATH"
 	ClamBinaryPath                  = "CLAM_BINARY_PATH"
 	FreshclamBinaryPath             = "FRESHCLAM_BINARY_PATH"
 	AlternativeFreshclamMirrorURL   = "ALTERNATIVE_FRESHCLAM_MIRROR_URL"
+	YaraBinaryPath                  = "YARA_BINARY_PATH"
+	YaraRulesPath                   = "YARA_RULES_PATH"
+	YaraRulesURL                    = "YARA_RULES_URL"
 	LynisInstallPath                = "LYNIS_INSTALL_PATH"
+	KICSBinaryPath                  = "KICS_BINARY_PATH"
+	KICSQueriesPath                 = "KICS_QUERIES_PATH"
+	KICSSeverityThreshold           = "KICS_SEVERITY_THRESHOLD"
 	AttachedVolumeDeviceName        = "ATTACHED_VOLUME_DEVICE_NAME"
 	defaultAttachedVolumeDeviceName = "xvdh"
 	ScannerBackendAddress           = "SCANNER_VMCLARITY_BACKEND_ADDRESS"
 	ScanConfigWatchInterval         = "SCAN_CONFIG_WATCH_INTERVAL"
 	ExploitDBAddress                = "EXPLOIT_DB_ADDRESS"
+	ExploitDBLocalPath              = "EXPLOIT_DB_LOCAL_PATH"
+	CABundlePath                    = "CA_BUNDLE_PATH"
 	TrivyServerAddress              = "TRIVY_SERVER_ADDRESS"
+	TrivyServerToken                = "TRIVY_SERVER_TOKEN"
 	GrypeServerAddress              = "GRYPE_SERVER_ADDRESS"
+	GrypeServerTimeout              = "GRYPE_SERVER_TIMEOUT"
+	GrypeDBUpdate                   = "GRYPE_DB_UPDATE"
+	GrypeDBListingURL               = "GRYPE_DB_LISTING_URL"
+	GrypeDBRootDir                  = "GRYPE_DB_ROOT_DIR"
+	VulnerabilityAliasesSource      = "VULNERABILITY_ALIASES_SOURCE"
+	OSVScannerBinaryPath            = "OSV_SCANNER_BINARY_PATH"
 	ChkrootkitBinaryPath            = "CHKROOTKIT_BINARY_PATH"
+	RkhunterBinaryPath              = "RKHUNTER_BINARY_PATH"
+	ScanningJobLaunchMaxAttempts    = "SCANNING_JOB_LAUNCH_MAX_ATTEMPTS"
+	ScanningJobLaunchRetryInterval  = "SCANNING_JOB_LAUNCH_RETRY_INTERVAL"
+	MaxJobRetries                   = "MAX_JOB_RETRIES"
+	JobRetryInterval                = "JOB_RETRY_INTERVAL"
+	WorkerPoolFixedSize             = "WORKER_POOL_FIXED_SIZE"
+	WorkerPoolMinWorkers            = "WORKER_POOL_MIN_WORKERS"
+	WorkerPoolIdleTimeout           = "WORKER_POOL_IDLE_TIMEOUT"
+	SnapshotCopyRetries             = "SNAPSHOT_COPY_RETRIES"
+	SnapshotCopyRetryInterval       = "SNAPSHOT_COPY_RETRY_INTERVAL"
+	SnapshotDirectRead              = "SNAPSHOT_DIRECT_READ"
+	NoTargetsPolicy                 = "NO_TARGETS_POLICY"
+	CircuitBreakerFailureThreshold  = "CIRCUIT_BREAKER_FAILURE_THRESHOLD"
+	CircuitBreakerOpenDuration      = "CIRCUIT_BREAKER_OPEN_DURATION"
+	ProviderAPIMaxConcurrentCalls   = "PROVIDER_API_MAX_CONCURRENT_CALLS"
+	OrphanReaperInterval            = "ORPHAN_REAPER_INTERVAL"
 )
 
 type OrchestratorConfig struct {
+	ProviderType          ProviderType
 	AWSConfig             *aws.Config
+	AzureConfig           *azure.Config
+	GCPConfig             *gcp.Config
+	TargetMetadataConfig  *targetmetadata.Config
+	WebhookConfig         *webhook.Config
 	ScannerBackendAddress string
+	// The interval between the deletions of the leaked scanning job
+	// resources, the reaper is disabled when it is 0. The resources are
+	// found by the scan result ID tag key of the provider.
+	OrphanReaperInterval time.Duration
 	ScannerConfig
 }
 
+type GrypeDBConfig struct {
+	// Whether grype downloads the latest DB before scanning. Disable it in
+	// air-gapped environments with a pre-seeded DB in RootDir.
+	UpdateDB bool
+	// The URL of the listing of the available DBs, for example of an
+	// internal mirror.
+	ListingURL string
+	// The directory of the DB on the scanner.
+	RootDir string
+}
+
 type ScannerConfig struct {
 	// We need to know where the VMClarity scanner is running so that we
 	// can boot the scanner jobs in the same region, there isn't a
@@ -63,6 +126,10 @@ type ScannerConfig struct {
 	// value.
 	Region string
 
+	// The type of the provider the scanning jobs run on, used to label
+	// the metrics of the scanner.
+	ProviderType ProviderType
+
 	// Address that the Scanner should use to talk to the VMClarity backend
 	// We use a configuration variable for this instead of discovering it
 	// automatically in case VMClarity backend has multiple IPs (internal
@@ -72,15 +139,96 @@ type ScannerConfig struct {
 
 	ExploitsDBAddress string
 
+	// The pre-synced go-exploitdb sqlite3 database in the scanner image
+	// container, if set the exploits are read from it instead of the
+	// exploit db server, for scanners without internet access.
+	ExploitsDBLocalPath string
+
 	TrivyServerAddress string
 
+	// Token the scanners authenticate to the trivy server with, no token
+	// is sent if empty.
+	TrivyServerToken string
+
 	GrypeServerAddress string
 
+	// Timeout of the requests to the grype server, unless the scan config
+	// overrides it.
+	GrypeServerTimeout time.Duration
+
+	// The vulnerability DB of grype when it runs locally on the scanner,
+	// which is when GrypeServerAddress isn't set.
+	GrypeDB GrypeDBConfig
+
+	// File path or URL of the vulnerability alias dataset used by the
+	// scanner to normalize vulnerability IDs to a canonical CVE.
+	VulnerabilityAliasesSource string
+
 	JobResultTimeout          time.Duration
 	JobResultsPollingInterval time.Duration
 	ScanConfigWatchInterval   time.Duration
 	DeleteJobPolicy           DeleteJobPolicyType
 
+	// The results of a job are polled with an exponential backoff from
+	// JobResultsPollingInterval up to this interval, which is reset when
+	// the state of the job changes. The results are polled at the fixed
+	// JobResultsPollingInterval when it's not set.
+	JobResultsPollingMaxInterval time.Duration
+	JobResultsPollingMultiplier  float64
+
+	// The state a scan is completed with when the scope of its scan
+	// config doesn't match any targets, for example when all the
+	// instances were terminated.
+	NoTargetsPolicy NoTargetsPolicyType
+
+	// The number of attempts to launch a scanning job, and the initial
+	// interval between them which is increased exponentially. Launch
+	// failures are often transient (capacity, throttling).
+	ScanningJobLaunchMaxAttempts   int
+	ScanningJobLaunchRetryInterval time.Duration
+
+	// The number of times to rerun a failed scanning job of a target with
+	// fresh infrastructure, and the initial interval between the reruns
+	// which is increased exponentially. A target is only failed once the
+	// retries are exhausted.
+	MaxJobRetries    int
+	JobRetryInterval time.Duration
+
+	// The workers of a scan are started as its targets are queued, up to
+	// the max parallel scanners of the scan, and exit once they were idle
+	// for the idle timeout, down to the min workers. With a fixed size
+	// pool, or an idle timeout of 0, the max parallel scanners workers run
+	// for the whole scan.
+	WorkerPoolFixedSize   bool
+	WorkerPoolMinWorkers  int
+	WorkerPoolIdleTimeout time.Duration
+
+	// The number of times to retry copying a snapshot to the scanner
+	// region, and the initial interval between the retries which is
+	// increased exponentially. Cross region copies often fail transiently
+	// (throttling, eventual consistency).
+	SnapshotCopyRetries       int
+	SnapshotCopyRetryInterval time.Duration
+
+	// Whether the scanner instances read the blocks of the snapshots
+	// directly from the provider instead of attaching volumes created from
+	// them. The volumes are attached when the provider doesn't support
+	// reading the snapshots directly.
+	SnapshotDirectRead bool
+
+	// The number of consecutive scanning job failures in a provider
+	// region after which new jobs in the region fail fast, and the time
+	// until a probe job is let through. The circuit breaker is disabled
+	// when the threshold is 0.
+	CircuitBreakerFailureThreshold int
+	CircuitBreakerOpenDuration     time.Duration
+
+	// The maximum number of concurrent provider API calls (snapshot, copy,
+	// volume creation, attach and launch) made by the scanning jobs of all
+	// the scans, independently of the number of parallel scanners of each
+	// scan. The calls are not limited when it is 0.
+	ProviderAPIMaxConcurrentCalls int
+
 	// The container image to use once we've booted the scanner virtual
 	// machine, that contains the VMClarity CLI plus all the required
 	// tools.
@@ -90,45 +238,111 @@ type ScannerConfig struct {
 	// Mainly used for debugging.
 	ScannerKeyPairName string
 
+	// The proxy the scanner instances egress through, the proxy of the
+	// scanner instance creation config of a scan overrides it field by
+	// field.
+	ScannerHTTPProxy  string
+	ScannerHTTPSProxy string
+	ScannerNoProxy    string
+
 	// The gitleaks binary path in the scanner image container.
 	GitleaksBinaryPath string
 
+	// The trufflehog binary path in the scanner image container.
+	TrufflehogBinaryPath string
+
 	// The clam binary path in the scanner image container.
 	ClamBinaryPath string
 
+	// The osv-scanner binary path in the scanner image container.
+	OSVScannerBinaryPath string
+
 	// The freshclam binary path in the scanner image container
 	FreshclamBinaryPath string
 
 	// The freshclam mirror url to use if it's enabled
 	AlternativeFreshclamMirrorURL string
 
+	// The yara binary path in the scanner image container.
+	YaraBinaryPath string
+
+	// The YARA rules file or directory in the scanner image container.
+	YaraRulesPath string
+
+	// The url to download the YARA rules file from, e.g. an internal rules
+	// server.
+	YaraRulesURL string
+
 	// The location where Lynis is installed in the scanner image
 	LynisInstallPath string
 
+	// The kics binary path in the scanner image container.
+	KICSBinaryPath string
+
+	// The KICS queries directory in the scanner image container.
+	KICSQueriesPath string
+
+	// The lowest KICS severity to report (TRACE, INFO, LOW, MEDIUM, HIGH or
+	// CRITICAL), if not set all results are reported.
+	KICSSeverityThreshold string
+
 	// The chkrootkit binary path in the scanner image container.
 	ChkrootkitBinaryPath string
 
+	// The rkhunter binary path in the scanner image container.
+	RkhunterBinaryPath string
+
 	// the name of the block device to attach to the scanner job
 	DeviceName string
+
+	// The PEM CA bundle written to the scanner instances, which the scanner
+	// trusts on top of the system certificates, e.g. of a TLS intercepting
+	// proxy.
+	CABundle string
 }
 
 func setConfigDefaults(backendHost string, backendPort int, backendBaseURL string) {
+	viper.SetDefault(ProviderTypeEnv, string(ProviderTypeAWS))
 	viper.SetDefault(ScannerAWSRegion, defaultScannerAWSRegion)
 	viper.SetDefault(JobResultTimeout, "120m")
 	viper.SetDefault(JobResultsPollingInterval, "30s")
+	viper.SetDefault(JobResultsPollingMultiplier, 2) // nolint:gomnd
 	viper.SetDefault(ScanConfigWatchInterval, "30s")
 	viper.SetDefault(DeleteJobPolicy, string(DeleteJobPolicyAlways))
+	viper.SetDefault(NoTargetsPolicy, string(NoTargetsPolicyDone))
+	viper.SetDefault(ScanningJobLaunchMaxAttempts, 3)
+	viper.SetDefault(ScanningJobLaunchRetryInterval, "30s")
+	viper.SetDefault(MaxJobRetries, 2)
+	viper.SetDefault(JobRetryInterval, "1m")
+	viper.SetDefault(WorkerPoolMinWorkers, 1)
+	viper.SetDefault(WorkerPoolIdleTimeout, "1m")
+	viper.SetDefault(SnapshotCopyRetries, 3)
+	viper.SetDefault(SnapshotCopyRetryInterval, "30s")
+	viper.SetDefault(CircuitBreakerFailureThreshold, 5)
+	viper.SetDefault(CircuitBreakerOpenDuration, "5m")
+	viper.SetDefault(OrphanReaperInterval, "1h")
+	viper.SetDefault(GrypeServerTimeout, "2m")
+	viper.SetDefault(GrypeDBUpdate, true)
+	viper.SetDefault(GrypeDBListingURL, "https://toolbox-data.anchore.io/grype/databases/listing.json")
+	viper.SetDefault(GrypeDBRootDir, "/tmp/")
 	viper.SetDefault(ScannerBackendAddress, fmt.Sprintf("http://%s%s", net.JoinHostPort(backendHost, strconv.Itoa(backendPort)), backendBaseURL))
 	// https://github.com/openclarity/vmclarity-tools-base/blob/main/Dockerfile#L33
 	viper.SetDefault(GitleaksBinaryPath, "/artifacts/gitleaks")
+	viper.SetDefault(TrufflehogBinaryPath, "/artifacts/trufflehog")
+	viper.SetDefault(OSVScannerBinaryPath, "/artifacts/osv-scanner")
 	// https://github.com/openclarity/vmclarity-tools-base/blob/main/Dockerfile#L35
 	viper.SetDefault(LynisInstallPath, "/artifacts/lynis")
+	// Copied from the KICS image in Dockerfile.cli.
+	viper.SetDefault(KICSBinaryPath, "/artifacts/kics")
+	viper.SetDefault(KICSQueriesPath, "/artifacts/kics-queries")
 	// https://github.com/openclarity/vmclarity-tools-base/blob/main/Dockerfile
 	viper.SetDefault(ChkrootkitBinaryPath, "/artifacts/chkrootkit")
+	viper.SetDefault(RkhunterBinaryPath, "/artifacts/rkhunter")
 	viper.SetDefault(ExploitDBAddress, fmt.Sprintf("http://%s", net.JoinHostPort(backendHost, "1326")))
 	viper.SetDefault(AttachedVolumeDeviceName, defaultAttachedVolumeDeviceName)
 	viper.SetDefault(ClamBinaryPath, "clamscan")
 	viper.SetDefault(FreshclamBinaryPath, "freshclam")
+	viper.SetDefault(YaraBinaryPath, "yara")
 
 	viper.AutomaticEnv()
 }
@@ -136,34 +350,109 @@ func setConfigDefaults(backendHost string, backendPort int, backendBaseURL strin
 func LoadConfig(backendHost string, backendPort int, baseURL string) (*OrchestratorConfig, error) {
 	setConfigDefaults(backendHost, backendPort, baseURL)
 
+	providerType := getProviderType(viper.GetString(ProviderTypeEnv))
+
 	config := &OrchestratorConfig{
+		ProviderType:          providerType,
 		AWSConfig:             aws.LoadConfig(),
+		AzureConfig:           azure.LoadConfig(),
+		GCPConfig:             gcp.LoadConfig(),
+		TargetMetadataConfig:  targetmetadata.LoadConfig(),
+		WebhookConfig:         webhook.LoadConfig(),
 		ScannerBackendAddress: viper.GetString(ScannerBackendAddress),
+		OrphanReaperInterval:  viper.GetDuration(OrphanReaperInterval),
 		ScannerConfig: ScannerConfig{
-			Region:                        viper.GetString(ScannerAWSRegion),
-			JobResultTimeout:              viper.GetDuration(JobResultTimeout),
-			JobResultsPollingInterval:     viper.GetDuration(JobResultsPollingInterval),
-			ScanConfigWatchInterval:       viper.GetDuration(ScanConfigWatchInterval),
-			DeleteJobPolicy:               getDeleteJobPolicyType(viper.GetString(DeleteJobPolicy)),
-			ScannerImage:                  viper.GetString(ScannerContainerImage),
-			ScannerBackendAddress:         viper.GetString(ScannerBackendAddress),
-			ScannerKeyPairName:            viper.GetString(ScannerKeyPairName),
-			GitleaksBinaryPath:            viper.GetString(GitleaksBinaryPath),
-			LynisInstallPath:              viper.GetString(LynisInstallPath),
-			DeviceName:                    viper.GetString(AttachedVolumeDeviceName),
-			ExploitsDBAddress:             viper.GetString(ExploitDBAddress),
-			ClamBinaryPath:                viper.GetString(ClamBinaryPath),
-			FreshclamBinaryPath:           viper.GetString(FreshclamBinaryPath),
-			AlternativeFreshclamMirrorURL: viper.GetString(AlternativeFreshclamMirrorURL),
-			TrivyServerAddress:            viper.GetString(TrivyServerAddress),
-			GrypeServerAddress:            viper.GetString(GrypeServerAddress),
-			ChkrootkitBinaryPath:          viper.GetString(ChkrootkitBinaryPath),
+			Region:                         viper.GetString(ScannerAWSRegion),
+			ProviderType:                   providerType,
+			JobResultTimeout:               viper.GetDuration(JobResultTimeout),
+			JobResultsPollingInterval:      viper.GetDuration(JobResultsPollingInterval),
+			JobResultsPollingMaxInterval:   viper.GetDuration(JobResultsPollingMaxInterval),
+			JobResultsPollingMultiplier:    viper.GetFloat64(JobResultsPollingMultiplier),
+			ScanConfigWatchInterval:        viper.GetDuration(ScanConfigWatchInterval),
+			DeleteJobPolicy:                getDeleteJobPolicyType(viper.GetString(DeleteJobPolicy)),
+			NoTargetsPolicy:                getNoTargetsPolicyType(viper.GetString(NoTargetsPolicy)),
+			ScanningJobLaunchMaxAttempts:   viper.GetInt(ScanningJobLaunchMaxAttempts),
+			ScanningJobLaunchRetryInterval: viper.GetDuration(ScanningJobLaunchRetryInterval),
+			MaxJobRetries:                  viper.GetInt(MaxJobRetries),
+			JobRetryInterval:               viper.GetDuration(JobRetryInterval),
+			WorkerPoolFixedSize:            viper.GetBool(WorkerPoolFixedSize),
+			WorkerPoolMinWorkers:           viper.GetInt(WorkerPoolMinWorkers),
+			WorkerPoolIdleTimeout:          viper.GetDuration(WorkerPoolIdleTimeout),
+			SnapshotCopyRetries:            viper.GetInt(SnapshotCopyRetries),
+			SnapshotCopyRetryInterval:      viper.GetDuration(SnapshotCopyRetryInterval),
+			SnapshotDirectRead:             viper.GetBool(SnapshotDirectRead),
+			CircuitBreakerFailureThreshold: viper.GetInt(CircuitBreakerFailureThreshold),
+			CircuitBreakerOpenDuration:     viper.GetDuration(CircuitBreakerOpenDuration),
+			ProviderAPIMaxConcurrentCalls:  viper.GetInt(ProviderAPIMaxConcurrentCalls),
+			ScannerImage:                   viper.GetString(ScannerContainerImage),
+			ScannerBackendAddress:          viper.GetString(ScannerBackendAddress),
+			ScannerKeyPairName:             viper.GetString(ScannerKeyPairName),
+			ScannerHTTPProxy:               viper.GetString(ScannerHTTPProxy),
+			ScannerHTTPSProxy:              viper.GetString(ScannerHTTPSProxy),
+			ScannerNoProxy:                 viper.GetString(ScannerNoProxy),
+			GitleaksBinaryPath:             viper.GetString(GitleaksBinaryPath),
+			TrufflehogBinaryPath:           viper.GetString(TrufflehogBinaryPath),
+			LynisInstallPath:               viper.GetString(LynisInstallPath),
+			KICSBinaryPath:                 viper.GetString(KICSBinaryPath),
+			KICSQueriesPath:                viper.GetString(KICSQueriesPath),
+			KICSSeverityThreshold:          viper.GetString(KICSSeverityThreshold),
+			DeviceName:                     viper.GetString(AttachedVolumeDeviceName),
+			ExploitsDBAddress:              viper.GetString(ExploitDBAddress),
+			ExploitsDBLocalPath:            viper.GetString(ExploitDBLocalPath),
+			ClamBinaryPath:                 viper.GetString(ClamBinaryPath),
+			FreshclamBinaryPath:            viper.GetString(FreshclamBinaryPath),
+			AlternativeFreshclamMirrorURL:  viper.GetString(AlternativeFreshclamMirrorURL),
+			YaraBinaryPath:                 viper.GetString(YaraBinaryPath),
+			YaraRulesPath:                  viper.GetString(YaraRulesPath),
+			YaraRulesURL:                   viper.GetString(YaraRulesURL),
+			TrivyServerAddress:             viper.GetString(TrivyServerAddress),
+			TrivyServerToken:               viper.GetString(TrivyServerToken),
+			GrypeServerAddress:             viper.GetString(GrypeServerAddress),
+			GrypeServerTimeout:             viper.GetDuration(GrypeServerTimeout),
+			VulnerabilityAliasesSource:     viper.GetString(VulnerabilityAliasesSource),
+			OSVScannerBinaryPath:           viper.GetString(OSVScannerBinaryPath),
+			ChkrootkitBinaryPath:           viper.GetString(ChkrootkitBinaryPath),
+			RkhunterBinaryPath:             viper.GetString(RkhunterBinaryPath),
+			GrypeDB: GrypeDBConfig{
+				UpdateDB:   viper.GetBool(GrypeDBUpdate),
+				ListingURL: viper.GetString(GrypeDBListingURL),
+				RootDir:    viper.GetString(GrypeDBRootDir),
+			},
 		},
 	}
 
+	if caBundlePath := viper.GetString(CABundlePath); caBundlePath != "" {
+		caBundle, err := os.ReadFile(caBundlePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %v", err)
+		}
+		config.CABundle = string(caBundle)
+	}
+
+	// The scanner jobs are booted in the scanner location of the
+	// configured provider.
+	switch config.ProviderType {
+	case ProviderTypeAzure:
+		config.Region = config.AzureConfig.ScannerLocation
+	case ProviderTypeGCP:
+		config.Region = config.GCPConfig.ScannerRegion()
+	case ProviderTypeAWS:
+		// The region is taken from SCANNER_AWS_REGION.
+	}
+
 	return config, nil
 }
 
+func getProviderType(providerType string) ProviderType {
+	pt := ProviderType(providerType)
+	if !pt.IsValid() {
+		log.Warnf("Invalid %s type (%s) - using default `%s`", ProviderTypeEnv, providerType, ProviderTypeAWS)
+		pt = ProviderTypeAWS
+	}
+
+	return pt
+}
+
 func getDeleteJobPolicyType(policyType string) DeleteJobPolicyType {
 	deleteJobPolicy := DeleteJobPolicyType(policyType)
 	if !deleteJobPolicy.IsValid() {
@@ -173,3 +462,13 @@ func getDeleteJobPolicyType(policyType string) DeleteJobPolicyType {
 
 	return deleteJobPolicy
 }
+
+func getNoTargetsPolicyType(policyType string) NoTargetsPolicyType {
+	noTargetsPolicy := NoTargetsPolicyType(policyType)
+	if !noTargetsPolicy.IsValid() {
+		log.Warnf("Invalid %s type (%s) - using default `%s`", NoTargetsPolicy, policyType, NoTargetsPolicyDone)
+		noTargetsPolicy = NoTargetsPolicyDone
+	}
+
+	return noTargetsPolicy
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	GCPProjectID                 = "GCP_PROJECT_ID"
+	GCPScannerZone               = "GCP_SCANNER_ZONE"
+	GCPScannerSubnetwork         = "GCP_SCANNER_SUBNETWORK"
+	GCPScannerMachineType        = "GCP_SCANNER_MACHINE_TYPE"
+	GCPScannerSourceImage        = "GCP_SCANNER_SOURCE_IMAGE"
+	GCPScanIDLabelKey            = "GCP_SCAN_ID_LABEL_KEY"
+	GCPScanResultIDLabelKey      = "GCP_SCAN_RESULT_ID_LABEL_KEY"
+	GCPTargetIDLabelKey          = "GCP_TARGET_ID_LABEL_KEY"
+	defaultGCPScannerMachineType = "e2-standard-2"
+	defaultGCPScannerSourceImage = "projects/ubuntu-os-cloud/global/images/family/ubuntu-2204-lts" // ubuntu server 22.04 LTS
+	// GCP label keys must be lowercase.
+	defaultGCPScanIDLabelKey       = "vmclarity-scan-id"
+	defaultGCPScanResultIDLabelKey = "vmclarity-scan-result-id"
+	defaultGCPTargetIDLabelKey     = "vmclarity-target-id"
+)
+
+type Config struct {
+	ProjectID          string // the project to discover and scan instances in
+	ScannerZone        string // the zone of the scanner instances
+	ScannerSubnetwork  string // the scanner's subnetwork URL
+	ScannerMachineType string // the scanner's machine type
+	ScannerSourceImage string // image of a scanner job
+
+	ScanIDLabelKey       string // the key of the label of the scanning job resources with the ID of their Scan
+	ScanResultIDLabelKey string // the key of the label of the scanning job resources with the ID of their ScanResult
+	TargetIDLabelKey     string // the key of the label of the scanning job resources with the ID of their Target
+}
+
+// ScannerRegion returns the region of the scanner zone.
+func (c *Config) ScannerRegion() string {
+	return ZoneToRegion(c.ScannerZone)
+}
+
+// ZoneToRegion returns the region of a zone, for example us-central1-a is in
+// the us-central1 region.
+func ZoneToRegion(zone string) string {
+	i := strings.LastIndex(zone, "-")
+	if i < 0 {
+		return zone
+	}
+	return zone[:i]
+}
+
+func setConfigDefaults() {
+	viper.SetDefault(GCPScannerMachineType, defaultGCPScannerMachineType)
+	viper.SetDefault(GCPScannerSourceImage, defaultGCPScannerSourceImage)
+	viper.SetDefault(GCPScanIDLabelKey, defaultGCPScanIDLabelKey)
+	viper.SetDefault(GCPScanResultIDLabelKey, defaultGCPScanResultIDLabelKey)
+	viper.SetDefault(GCPTargetIDLabelKey, defaultGCPTargetIDLabelKey)
+
+	viper.AutomaticEnv()
+}
+
+func LoadConfig() *Config {
+	setConfigDefaults()
+
+	config := &Config{
+		ProjectID:          viper.GetString(GCPProjectID),
+		ScannerZone:        viper.GetString(GCPScannerZone),
+		ScannerSubnetwork:  viper.GetString(GCPScannerSubnetwork),
+		ScannerMachineType: viper.GetString(GCPScannerMachineType),
+		ScannerSourceImage: viper.GetString(GCPScannerSourceImage),
+
+		ScanIDLabelKey:       viper.GetString(GCPScanIDLabelKey),
+		ScanResultIDLabelKey: viper.GetString(GCPScanResultIDLabelKey),
+		TargetIDLabelKey:     viper.GetString(GCPTargetIDLabelKey),
+	}
+
+	return config
+}
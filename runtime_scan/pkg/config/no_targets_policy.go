@@ -0,0 +1,34 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// NoTargetsPolicyType defines the state a scan is completed with when the
+// scope of its scan config doesn't match any targets.
+type NoTargetsPolicyType string
+
+const (
+	NoTargetsPolicyDone   NoTargetsPolicyType = "Done"
+	NoTargetsPolicyFailed NoTargetsPolicyType = "Failed"
+)
+
+func (nt NoTargetsPolicyType) IsValid() bool {
+	switch nt {
+	case NoTargetsPolicyDone, NoTargetsPolicyFailed:
+		return true
+	default:
+		return false
+	}
+}
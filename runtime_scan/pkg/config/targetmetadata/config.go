@@ -0,0 +1,52 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetmetadata
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	TargetMetadataSourceURL     = "TARGET_METADATA_SOURCE_URL"
+	TargetMetadataSourceToken   = "TARGET_METADATA_SOURCE_TOKEN"
+	TargetMetadataSourceTimeout = "TARGET_METADATA_SOURCE_TIMEOUT"
+)
+
+type Config struct {
+	SourceURL     string        // base URL of the metadata source, target metadata is disabled if empty
+	SourceToken   string        // optional bearer token to authenticate against the metadata source
+	SourceTimeout time.Duration // timeout of a single metadata request
+}
+
+func setConfigDefaults() {
+	viper.SetDefault(TargetMetadataSourceTimeout, "10s")
+
+	viper.AutomaticEnv()
+}
+
+func LoadConfig() *Config {
+	setConfigDefaults()
+
+	config := &Config{
+		SourceURL:     viper.GetString(TargetMetadataSourceURL),
+		SourceToken:   viper.GetString(TargetMetadataSourceToken),
+		SourceTimeout: viper.GetDuration(TargetMetadataSourceTimeout),
+	}
+
+	return config
+}
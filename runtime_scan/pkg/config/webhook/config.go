@@ -0,0 +1,162 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	ScanWebhookURL           = "SCAN_WEBHOOK_URL"
+	ScanWebhookSecret        = "SCAN_WEBHOOK_SECRET"
+	ScanWebhookTimeout       = "SCAN_WEBHOOK_TIMEOUT"
+	ScanWebhookMaxAttempts   = "SCAN_WEBHOOK_MAX_ATTEMPTS"
+	ScanWebhookRetryInterval = "SCAN_WEBHOOK_RETRY_INTERVAL"
+	ScanTargetWebhookURL     = "SCAN_TARGET_WEBHOOK_URL"
+
+	ScanWebhookDispatchConcurrency = "SCAN_WEBHOOK_DISPATCH_CONCURRENCY"
+	ScanWebhookDispatchQueueSize   = "SCAN_WEBHOOK_DISPATCH_QUEUE_SIZE"
+	ScanWebhookDispatchDropPolicy  = "SCAN_WEBHOOK_DISPATCH_DROP_POLICY"
+
+	ScanAlertWebhookURL                       = "SCAN_ALERT_WEBHOOK_URL"
+	ScanAlertWebhookFormat                    = "SCAN_ALERT_WEBHOOK_FORMAT"
+	ScanAlertFindingTypes                     = "SCAN_ALERT_FINDING_TYPES"
+	ScanAlertCriticalVulnerabilitiesThreshold = "SCAN_ALERT_CRITICAL_VULNERABILITIES_THRESHOLD"
+)
+
+// AlertFormat is the message format of the incoming webhook the alerts are
+// posted to.
+type AlertFormat string
+
+const (
+	AlertFormatSlack AlertFormat = "slack"
+	AlertFormatTeams AlertFormat = "teams"
+)
+
+// AlertFindingType is a kind of finding which triggers an alert.
+type AlertFindingType string
+
+const (
+	AlertFindingTypeVulnerabilities AlertFindingType = "vulnerabilities"
+	AlertFindingTypeMalware         AlertFindingType = "malware"
+	AlertFindingTypeRootkits        AlertFindingType = "rootkits"
+)
+
+// DropPolicy selects the notification which is dropped when the dispatch
+// queue is full.
+type DropPolicy string
+
+const (
+	// DropPolicyNewest drops the notification which didn't fit in the queue.
+	DropPolicyNewest DropPolicy = "newest"
+	// DropPolicyOldest drops the oldest queued notification to make room
+	// for the new one.
+	DropPolicyOldest DropPolicy = "oldest"
+)
+
+type Config struct {
+	URL           string        // URL the scan events are posted to, the webhook is disabled if empty
+	Secret        string        // optional shared secret used to sign the requests with HMAC-SHA256
+	Timeout       time.Duration // timeout of a single webhook request
+	MaxAttempts   int           // number of attempts to deliver an event
+	RetryInterval time.Duration // initial interval between the attempts, increased exponentially
+	// TargetURL is the URL the target scan events are posted to, signed
+	// with the Secret, the target webhook is disabled if empty.
+	TargetURL string
+	Alert     AlertConfig
+	Dispatch  DispatchConfig
+}
+
+// DispatchConfig bounds the delivery of the notifications to all the
+// webhooks, so that a burst of notifications or a slow webhook doesn't block
+// the scans.
+type DispatchConfig struct {
+	Concurrency int        // number of notifications delivered at the same time
+	QueueSize   int        // number of notifications waiting for delivery
+	DropPolicy  DropPolicy // notification dropped when the queue is full
+}
+
+// AlertConfig configures the alerts posted to a Slack or MS Teams incoming
+// webhook when a completed scan has critical findings. The delivery uses the
+// timeout and retries of the Config.
+type AlertConfig struct {
+	URL          string             // incoming webhook URL, the alerts are disabled if empty
+	Format       AlertFormat        // message format of the incoming webhook
+	FindingTypes []AlertFindingType // kinds of findings which trigger an alert
+	// CriticalVulnerabilitiesThreshold is the number of critical
+	// vulnerabilities a scan may find without triggering an alert.
+	CriticalVulnerabilitiesThreshold int
+}
+
+func setConfigDefaults() {
+	viper.SetDefault(ScanWebhookTimeout, "10s")
+	viper.SetDefault(ScanWebhookMaxAttempts, 3)
+	viper.SetDefault(ScanWebhookRetryInterval, "5s")
+	viper.SetDefault(ScanWebhookDispatchConcurrency, 4)
+	viper.SetDefault(ScanWebhookDispatchQueueSize, 1000)
+	viper.SetDefault(ScanWebhookDispatchDropPolicy, string(DropPolicyOldest))
+	viper.SetDefault(ScanAlertWebhookFormat, string(AlertFormatSlack))
+	viper.SetDefault(ScanAlertFindingTypes, strings.Join([]string{
+		string(AlertFindingTypeVulnerabilities),
+		string(AlertFindingTypeMalware),
+		string(AlertFindingTypeRootkits),
+	}, ","))
+	viper.SetDefault(ScanAlertCriticalVulnerabilitiesThreshold, 0)
+
+	viper.AutomaticEnv()
+}
+
+func LoadConfig() *Config {
+	setConfigDefaults()
+
+	config := &Config{
+		URL:           viper.GetString(ScanWebhookURL),
+		Secret:        viper.GetString(ScanWebhookSecret),
+		Timeout:       viper.GetDuration(ScanWebhookTimeout),
+		MaxAttempts:   viper.GetInt(ScanWebhookMaxAttempts),
+		RetryInterval: viper.GetDuration(ScanWebhookRetryInterval),
+		TargetURL:     viper.GetString(ScanTargetWebhookURL),
+		Alert: AlertConfig{
+			URL:                              viper.GetString(ScanAlertWebhookURL),
+			Format:                           AlertFormat(strings.ToLower(viper.GetString(ScanAlertWebhookFormat))),
+			FindingTypes:                     parseAlertFindingTypes(viper.GetString(ScanAlertFindingTypes)),
+			CriticalVulnerabilitiesThreshold: viper.GetInt(ScanAlertCriticalVulnerabilitiesThreshold),
+		},
+		Dispatch: DispatchConfig{
+			Concurrency: viper.GetInt(ScanWebhookDispatchConcurrency),
+			QueueSize:   viper.GetInt(ScanWebhookDispatchQueueSize),
+			DropPolicy:  DropPolicy(strings.ToLower(viper.GetString(ScanWebhookDispatchDropPolicy))),
+		},
+	}
+
+	return config
+}
+
+// parseAlertFindingTypes parses a comma separated list of finding types.
+func parseAlertFindingTypes(s string) []AlertFindingType {
+	var ret []AlertFindingType
+	for _, findingType := range strings.Split(s, ",") {
+		findingType = strings.ToLower(strings.TrimSpace(findingType))
+		if findingType == "" {
+			continue
+		}
+		ret = append(ret, AlertFindingType(findingType))
+	}
+	return ret
+}
@@ -0,0 +1,59 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limiter
+
+import (
+	"context"
+	"fmt"
+)
+
+// Limiter limits the number of concurrent calls, for example to the cloud
+// provider API. A nil limiter doesn't limit the calls.
+type Limiter struct {
+	slots chan struct{}
+}
+
+// New returns a limiter which lets maxConcurrent calls run concurrently, or
+// nil which doesn't limit the calls if maxConcurrent isn't positive.
+func New(maxConcurrent int) *Limiter {
+	if maxConcurrent <= 0 {
+		return nil
+	}
+
+	return &Limiter{
+		slots: make(chan struct{}, maxConcurrent),
+	}
+}
+
+// Do runs the call once fewer than the maximum number of concurrent calls
+// are running. It fails without running the call if the context is done
+// before that.
+func (l *Limiter) Do(ctx context.Context, call func() error) error {
+	if l == nil {
+		return call()
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for a concurrent call slot was canceled: %w", ctx.Err())
+	}
+	defer func() {
+		<-l.slots
+	}()
+
+	return call()
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limiter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiter_Do(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxConcurrent int
+		calls         int
+		wantMax       int32
+	}{
+		{
+			name:          "limits the concurrent calls",
+			maxConcurrent: 2,
+			calls:         10,
+			wantMax:       2,
+		},
+		{
+			name:          "doesn't limit the calls when not positive",
+			maxConcurrent: 0,
+			calls:         5,
+			wantMax:       5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(tt.maxConcurrent)
+
+			var running, maxRunning int32
+			release := make(chan struct{})
+			var wg sync.WaitGroup
+			for i := 0; i < tt.calls; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					err := l.Do(context.Background(), func() error {
+						n := atomic.AddInt32(&running, 1)
+						for {
+							m := atomic.LoadInt32(&maxRunning)
+							if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+								break
+							}
+						}
+						<-release
+						atomic.AddInt32(&running, -1)
+						return nil
+					})
+					if err != nil {
+						t.Errorf("Do() error = %v", err)
+					}
+				}()
+			}
+
+			// Let the calls which are allowed to run start before releasing them.
+			time.Sleep(50 * time.Millisecond)
+			close(release)
+			wg.Wait()
+
+			if maxRunning != tt.wantMax {
+				t.Errorf("Do() max concurrent calls = %v, want %v", maxRunning, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestLimiter_DoCanceled(t *testing.T) {
+	l := New(1)
+
+	release := make(chan struct{})
+	go func() {
+		_ = l.Do(context.Background(), func() error {
+			<-release
+			return nil
+		})
+	}()
+	defer close(release)
+	// Wait for the first call to take the only slot.
+	for len(l.slots) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+	if err := l.Do(ctx, func() error {
+		called = true
+		return nil
+	}); err == nil {
+		t.Errorf("Do() expected an error when the context is canceled")
+	}
+	if called {
+		t.Errorf("Do() ran the call although the context is canceled")
+	}
+}
@@ -30,17 +30,100 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 )
 
-func (scw *ScanConfigWatcher) scan(ctx context.Context, scanConfig *models.ScanConfig) error {
+// errScanCanceled is returned when the scan is deleted while it is pending,
+// before it started.
+var errScanCanceled = errors.New("scan was canceled before it started")
+
+// RunScanConfig starts a scan of the scan config now, regardless of its
+// schedule, and returns the ID of the scan. The scan runs until the watcher is
+// stopped, like the scheduled scans.
+func (scw *ScanConfigWatcher) RunScanConfig(scanConfig *models.ScanConfig) (string, error) {
+	scw.mu.Lock()
+	ctx := scw.runCtx
+	scw.mu.Unlock()
+	if ctx == nil {
+		return "", errors.New("scan config watcher is not started")
+	}
+
+	return scw.scan(ctx, scanConfig)
+}
+
+func (scw *ScanConfigWatcher) scan(ctx context.Context, scanConfig *models.ScanConfig) (string, error) {
+	// The preset is resolved before the scan is created so that the scan
+	// config snapshot of the scan holds the families which are scanned.
+	familiesConfig, err := _scanner.ResolveFamiliesPreset(scanConfig.ScanFamiliesConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve scan families preset: %v", err)
+	}
+	resolvedScanConfig := *scanConfig
+	resolvedScanConfig.ScanFamiliesConfig = familiesConfig
+	scanConfig = &resolvedScanConfig
+
+	// The scan is counted before it's initialized so that draining waits
+	// for it too.
+	scw.mu.Lock()
+	if scw.draining {
+		scw.mu.Unlock()
+		return "", ErrDraining
+	}
+	scw.runningScans.Add(1)
+	scw.mu.Unlock()
+
 	// TODO: check if existing scan or a new scan
 	targetInstances, scanID, err := scw.initNewScan(ctx, scanConfig)
+	if errors.Is(err, errScanCanceled) {
+		// The scan was canceled on purpose, which counts as this run of
+		// the scan config.
+		scw.runningScans.Done()
+		log.Infof("Scan %s of scan config %s was canceled while it was pending", scanID, *scanConfig.Id)
+		return scanID, nil
+	}
 	if err != nil {
-		return fmt.Errorf("failed to init new scan: %v", err)
+		scw.runningScans.Done()
+		return "", fmt.Errorf("failed to init new scan: %v", err)
+	}
+
+	scanner := _scanner.CreateScanner(scw.scannerConfig, scw.providerClient, scw.backendClient, scw.circuitBreakers, scw.providerLimiter, scw.notifier, scanConfig, targetInstances, scanID)
+
+	scw.mu.Lock()
+	if scw.draining {
+		// The watcher was drained while the scan was initialized, the
+		// scan is canceled as soon as it starts.
+		scanner.Drain()
 	}
+	scw.runningScanners[scanner] = struct{}{}
+	scw.mu.Unlock()
+
+	go func() {
+		defer func() {
+			scw.mu.Lock()
+			delete(scw.runningScanners, scanner)
+			scw.mu.Unlock()
+			scw.runningScans.Done()
+		}()
+		scanner.Scan(ctx)
+	}()
 
-	scanner := _scanner.CreateScanner(scw.scannerConfig, scw.providerClient, scw.backendClient, scanConfig, targetInstances, scanID)
-	go scanner.Scan(ctx)
+	return scanID, nil
+}
 
-	return nil
+// newScanConfigSnapshot returns the snapshot of the scan config which the
+// scan is run with, so that later changes of the config don't affect it.
+func newScanConfigSnapshot(scanConfig *models.ScanConfig) *models.ScanConfigData {
+	return &models.ScanConfigData{
+		MaxParallelScanners:     scanConfig.MaxParallelScanners,
+		Name:                    scanConfig.Name,
+		ScanFamiliesConfig:      scanConfig.ScanFamiliesConfig,
+		Scheduled:               scanConfig.Scheduled,
+		Scope:                   scanConfig.Scope,
+		ScanJobTimeoutSeconds:   scanConfig.ScanJobTimeoutSeconds,
+		MaxScannerInstanceHours: scanConfig.MaxScannerInstanceHours,
+		PartitionsToScan:        scanConfig.PartitionsToScan,
+		ScanAllVolumes:          scanConfig.ScanAllVolumes,
+		ExistingSnapshots:       scanConfig.ExistingSnapshots,
+		IgnoreRules:             scanConfig.IgnoreRules,
+		VerifySnapshotIntegrity: scanConfig.VerifySnapshotIntegrity,
+	}
 }
 
 // initNewScan Initialized a new scan, returns target instances and scan ID.
@@ -51,16 +134,10 @@ func (scw *ScanConfigWatcher) initNewScan(ctx context.Context, scanConfig *model
 		ScanConfig: &models.ScanConfigRelationship{
 			Id: *scanConfig.Id,
 		},
-		ScanConfigSnapshot: &models.ScanConfigData{
-			MaxParallelScanners: scanConfig.MaxParallelScanners,
-			Name:                scanConfig.Name,
-			ScanFamiliesConfig:  scanConfig.ScanFamiliesConfig,
-			Scheduled:           scanConfig.Scheduled,
-			Scope:               scanConfig.Scope,
-		},
-		StartTime: &now,
-		State:     utils.PointerTo(models.ScanStatePending),
-		Summary:   createInitScanSummary(),
+		ScanConfigSnapshot: newScanConfigSnapshot(scanConfig),
+		StartTime:          &now,
+		State:              utils.PointerTo(models.ScanStatePending),
+		Summary:            createInitScanSummary(),
 	}
 	var scanID string
 	createdScan, err := scw.backendClient.PostScan(ctx, *scan)
@@ -94,6 +171,9 @@ func (scw *ScanConfigWatcher) initNewScan(ctx context.Context, scanConfig *model
 		StateMessage: utils.PointerTo("Targets for scan successfully discovered"),
 	}
 	err = scw.backendClient.PatchScan(ctx, scanID, scan)
+	if errors.Is(err, backendclient.ErrScanNotFound) {
+		return nil, scanID, errScanCanceled
+	}
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to update scan: %v", err)
 	}
@@ -148,7 +228,7 @@ func (scw *ScanConfigWatcher) createTargetInstances(ctx context.Context, instanc
 
 func (scw *ScanConfigWatcher) createTarget(ctx context.Context, instance types.Instance) (string, error) {
 	info := models.TargetType{}
-	instanceProvider := models.AWS
+	instanceProvider := instance.GetProvider()
 	err := info.FromVMInfo(models.VMInfo{
 		InstanceID:       instance.GetID(),
 		InstanceProvider: &instanceProvider,
@@ -157,16 +237,43 @@ func (scw *ScanConfigWatcher) createTarget(ctx context.Context, instance types.I
 	if err != nil {
 		return "", fmt.Errorf("failed to create VMInfo: %v", err)
 	}
+	metadata := scw.getTargetMetadata(ctx, instance)
 	createdTarget, err := scw.backendClient.PostTarget(ctx, models.Target{
 		TargetInfo: &info,
+		Metadata:   metadata,
 	})
 	if err != nil {
 		var conErr backendclient.TargetConflictError
 		if errors.As(err, &conErr) {
-			log.Infof("Target already exist. target id=%v.", *conErr.ConflictingTarget.Id)
-			return *conErr.ConflictingTarget.Id, nil
+			targetID := *conErr.ConflictingTarget.Id
+			log.Infof("Target already exist. target id=%v.", targetID)
+			// Refresh the metadata of the existing target, the
+			// source is authoritative for it.
+			if metadata != nil {
+				if err := scw.backendClient.PatchTarget(ctx, models.Target{Metadata: metadata}, targetID); err != nil {
+					log.Warnf("Failed to update target metadata. target id=%v: %v", targetID, err)
+				}
+			}
+			return targetID, nil
 		}
 		return "", fmt.Errorf("failed to post target: %v", err)
 	}
 	return *createdTarget.Id, nil
 }
+
+// getTargetMetadata fetches the metadata of the instance from the target
+// metadata source if one is configured. Failing to get the metadata must not
+// prevent the instance from being scanned so errors are only logged.
+func (scw *ScanConfigWatcher) getTargetMetadata(ctx context.Context, instance types.Instance) *models.TargetMetadata {
+	if scw.targetMetadataSource == nil {
+		return nil
+	}
+
+	metadata, err := scw.targetMetadataSource.GetTargetMetadata(ctx, instance)
+	if err != nil {
+		log.Warnf("Failed to get target metadata. instanceID=%v: %v", instance.GetID(), err)
+		return nil
+	}
+
+	return metadata
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configwatcher
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_newScanConfigSnapshot(t *testing.T) {
+	tests := []struct {
+		name       string
+		scanConfig *models.ScanConfig
+		want       *models.ScanConfigData
+	}{
+		{
+			name: "copies the scan settings",
+			scanConfig: &models.ScanConfig{
+				Id:                    utils.PointerTo("scan-config-1"),
+				Name:                  utils.PointerTo("scan-config"),
+				MaxParallelScanners:   utils.PointerTo(2),
+				ScanJobTimeoutSeconds: utils.PointerTo(600),
+				ScanAllVolumes:        utils.PointerTo(true),
+				PartitionsToScan:      &[]string{"/dev/sda1"},
+			},
+			want: &models.ScanConfigData{
+				Name:                  utils.PointerTo("scan-config"),
+				MaxParallelScanners:   utils.PointerTo(2),
+				ScanJobTimeoutSeconds: utils.PointerTo(600),
+				ScanAllVolumes:        utils.PointerTo(true),
+				PartitionsToScan:      &[]string{"/dev/sda1"},
+			},
+		},
+		{
+			name: "copies the ignore rules",
+			scanConfig: &models.ScanConfig{
+				Name: utils.PointerTo("scan-config"),
+				IgnoreRules: &[]models.IgnoreRule{
+					{
+						VulnerabilityName: utils.PointerTo("CVE-2023-0001"),
+						Reason:            utils.PointerTo("not exploitable"),
+					},
+				},
+			},
+			want: &models.ScanConfigData{
+				Name: utils.PointerTo("scan-config"),
+				IgnoreRules: &[]models.IgnoreRule{
+					{
+						VulnerabilityName: utils.PointerTo("CVE-2023-0001"),
+						Reason:            utils.PointerTo("not exploitable"),
+					},
+				},
+			},
+		},
+		{
+			name: "copies the snapshot integrity verification",
+			scanConfig: &models.ScanConfig{
+				Name:                    utils.PointerTo("scan-config"),
+				VerifySnapshotIntegrity: utils.PointerTo(true),
+			},
+			want: &models.ScanConfigData{
+				Name:                    utils.PointerTo("scan-config"),
+				VerifySnapshotIntegrity: utils.PointerTo(true),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newScanConfigSnapshot(tt.scanConfig)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("newScanConfigSnapshot() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,38 +17,97 @@ package configwatcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
-	"github.com/aptible/supercronic/cronexpr"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/circuitbreaker"
 	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/limiter"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	_scanner "github.com/openclarity/vmclarity/runtime_scan/pkg/scanner"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/targetmetadata"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/webhook"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	sharedUtils "github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 const (
 	timeWindow = 5 * time.Minute
+	// The watcher isn't alive if the scan configs weren't reconciled for
+	// this many watch intervals.
+	maxMissedReconciles = 5
 )
 
+// ScanConfigGetter gets a scan config with its credentials, which the backend
+// API doesn't return.
+type ScanConfigGetter interface {
+	GetScanConfigWithCredentials(scanConfigID models.ScanConfigID) (models.ScanConfig, error)
+}
+
 type ScanConfigWatcher struct {
 	backendClient  *backendclient.BackendClient
 	providerClient provider.Client
-	scannerConfig  *_config.ScannerConfig
+	// scanConfigs gets the scan configs to scan with their credentials.
+	scanConfigs ScanConfigGetter
+	// targetMetadataSource is optional, targets are not enriched with
+	// metadata if it is nil.
+	targetMetadataSource targetmetadata.Source
+	scannerConfig        *_config.ScannerConfig
+	// circuitBreakers are shared by the scans to fail jobs fast in
+	// provider regions which keep failing.
+	circuitBreakers *circuitbreaker.Registry
+	// providerLimiter is shared by the scans to limit the concurrent
+	// provider API calls of all their scanning jobs.
+	providerLimiter *limiter.Limiter
+	// notifier is optional, external systems are not notified about the
+	// scans if it is nil.
+	notifier webhook.Notifier
+
+	// runCtx is the context the watcher was started with, which the scans
+	// run on demand run with. It's nil until the watcher is started.
+	runCtx context.Context
+	// runningScanners are the scanners of the scans which are still
+	// running, they are stopped when the watcher is drained.
+	runningScanners map[*_scanner.Scanner]struct{}
+	// runningScans counts the scans until their scanners return.
+	runningScans sync.WaitGroup
+	// draining is set once the watcher is drained, no new scans are
+	// started from then on.
+	draining bool
+	// lastReconcileTime and lastReconcileErr are the time and the result
+	// of the last reconcile of the scan configs, to tell if the watcher is
+	// alive.
+	lastReconcileTime time.Time
+	lastReconcileErr  error
+	mu                sync.Mutex
 }
 
 func CreateScanConfigWatcher(
 	backendClient *backendclient.BackendClient,
 	providerClient provider.Client,
+	scanConfigs ScanConfigGetter,
+	targetMetadataSource targetmetadata.Source,
 	scannerConfig _config.ScannerConfig,
+	circuitBreakers *circuitbreaker.Registry,
+	providerLimiter *limiter.Limiter,
+	notifier webhook.Notifier,
 ) *ScanConfigWatcher {
 	return &ScanConfigWatcher{
-		backendClient:  backendClient,
-		providerClient: providerClient,
-		scannerConfig:  &scannerConfig,
+		backendClient:        backendClient,
+		providerClient:       providerClient,
+		scanConfigs:          scanConfigs,
+		targetMetadataSource: targetMetadataSource,
+		scannerConfig:        &scannerConfig,
+		circuitBreakers:      circuitBreakers,
+		providerLimiter:      providerLimiter,
+		notifier:             notifier,
+		runningScanners:      make(map[*_scanner.Scanner]struct{}),
 	}
 }
 
@@ -87,6 +146,14 @@ func (scw *ScanConfigWatcher) reconcileScanConfigs(ctx context.Context) error {
 		shouldScan := false
 		scanConfigID := *scanConfig.Id
 		operationTime := *scanConfig.Scheduled.OperationTime
+		var cron *sharedUtils.ScheduleCron
+		if scanConfig.Scheduled.CronLine != nil {
+			cron, err = sharedUtils.ParseScheduleCron(*scanConfig.Scheduled.CronLine, scanConfig.Scheduled.Timezone)
+			if err != nil {
+				log.Errorf("Failed to parse the schedule of scan config (%s): %v", scanConfigID, err)
+				continue
+			}
+		}
 		shouldScan, err = scw.shouldScan(ctx, scanConfigID, operationTime, now)
 		if err != nil {
 			log.Errorf("Failed to check whether should scan according to scan config (%s): %v", scanConfigID, err)
@@ -95,33 +162,53 @@ func (scw *ScanConfigWatcher) reconcileScanConfigs(ctx context.Context) error {
 
 		if shouldScan {
 			log.Infof("A new scan should be started from ScanConfig %s", scanConfigID)
-			if err = scw.scan(ctx, &scanConfig); err != nil {
+			// The listed scan configs don't have their credentials.
+			fullScanConfig, err := scw.scanConfigs.GetScanConfigWithCredentials(scanConfigID)
+			if err != nil {
+				log.Errorf("Failed to get scan config (%s): %v", scanConfigID, err)
+				continue
+			}
+			if _, err = scw.scan(ctx, &fullScanConfig); err != nil {
 				log.Errorf("Failed to schedule a scan for scan config (%s): %v", *scanConfig.Id, err)
 			} else {
 				log.Infof("Succeeded to schedule a scan for scan config (%s)", *scanConfig.Id)
-				if scanConfig.Scheduled.CronLine != nil {
+				// Only the changed fields are patched, patching the
+				// listed scan config would drop its credentials.
+				var patch models.ScanConfig
+				if cron != nil {
 					// calculate next operation time based on current operation time
-					nextOperationTime := cronexpr.MustParse(*scanConfig.Scheduled.CronLine).Next(operationTime)
-					scanConfig.Scheduled.OperationTime = &nextOperationTime
+					nextOperationTime := cron.Next(operationTime)
+					patch.Scheduled = &models.RuntimeScheduleScanConfig{OperationTime: &nextOperationTime}
 					log.Debugf("Patching ScanConfig %s with a new operation time (%s)", scanConfigID, nextOperationTime.String())
 				} else {
 					// not a periodic scan, we should disable the scan config, so it will not be fetched again.
-					scanConfig.Disabled = utils.PointerTo(true)
-					log.Debugf("Patching ScanConfig %s with disabled (%v)", scanConfigID, *scanConfig.Disabled)
+					patch.Disabled = utils.PointerTo(true)
+					log.Debugf("Patching ScanConfig %s with disabled (%v)", scanConfigID, *patch.Disabled)
 				}
-				if err = scw.backendClient.PatchScanConfig(ctx, scanConfigID, &scanConfig); err != nil {
+				if err = scw.backendClient.PatchScanConfig(ctx, scanConfigID, &patch); err != nil {
 					log.Errorf("Failed to patch scan config: %v", err)
 				}
 			}
 		} else {
 			log.Debugf("No scan should be started from ScanConfig %s", scanConfigID)
-			if operationTime.Before(now) && scanConfig.Scheduled.CronLine != nil {
+			if operationTime.Before(now) && cron != nil {
 				// If operationTime is not within the window, and it was in the past,
 				// we will calculate the next operation time until we will find one that is in the future.
-				nextOperationTime := findFirstOperationTimeInTheFuture(operationTime, now, *scanConfig.Scheduled.CronLine)
-				scanConfig.Scheduled.OperationTime = &nextOperationTime
+				nextOperationTime := cron.FirstNotBefore(operationTime, now)
+				patch := models.ScanConfig{
+					Scheduled: &models.RuntimeScheduleScanConfig{OperationTime: &nextOperationTime},
+				}
 				log.Debugf("Patching ScanConfig %s with a new operation time (%s)", scanConfigID, nextOperationTime.String())
-				if err = scw.backendClient.PatchScanConfig(ctx, scanConfigID, &scanConfig); err != nil {
+				if err = scw.backendClient.PatchScanConfig(ctx, scanConfigID, &patch); err != nil {
+					log.Errorf("Failed to patch scan config: %v", err)
+				}
+			} else if cron == nil && isMissedOperationTime(operationTime, now, timeWindow) {
+				// A one-time scan is started at most once, if its operationTime was
+				// missed we should disable the scan config instead of keeping it enabled forever.
+				log.Warnf("ScanConfig %s missed its one-time operation time (%s), disabling it",
+					scanConfigID, operationTime.Format(time.RFC3339))
+				patch := models.ScanConfig{Disabled: utils.PointerTo(true)}
+				if err = scw.backendClient.PatchScanConfig(ctx, scanConfigID, &patch); err != nil {
 					log.Errorf("Failed to patch scan config: %v", err)
 				}
 			}
@@ -131,12 +218,9 @@ func (scw *ScanConfigWatcher) reconcileScanConfigs(ctx context.Context) error {
 	return nil
 }
 
-func findFirstOperationTimeInTheFuture(operationTime time.Time, now time.Time, cronLine string) time.Time {
-	expr := cronexpr.MustParse(cronLine)
-	for operationTime.Before(now) {
-		operationTime = expr.Next(operationTime)
-	}
-	return operationTime
+// isMissedOperationTime checks if `operationTime` is already before the window around `now`.
+func isMissedOperationTime(operationTime, now time.Time, window time.Duration) bool {
+	return operationTime.Before(now.Add(-window / 2)) //nolint: gomnd
 }
 
 // isWithinTheWindow checks if `checkTime` is within the window (after `now - window/2` and before `now + window/2`).
@@ -171,12 +255,26 @@ func (scw *ScanConfigWatcher) shouldScan(ctx context.Context, scanConfigID strin
 }
 
 func (scw *ScanConfigWatcher) Start(ctx context.Context) {
+	scw.mu.Lock()
+	scw.runCtx = ctx
+	scw.lastReconcileTime = time.Now()
+	scw.mu.Unlock()
+
 	go func() {
 		for {
 			select {
 			case <-time.After(scw.scannerConfig.ScanConfigWatchInterval):
+				if scw.isDraining() {
+					log.Infof("Stop watching scan configs, draining the running scans.")
+					return
+				}
 				log.Debug("Looking for ScanConfigs to Scan")
-				if err := scw.reconcileScanConfigs(ctx); err != nil {
+				err := scw.reconcileScanConfigs(ctx)
+				scw.mu.Lock()
+				scw.lastReconcileTime = time.Now()
+				scw.lastReconcileErr = err
+				scw.mu.Unlock()
+				if err != nil {
 					log.Warnf("Failed to reconcile scan configs: %v", err)
 					break
 				}
@@ -187,3 +285,68 @@ func (scw *ScanConfigWatcher) Start(ctx context.Context) {
 		}
 	}()
 }
+
+// CheckAlive checks that the scan configs were reconciled recently and that
+// the last reconcile succeeded.
+func (scw *ScanConfigWatcher) CheckAlive(now time.Time) error {
+	scw.mu.Lock()
+	defer scw.mu.Unlock()
+
+	if scw.runCtx == nil {
+		return errors.New("scan config watcher is not started")
+	}
+	if scw.draining {
+		return ErrDraining
+	}
+	if sinceReconcile := now.Sub(scw.lastReconcileTime); sinceReconcile > maxMissedReconciles*scw.scannerConfig.ScanConfigWatchInterval {
+		return fmt.Errorf("scan configs were not reconciled for %v", sinceReconcile.Round(time.Second))
+	}
+	if scw.lastReconcileErr != nil {
+		return fmt.Errorf("failed to reconcile scan configs: %w", scw.lastReconcileErr)
+	}
+
+	return nil
+}
+
+// ErrDraining is returned when a scan is started after the watcher was drained.
+var ErrDraining = errors.New("scan config watcher is draining, no new scans are started")
+
+func (scw *ScanConfigWatcher) isDraining() bool {
+	scw.mu.Lock()
+	defer scw.mu.Unlock()
+
+	return scw.draining
+}
+
+// Drain stops starting new scans and cancels the running scans, then waits
+// until their workers cleaned up the jobs they were running and marked their
+// targets as not scanned, or until ctx is done. The context the watcher was started with must not be canceled before
+// Drain returns, since the jobs are cleaned up with it.
+func (scw *ScanConfigWatcher) Drain(ctx context.Context) error {
+	scw.mu.Lock()
+	scw.draining = true
+	scanners := make([]*_scanner.Scanner, 0, len(scw.runningScanners))
+	for scanner := range scw.runningScanners {
+		scanners = append(scanners, scanner)
+	}
+	scw.mu.Unlock()
+
+	log.Infof("Draining %d running scans", len(scanners))
+	for _, scanner := range scanners {
+		scanner.Drain()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		scw.runningScans.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Infof("All running scans were drained")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for the running scans to clean up their jobs: %w", ctx.Err())
+	}
+}
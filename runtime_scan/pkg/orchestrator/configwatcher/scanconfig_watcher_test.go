@@ -16,10 +16,13 @@
 package configwatcher
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
-	"github.com/google/go-cmp/cmp"
+	"github.com/openclarity/vmclarity/api/models"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
 )
 
 func Test_isWithinTheWindow(t *testing.T) {
@@ -79,45 +82,140 @@ func Test_isWithinTheWindow(t *testing.T) {
 	}
 }
 
-func Test_findFirstOperationTimeInTheFuture(t *testing.T) {
-	now, _ := time.Parse(time.RFC3339, "2006-01-02T20:00:00Z")
-	oneHourFromNow := now.Add(1 * time.Hour)
-	fiveHoursFromNow := now.Add(5 * time.Hour)
-	fiveHoursBeforeNow := now.Add(-5 * time.Hour)
+func Test_isMissedOperationTime(t *testing.T) {
+	now := time.Now()
 	type args struct {
 		operationTime time.Time
 		now           time.Time
-		cronLine      string
+		window        time.Duration
 	}
 	tests := []struct {
 		name string
 		args args
-		want time.Time
+		want bool
 	}{
 		{
-			name: "operation time already in the future",
+			name: "in the future",
 			args: args{
-				operationTime: fiveHoursFromNow,
+				operationTime: now.Add(10 * time.Minute),
 				now:           now,
-				cronLine:      "0 */4 * * *",
+				window:        5 * time.Minute,
 			},
-			want: fiveHoursFromNow,
+			want: false,
 		},
 		{
-			name: "operation time in the past",
+			name: "in the window - before now",
 			args: args{
-				operationTime: fiveHoursBeforeNow,
+				operationTime: now.Add(-2 * time.Minute),
 				now:           now,
-				cronLine:      "0 */3 * * *",
+				window:        5 * time.Minute,
+			},
+			want: false,
+		},
+		{
+			name: "before the window",
+			args: args{
+				operationTime: now.Add(-3 * time.Minute),
+				now:           now,
+				window:        5 * time.Minute,
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isMissedOperationTime(tt.args.operationTime, tt.args.now, tt.args.window); got != tt.want {
+				t.Errorf("isMissedOperationTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanConfigWatcher_RunScanConfig_notStarted(t *testing.T) {
+	scw := &ScanConfigWatcher{}
+	if _, err := scw.RunScanConfig(&models.ScanConfig{}); err == nil {
+		t.Errorf("RunScanConfig() expected an error before the watcher is started")
+	}
+}
+
+func TestScanConfigWatcher_Drain(t *testing.T) {
+	scw := CreateScanConfigWatcher(nil, nil, nil, nil, _config.ScannerConfig{ScanConfigWatchInterval: time.Hour}, nil, nil, nil)
+	scw.Start(context.Background())
+
+	// A scan which didn't return yet.
+	scw.runningScans.Add(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := scw.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Drain() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if _, err := scw.RunScanConfig(&models.ScanConfig{}); !errors.Is(err, ErrDraining) {
+		t.Errorf("RunScanConfig() error = %v, want %v", err, ErrDraining)
+	}
+
+	scw.runningScans.Done()
+	if err := scw.Drain(context.Background()); err != nil {
+		t.Errorf("Drain() unexpected error = %v", err)
+	}
+}
+
+func TestScanConfigWatcher_CheckAlive(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		watcher *ScanConfigWatcher
+		wantErr bool
+	}{
+		{
+			name:    "not started",
+			watcher: &ScanConfigWatcher{},
+			wantErr: true,
+		},
+		{
+			name: "reconciled recently",
+			watcher: &ScanConfigWatcher{
+				scannerConfig:     &_config.ScannerConfig{ScanConfigWatchInterval: time.Minute},
+				runCtx:            context.Background(),
+				lastReconcileTime: now.Add(-2 * time.Minute),
+			},
+			wantErr: false,
+		},
+		{
+			name: "not reconciled for too long",
+			watcher: &ScanConfigWatcher{
+				scannerConfig:     &_config.ScannerConfig{ScanConfigWatchInterval: time.Minute},
+				runCtx:            context.Background(),
+				lastReconcileTime: now.Add(-10 * time.Minute),
+			},
+			wantErr: true,
+		},
+		{
+			name: "last reconcile failed",
+			watcher: &ScanConfigWatcher{
+				scannerConfig:     &_config.ScannerConfig{ScanConfigWatchInterval: time.Minute},
+				runCtx:            context.Background(),
+				lastReconcileTime: now,
+				lastReconcileErr:  errors.New("backend unavailable"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "draining",
+			watcher: &ScanConfigWatcher{
+				scannerConfig:     &_config.ScannerConfig{ScanConfigWatchInterval: time.Minute},
+				runCtx:            context.Background(),
+				lastReconcileTime: now,
+				draining:          true,
 			},
-			want: oneHourFromNow,
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := findFirstOperationTimeInTheFuture(tt.args.operationTime, tt.args.now, tt.args.cronLine)
-			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("findFirstOperationTimeInTheFuture() mismatch (-want +got):\n%s", diff)
+			if err := tt.watcher.CheckAlive(now); (err != nil) != tt.wantErr {
+				t.Errorf("CheckAlive() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
@@ -17,36 +17,103 @@ package orchestrator
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/circuitbreaker"
 	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/limiter"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/configwatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/discovery"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/orphanreaper"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanresultprocessor"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanwatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/scanner"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/targetmetadata"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/webhook"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/families"
 )
 
 type Orchestrator interface {
 	Start(ctx context.Context)
 	Stop(cancel context.CancelFunc)
+	// Shutdown stops starting new scans, cancels the running scans and
+	// waits until the jobs they were running are cleaned up, or until ctx
+	// is done, then stops the orchestrator.
+	Shutdown(ctx context.Context) error
+	// CircuitBreakers returns the circuit breakers of the provider
+	// regions the scanning jobs run in.
+	CircuitBreakers() *circuitbreaker.Registry
+	// PlanScan plans a scan of the scan config without launching any
+	// infrastructure.
+	PlanScan(ctx context.Context, scanConfig models.ScanConfigData) (*models.ScanPlan, error)
+	// ValidateScanConfig validates the scan config the way it's validated
+	// when a scan of it runs, without launching any infrastructure.
+	ValidateScanConfig(ctx context.Context, scanConfig models.ScanConfigData) *models.ScanConfigValidation
+	// RunScanConfig starts a scan of the scan config now, regardless of
+	// its schedule, and returns the ID of the scan.
+	RunScanConfig(scanConfig models.ScanConfig) (string, error)
+	// GetScannerLogs returns the console output of the scanner instance in
+	// the location with the credentials redacted.
+	GetScannerLogs(ctx context.Context, location, instanceID string) (string, error)
+	// ListProviderInstances lists the instances the provider currently
+	// discovers in the location, or in all the locations if not set.
+	ListProviderInstances(ctx context.Context, location string) ([]models.ProviderInstance, error)
+	// CheckProvider checks that the provider is reachable with the
+	// configured credentials.
+	CheckProvider(ctx context.Context) error
+	// CheckAlive checks that the orchestrator keeps looking for scan
+	// configs to scan.
+	CheckAlive() error
 }
 
 type orchestrator struct {
 	config              *_config.OrchestratorConfig
+	providerClient      provider.Client
+	circuitBreakers     *circuitbreaker.Registry
 	scanConfigWatcher   *configwatcher.ScanConfigWatcher
 	scopeDiscoverer     *discovery.ScopeDiscoverer
 	scanResultProcessor *scanresultprocessor.ScanResultProcessor
 	scanWatcher         *scanwatcher.Watcher
+	orphanReaper        *orphanreaper.Reaper
+	dispatcher          *webhook.Dispatcher // nil when scan notifications are disabled
 	cancelFunc          context.CancelFunc
 }
 
-func Create(config *_config.OrchestratorConfig, providerClient provider.Client, backendClient *backendclient.BackendClient) (Orchestrator, error) {
+// Create creates the orchestrator, scanConfigs gets the scan configs to scan
+// with their credentials, which the backend API doesn't return.
+func Create(config *_config.OrchestratorConfig, providerClient provider.Client, backendClient *backendclient.BackendClient, scanConfigs configwatcher.ScanConfigGetter) (Orchestrator, error) {
+	circuitBreakers := circuitbreaker.NewRegistry(circuitbreaker.Config{
+		FailureThreshold: config.CircuitBreakerFailureThreshold,
+		OpenDuration:     config.CircuitBreakerOpenDuration,
+	})
+	// A nil *webhook.Dispatcher isn't a nil webhook.Notifier, so pass the
+	// notifier to the scans only when the notifications are enabled.
+	dispatcher := webhook.New(config.WebhookConfig)
+	var notifier webhook.Notifier
+	if dispatcher != nil {
+		notifier = dispatcher
+	}
 	orc := &orchestrator{
-		config:              config,
-		scanConfigWatcher:   configwatcher.CreateScanConfigWatcher(backendClient, providerClient, config.ScannerConfig),
+		config:          config,
+		providerClient:  providerClient,
+		circuitBreakers: circuitBreakers,
+		dispatcher:      dispatcher,
+		scanConfigWatcher: configwatcher.CreateScanConfigWatcher(
+			backendClient,
+			providerClient,
+			scanConfigs,
+			targetmetadata.New(config.TargetMetadataConfig),
+			config.ScannerConfig,
+			circuitBreakers,
+			limiter.New(config.ProviderAPIMaxConcurrentCalls),
+			notifier,
+		),
 		scopeDiscoverer:     discovery.CreateScopeDiscoverer(backendClient, providerClient),
 		scanResultProcessor: scanresultprocessor.NewScanResultProcessor(backendClient),
 		scanWatcher: scanwatcher.New(scanwatcher.Config{
@@ -54,6 +121,12 @@ func Create(config *_config.OrchestratorConfig, providerClient provider.Client,
 			PollPeriod:       scanwatcher.DefaultPollInterval,
 			ReconcileTimeout: scanwatcher.DefaultReconcileTimeout,
 		}),
+		orphanReaper: orphanreaper.New(orphanreaper.Config{
+			Backend:         backendClient,
+			Provider:        providerClient,
+			Interval:        config.OrphanReaperInterval,
+			DeleteJobPolicy: config.DeleteJobPolicy,
+		}),
 	}
 
 	return orc, nil
@@ -67,6 +140,54 @@ func (o *orchestrator) Start(ctx context.Context) {
 	o.scopeDiscoverer.Start(ctx)
 	o.scanResultProcessor.Start(ctx)
 	o.scanWatcher.Start(ctx)
+	o.orphanReaper.Start(ctx)
+	if o.dispatcher != nil {
+		o.dispatcher.Start(ctx)
+	}
+}
+
+func (o *orchestrator) CircuitBreakers() *circuitbreaker.Registry {
+	return o.circuitBreakers
+}
+
+func (o *orchestrator) PlanScan(ctx context.Context, scanConfig models.ScanConfigData) (*models.ScanPlan, error) {
+	// nolint:wrapcheck
+	return scanner.Plan(ctx, &o.config.ScannerConfig, o.providerClient, scanConfig)
+}
+
+func (o *orchestrator) ValidateScanConfig(ctx context.Context, scanConfig models.ScanConfigData) *models.ScanConfigValidation {
+	return scanner.ValidateScanConfig(ctx, &o.config.ScannerConfig, o.providerClient, scanConfig)
+}
+
+func (o *orchestrator) RunScanConfig(scanConfig models.ScanConfig) (string, error) {
+	// nolint:wrapcheck
+	return o.scanConfigWatcher.RunScanConfig(&scanConfig)
+}
+
+func (o *orchestrator) GetScannerLogs(ctx context.Context, location, instanceID string) (string, error) {
+	logs, err := o.providerClient.GetScanningJobLogs(ctx, location, instanceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the logs of scanner instance %s: %w", instanceID, err)
+	}
+	return families.RedactLogs(logs), nil
+}
+
+func (o *orchestrator) ListProviderInstances(ctx context.Context, location string) ([]models.ProviderInstance, error) {
+	instances, err := o.providerClient.ListInstances(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the provider instances: %w", err)
+	}
+	return instances, nil
+}
+
+func (o *orchestrator) CheckProvider(ctx context.Context) error {
+	// nolint:wrapcheck
+	return o.providerClient.CheckConnectivity(ctx)
+}
+
+func (o *orchestrator) CheckAlive() error {
+	// nolint:wrapcheck
+	return o.scanConfigWatcher.CheckAlive(time.Now())
 }
 
 func (o *orchestrator) Stop(cancel context.CancelFunc) {
@@ -75,3 +196,20 @@ func (o *orchestrator) Stop(cancel context.CancelFunc) {
 		o.cancelFunc()
 	}
 }
+
+func (o *orchestrator) Shutdown(ctx context.Context) error {
+	log.Infof("Shutting down Orchestrator server")
+	// The scans clean up their jobs with the context of the orchestrator,
+	// so it's canceled only once they are drained.
+	err := o.scanConfigWatcher.Drain(ctx)
+	// The drained scans may have queued notifications of their completion.
+	if err == nil && o.dispatcher != nil {
+		err = o.dispatcher.Drain(ctx)
+	}
+	if o.cancelFunc != nil {
+		o.cancelFunc()
+	}
+
+	// nolint:wrapcheck
+	return err
+}
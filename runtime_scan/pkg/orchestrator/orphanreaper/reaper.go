@@ -0,0 +1,295 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orphanreaper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+)
+
+// The number of IDs to filter by in a single backend request, so that the
+// request URL stays short.
+const idsPerRequest = 50
+
+type Config struct {
+	Backend  *backendclient.BackendClient
+	Provider provider.Client
+	// The interval between the reaps, the reaper is disabled when it is 0.
+	Interval        time.Duration
+	DeleteJobPolicy _config.DeleteJobPolicyType
+}
+
+// Reaper periodically deletes the scanning job resources which were leaked,
+// for example when the orchestrator was restarted while the jobs were
+// running. A resource is leaked if the ScanResult or the Scan of its job
+// doesn't exist anymore, or if the Scan is finished and the jobs are deleted
+// according to the delete job policy.
+type Reaper struct {
+	logger          *log.Entry
+	backendClient   *backendclient.BackendClient
+	providerClient  provider.Client
+	interval        time.Duration
+	deleteJobPolicy _config.DeleteJobPolicyType
+}
+
+func New(c Config) *Reaper {
+	return &Reaper{
+		logger:          log.WithFields(log.Fields{"controller": "OrphanReaper"}),
+		backendClient:   c.Backend,
+		providerClient:  c.Provider,
+		interval:        c.Interval,
+		deleteJobPolicy: c.DeleteJobPolicy,
+	}
+}
+
+func (r *Reaper) Start(ctx context.Context) {
+	if r.interval <= 0 {
+		r.logger.Info("Orphaned job resources reaper is disabled")
+		return
+	}
+
+	go func() {
+		for {
+			if err := r.reap(ctx); err != nil {
+				r.logger.Warnf("Failed to reap orphaned job resources: %v", err)
+			}
+			select {
+			case <-time.After(r.interval):
+				r.logger.Debug("Reap interval elapsed")
+			case <-ctx.Done():
+				r.logger.Infof("Stop reaping orphaned job resources.")
+				return
+			}
+		}
+	}()
+}
+
+func (r *Reaper) reap(ctx context.Context) error {
+	resources, err := r.providerClient.ListJobResources(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list job resources: %v", err)
+	}
+
+	var scanResultIDs []string
+	for _, resource := range resources {
+		if resource.ScanResultID != "" {
+			scanResultIDs = append(scanResultIDs, resource.ScanResultID)
+		}
+	}
+	if len(scanResultIDs) == 0 {
+		return nil
+	}
+
+	scanResultScans, err := r.getScanResultScans(ctx, scanResultIDs)
+	if err != nil {
+		return err
+	}
+	scanIDs := make([]string, 0, len(scanResultScans))
+	for _, scanID := range scanResultScans {
+		scanIDs = append(scanIDs, scanID)
+	}
+	scanStates, err := r.getScanStates(ctx, scanIDs)
+	if err != nil {
+		return err
+	}
+
+	var orphaned []types.JobResource
+	for _, resource := range resources {
+		if resource.ScanResultID == "" {
+			continue
+		}
+		if isOrphaned(resource.ScanResultID, scanResultScans, scanStates, r.deleteJobPolicy) {
+			orphaned = append(orphaned, resource)
+		}
+	}
+	if len(orphaned) == 0 {
+		return nil
+	}
+
+	r.logger.Infof("Deleting %d orphaned job resources", len(orphaned))
+	sortForDeletion(orphaned)
+	for _, resource := range orphaned {
+		if err := deleteJobResource(ctx, resource); err != nil {
+			// The resource will be deleted by the next reap if it
+			// couldn't be deleted yet, for example a volume which is
+			// still attached to an instance being terminated.
+			r.logger.Warnf("Failed to delete orphaned job resource. scanID=%v, scanResultID=%v, targetID=%v: %v",
+				resource.ScanID, resource.ScanResultID, resource.TargetID, err)
+		}
+	}
+
+	return nil
+}
+
+// getScanResultScans returns the scan ID of the existing scan results by
+// their ID.
+func (r *Reaper) getScanResultScans(ctx context.Context, scanResultIDs []string) (map[string]string, error) {
+	ret := make(map[string]string)
+	for _, ids := range chunkIDs(scanResultIDs) {
+		filter := idsFilter(ids)
+		selector := "id,scan"
+		scanResults, err := r.backendClient.GetScanResults(ctx, models.GetScanResultsParams{
+			Filter: &filter,
+			Select: &selector,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scan results: %v", err)
+		}
+		if scanResults.Items == nil {
+			continue
+		}
+		for _, scanResult := range *scanResults.Items {
+			if scanResult.Id == nil || scanResult.Scan == nil {
+				continue
+			}
+			ret[*scanResult.Id] = scanResult.Scan.Id
+		}
+	}
+
+	return ret, nil
+}
+
+// getScanStates returns the state of the existing scans by their ID.
+func (r *Reaper) getScanStates(ctx context.Context, scanIDs []string) (map[string]models.ScanState, error) {
+	ret := make(map[string]models.ScanState)
+	for _, ids := range chunkIDs(scanIDs) {
+		filter := idsFilter(ids)
+		selector := "id,state"
+		scans, err := r.backendClient.GetScans(ctx, models.GetScansParams{
+			Filter: &filter,
+			Select: &selector,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scans: %v", err)
+		}
+		if scans == nil || scans.Items == nil {
+			continue
+		}
+		for _, scan := range *scans.Items {
+			if scan.Id == nil {
+				continue
+			}
+			state, _ := scan.GetState()
+			ret[*scan.Id] = state
+		}
+	}
+
+	return ret, nil
+}
+
+// isOrphaned returns whether the resources of the scanning job of the scan
+// result are leaked.
+func isOrphaned(scanResultID string, scanResultScans map[string]string, scanStates map[string]models.ScanState, deleteJobPolicy _config.DeleteJobPolicyType) bool {
+	scanID, ok := scanResultScans[scanResultID]
+	if !ok {
+		return true
+	}
+	state, ok := scanStates[scanID]
+	if !ok {
+		return true
+	}
+
+	switch state {
+	case models.ScanStateDone, models.ScanStateFailed:
+		// The jobs of finished scans are kept according to the delete
+		// job policy, for example for debugging.
+		return deleteJobPolicy == _config.DeleteJobPolicyAlways
+	case models.ScanStatePending, models.ScanStateDiscovered, models.ScanStateInProgress, models.ScanStateAborted:
+		fallthrough
+	default:
+		return false
+	}
+}
+
+// sortForDeletion orders the instances before the volumes that may be
+// attached to them, and the volumes before the snapshots.
+func sortForDeletion(resources []types.JobResource) {
+	order := func(resource types.JobResource) int {
+		switch {
+		case resource.Instance != nil:
+			return 0
+		case resource.Volume != nil:
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return order(resources[i]) < order(resources[j])
+	})
+}
+
+func deleteJobResource(ctx context.Context, resource types.JobResource) error {
+	switch {
+	case resource.Instance != nil:
+		if err := resource.Instance.Delete(ctx); err != nil {
+			return fmt.Errorf("failed to delete instance %v: %v", resource.Instance.GetID(), err)
+		}
+	case resource.Volume != nil:
+		if err := resource.Volume.Delete(ctx); err != nil {
+			return fmt.Errorf("failed to delete volume %v: %v", resource.Volume.GetID(), err)
+		}
+	case resource.Snapshot != nil:
+		if err := resource.Snapshot.Delete(ctx); err != nil {
+			return fmt.Errorf("failed to delete snapshot %v: %v", resource.Snapshot.GetID(), err)
+		}
+	}
+
+	return nil
+}
+
+// chunkIDs returns the unique IDs in chunks of idsPerRequest.
+func chunkIDs(ids []string) [][]string {
+	seen := make(map[string]struct{}, len(ids))
+	var unique []string
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	var ret [][]string
+	for len(unique) > idsPerRequest {
+		ret = append(ret, unique[:idsPerRequest])
+		unique = unique[idsPerRequest:]
+	}
+	if len(unique) > 0 {
+		ret = append(ret, unique)
+	}
+
+	return ret
+}
+
+func idsFilter(ids []string) string {
+	conditions := make([]string, len(ids))
+	for i, id := range ids {
+		conditions[i] = fmt.Sprintf("id eq '%s'", strings.ReplaceAll(id, "'", "''"))
+	}
+	return strings.Join(conditions, " or ")
+}
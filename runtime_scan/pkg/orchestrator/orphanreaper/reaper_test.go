@@ -0,0 +1,201 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orphanreaper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+)
+
+func Test_isOrphaned(t *testing.T) {
+	scanResultScans := map[string]string{
+		"result-in-progress": "scan-in-progress",
+		"result-aborted":     "scan-aborted",
+		"result-done":        "scan-done",
+		"result-failed":      "scan-failed",
+		"result-deleted":     "scan-deleted",
+	}
+	scanStates := map[string]models.ScanState{
+		"scan-in-progress": models.ScanStateInProgress,
+		"scan-aborted":     models.ScanStateAborted,
+		"scan-done":        models.ScanStateDone,
+		"scan-failed":      models.ScanStateFailed,
+	}
+	tests := []struct {
+		name            string
+		scanResultID    string
+		deleteJobPolicy _config.DeleteJobPolicyType
+		want            bool
+	}{
+		{
+			name:            "scan result doesn't exist",
+			scanResultID:    "result-unknown",
+			deleteJobPolicy: _config.DeleteJobPolicyNever,
+			want:            true,
+		},
+		{
+			name:            "scan doesn't exist",
+			scanResultID:    "result-deleted",
+			deleteJobPolicy: _config.DeleteJobPolicyNever,
+			want:            true,
+		},
+		{
+			name:            "scan in progress",
+			scanResultID:    "result-in-progress",
+			deleteJobPolicy: _config.DeleteJobPolicyAlways,
+			want:            false,
+		},
+		{
+			name:            "scan aborted",
+			scanResultID:    "result-aborted",
+			deleteJobPolicy: _config.DeleteJobPolicyAlways,
+			want:            false,
+		},
+		{
+			name:            "scan done and jobs are always deleted",
+			scanResultID:    "result-done",
+			deleteJobPolicy: _config.DeleteJobPolicyAlways,
+			want:            true,
+		},
+		{
+			name:            "scan failed and jobs are always deleted",
+			scanResultID:    "result-failed",
+			deleteJobPolicy: _config.DeleteJobPolicyAlways,
+			want:            true,
+		},
+		{
+			name:            "scan failed and failed jobs are kept",
+			scanResultID:    "result-failed",
+			deleteJobPolicy: _config.DeleteJobPolicyOnSuccess,
+			want:            false,
+		},
+		{
+			name:            "scan done and jobs are never deleted",
+			scanResultID:    "result-done",
+			deleteJobPolicy: _config.DeleteJobPolicyNever,
+			want:            false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isOrphaned(tt.scanResultID, scanResultScans, scanStates, tt.deleteJobPolicy); got != tt.want {
+				t.Errorf("isOrphaned() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeInstance struct {
+	types.Instance
+	id string
+}
+
+func (i *fakeInstance) GetID() string {
+	return i.id
+}
+
+type fakeVolume struct {
+	types.Volume
+	id string
+}
+
+func (v *fakeVolume) GetID() string {
+	return v.id
+}
+
+type fakeSnapshot struct {
+	types.Snapshot
+	id string
+}
+
+func (s *fakeSnapshot) GetID() string {
+	return s.id
+}
+
+func Test_sortForDeletion(t *testing.T) {
+	resources := []types.JobResource{
+		{JobInfo: types.JobInfo{ScanResultID: "1"}, Snapshot: &fakeSnapshot{id: "snap-1"}},
+		{JobInfo: types.JobInfo{ScanResultID: "1"}, Volume: &fakeVolume{id: "vol-1"}},
+		{JobInfo: types.JobInfo{ScanResultID: "2"}, Snapshot: &fakeSnapshot{id: "snap-2"}},
+		{JobInfo: types.JobInfo{ScanResultID: "1"}, Instance: &fakeInstance{id: "i-1"}},
+	}
+	sortForDeletion(resources)
+
+	var got []string
+	for _, resource := range resources {
+		switch {
+		case resource.Instance != nil:
+			got = append(got, resource.Instance.GetID())
+		case resource.Volume != nil:
+			got = append(got, resource.Volume.GetID())
+		case resource.Snapshot != nil:
+			got = append(got, resource.Snapshot.GetID())
+		}
+	}
+	want := []string{"i-1", "vol-1", "snap-1", "snap-2"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("sortForDeletion() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_chunkIDs(t *testing.T) {
+	var ids []string
+	for i := 0; i < idsPerRequest+1; i++ {
+		ids = append(ids, fmt.Sprintf("id-%d", i))
+	}
+	tests := []struct {
+		name string
+		ids  []string
+		want [][]string
+	}{
+		{
+			name: "no ids",
+			ids:  nil,
+			want: nil,
+		},
+		{
+			name: "duplicate ids",
+			ids:  []string{"a", "b", "a"},
+			want: [][]string{{"a", "b"}},
+		},
+		{
+			name: "more ids than per request",
+			ids:  ids,
+			want: [][]string{ids[:idsPerRequest], ids[idsPerRequest:]},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, chunkIDs(tt.ids)); diff != "" {
+				t.Errorf("chunkIDs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_idsFilter(t *testing.T) {
+	got := idsFilter([]string{"a", "b'c"})
+	want := "id eq 'a' or id eq 'b''c'"
+	if got != want {
+		t.Errorf("idsFilter() = %v, want %v", got, want)
+	}
+}
@@ -18,6 +18,7 @@ package scanresultprocessor
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/openclarity/vmclarity/api/models"
@@ -102,3 +103,39 @@ func (srp *ScanResultProcessor) getActiveFindingsByType(ctx context.Context, fin
 	}
 	return *activeFindings.Count, nil
 }
+
+// findingPartition returns the device name of the partition of the asset that
+// a finding was found on. If a single partition was scanned, all the findings
+// were found on it, otherwise the partition is known only for findings with a
+// path under the mount point of one of the scanned partitions.
+func findingPartition(scanResult models.TargetScanResult, path *string) *string {
+	if scanResult.Partitions == nil {
+		return nil
+	}
+
+	var scannedPartitions []models.ScannedPartition
+	for _, partition := range *scanResult.Partitions {
+		if partition.Scanned != nil && *partition.Scanned {
+			scannedPartitions = append(scannedPartitions, partition)
+		}
+	}
+
+	if len(scannedPartitions) == 1 {
+		return scannedPartitions[0].DeviceName
+	}
+
+	if path == nil {
+		return nil
+	}
+	for _, partition := range scannedPartitions {
+		if partition.MountPoint == nil || *partition.MountPoint == "" {
+			continue
+		}
+		mountPoint := strings.TrimSuffix(*partition.MountPoint, "/") + "/"
+		if strings.HasPrefix(*path, mountPoint) {
+			return partition.DeviceName
+		}
+	}
+
+	return nil
+}
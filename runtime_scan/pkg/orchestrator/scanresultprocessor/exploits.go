@@ -96,6 +96,7 @@ func (srp *ScanResultProcessor) reconcileResultExploitsToFindings(ctx context.Co
 				Scan:        scanResult.Scan,
 				Asset:       scanResult.Target,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				Partition:   findingPartition(scanResult, nil),
 				FindingInfo: &findingInfo,
 			}
 
@@ -93,6 +93,7 @@ func (srp *ScanResultProcessor) reconcileResultMalwareToFindings(ctx context.Con
 				Scan:        scanResult.Scan,
 				Asset:       scanResult.Target,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				Partition:   findingPartition(scanResult, item.Path),
 				FindingInfo: &findingInfo,
 			}
 
@@ -98,6 +98,7 @@ func (srp *ScanResultProcessor) reconcileResultMisconfigurationsToFindings(ctx c
 				Scan:        scanResult.Scan,
 				Asset:       scanResult.Target,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				Partition:   findingPartition(scanResult, item.ScannedPath),
 				FindingInfo: &findingInfo,
 			}
 
@@ -97,6 +97,7 @@ func (srp *ScanResultProcessor) reconcileResultPackagesToFindings(ctx context.Co
 				Scan:        scanResult.Scan,
 				Asset:       scanResult.Target,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				Partition:   findingPartition(scanResult, nil),
 				FindingInfo: &findingInfo,
 			}
 
@@ -93,6 +93,7 @@ func (srp *ScanResultProcessor) reconcileResultRootkitsToFindings(ctx context.Co
 				Scan:        scanResult.Scan,
 				Asset:       scanResult.Target,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				Partition:   findingPartition(scanResult, nil),
 				FindingInfo: &findingInfo,
 			}
 
@@ -97,6 +97,7 @@ func (srp *ScanResultProcessor) reconcileResultSecretsToFindings(ctx context.Con
 				Scan:        scanResult.Scan,
 				Asset:       scanResult.Target,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				Partition:   findingPartition(scanResult, item.FilePath),
 				FindingInfo: &findingInfo,
 			}
 
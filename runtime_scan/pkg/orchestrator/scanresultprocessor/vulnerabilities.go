@@ -67,6 +67,7 @@ func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx con
 				VulnerabilityName: vuln.VulnerabilityName,
 				Description:       vuln.Description,
 				Severity:          vuln.Severity,
+				OriginalSeverity:  vuln.OriginalSeverity,
 				Links:             vuln.Links,
 				Distro:            vuln.Distro,
 				Cvss:              vuln.Cvss,
@@ -86,6 +87,7 @@ func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx con
 				Scan:        scanResult.Scan,
 				Asset:       scanResult.Target,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				Partition:   findingPartition(scanResult, vuln.Path),
 				FindingInfo: &findingInfo,
 			}
 
@@ -0,0 +1,112 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
+	ebstypes "github.com/aws/aws-sdk-go-v2/service/ebs/types"
+
+	"github.com/openclarity/vmclarity/shared/pkg/ebsblocks"
+)
+
+const (
+	// blockChecksumsConcurrency is the number of blocks whose checksum is
+	// read concurrently.
+	blockChecksumsConcurrency = 16
+)
+
+// GetBlockChecksums returns the checksums of the blocks of the snapshot by
+// block index, as returned by the EBS direct APIs. The checksum of a block is
+// returned with its data, so the data of every block is requested.
+func (s *SnapshotImpl) GetBlockChecksums(ctx context.Context) (map[int32]string, error) {
+	snapshotBlocks, err := ebsblocks.ListSnapshotBlocks(ctx, s.ebsClient, s.id, func(options *ebs.Options) {
+		options.Region = s.region
+	})
+	if err != nil {
+		return nil, err
+	}
+	blocks := snapshotBlocks.Blocks
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	checksums := make(map[int32]string, len(blocks))
+	blocksChan := make(chan ebstypes.Block)
+	for i := 0; i < blockChecksumsConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for block := range blocksChan {
+				checksum, err := s.getBlockChecksum(ctx, block)
+				mu.Lock()
+				switch {
+				case err == nil:
+					checksums[*block.BlockIndex] = checksum
+				case firstErr == nil:
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+send:
+	for _, block := range blocks {
+		select {
+		case blocksChan <- block:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(blocksChan)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get the block checksums of snapshot. snapshotID=%v: %w", s.id, err)
+	}
+
+	return checksums, nil
+}
+
+func (s *SnapshotImpl) getBlockChecksum(ctx context.Context, block ebstypes.Block) (string, error) {
+	out, err := s.ebsClient.GetSnapshotBlock(ctx, &ebs.GetSnapshotBlockInput{
+		SnapshotId: &s.id,
+		BlockIndex: block.BlockIndex,
+		BlockToken: block.BlockToken,
+	}, func(options *ebs.Options) {
+		options.Region = s.region
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get snapshot block. snapshotID=%v, blockIndex=%v: %v", s.id, *block.BlockIndex, err)
+	}
+	// Only the checksum is needed, the data of the block isn't read.
+	_ = out.BlockData.Close()
+	if out.Checksum == nil {
+		return "", fmt.Errorf("checksum of snapshot block is not set. snapshotID=%v, blockIndex=%v", s.id, *block.BlockIndex)
+	}
+
+	return string(out.ChecksumAlgorithm) + ":" + *out.Checksum, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	awstype "github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	log "github.com/sirupsen/logrus"
@@ -36,8 +37,13 @@ import (
 )
 
 type Client struct {
-	ec2Client *ec2.Client
-	awsConfig *aws.Config
+	ec2Client           *ec2.Client
+	ebsClient           *ebs.Client
+	awsConfig           *aws.Config
+	describeCache       *describeCache
+	fastSnapshotRestore fastSnapshotRestoreConfig
+	jobTagKeys          jobTagKeys
+	scannerKMSKeyID     string
 }
 
 var (
@@ -55,7 +61,18 @@ var (
 
 func Create(ctx context.Context, config *aws.Config) (*Client, error) {
 	awsClient := Client{
-		awsConfig: config,
+		awsConfig:     config,
+		describeCache: newDescribeCache(config.DescribeCacheTTL),
+		fastSnapshotRestore: fastSnapshotRestoreConfig{
+			enabled: config.FastSnapshotRestoreEnabled,
+			timeout: config.FastSnapshotRestoreTimeout,
+		},
+		jobTagKeys: jobTagKeys{
+			scanID:       config.ScanIDTagKey,
+			scanResultID: config.ScanResultIDTagKey,
+			targetID:     config.TargetIDTagKey,
+		},
+		scannerKMSKeyID: config.ScannerKMSKeyID,
 	}
 
 	cfg, err := awsconfig.LoadDefaultConfig(ctx)
@@ -65,10 +82,21 @@ func Create(ctx context.Context, config *aws.Config) (*Client, error) {
 
 	// nolint:contextcheck
 	awsClient.ec2Client = ec2.NewFromConfig(cfg)
+	// nolint:contextcheck
+	awsClient.ebsClient = ebs.NewFromConfig(cfg)
 
 	return &awsClient, nil
 }
 
+func (c *Client) CheckConnectivity(ctx context.Context) error {
+	_, err := c.ec2Client.DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
+	if err != nil {
+		return fmt.Errorf("failed to describe regions: %v", err)
+	}
+
+	return nil
+}
+
 func (c *Client) DiscoverScopes(ctx context.Context) (*models.Scopes, error) {
 	regions, err := c.ListAllRegions(ctx, true)
 	if err != nil {
@@ -90,7 +118,6 @@ func (c *Client) DiscoverScopes(ctx context.Context) (*models.Scopes, error) {
 
 func (c *Client) DiscoverInstances(ctx context.Context, scanScope *models.ScanScopeType) ([]types.Instance, error) {
 	var ret []types.Instance
-	var filters []ec2types.Filter
 
 	awsScanScope, err := scanScope.AsAwsScanScope()
 	if err != nil {
@@ -106,44 +133,65 @@ func (c *Client) DiscoverInstances(ctx context.Context, scanScope *models.ScanSc
 	if len(regions) == 0 {
 		return nil, fmt.Errorf("no regions to scan")
 	}
-	filters = append(filters, createInclusionTagsFilters(scope.TagSelector)...)
-	filters = append(filters, createInstanceStateFilters(scope.ScanStopped)...)
-
-	for _, region := range regions {
-		// if no vpcs, that mean that we don't need any vpc filters
-		if len(region.VPCs) == 0 {
-			instances, err := c.GetInstances(ctx, filters, scope.ExcludeTags, region.Name)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get instances: %v", err)
+	// An instance matching more than one of the tag selector filters is
+	// returned by more than one DescribeInstances call, so keep track of the
+	// instances which were already found.
+	found := make(map[string]struct{})
+	for _, tagFilters := range createTagSelectorFilters(scope.TagSelector, scope.MatchAnyTag) {
+		filters := append(tagFilters, createInstanceStateFilters(scope.ScanStopped)...)
+
+		for _, region := range regions {
+			// if no vpcs, that mean that we don't need any vpc filters
+			if len(region.VPCs) == 0 {
+				instances, err := c.GetInstances(ctx, filters, scope.ExcludeTags, region.Name)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get instances: %v", err)
+				}
+				ret = appendNewInstances(ret, found, instances)
+				continue
 			}
-			ret = append(ret, instances...)
-			continue
-		}
 
-		// need to do a per vpc call for DescribeInstances
-		for _, vpc := range region.VPCs {
-			vpcFilters := append(filters, createVPCFilters(vpc)...)
+			// need to do a per vpc call for DescribeInstances
+			for _, vpc := range region.VPCs {
+				vpcFilters := append(filters, createVPCFilters(vpc)...)
 
-			instances, err := c.GetInstances(ctx, vpcFilters, scope.ExcludeTags, region.Name)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get instances: %v", err)
+				instances, err := c.GetInstances(ctx, vpcFilters, scope.ExcludeTags, region.Name)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get instances: %v", err)
+				}
+				ret = appendNewInstances(ret, found, instances)
 			}
-			ret = append(ret, instances...)
 		}
 	}
 	return ret, nil
 }
 
+func appendNewInstances(instances []types.Instance, found map[string]struct{}, newInstances []types.Instance) []types.Instance {
+	for _, instance := range newInstances {
+		if _, ok := found[instance.GetID()]; ok {
+			continue
+		}
+		found[instance.GetID()] = struct{}{}
+		instances = append(instances, instance)
+	}
+	return instances
+}
+
 func convertFromAPIScanScope(scope *models.AwsScanScope) *ScanScope {
 	return &ScanScope{
 		AllRegions:  convertBool(scope.AllRegions),
 		Regions:     convertFromAPIRegions(scope.Regions),
 		ScanStopped: convertBool(scope.ShouldScanStoppedInstances),
 		TagSelector: convertFromAPITags(scope.InstanceTagSelector),
+		MatchAnyTag: isTagSelectorOperatorOr(scope.InstanceTagSelectorOperator),
 		ExcludeTags: convertFromAPITags(scope.InstanceTagExclusion),
 	}
 }
 
+func isTagSelectorOperatorOr(operator *models.TagSelectorOperator) bool {
+	return operator != nil && *operator == models.OR
+}
+
 func convertFromAPITags(tags *[]models.Tag) []Tag {
 	var ret []Tag
 	if tags != nil {
@@ -244,25 +292,49 @@ func convertBool(all *bool) bool {
 }
 
 func (c *Client) RunScanningJob(ctx context.Context, region, id string, config provider.ScanningJobConfig) (types.Instance, error) {
+	// A previous attempt may have launched the instance before failing, in
+	// that case reuse it instead of launching a duplicate.
+	existingInstance, err := c.getScanningJobInstance(ctx, region, config.ScanResultID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing scanner instance: %v", err)
+	}
+	if existingInstance != nil {
+		log.Infof("Scanner instance already exists. scanResultID=%v, instanceID=%v", config.ScanResultID, existingInstance.GetID())
+		return existingInstance, nil
+	}
+
+	directReadSnapshotIDs, directReadDevices := directReadSnapshots(config.DirectReadVolumes)
 	cloudInitData := cloudinit.Data{
-		ScannerCLIConfig: config.ScannerCLIConfig,
-		ScannerImage:     config.ScannerImage,
-		ServerAddress:    config.VMClarityAddress,
-		ScanResultID:     config.ScanResultID,
+		ScannerCLIConfig:            config.ScannerCLIConfig,
+		ScannerImage:                config.ScannerImage,
+		ScannerImagePullCredentials: config.ScannerImagePullCredentials(),
+		ServerAddress:               config.VMClarityAddress,
+		ScanResultID:                config.ScanResultID,
+		PartitionsToScan:            config.PartitionsToScan,
+		CABundle:                    config.CABundle,
+		ScannerProxy:                config.ScannerProxy,
+		DirectReadSnapshots:         directReadSnapshotIDs,
+		DirectReadDevices:           directReadDevices,
+		DirectReadRegion:            region,
 	}
 	userData, err := cloudinit.GenerateCloudInit(cloudInitData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate cloud-init: %v", err)
 	}
 
-	instanceTags := createInstanceTags(id)
+	instanceTags := createInstanceTags(id, c.jobTagKeys, config.JobInfo())
 	userDataBase64 := base64.StdEncoding.EncodeToString([]byte(userData))
 
+	instanceType := c.awsConfig.InstanceType
+	if config.InstanceType != "" {
+		instanceType = config.InstanceType
+	}
+
 	runInstancesInput := &ec2.RunInstancesInput{
 		MaxCount:     utils.Int32Ptr(1),
 		MinCount:     utils.Int32Ptr(1),
 		ImageId:      &c.awsConfig.AmiID,
-		InstanceType: ec2types.InstanceType(c.awsConfig.InstanceType),
+		InstanceType: ec2types.InstanceType(instanceType),
 		TagSpecifications: []ec2types.TagSpecification{
 			{
 				ResourceType: ec2types.ResourceTypeInstance,
@@ -270,20 +342,22 @@ func (c *Client) RunScanningJob(ctx context.Context, region, id string, config p
 			},
 			{
 				ResourceType: ec2types.ResourceTypeVolume,
-				Tags:         vmclarityTags,
+				Tags:         createJobResourceTags(c.jobTagKeys, config.JobInfo()),
 			},
 		},
-		UserData: &userDataBase64,
+		UserData:            &userDataBase64,
+		BlockDeviceMappings: createScratchVolumeBlockDeviceMappings(config.DirectReadVolumes),
 	}
 
-	// Create network interface in the scanner subnet with the scanner security group.
+	// Create network interface in the scanner subnet of the region with the scanner security group.
+	network := c.awsConfig.GetRegionNetwork(region)
 	runInstancesInput.NetworkInterfaces = []ec2types.InstanceNetworkInterfaceSpecification{
 		{
 			AssociatePublicIpAddress: utils.BoolPtr(false),
 			DeleteOnTermination:      utils.BoolPtr(true),
 			DeviceIndex:              utils.Int32Ptr(0),
-			Groups:                   []string{c.awsConfig.SecurityGroupID},
-			SubnetId:                 &c.awsConfig.SubnetID,
+			Groups:                   []string{network.SecurityGroupID},
+			SubnetId:                 &network.SubnetID,
 		},
 	}
 
@@ -300,6 +374,14 @@ func (c *Client) RunScanningJob(ctx context.Context, region, id string, config p
 				},
 			}
 		}
+		runInstancesInput.MetadataOptions = createInstanceMetadataOptions(config.ScannerInstanceCreationConfig)
+		// Let the scanner get its AWS credentials from the instance metadata
+		// service instead of embedding static credentials.
+		if arn := config.ScannerInstanceCreationConfig.InstanceProfileARN; arn != nil && *arn != "" {
+			runInstancesInput.IamInstanceProfile = &ec2types.IamInstanceProfileSpecification{
+				Arn: arn,
+			}
+		}
 		// In the case of spot instances, we have higher probability to start an instance
 		// by increasing RetryMaxAttempts
 		if config.ScannerInstanceCreationConfig.RetryMaxAttempts != nil {
@@ -323,26 +405,177 @@ func (c *Client) RunScanningJob(ctx context.Context, region, id string, config p
 	}
 
 	return &InstanceImpl{
-		ec2Client:        c.ec2Client,
-		id:               *out.Instances[0].InstanceId,
-		region:           region,
-		availabilityZone: *out.Instances[0].Placement.AvailabilityZone,
+		ec2Client:           c.ec2Client,
+		ebsClient:           c.ebsClient,
+		describeCache:       c.describeCache,
+		fastSnapshotRestore: c.fastSnapshotRestore,
+		jobTagKeys:          c.jobTagKeys,
+		scannerKMSKeyID:     c.scannerKMSKeyID,
+		id:                  *out.Instances[0].InstanceId,
+		region:              region,
+		availabilityZone:    *out.Instances[0].Placement.AvailabilityZone,
 	}, nil
 }
 
-func createInstanceTags(id string) []ec2types.Tag {
+// createInstanceMetadataOptions returns the metadata options of the scanner
+// instance, or nil to keep the AWS defaults if none are configured.
+func createInstanceMetadataOptions(creationConfig *models.ScannerInstanceCreationConfig) *ec2types.InstanceMetadataOptionsRequest {
+	if creationConfig.RequireIMDSv2 == nil && creationConfig.InstanceMetadataHopLimit == nil {
+		return nil
+	}
+
+	options := &ec2types.InstanceMetadataOptionsRequest{}
+	if creationConfig.RequireIMDSv2 != nil {
+		options.HttpTokens = ec2types.HttpTokensStateOptional
+		if *creationConfig.RequireIMDSv2 {
+			options.HttpTokens = ec2types.HttpTokensStateRequired
+		}
+	}
+	if hopLimit := creationConfig.InstanceMetadataHopLimit; hopLimit != nil {
+		options.HttpPutResponseHopLimit = utils.Int32Ptr(int32(*hopLimit))
+	}
+
+	return options
+}
+
+// createScratchVolumeBlockDeviceMappings returns the block device mappings of
+// the empty scratch volumes which the scanner instance reads the snapshots
+// directly to. The volumes are tagged with the job info like the other job
+// volumes and deleted with the scanner instance.
+func createScratchVolumeBlockDeviceMappings(volumes []provider.DirectReadVolume) []ec2types.BlockDeviceMapping {
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	mappings := make([]ec2types.BlockDeviceMapping, 0, len(volumes))
+	for _, volume := range volumes {
+		mappings = append(mappings, ec2types.BlockDeviceMapping{
+			DeviceName: utils.StringPtr(volume.DeviceName),
+			Ebs: &ec2types.EbsBlockDevice{
+				DeleteOnTermination: utils.BoolPtr(true),
+				Encrypted:           utils.BoolPtr(true),
+				VolumeSize:          utils.Int32Ptr(int32(volume.Size)),
+				VolumeType:          ec2types.VolumeTypeGp3,
+			},
+		})
+	}
+
+	return mappings
+}
+
+// directReadSnapshots returns the IDs of the snapshots which the scanner
+// instance reads directly to the scratch volumes, and the device names of the
+// scratch volumes in the same order.
+func directReadSnapshots(volumes []provider.DirectReadVolume) ([]string, []string) {
+	if len(volumes) == 0 {
+		return nil, nil
+	}
+
+	snapshotIDs := make([]string, 0, len(volumes))
+	deviceNames := make([]string, 0, len(volumes))
+	for _, volume := range volumes {
+		snapshotIDs = append(snapshotIDs, volume.SnapshotID)
+		deviceNames = append(deviceNames, volume.DeviceName)
+	}
+
+	return snapshotIDs, deviceNames
+}
+
+func createInstanceTags(id string, keys jobTagKeys, job types.JobInfo) []ec2types.Tag {
 	nameTagValue := fmt.Sprintf("vmclarity-scanner-%s", id)
 
 	var ret []ec2types.Tag
-	ret = append(ret, vmclarityTags...)
 	ret = append(ret, ec2types.Tag{
 		Key:   &nameTagKey,
 		Value: &nameTagValue,
 	})
+	ret = append(ret, createJobResourceTags(keys, job)...)
 
 	return ret
 }
 
+// jobTagKeys are the keys of the tags of the scanning job resources with the
+// info of their job.
+type jobTagKeys struct {
+	scanID       string
+	scanResultID string
+	targetID     string
+}
+
+// createJobResourceTags returns the tags of a resource of the scanning job.
+// The resources are tagged with the info of the job so that they can be
+// traced in the console, so that the instance is found if the launch is
+// retried, and so that leaked resources are found by the orphaned resource
+// reaper.
+func createJobResourceTags(keys jobTagKeys, job types.JobInfo) []ec2types.Tag {
+	var ret []ec2types.Tag
+	ret = append(ret, vmclarityTags...)
+	for _, tag := range []struct{ key, value string }{
+		{keys.scanID, job.ScanID},
+		{keys.scanResultID, job.ScanResultID},
+		{keys.targetID, job.TargetID},
+	} {
+		if tag.key == "" || tag.value == "" {
+			continue
+		}
+		ret = append(ret, ec2types.Tag{
+			Key:   utils.StringPtr(tag.key),
+			Value: utils.StringPtr(tag.value),
+		})
+	}
+
+	return ret
+}
+
+// getScanningJobInstance returns the pending or running scanner instance that
+// was launched for the scan result, or nil if there is none.
+func (c *Client) getScanningJobInstance(ctx context.Context, region, scanResultID string) (types.Instance, error) {
+	if scanResultID == "" {
+		// nolint:nilnil
+		return nil, nil
+	}
+
+	out, err := c.ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   utils.StringPtr("tag:" + c.jobTagKeys.scanResultID),
+				Values: []string{scanResultID},
+			},
+			{
+				Name:   utils.StringPtr(instanceStateFilterName),
+				Values: []string{string(ec2types.InstanceStateNamePending), string(ec2types.InstanceStateNameRunning)},
+			},
+		},
+	}, func(options *ec2.Options) {
+		options.Region = region
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe instances: %v", err)
+	}
+
+	for _, reservation := range out.Reservations {
+		for _, instance := range reservation.Instances {
+			if instance.InstanceId == nil || instance.Placement == nil || instance.Placement.AvailabilityZone == nil {
+				continue
+			}
+			return &InstanceImpl{
+				ec2Client:           c.ec2Client,
+				ebsClient:           c.ebsClient,
+				describeCache:       c.describeCache,
+				fastSnapshotRestore: c.fastSnapshotRestore,
+				jobTagKeys:          c.jobTagKeys,
+				scannerKMSKeyID:     c.scannerKMSKeyID,
+				id:                  *instance.InstanceId,
+				region:              region,
+				availabilityZone:    *instance.Placement.AvailabilityZone,
+			}, nil
+		}
+	}
+
+	// nolint:nilnil
+	return nil, nil
+}
+
 func (c *Client) GetInstances(ctx context.Context, filters []ec2types.Filter, excludeTags []Tag, regionID string) ([]types.Instance, error) {
 	ret := make([]types.Instance, 0)
 
@@ -398,9 +631,14 @@ func (c *Client) getInstancesFromDescribeInstancesOutput(result *ec2.DescribeIns
 				continue
 			}
 			ret = append(ret, &InstanceImpl{
-				ec2Client: c.ec2Client,
-				id:        *instance.InstanceId,
-				region:    regionID,
+				ec2Client:           c.ec2Client,
+				ebsClient:           c.ebsClient,
+				describeCache:       c.describeCache,
+				fastSnapshotRestore: c.fastSnapshotRestore,
+				jobTagKeys:          c.jobTagKeys,
+				scannerKMSKeyID:     c.scannerKMSKeyID,
+				id:                  *instance.InstanceId,
+				region:              regionID,
 			})
 		}
 	}
@@ -474,6 +712,21 @@ func createInclusionTagsFilters(tags []Tag) []ec2types.Filter {
 	return filters
 }
 
+// createTagSelectorFilters returns the sets of filters which select the
+// instances matching the tag selector. Filters are joined with an AND, so
+// matching any of the tags requires a DescribeInstances call per tag.
+func createTagSelectorFilters(tags []Tag, matchAnyTag bool) [][]ec2types.Filter {
+	if !matchAnyTag || len(tags) == 0 {
+		return [][]ec2types.Filter{createInclusionTagsFilters(tags)}
+	}
+
+	ret := make([][]ec2types.Filter, 0, len(tags))
+	for _, tag := range tags {
+		ret = append(ret, createInclusionTagsFilters([]Tag{tag}))
+	}
+	return ret
+}
+
 func (c *Client) getRegionsToScan(ctx context.Context, scope *ScanScope) ([]Region, error) {
 	if scope.AllRegions {
 		return c.ListAllRegions(ctx, false)
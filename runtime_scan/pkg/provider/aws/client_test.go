@@ -23,6 +23,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
 )
 
@@ -167,6 +170,70 @@ func Test_createInclusionTagsFilters(t *testing.T) {
 	}
 }
 
+func Test_createTagSelectorFilters(t *testing.T) {
+	var (
+		filterTagName1 = "tag:foo"
+		filterTagName2 = "tag:baz"
+	)
+	tags := []Tag{
+		{Key: "foo", Val: "bar"},
+		{Key: "baz", Val: "qux"},
+	}
+
+	type args struct {
+		tags        []Tag
+		matchAnyTag bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]ec2types.Filter
+	}{
+		{
+			name: "no tags",
+			args: args{
+				tags:        nil,
+				matchAnyTag: true,
+			},
+			want: [][]ec2types.Filter{nil},
+		},
+		{
+			name: "AND - a single set of filters",
+			args: args{
+				tags: tags,
+			},
+			want: [][]ec2types.Filter{
+				{
+					{Name: &filterTagName1, Values: []string{"bar"}},
+					{Name: &filterTagName2, Values: []string{"qux"}},
+				},
+			},
+		},
+		{
+			name: "OR - a set of filters per tag",
+			args: args{
+				tags:        tags,
+				matchAnyTag: true,
+			},
+			want: [][]ec2types.Filter{
+				{
+					{Name: &filterTagName1, Values: []string{"bar"}},
+				},
+				{
+					{Name: &filterTagName2, Values: []string{"qux"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createTagSelectorFilters(tt.args.tags, tt.args.matchAnyTag); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createTagSelectorFilters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_hasExcludedTags(t *testing.T) {
 	var (
 		tagName1 = "foo1"
@@ -634,3 +701,178 @@ func TestClient_getInstancesFromDescribeInstancesOutput(t *testing.T) {
 		})
 	}
 }
+
+func Test_createJobResourceTags(t *testing.T) {
+	keys := jobTagKeys{
+		scanID:       "VMClarity.ScanID",
+		scanResultID: "VMClarity.ScanResultID",
+		targetID:     "VMClarity.TargetID",
+	}
+	type args struct {
+		keys jobTagKeys
+		job  types.JobInfo
+	}
+	tests := []struct {
+		name string
+		args args
+		want []ec2types.Tag
+	}{
+		{
+			name: "all job info",
+			args: args{
+				keys: keys,
+				job: types.JobInfo{
+					ScanID:       "scan-1",
+					ScanResultID: "scan-result-1",
+					TargetID:     "target-1",
+				},
+			},
+			want: []ec2types.Tag{
+				{
+					Key:   utils.StringPtr("Owner"),
+					Value: utils.StringPtr("VMClarity"),
+				},
+				{
+					Key:   utils.StringPtr("VMClarity.ScanID"),
+					Value: utils.StringPtr("scan-1"),
+				},
+				{
+					Key:   utils.StringPtr("VMClarity.ScanResultID"),
+					Value: utils.StringPtr("scan-result-1"),
+				},
+				{
+					Key:   utils.StringPtr("VMClarity.TargetID"),
+					Value: utils.StringPtr("target-1"),
+				},
+			},
+		},
+		{
+			name: "missing job info and tag keys are skipped",
+			args: args{
+				keys: jobTagKeys{
+					scanResultID: "VMClarity.ScanResultID",
+					targetID:     "VMClarity.TargetID",
+				},
+				job: types.JobInfo{
+					ScanID:       "scan-1",
+					ScanResultID: "scan-result-1",
+				},
+			},
+			want: []ec2types.Tag{
+				{
+					Key:   utils.StringPtr("Owner"),
+					Value: utils.StringPtr("VMClarity"),
+				},
+				{
+					Key:   utils.StringPtr("VMClarity.ScanResultID"),
+					Value: utils.StringPtr("scan-result-1"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createJobResourceTags(tt.args.keys, tt.args.job); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createJobResourceTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_createInstanceMetadataOptions(t *testing.T) {
+	tests := []struct {
+		name           string
+		creationConfig *models.ScannerInstanceCreationConfig
+		want           *ec2types.InstanceMetadataOptionsRequest
+	}{
+		{
+			name:           "nothing configured keeps the defaults",
+			creationConfig: &models.ScannerInstanceCreationConfig{UseSpotInstances: true},
+			want:           nil,
+		},
+		{
+			name: "require IMDSv2 with hop limit",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				RequireIMDSv2:            utils.BoolPtr(true),
+				InstanceMetadataHopLimit: utils.PointerTo(1),
+			},
+			want: &ec2types.InstanceMetadataOptionsRequest{
+				HttpTokens:              ec2types.HttpTokensStateRequired,
+				HttpPutResponseHopLimit: utils.Int32Ptr(1),
+			},
+		},
+		{
+			name: "IMDSv2 not required",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				RequireIMDSv2: utils.BoolPtr(false),
+			},
+			want: &ec2types.InstanceMetadataOptionsRequest{
+				HttpTokens: ec2types.HttpTokensStateOptional,
+			},
+		},
+		{
+			name: "only hop limit",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				InstanceMetadataHopLimit: utils.PointerTo(2),
+			},
+			want: &ec2types.InstanceMetadataOptionsRequest{
+				HttpPutResponseHopLimit: utils.Int32Ptr(2),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createInstanceMetadataOptions(tt.creationConfig); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createInstanceMetadataOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_createScratchVolumeBlockDeviceMappings(t *testing.T) {
+	tests := []struct {
+		name    string
+		volumes []provider.DirectReadVolume
+		want    []ec2types.BlockDeviceMapping
+	}{
+		{
+			name:    "volumes are attached",
+			volumes: nil,
+			want:    nil,
+		},
+		{
+			name: "root and data volumes",
+			volumes: []provider.DirectReadVolume{
+				{SnapshotID: "snap-1", Size: 8, DeviceName: "xvdh"},
+				{SnapshotID: "snap-2", Size: 100, DeviceName: "xvdi"},
+			},
+			want: []ec2types.BlockDeviceMapping{
+				{
+					DeviceName: utils.StringPtr("xvdh"),
+					Ebs: &ec2types.EbsBlockDevice{
+						DeleteOnTermination: utils.BoolPtr(true),
+						Encrypted:           utils.BoolPtr(true),
+						VolumeSize:          utils.Int32Ptr(8),
+						VolumeType:          ec2types.VolumeTypeGp3,
+					},
+				},
+				{
+					DeviceName: utils.StringPtr("xvdi"),
+					Ebs: &ec2types.EbsBlockDevice{
+						DeleteOnTermination: utils.BoolPtr(true),
+						Encrypted:           utils.BoolPtr(true),
+						VolumeSize:          utils.Int32Ptr(100),
+						VolumeType:          ec2types.VolumeTypeGp3,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createScratchVolumeBlockDeviceMappings(tt.volumes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createScratchVolumeBlockDeviceMappings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
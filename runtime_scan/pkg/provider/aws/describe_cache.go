@@ -0,0 +1,113 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/cache"
+)
+
+// describeCache caches the describe results of single resources so that
+// concurrent jobs and consecutive waiters don't describe the same resource
+// over and over. A nil describeCache describes directly.
+type describeCache struct {
+	instances *cache.TTLCache[*ec2.DescribeInstancesOutput]
+	volumes   *cache.TTLCache[*ec2.DescribeVolumesOutput]
+	snapshots *cache.TTLCache[*ec2.DescribeSnapshotsOutput]
+}
+
+func newDescribeCache(ttl time.Duration) *describeCache {
+	return &describeCache{
+		instances: cache.New[*ec2.DescribeInstancesOutput]("aws_instances", ttl),
+		volumes:   cache.New[*ec2.DescribeVolumesOutput]("aws_volumes", ttl),
+		snapshots: cache.New[*ec2.DescribeSnapshotsOutput]("aws_snapshots", ttl),
+	}
+}
+
+func describeCacheKey(region, id string) string {
+	return fmt.Sprintf("%s/%s", region, id)
+}
+
+func (c *describeCache) describeInstance(ctx context.Context, ec2Client *ec2.Client, region, instanceID string) (*ec2.DescribeInstancesOutput, error) {
+	load := func() (*ec2.DescribeInstancesOutput, error) {
+		// nolint:wrapcheck
+		return ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+			InstanceIds: []string{instanceID},
+		}, func(options *ec2.Options) {
+			options.Region = region
+		})
+	}
+	if c == nil {
+		return load()
+	}
+
+	return c.instances.GetOrLoad(describeCacheKey(region, instanceID), load)
+}
+
+func (c *describeCache) describeVolume(ctx context.Context, ec2Client *ec2.Client, region, volumeID string) (*ec2.DescribeVolumesOutput, error) {
+	load := func() (*ec2.DescribeVolumesOutput, error) {
+		// nolint:wrapcheck
+		return ec2Client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+			VolumeIds: []string{volumeID},
+		}, func(options *ec2.Options) {
+			options.Region = region
+		})
+	}
+	if c == nil {
+		return load()
+	}
+
+	return c.volumes.GetOrLoad(describeCacheKey(region, volumeID), load)
+}
+
+func (c *describeCache) describeSnapshot(ctx context.Context, ec2Client *ec2.Client, region, snapshotID string) (*ec2.DescribeSnapshotsOutput, error) {
+	load := func() (*ec2.DescribeSnapshotsOutput, error) {
+		// nolint:wrapcheck
+		return ec2Client.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+			SnapshotIds: []string{snapshotID},
+		}, func(options *ec2.Options) {
+			options.Region = region
+		})
+	}
+	if c == nil {
+		return load()
+	}
+
+	return c.snapshots.GetOrLoad(describeCacheKey(region, snapshotID), load)
+}
+
+func (c *describeCache) invalidateInstance(region, instanceID string) {
+	if c != nil {
+		c.instances.Invalidate(describeCacheKey(region, instanceID))
+	}
+}
+
+func (c *describeCache) invalidateVolume(region, volumeID string) {
+	if c != nil {
+		c.volumes.Invalidate(describeCacheKey(region, volumeID))
+	}
+}
+
+func (c *describeCache) invalidateSnapshot(region, snapshotID string) {
+	if c != nil {
+		c.snapshots.Invalidate(describeCacheKey(region, snapshotID))
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/smithy-go"
+
+	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// snapshotEncryption is the encryption of a snapshot. Snapshots of volumes
+// encrypted with a customer managed KMS key can only be copied to another
+// region or account, and volumes can only be created from them, if the key
+// permits it. Otherwise, they are re-encrypted with the KMS key of the
+// scanner.
+type snapshotEncryption struct {
+	encrypted bool
+	kmsKeyID  string
+}
+
+func (s *SnapshotImpl) getEncryption(ctx context.Context) (snapshotEncryption, error) {
+	out, err := s.describeCache.describeSnapshot(ctx, s.ec2Client, s.region, s.id)
+	if err != nil {
+		return snapshotEncryption{}, fmt.Errorf("failed to describe snapshot. snapshotID=%v: %v", s.id, err)
+	}
+	if len(out.Snapshots) != 1 {
+		return snapshotEncryption{}, fmt.Errorf("got unexcpected number of snapshots (%v) with snapshot id %v. excpecting 1", len(out.Snapshots), s.id)
+	}
+
+	return snapshotEncryption{
+		encrypted: runtimeScanUtils.ValueOrZero(out.Snapshots[0].Encrypted),
+		kmsKeyID:  runtimeScanUtils.ValueOrZero(out.Snapshots[0].KmsKeyId),
+	}, nil
+}
+
+// reEncryptionKMSKeyID returns the KMS key of the scanner to re-encrypt the
+// snapshot with, or an empty string if the encryption of the snapshot is
+// kept.
+func (e snapshotEncryption) reEncryptionKMSKeyID(scannerKMSKeyID string) string {
+	if !e.encrypted || scannerKMSKeyID == "" || e.kmsKeyID == scannerKMSKeyID {
+		return ""
+	}
+	return scannerKMSKeyID
+}
+
+// kmsKeyAccessError returns an error which explains that the KMS key of the
+// encrypted snapshot doesn't permit the scanner to use it if the error is
+// caused by that, otherwise it returns nil.
+func (e snapshotEncryption) kmsKeyAccessError(snapshotID string, err error) error {
+	if !e.encrypted || !isKMSKeyAccessError(err) {
+		return nil
+	}
+
+	return fmt.Errorf("KMS key %v of snapshot %v doesn't permit the scanner to use it, the key policy must allow the scanner "+
+		"role kms:DescribeKey, kms:Decrypt, kms:ReEncrypt*, kms:CreateGrant and kms:GenerateDataKey* on the key, "+
+		"or shared with the scanner account for cross-account scans: %v", e.kmsKeyID, snapshotID, err)
+}
+
+func isKMSKeyAccessError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return strings.Contains(strings.ToUpper(apiErr.ErrorCode()), "KMS") ||
+			strings.Contains(strings.ToUpper(apiErr.ErrorMessage()), "KMS")
+	}
+	return err != nil && strings.Contains(strings.ToUpper(err.Error()), "KMS")
+}
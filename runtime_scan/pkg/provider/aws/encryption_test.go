@@ -0,0 +1,111 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go"
+)
+
+func Test_snapshotEncryption_reEncryptionKMSKeyID(t *testing.T) {
+	tests := []struct {
+		name            string
+		encryption      snapshotEncryption
+		scannerKMSKeyID string
+		want            string
+	}{
+		{
+			name:            "unencrypted snapshot",
+			encryption:      snapshotEncryption{},
+			scannerKMSKeyID: "scanner-key",
+			want:            "",
+		},
+		{
+			name:       "no scanner key",
+			encryption: snapshotEncryption{encrypted: true, kmsKeyID: "target-key"},
+			want:       "",
+		},
+		{
+			name:            "encrypted with the scanner key",
+			encryption:      snapshotEncryption{encrypted: true, kmsKeyID: "scanner-key"},
+			scannerKMSKeyID: "scanner-key",
+			want:            "",
+		},
+		{
+			name:            "encrypted with the target key",
+			encryption:      snapshotEncryption{encrypted: true, kmsKeyID: "target-key"},
+			scannerKMSKeyID: "scanner-key",
+			want:            "scanner-key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.encryption.reEncryptionKMSKeyID(tt.scannerKMSKeyID); got != tt.want {
+				t.Errorf("reEncryptionKMSKeyID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_snapshotEncryption_kmsKeyAccessError(t *testing.T) {
+	tests := []struct {
+		name       string
+		encryption snapshotEncryption
+		err        error
+		wantErr    bool
+	}{
+		{
+			name:       "KMS error of an encrypted snapshot",
+			encryption: snapshotEncryption{encrypted: true, kmsKeyID: "target-key"},
+			err:        &smithy.GenericAPIError{Code: "InvalidKMSKey.InvalidState", Message: "key is pending deletion"},
+			wantErr:    true,
+		},
+		{
+			name:       "KMS error in the message",
+			encryption: snapshotEncryption{encrypted: true, kmsKeyID: "target-key"},
+			err:        &smithy.GenericAPIError{Code: "InvalidParameterValue", Message: "The KMS key is not accessible"},
+			wantErr:    true,
+		},
+		{
+			name:       "KMS error in the state message",
+			encryption: snapshotEncryption{encrypted: true, kmsKeyID: "target-key"},
+			err:        errors.New("Given KMS key is not accessible"),
+			wantErr:    true,
+		},
+		{
+			name:       "other error of an encrypted snapshot",
+			encryption: snapshotEncryption{encrypted: true, kmsKeyID: "target-key"},
+			err:        &smithy.GenericAPIError{Code: "SnapshotCopyLimitExceeded", Message: "too many copies"},
+			wantErr:    false,
+		},
+		{
+			name:       "unencrypted snapshot",
+			encryption: snapshotEncryption{},
+			err:        &smithy.GenericAPIError{Code: "InvalidKMSKey.InvalidState"},
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.encryption.kmsKeyAccessError("snap-1", tt.err)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("kmsKeyAccessError() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	log "github.com/sirupsen/logrus"
+
+	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// fastSnapshotRestoreConfig configures enabling fast snapshot restore (FSR)
+// for a snapshot in the scanner availability zone before creating a volume
+// from it, so that the volume is fully initialized when it is attached.
+type fastSnapshotRestoreConfig struct {
+	enabled bool
+	timeout time.Duration
+}
+
+// enableFastSnapshotRestore enables FSR for the snapshot in the availability
+// zone and waits for it to be enabled. The availability zone is recorded so
+// that FSR is disabled again when the snapshot is deleted, even if waiting
+// for it has failed.
+func (s *SnapshotImpl) enableFastSnapshotRestore(ctx context.Context, availabilityZone string) error {
+	out, err := s.ec2Client.EnableFastSnapshotRestores(ctx, &ec2.EnableFastSnapshotRestoresInput{
+		AvailabilityZones: []string{availabilityZone},
+		SourceSnapshotIds: []string{s.id},
+	}, func(options *ec2.Options) {
+		options.Region = s.region
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enable fast snapshot restore: %v", err)
+	}
+	if errs := fastSnapshotRestoreErrors(out.Unsuccessful); len(errs) > 0 {
+		return fmt.Errorf("failed to enable fast snapshot restore: %s", strings.Join(errs, ", "))
+	}
+	s.fastSnapshotRestoreAvailabilityZones = append(s.fastSnapshotRestoreAvailabilityZones, availabilityZone)
+
+	ctx, cancel := context.WithTimeout(ctx, s.fastSnapshotRestore.timeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
+			state, err := s.getFastSnapshotRestoreState(ctx, availabilityZone)
+			if err != nil {
+				return err
+			}
+			if state == ec2types.FastSnapshotRestoreStateCodeEnabled {
+				return nil
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for fast snapshot restore to be enabled was canceled: %v", ctx.Err())
+		}
+	}
+}
+
+func (s *SnapshotImpl) getFastSnapshotRestoreState(ctx context.Context, availabilityZone string) (ec2types.FastSnapshotRestoreStateCode, error) {
+	out, err := s.ec2Client.DescribeFastSnapshotRestores(ctx, &ec2.DescribeFastSnapshotRestoresInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   utils.StringPtr("snapshot-id"),
+				Values: []string{s.id},
+			},
+			{
+				Name:   utils.StringPtr("availability-zone"),
+				Values: []string{availabilityZone},
+			},
+		},
+	}, func(options *ec2.Options) {
+		options.Region = s.region
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe fast snapshot restores. snapshotID=%v: %v", s.id, err)
+	}
+	if len(out.FastSnapshotRestores) != 1 {
+		return "", fmt.Errorf("got unexpected number of fast snapshot restores (%v) with snapshot id %v. expecting 1", len(out.FastSnapshotRestores), s.id)
+	}
+
+	return out.FastSnapshotRestores[0].State, nil
+}
+
+// disableFastSnapshotRestore disables FSR in all the availability zones it
+// was enabled in, to avoid being charged for it once the snapshot is no
+// longer needed.
+func (s *SnapshotImpl) disableFastSnapshotRestore(ctx context.Context) error {
+	if len(s.fastSnapshotRestoreAvailabilityZones) == 0 {
+		return nil
+	}
+
+	out, err := s.ec2Client.DisableFastSnapshotRestores(ctx, &ec2.DisableFastSnapshotRestoresInput{
+		AvailabilityZones: s.fastSnapshotRestoreAvailabilityZones,
+		SourceSnapshotIds: []string{s.id},
+	}, func(options *ec2.Options) {
+		options.Region = s.region
+	})
+	if err != nil {
+		return fmt.Errorf("failed to disable fast snapshot restore: %v", err)
+	}
+	if len(out.Unsuccessful) > 0 {
+		log.Warningf("Failed to disable fast snapshot restore for some availability zones. snapshotID=%v", s.id)
+	}
+	s.fastSnapshotRestoreAvailabilityZones = nil
+
+	return nil
+}
+
+func fastSnapshotRestoreErrors(items []ec2types.EnableFastSnapshotRestoreErrorItem) []string {
+	var errs []string
+	for _, item := range items {
+		for _, stateErr := range item.FastSnapshotRestoreStateErrors {
+			if stateErr.Error == nil {
+				continue
+			}
+			errs = append(errs, fmt.Sprintf("%s: %s", runtimeScanUtils.ValueOrZero(stateErr.AvailabilityZone), runtimeScanUtils.ValueOrZero(stateErr.Error.Message)))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,76 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_fastSnapshotRestoreErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []ec2types.EnableFastSnapshotRestoreErrorItem
+		want  []string
+	}{
+		{
+			name:  "no errors",
+			items: nil,
+			want:  nil,
+		},
+		{
+			name: "errors of multiple availability zones",
+			items: []ec2types.EnableFastSnapshotRestoreErrorItem{
+				{
+					SnapshotId: utils.StringPtr("snap-1"),
+					FastSnapshotRestoreStateErrors: []ec2types.EnableFastSnapshotRestoreStateErrorItem{
+						{
+							AvailabilityZone: utils.StringPtr("us-east-1a"),
+							Error: &ec2types.EnableFastSnapshotRestoreStateError{
+								Code:    utils.StringPtr("InsufficientCredits"),
+								Message: utils.StringPtr("not enough credits"),
+							},
+						},
+						{
+							AvailabilityZone: utils.StringPtr("us-east-1b"),
+						},
+						{
+							AvailabilityZone: utils.StringPtr("us-east-1c"),
+							Error: &ec2types.EnableFastSnapshotRestoreStateError{
+								Message: utils.StringPtr("snapshot not found"),
+							},
+						},
+					},
+				},
+			},
+			want: []string{
+				"us-east-1a: not enough credits",
+				"us-east-1c: snapshot not found",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fastSnapshotRestoreErrors(tt.items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fastSnapshotRestoreErrors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
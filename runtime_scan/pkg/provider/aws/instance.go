@@ -21,18 +21,26 @@ import (
 	"strings"
 	"time"
 
+	awstype "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 type InstanceImpl struct {
-	ec2Client        *ec2.Client
-	id               string
-	region           string
-	availabilityZone string
+	ec2Client           *ec2.Client
+	ebsClient           *ebs.Client
+	describeCache       *describeCache
+	fastSnapshotRestore fastSnapshotRestoreConfig
+	jobTagKeys          jobTagKeys
+	scannerKMSKeyID     string
+	id                  string
+	region              string
+	availabilityZone    string
 }
 
 func (i *InstanceImpl) GetID() string {
@@ -47,43 +55,87 @@ func (i *InstanceImpl) GetAvailabilityZone() string {
 	return i.availabilityZone
 }
 
+func (i *InstanceImpl) GetProvider() models.CloudProvider {
+	return models.AWS
+}
+
 func (i *InstanceImpl) GetRootVolume(ctx context.Context) (types.Volume, error) {
-	out, err := i.ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
-		InstanceIds: []string{i.id},
-	}, func(options *ec2.Options) {
-		options.Region = i.region
-	})
+	outInstance, err := i.describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rootDeviceName := *outInstance.RootDeviceName
+
+	// find root volume of the instance
+	for _, blkDevice := range outInstance.BlockDeviceMappings {
+		if strings.Compare(*blkDevice.DeviceName, rootDeviceName) == 0 {
+			return i.newVolume(*blkDevice.Ebs.VolumeId), nil
+		}
+	}
+	return nil, fmt.Errorf("failed to find root device volume")
+}
+
+func (i *InstanceImpl) GetVolumes(ctx context.Context) ([]types.Volume, error) {
+	outInstance, err := i.describe(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to describe instances: %v", err)
+		return nil, err
+	}
+	rootDeviceName := awstype.ToString(outInstance.RootDeviceName)
+
+	var rootVolume types.Volume
+	var dataVolumes []types.Volume
+	for _, blkDevice := range outInstance.BlockDeviceMappings {
+		if blkDevice.Ebs == nil || blkDevice.Ebs.VolumeId == nil {
+			continue
+		}
+		volume := i.newVolume(*blkDevice.Ebs.VolumeId)
+		if awstype.ToString(blkDevice.DeviceName) == rootDeviceName {
+			rootVolume = volume
+			continue
+		}
+		dataVolumes = append(dataVolumes, volume)
+	}
+	if rootVolume == nil {
+		return nil, fmt.Errorf("failed to find root device volume")
+	}
+
+	return append([]types.Volume{rootVolume}, dataVolumes...), nil
+}
+
+// describe returns the description of the instance.
+func (i *InstanceImpl) describe(ctx context.Context) (ec2types.Instance, error) {
+	out, err := i.describeCache.describeInstance(ctx, i.ec2Client, i.region, i.id)
+	if err != nil {
+		return ec2types.Instance{}, fmt.Errorf("failed to describe instances: %v", err)
 	}
 
 	if len(out.Reservations) == 0 {
-		return nil, fmt.Errorf("no reservations were found")
+		return ec2types.Instance{}, fmt.Errorf("no reservations were found")
 	}
 	if len(out.Reservations) > 1 {
-		return nil, fmt.Errorf("more than one reservations were found")
+		return ec2types.Instance{}, fmt.Errorf("more than one reservations were found")
 	}
 	if len(out.Reservations[0].Instances) == 0 {
-		return nil, fmt.Errorf("no instances were found")
+		return ec2types.Instance{}, fmt.Errorf("no instances were found")
 	}
 	if len(out.Reservations[0].Instances) > 1 {
-		return nil, fmt.Errorf("more than one instances were found")
+		return ec2types.Instance{}, fmt.Errorf("more than one instances were found")
 	}
 
-	outInstance := out.Reservations[0].Instances[0]
-	rootDeviceName := *outInstance.RootDeviceName
+	return out.Reservations[0].Instances[0], nil
+}
 
-	// find root volume of the instance
-	for _, blkDevice := range outInstance.BlockDeviceMappings {
-		if strings.Compare(*blkDevice.DeviceName, rootDeviceName) == 0 {
-			return &VolumeImpl{
-				ec2Client: i.ec2Client,
-				id:        *blkDevice.Ebs.VolumeId,
-				region:    i.region,
-			}, nil
-		}
+func (i *InstanceImpl) newVolume(id string) *VolumeImpl {
+	return &VolumeImpl{
+		ec2Client:           i.ec2Client,
+		ebsClient:           i.ebsClient,
+		describeCache:       i.describeCache,
+		fastSnapshotRestore: i.fastSnapshotRestore,
+		jobTagKeys:          i.jobTagKeys,
+		scannerKMSKeyID:     i.scannerKMSKeyID,
+		id:                  id,
+		region:              i.region,
 	}
-	return nil, fmt.Errorf("failed to find root device volume")
 }
 
 func (i *InstanceImpl) WaitForReady(ctx context.Context) error {
@@ -93,11 +145,7 @@ func (i *InstanceImpl) WaitForReady(ctx context.Context) error {
 	for {
 		select {
 		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
-			out, err := i.ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
-				InstanceIds: []string{i.id},
-			}, func(options *ec2.Options) {
-				options.Region = i.region
-			})
+			out, err := i.describeCache.describeInstance(ctx, i.ec2Client, i.region, i.id)
 			if err != nil {
 				return fmt.Errorf("failed to describe instance. instanceID=%v: %v", i.id, err)
 			}
@@ -121,6 +169,7 @@ func (i *InstanceImpl) Delete(ctx context.Context) error {
 	}, func(options *ec2.Options) {
 		options.Region = i.region
 	})
+	i.describeCache.invalidateInstance(i.region, i.id)
 	if err != nil {
 		return fmt.Errorf("failed to terminate instances: %v", err)
 	}
@@ -136,6 +185,8 @@ func (i *InstanceImpl) AttachVolume(ctx context.Context, volume types.Volume, de
 	}, func(options *ec2.Options) {
 		options.Region = i.GetLocation()
 	})
+	i.describeCache.invalidateInstance(i.region, i.id)
+	i.describeCache.invalidateVolume(i.region, volume.GetID())
 	if err != nil {
 		return fmt.Errorf("failed to attach volume: %v", err)
 	}
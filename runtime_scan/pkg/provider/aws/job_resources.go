@@ -0,0 +1,200 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+const tagKeyFilterName = "tag-key"
+
+// ListJobResources lists the resources of the scanning jobs in all the
+// regions, since the snapshots are taken in the regions of the targets.
+func (c *Client) ListJobResources(ctx context.Context) ([]types.JobResource, error) {
+	regions, err := c.ListAllRegions(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions: %v", err)
+	}
+
+	var ret []types.JobResource
+	for _, region := range regions {
+		resources, err := c.listRegionJobResources(ctx, region.Name)
+		if err != nil {
+			log.Warnf("Failed to list job resources. region=%v: %v", region.Name, err)
+			continue
+		}
+		ret = append(ret, resources...)
+	}
+
+	return ret, nil
+}
+
+func (c *Client) listRegionJobResources(ctx context.Context, region string) ([]types.JobResource, error) {
+	filters := []ec2types.Filter{
+		{
+			Name:   utils.StringPtr(tagKeyFilterName),
+			Values: []string{c.jobTagKeys.scanResultID},
+		},
+	}
+	regionOption := func(options *ec2.Options) {
+		options.Region = region
+	}
+
+	var ret []types.JobResource
+	var nextToken *string
+	for {
+		out, err := c.ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+			Filters: append(filters, ec2types.Filter{
+				Name: utils.StringPtr(instanceStateFilterName),
+				Values: []string{
+					string(ec2types.InstanceStateNamePending),
+					string(ec2types.InstanceStateNameRunning),
+					string(ec2types.InstanceStateNameStopping),
+					string(ec2types.InstanceStateNameStopped),
+				},
+			}),
+			MaxResults: utils.Int32Ptr(maxResults),
+			NextToken:  nextToken,
+		}, regionOption)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe instances: %v", err)
+		}
+		for _, reservation := range out.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.InstanceId == nil {
+					continue
+				}
+				var availabilityZone string
+				if instance.Placement != nil && instance.Placement.AvailabilityZone != nil {
+					availabilityZone = *instance.Placement.AvailabilityZone
+				}
+				ret = append(ret, types.JobResource{
+					JobInfo: c.getJobInfo(instance.Tags),
+					Instance: &InstanceImpl{
+						ec2Client:           c.ec2Client,
+						ebsClient:           c.ebsClient,
+						describeCache:       c.describeCache,
+						fastSnapshotRestore: c.fastSnapshotRestore,
+						jobTagKeys:          c.jobTagKeys,
+						id:                  *instance.InstanceId,
+						region:              region,
+						availabilityZone:    availabilityZone,
+					},
+				})
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	nextToken = nil
+	for {
+		out, err := c.ec2Client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+			Filters:    filters,
+			MaxResults: utils.Int32Ptr(maxResults),
+			NextToken:  nextToken,
+		}, regionOption)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe volumes: %v", err)
+		}
+		for _, volume := range out.Volumes {
+			if volume.VolumeId == nil {
+				continue
+			}
+			ret = append(ret, types.JobResource{
+				JobInfo: c.getJobInfo(volume.Tags),
+				Volume: &VolumeImpl{
+					ec2Client:           c.ec2Client,
+					ebsClient:           c.ebsClient,
+					describeCache:       c.describeCache,
+					fastSnapshotRestore: c.fastSnapshotRestore,
+					jobTagKeys:          c.jobTagKeys,
+					id:                  *volume.VolumeId,
+					region:              region,
+				},
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	nextToken = nil
+	for {
+		out, err := c.ec2Client.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+			Filters:    filters,
+			OwnerIds:   []string{"self"},
+			MaxResults: utils.Int32Ptr(maxResults),
+			NextToken:  nextToken,
+		}, regionOption)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe snapshots: %v", err)
+		}
+		for _, snapshot := range out.Snapshots {
+			if snapshot.SnapshotId == nil {
+				continue
+			}
+			job := c.getJobInfo(snapshot.Tags)
+			ret = append(ret, types.JobResource{
+				JobInfo: job,
+				Snapshot: &SnapshotImpl{
+					ec2Client:           c.ec2Client,
+					ebsClient:           c.ebsClient,
+					describeCache:       c.describeCache,
+					fastSnapshotRestore: c.fastSnapshotRestore,
+					jobTagKeys:          c.jobTagKeys,
+					id:                  *snapshot.SnapshotId,
+					region:              region,
+					job:                 job,
+				},
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	return ret, nil
+}
+
+func (c *Client) getJobInfo(tags []ec2types.Tag) types.JobInfo {
+	return types.JobInfo{
+		ScanID:       getTagValue(tags, c.jobTagKeys.scanID),
+		ScanResultID: getTagValue(tags, c.jobTagKeys.scanResultID),
+		TargetID:     getTagValue(tags, c.jobTagKeys.targetID),
+	}
+}
+
+func getTagValue(tags []ec2types.Tag, key string) string {
+	for _, tag := range tags {
+		if tag.Key != nil && *tag.Key == key && tag.Value != nil {
+			return *tag.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// ListInstances lists all the instances in the region, or in all the regions
+// if it isn't set, regardless of any scan scope.
+func (c *Client) ListInstances(ctx context.Context, location string) ([]models.ProviderInstance, error) {
+	regions := []string{location}
+	if location == "" {
+		allRegions, err := c.ListAllRegions(ctx, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list regions: %v", err)
+		}
+		regions = make([]string, 0, len(allRegions))
+		for _, region := range allRegions {
+			regions = append(regions, region.Name)
+		}
+	}
+
+	var ret []models.ProviderInstance
+	for _, region := range regions {
+		instances, err := c.listRegionInstances(ctx, region)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instances. region=%v: %v", region, err)
+		}
+		ret = append(ret, instances...)
+	}
+
+	return ret, nil
+}
+
+func (c *Client) listRegionInstances(ctx context.Context, region string) ([]models.ProviderInstance, error) {
+	regionOption := func(options *ec2.Options) {
+		options.Region = region
+	}
+
+	var ret []models.ProviderInstance
+	var nextToken *string
+	for {
+		out, err := c.ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+			MaxResults: utils.Int32Ptr(maxResults),
+			NextToken:  nextToken,
+		}, regionOption)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe instances: %v", err)
+		}
+		for _, reservation := range out.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.InstanceId == nil {
+					continue
+				}
+				ret = append(ret, convertToAPIProviderInstance(instance, region))
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	return ret, nil
+}
+
+func convertToAPIProviderInstance(instance ec2types.Instance, region string) models.ProviderInstance {
+	ret := models.ProviderInstance{
+		InstanceID:       instance.InstanceId,
+		InstanceProvider: utils.PointerTo(models.AWS),
+		Location:         &region,
+		Tags:             convertToAPITags(instance.Tags),
+	}
+	if instance.Placement != nil {
+		ret.AvailabilityZone = instance.Placement.AvailabilityZone
+	}
+	if instance.State != nil {
+		ret.State = utils.PointerTo(string(instance.State.Name))
+	}
+
+	return ret
+}
+
+// convertToAPITags converts the tags ordered by key.
+func convertToAPITags(tags []ec2types.Tag) *[]models.Tag {
+	ret := make([]models.Tag, 0, len(tags))
+	for _, tag := range tags {
+		if tag.Key == nil {
+			continue
+		}
+		ret = append(ret, models.Tag{
+			Key:   *tag.Key,
+			Value: utils.ValueOrZero(tag.Value),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Key < ret[j].Key
+	})
+
+	return &ret
+}
@@ -0,0 +1,48 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// GetScanningJobLogs returns the console output of the scanner instance in
+// the region. AWS keeps the output for a while after the instance is
+// terminated.
+func (c *Client) GetScanningJobLogs(ctx context.Context, region, instanceID string) (string, error) {
+	out, err := c.ec2Client.GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{
+		InstanceId: &instanceID,
+	}, func(options *ec2.Options) {
+		options.Region = region
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get console output: %v", err)
+	}
+	if out.Output == nil {
+		return "", nil
+	}
+
+	output, err := base64.StdEncoding.DecodeString(*out.Output)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode console output: %v", err)
+	}
+
+	return string(output), nil
+}
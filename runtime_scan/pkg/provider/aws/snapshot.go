@@ -17,20 +17,62 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	awstype "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 type SnapshotImpl struct {
-	ec2Client *ec2.Client
-	id        string
-	region    string
+	ec2Client           *ec2.Client
+	ebsClient           *ebs.Client
+	describeCache       *describeCache
+	fastSnapshotRestore fastSnapshotRestoreConfig
+	jobTagKeys          jobTagKeys
+	scannerKMSKeyID     string
+	id                  string
+	region              string
+	// job is the scanning job the snapshot was taken for, its copies and
+	// volumes are tagged with it.
+	job types.JobInfo
+
+	// fastSnapshotRestoreAvailabilityZones are the availability zones in
+	// which fast snapshot restore was enabled for the snapshot.
+	fastSnapshotRestoreAvailabilityZones []string
+}
+
+// GetSnapshot gets the existing snapshot in the region. It fails if the
+// snapshot doesn't exist.
+func (c *Client) GetSnapshot(ctx context.Context, region, snapshotID string, job types.JobInfo) (types.Snapshot, error) {
+	out, err := c.describeCache.describeSnapshot(ctx, c.ec2Client, region, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe snapshot. snapshotID=%v: %v", snapshotID, err)
+	}
+	if len(out.Snapshots) != 1 {
+		return nil, fmt.Errorf("got unexcpected number of snapshots (%v) with snapshot id %v. excpecting 1", len(out.Snapshots), snapshotID)
+	}
+
+	// Fast snapshot restore isn't enabled for the existing snapshot since
+	// the snapshot isn't deleted with the job, which disables it.
+	return &SnapshotImpl{
+		ec2Client:       c.ec2Client,
+		ebsClient:       c.ebsClient,
+		describeCache:   c.describeCache,
+		jobTagKeys:      c.jobTagKeys,
+		scannerKMSKeyID: c.scannerKMSKeyID,
+		id:              snapshotID,
+		region:          region,
+		job:             job,
+	}, nil
 }
 
 func (s *SnapshotImpl) GetID() string {
@@ -41,28 +83,77 @@ func (s *SnapshotImpl) GetRegion() string {
 	return s.region
 }
 
+func (s *SnapshotImpl) GetSize(ctx context.Context) (int64, error) {
+	out, err := s.describeCache.describeSnapshot(ctx, s.ec2Client, s.region, s.id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to describe snapshot. snapshotID=%v: %v", s.id, err)
+	}
+	if len(out.Snapshots) != 1 {
+		return 0, fmt.Errorf("got unexcpected number of snapshots (%v) with snapshot id %v. excpecting 1", len(out.Snapshots), s.id)
+	}
+	if out.Snapshots[0].VolumeSize == nil {
+		return 0, fmt.Errorf("volume size of snapshot is not set. snapshotID=%v", s.id)
+	}
+
+	return int64(*out.Snapshots[0].VolumeSize), nil
+}
+
+// SupportsDirectRead returns whether the scanner instance can read the blocks
+// of the snapshot through the EBS direct APIs, which don't support the
+// snapshots in the archive tier.
+func (s *SnapshotImpl) SupportsDirectRead(ctx context.Context) (bool, error) {
+	out, err := s.describeCache.describeSnapshot(ctx, s.ec2Client, s.region, s.id)
+	if err != nil {
+		return false, fmt.Errorf("failed to describe snapshot. snapshotID=%v: %v", s.id, err)
+	}
+	if len(out.Snapshots) != 1 {
+		return false, fmt.Errorf("got unexcpected number of snapshots (%v) with snapshot id %v. excpecting 1", len(out.Snapshots), s.id)
+	}
+
+	return out.Snapshots[0].StorageTier != ec2types.StorageTierArchive, nil
+}
+
 func (s *SnapshotImpl) Copy(ctx context.Context, dstRegion string) (types.Snapshot, error) {
-	snap, err := s.ec2Client.CopySnapshot(ctx, &ec2.CopySnapshotInput{
+	encryption, err := s.getEncryption(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot encryption: %v", err)
+	}
+
+	params := ec2.CopySnapshotInput{
 		SourceRegion:     &s.region,
 		SourceSnapshotId: &s.id,
 		Description:      &snapshotDescription,
 		TagSpecifications: []ec2types.TagSpecification{
 			{
 				ResourceType: ec2types.ResourceTypeSnapshot,
-				Tags:         vmclarityTags,
+				Tags:         createJobResourceTags(s.jobTagKeys, s.job),
 			},
 		},
-	}, func(options *ec2.Options) {
+	}
+	if kmsKeyID := encryption.reEncryptionKMSKeyID(s.scannerKMSKeyID); kmsKeyID != "" {
+		params.Encrypted = awstype.Bool(true)
+		params.KmsKeyId = &kmsKeyID
+	}
+	snap, err := s.ec2Client.CopySnapshot(ctx, &params, func(options *ec2.Options) {
 		options.Region = dstRegion
 	})
 	if err != nil {
+		if kmsErr := encryption.kmsKeyAccessError(s.id, err); kmsErr != nil {
+			return nil, fmt.Errorf("failed to copy snapshot: %w", kmsErr)
+		}
 		return nil, fmt.Errorf("failed to copy snapshot: %v", err)
 	}
 
 	return &SnapshotImpl{
-		ec2Client: s.ec2Client,
-		id:        *snap.SnapshotId,
-		region:    dstRegion,
+		ec2Client:           s.ec2Client,
+		ebsClient:           s.ebsClient,
+		describeCache:       s.describeCache,
+		fastSnapshotRestore: s.fastSnapshotRestore,
+		jobTagKeys:          s.jobTagKeys,
+		scannerKMSKeyID:     s.scannerKMSKeyID,
+		id:                  *snap.SnapshotId,
+		region:              dstRegion,
+		job:                 s.job,
 	}, nil
 }
 
@@ -71,11 +162,16 @@ func (s *SnapshotImpl) Delete(ctx context.Context) error {
 		return nil
 	}
 
+	if err := s.disableFastSnapshotRestore(ctx); err != nil {
+		log.Errorf("Failed to disable fast snapshot restore. snapshotID=%v: %v", s.id, err)
+	}
+
 	_, err := s.ec2Client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
 		SnapshotId: &s.id,
 	}, func(options *ec2.Options) {
 		options.Region = s.region
 	})
+	s.describeCache.invalidateSnapshot(s.region, s.id)
 	if err != nil {
 		return fmt.Errorf("failed to delete snapshot: %v", err)
 	}
@@ -87,19 +183,29 @@ func (s *SnapshotImpl) WaitForReady(ctx context.Context) error {
 	for {
 		select {
 		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
-			out, err := s.ec2Client.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
-				SnapshotIds: []string{s.id},
-			}, func(options *ec2.Options) {
-				options.Region = s.region
-			})
+			out, err := s.describeCache.describeSnapshot(ctx, s.ec2Client, s.region, s.id)
 			if err != nil {
 				return fmt.Errorf("failed to describe snapshot. snapshotID=%v: %v", s.id, err)
 			}
 			if len(out.Snapshots) != 1 {
 				return fmt.Errorf("got unexcpected number of snapshots (%v) with snapshot id %v. excpecting 1", len(out.Snapshots), s.id)
 			}
-			if out.Snapshots[0].State == ec2types.SnapshotStateCompleted {
+			snapshot := out.Snapshots[0]
+			switch snapshot.State {
+			case ec2types.SnapshotStateCompleted:
 				return nil
+			case ec2types.SnapshotStateError:
+				// A copy fails asynchronously when the KMS key of the
+				// source snapshot can't be used.
+				stateErr := errors.New(runtimeScanUtils.ValueOrZero(snapshot.StateMessage))
+				encryption := snapshotEncryption{
+					encrypted: runtimeScanUtils.ValueOrZero(snapshot.Encrypted),
+					kmsKeyID:  runtimeScanUtils.ValueOrZero(snapshot.KmsKeyId),
+				}
+				if kmsErr := encryption.kmsKeyAccessError(s.id, stateErr); kmsErr != nil {
+					return fmt.Errorf("snapshot is in error state: %w", kmsErr)
+				}
+				return fmt.Errorf("snapshot is in error state. snapshotID=%v: %v", s.id, stateErr)
 			}
 		case <-ctx.Done():
 			return fmt.Errorf("waiting for snapshot ready was canceled: %v", ctx.Err())
@@ -108,26 +214,50 @@ func (s *SnapshotImpl) WaitForReady(ctx context.Context) error {
 }
 
 func (s *SnapshotImpl) CreateVolume(ctx context.Context, availabilityZone string) (types.Volume, error) {
+	if s.fastSnapshotRestore.enabled {
+		// The volume can be created without fast snapshot restore, it is
+		// only slower to read when it is scanned.
+		if err := s.enableFastSnapshotRestore(ctx, availabilityZone); err != nil {
+			log.Warningf("Creating volume without fast snapshot restore. snapshotID=%v, availabilityZone=%v: %v", s.id, availabilityZone, err)
+		}
+	}
+
 	params := ec2.CreateVolumeInput{
 		AvailabilityZone: &availabilityZone,
 		SnapshotId:       &s.id,
 		TagSpecifications: []ec2types.TagSpecification{
 			{
 				ResourceType: ec2types.ResourceTypeVolume,
-				Tags:         vmclarityTags,
+				Tags:         createJobResourceTags(s.jobTagKeys, s.job),
 			},
 		},
 		VolumeType: ec2types.VolumeTypeGp2,
 	}
+	encryption, err := s.getEncryption(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot encryption: %v", err)
+	}
+	if kmsKeyID := encryption.reEncryptionKMSKeyID(s.scannerKMSKeyID); kmsKeyID != "" {
+		params.Encrypted = awstype.Bool(true)
+		params.KmsKeyId = &kmsKeyID
+	}
 	out, err := s.ec2Client.CreateVolume(ctx, &params, func(options *ec2.Options) {
 		options.Region = s.region
 	})
 	if err != nil {
+		if kmsErr := encryption.kmsKeyAccessError(s.id, err); kmsErr != nil {
+			return nil, fmt.Errorf("failed to create volume: %w", kmsErr)
+		}
 		return nil, fmt.Errorf("failed to create volume: %v", err)
 	}
 	return &VolumeImpl{
-		ec2Client: s.ec2Client,
-		id:        *out.VolumeId,
-		region:    s.region,
+		ec2Client:           s.ec2Client,
+		ebsClient:           s.ebsClient,
+		describeCache:       s.describeCache,
+		fastSnapshotRestore: s.fastSnapshotRestore,
+		jobTagKeys:          s.jobTagKeys,
+		scannerKMSKeyID:     s.scannerKMSKeyID,
+		id:                  *out.VolumeId,
+		region:              s.region,
 	}, nil
 }
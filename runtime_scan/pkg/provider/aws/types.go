@@ -24,8 +24,9 @@ type ScanScope struct {
 	Regions     []Region
 	ScanStopped bool
 	// Only targets that have these tags will be selected for scanning within the selected scan scope.
-	// Multiple tags will be treated as an AND operator.
+	// Multiple tags will be treated as an AND operator, or as an OR operator if MatchAnyTag is set.
 	TagSelector []Tag
+	MatchAnyTag bool
 	// Targets that have these tags will be excluded from the scan, even if they match the tag selector.
 	// Multiple tags will be treated as an AND operator.
 	ExcludeTags []Tag
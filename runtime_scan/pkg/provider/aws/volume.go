@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
@@ -28,23 +29,28 @@ import (
 )
 
 type VolumeImpl struct {
-	ec2Client *ec2.Client
-	id        string
-	region    string
+	ec2Client           *ec2.Client
+	ebsClient           *ebs.Client
+	describeCache       *describeCache
+	fastSnapshotRestore fastSnapshotRestoreConfig
+	jobTagKeys          jobTagKeys
+	scannerKMSKeyID     string
+	id                  string
+	region              string
 }
 
 func (v *VolumeImpl) GetID() string {
 	return v.id
 }
 
-func (v *VolumeImpl) TakeSnapshot(ctx context.Context) (types.Snapshot, error) {
+func (v *VolumeImpl) TakeSnapshot(ctx context.Context, job types.JobInfo) (types.Snapshot, error) {
 	params := ec2.CreateSnapshotInput{
 		VolumeId:    &v.id,
 		Description: &snapshotDescription,
 		TagSpecifications: []ec2types.TagSpecification{
 			{
 				ResourceType: ec2types.ResourceTypeSnapshot,
-				Tags:         vmclarityTags,
+				Tags:         createJobResourceTags(v.jobTagKeys, job),
 			},
 		},
 	}
@@ -55,9 +61,15 @@ func (v *VolumeImpl) TakeSnapshot(ctx context.Context) (types.Snapshot, error) {
 		return nil, fmt.Errorf("failed to create snapshot: %v", err)
 	}
 	return &SnapshotImpl{
-		ec2Client: v.ec2Client,
-		id:        *out.SnapshotId,
-		region:    v.region,
+		ec2Client:           v.ec2Client,
+		ebsClient:           v.ebsClient,
+		describeCache:       v.describeCache,
+		fastSnapshotRestore: v.fastSnapshotRestore,
+		jobTagKeys:          v.jobTagKeys,
+		scannerKMSKeyID:     v.scannerKMSKeyID,
+		id:                  *out.SnapshotId,
+		region:              v.region,
+		job:                 job,
 	}, nil
 }
 
@@ -68,11 +80,7 @@ func (v *VolumeImpl) WaitForReady(ctx context.Context) error {
 	for {
 		select {
 		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
-			out, err := v.ec2Client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
-				VolumeIds: []string{v.id},
-			}, func(options *ec2.Options) {
-				options.Region = v.region
-			})
+			out, err := v.describeCache.describeVolume(ctx, v.ec2Client, v.region, v.id)
 			if err != nil {
 				return fmt.Errorf("failed to describe volumes. volumeID=%v: %v", v.id, err)
 			}
@@ -95,11 +103,7 @@ func (v *VolumeImpl) WaitForAttached(ctx context.Context) error {
 	for {
 		select {
 		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
-			out, err := v.ec2Client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
-				VolumeIds: []string{v.id},
-			}, func(options *ec2.Options) {
-				options.Region = v.region
-			})
+			out, err := v.describeCache.describeVolume(ctx, v.ec2Client, v.region, v.id)
 			if err != nil {
 				return fmt.Errorf("failed to describe volumes. volumeID=%v: %v", v.id, err)
 			}
@@ -125,6 +129,7 @@ func (v *VolumeImpl) Delete(ctx context.Context) error {
 	}, func(options *ec2.Options) {
 		options.Region = v.region
 	})
+	v.describeCache.invalidateVolume(v.region, v.id)
 	if err != nil {
 		return fmt.Errorf("failed to delete volume: %v", err)
 	}
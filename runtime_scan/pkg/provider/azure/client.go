@@ -0,0 +1,608 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	autorestazure "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/cloudinit"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/azure"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+type Client struct {
+	vmClient         compute.VirtualMachinesClient
+	disksClient      compute.DisksClient
+	snapshotsClient  compute.SnapshotsClient
+	interfacesClient network.InterfacesClient
+	azureConfig      *azure.Config
+}
+
+var (
+	tagKey        = "Owner"
+	tagVal        = "VMClarity"
+	vmclarityTags = map[string]*string{
+		tagKey: &tagVal,
+	}
+	nameTagKey = "Name"
+
+	scannerAdminUsername = "vmclarity"
+)
+
+func Create(_ context.Context, config *azure.Config) (*Client, error) {
+	if config.SubscriptionID == "" {
+		return nil, fmt.Errorf("%s is not set", azure.AzureSubscriptionID)
+	}
+
+	// Supports client credentials, client certificate, username/password
+	// and managed identity authentication using the standard AZURE_* env vars.
+	authorizer, err := auth.NewAuthorizerFromEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create azure authorizer: %v", err)
+	}
+
+	azureClient := Client{
+		vmClient:         compute.NewVirtualMachinesClient(config.SubscriptionID),
+		disksClient:      compute.NewDisksClient(config.SubscriptionID),
+		snapshotsClient:  compute.NewSnapshotsClient(config.SubscriptionID),
+		interfacesClient: network.NewInterfacesClient(config.SubscriptionID),
+		azureConfig:      config,
+	}
+	azureClient.vmClient.Authorizer = authorizer
+	azureClient.disksClient.Authorizer = authorizer
+	azureClient.snapshotsClient.Authorizer = authorizer
+	azureClient.interfacesClient.Authorizer = authorizer
+
+	return &azureClient, nil
+}
+
+func (c *Client) CheckConnectivity(ctx context.Context) error {
+	// Only the first page of the scanner resource group is listed.
+	_, err := c.vmClient.List(ctx, c.azureConfig.ScannerResourceGroup, "")
+	if err != nil {
+		return fmt.Errorf("failed to list virtual machines in resource group %s: %v", c.azureConfig.ScannerResourceGroup, err)
+	}
+
+	return nil
+}
+
+func (c *Client) DiscoverScopes(ctx context.Context) (*models.Scopes, error) {
+	vms, err := c.listAllVirtualMachines(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual machines: %v", err)
+	}
+
+	resourceGroupSet := make(map[string]struct{})
+	for _, vm := range vms {
+		resource, err := autorestazure.ParseResourceID(*vm.ID)
+		if err != nil {
+			log.Warnf("Failed to parse virtual machine ID. id=%v: %v", *vm.ID, err)
+			continue
+		}
+		resourceGroupSet[resource.ResourceGroup] = struct{}{}
+	}
+	resourceGroups := make([]string, 0, len(resourceGroupSet))
+	for resourceGroup := range resourceGroupSet {
+		resourceGroups = append(resourceGroups, resourceGroup)
+	}
+	sort.Strings(resourceGroups)
+
+	scopes := models.ScopeType{}
+	err = scopes.FromAzureSubscriptionScope(models.AzureSubscriptionScope{
+		SubscriptionID: &c.azureConfig.SubscriptionID,
+		ResourceGroups: &resourceGroups,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("FromAzureSubscriptionScope failed: %w", err)
+	}
+
+	return &models.Scopes{
+		ScopeInfo: &scopes,
+	}, nil
+}
+
+func (c *Client) DiscoverInstances(ctx context.Context, scanScope *models.ScanScopeType) ([]types.Instance, error) {
+	azureScanScope, err := scanScope.AsAzureScanScope()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert as azure scope: %v", err)
+	}
+
+	scope := convertFromAPIScanScope(&azureScanScope)
+
+	vms, err := c.listAllVirtualMachines(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual machines: %v", err)
+	}
+
+	ret := make([]types.Instance, 0)
+	for _, vm := range vms {
+		resource, err := autorestazure.ParseResourceID(*vm.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse virtual machine ID. id=%v: %v", *vm.ID, err)
+		}
+		if !isInResourceGroups(scope.ResourceGroups, resource.ResourceGroup) {
+			continue
+		}
+		if !matchesTagSelector(scope.TagSelector, scope.MatchAnyTag, vm.Tags) || hasExcludeTags(scope.ExcludeTags, vm.Tags) {
+			continue
+		}
+
+		instanceView, err := c.vmClient.InstanceView(ctx, resource.ResourceGroup, resource.ResourceName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get virtual machine instance view. id=%v: %v", *vm.ID, err)
+		}
+		if !isPowerStateToScan(getPowerState(instanceView.Statuses), scope.ScanStopped) {
+			continue
+		}
+
+		ret = append(ret, c.newInstance(vm, resource))
+	}
+
+	return ret, nil
+}
+
+func (c *Client) listAllVirtualMachines(ctx context.Context) ([]compute.VirtualMachine, error) {
+	var ret []compute.VirtualMachine
+
+	iter, err := c.vmClient.ListAllComplete(ctx, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all virtual machines: %v", err)
+	}
+	for iter.NotDone() {
+		vm := iter.Value()
+		if vm.ID != nil {
+			ret = append(ret, vm)
+		}
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get next virtual machines page: %v", err)
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *Client) newInstance(vm compute.VirtualMachine, resource autorestazure.Resource) *InstanceImpl {
+	var zone string
+	if vm.Zones != nil && len(*vm.Zones) > 0 {
+		zone = (*vm.Zones)[0]
+	}
+
+	return &InstanceImpl{
+		client:        c,
+		id:            *vm.ID,
+		name:          resource.ResourceName,
+		resourceGroup: resource.ResourceGroup,
+		location:      *vm.Location,
+		zone:          zone,
+	}
+}
+
+func convertFromAPIScanScope(scope *models.AzureScanScope) *ScanScope {
+	var resourceGroups []string
+	if scope.ResourceGroups != nil {
+		resourceGroups = *scope.ResourceGroups
+	}
+
+	return &ScanScope{
+		ResourceGroups: resourceGroups,
+		ScanStopped:    convertBool(scope.ShouldScanStoppedInstances),
+		TagSelector:    convertFromAPITags(scope.InstanceTagSelector),
+		MatchAnyTag:    isTagSelectorOperatorOr(scope.InstanceTagSelectorOperator),
+		ExcludeTags:    convertFromAPITags(scope.InstanceTagExclusion),
+	}
+}
+
+func isTagSelectorOperatorOr(operator *models.TagSelectorOperator) bool {
+	return operator != nil && *operator == models.OR
+}
+
+func convertFromAPITags(tags *[]models.Tag) []Tag {
+	var ret []Tag
+	if tags != nil {
+		for _, tag := range *tags {
+			ret = append(ret, Tag{
+				Key: tag.Key,
+				Val: tag.Value,
+			})
+		}
+	}
+
+	return ret
+}
+
+func convertBool(all *bool) bool {
+	if all != nil {
+		return *all
+	}
+	return false
+}
+
+func (c *Client) RunScanningJob(ctx context.Context, region, id string, config provider.ScanningJobConfig) (types.Instance, error) {
+	cloudInitData := cloudinit.Data{
+		ScannerCLIConfig:            config.ScannerCLIConfig,
+		ScannerImage:                config.ScannerImage,
+		ScannerImagePullCredentials: config.ScannerImagePullCredentials(),
+		ServerAddress:               config.VMClarityAddress,
+		ScanResultID:                config.ScanResultID,
+		PartitionsToScan:            config.PartitionsToScan,
+		CABundle:                    config.CABundle,
+		ScannerProxy:                config.ScannerProxy,
+	}
+	userData, err := cloudinit.GenerateCloudInit(cloudInitData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate cloud-init: %v", err)
+	}
+	userDataBase64 := base64.StdEncoding.EncodeToString([]byte(userData))
+
+	// The id is the full resource ID of the snapshot to scan which is too
+	// long to be used in a virtual machine name, so use a hash of it.
+	vmName := fmt.Sprintf("vmclarity-scanner-%s", shortHash(id))
+	resourceGroup := c.azureConfig.ScannerResourceGroup
+
+	// A previous attempt may have created the virtual machine before
+	// failing, in that case reuse it instead of creating a duplicate.
+	existingInstance, err := c.getScanningJobInstance(ctx, resourceGroup, vmName, config.ScanResultID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing scanner virtual machine: %v", err)
+	}
+	if existingInstance != nil {
+		log.Infof("Scanner virtual machine already exists. scanResultID=%v, name=%v", config.ScanResultID, vmName)
+		return existingInstance, nil
+	}
+
+	nic, err := c.createScannerNetworkInterface(ctx, region, vmName, config.JobInfo())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network interface: %v", err)
+	}
+
+	vmSize := c.azureConfig.ScannerVMSize
+	if config.InstanceType != "" {
+		vmSize = config.InstanceType
+	}
+
+	vm := compute.VirtualMachine{
+		Location: &region,
+		Tags:     c.createInstanceTags(vmName, config.JobInfo()),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(vmSize),
+			},
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: &compute.ImageReference{
+					Publisher: &c.azureConfig.ScannerImagePublisher,
+					Offer:     &c.azureConfig.ScannerImageOffer,
+					Sku:       &c.azureConfig.ScannerImageSKU,
+					Version:   &c.azureConfig.ScannerImageVersion,
+				},
+				OsDisk: &compute.OSDisk{
+					CreateOption: compute.DiskCreateOptionTypesFromImage,
+					DeleteOption: compute.DiskDeleteOptionTypesDelete,
+				},
+			},
+			OsProfile: &compute.OSProfile{
+				ComputerName:  &vmName,
+				AdminUsername: &scannerAdminUsername,
+				CustomData:    &userDataBase64,
+				LinuxConfiguration: &compute.LinuxConfiguration{
+					DisablePasswordAuthentication: utils.BoolPtr(true),
+					SSH: &compute.SSHConfiguration{
+						PublicKeys: &[]compute.SSHPublicKey{
+							{
+								Path:    utils.StringPtr(fmt.Sprintf("/home/%s/.ssh/authorized_keys", scannerAdminUsername)),
+								KeyData: &c.azureConfig.ScannerPublicKey,
+							},
+						},
+					},
+				},
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: &[]compute.NetworkInterfaceReference{
+					{
+						ID: nic.ID,
+						NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{
+							Primary:      utils.BoolPtr(true),
+							DeleteOption: compute.DeleteOptionsDelete,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Use spot instances if there is a configuration for it.
+	if config.ScannerInstanceCreationConfig != nil && config.ScannerInstanceCreationConfig.UseSpotInstances {
+		maxPrice, err := convertMaxPrice(config.ScannerInstanceCreationConfig.MaxPrice)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spot max price: %v", err)
+		}
+		vm.Priority = compute.VirtualMachinePriorityTypesSpot
+		vm.EvictionPolicy = compute.VirtualMachineEvictionPolicyTypesDelete
+		vm.BillingProfile = &compute.BillingProfile{
+			MaxPrice: &maxPrice,
+		}
+	}
+
+	// The creation is not awaited here, the caller waits for the instance
+	// to be ready.
+	_, err = c.vmClient.CreateOrUpdate(ctx, resourceGroup, vmName, vm)
+	if err != nil {
+		if _, nicErr := c.interfacesClient.Delete(ctx, resourceGroup, *nic.Name); nicErr != nil {
+			log.Errorf("Failed to delete network interface. name=%v: %v", *nic.Name, nicErr)
+		}
+		return nil, fmt.Errorf("failed to create virtual machine: %v", err)
+	}
+
+	return &InstanceImpl{
+		client:        c,
+		id:            virtualMachineID(c.azureConfig.SubscriptionID, resourceGroup, vmName),
+		name:          vmName,
+		resourceGroup: resourceGroup,
+		location:      region,
+	}, nil
+}
+
+func (c *Client) createScannerNetworkInterface(ctx context.Context, region, vmName string, job types.JobInfo) (network.Interface, error) {
+	nicName := fmt.Sprintf("%s-nic", vmName)
+	nic := network.Interface{
+		Location: &region,
+		Tags:     c.createJobResourceTags(job),
+		InterfacePropertiesFormat: &network.InterfacePropertiesFormat{
+			IPConfigurations: &[]network.InterfaceIPConfiguration{
+				{
+					Name: utils.StringPtr(fmt.Sprintf("%s-ipconfig", vmName)),
+					InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+						Subnet: &network.Subnet{
+							ID: &c.azureConfig.ScannerSubnetID,
+						},
+						PrivateIPAllocationMethod: network.IPAllocationMethodDynamic,
+					},
+				},
+			},
+		},
+	}
+	if c.azureConfig.ScannerSecurityGroupID != "" {
+		nic.NetworkSecurityGroup = &network.SecurityGroup{
+			ID: &c.azureConfig.ScannerSecurityGroupID,
+		}
+	}
+
+	future, err := c.interfacesClient.CreateOrUpdate(ctx, c.azureConfig.ScannerResourceGroup, nicName, nic)
+	if err != nil {
+		return network.Interface{}, fmt.Errorf("failed to create network interface: %v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, c.interfacesClient.Client); err != nil {
+		return network.Interface{}, fmt.Errorf("failed to wait for network interface creation: %v", err)
+	}
+
+	return future.Result(c.interfacesClient)
+}
+
+func (c *Client) createInstanceTags(name string, job types.JobInfo) map[string]*string {
+	ret := c.createJobResourceTags(job)
+	ret[nameTagKey] = utils.StringPtr(name)
+
+	return ret
+}
+
+// createJobResourceTags returns the tags of a resource of the scanning job.
+// The resources are tagged with the info of the job so that they can be
+// traced in the portal, and so that leaked resources are found by the
+// orphaned resource reaper.
+func (c *Client) createJobResourceTags(job types.JobInfo) map[string]*string {
+	ret := make(map[string]*string, len(vmclarityTags)+4)
+	for key, val := range vmclarityTags {
+		ret[key] = val
+	}
+	for key, val := range map[string]string{
+		c.azureConfig.ScanIDTagKey:       job.ScanID,
+		c.azureConfig.ScanResultIDTagKey: job.ScanResultID,
+		c.azureConfig.TargetIDTagKey:     job.TargetID,
+	} {
+		if key != "" && val != "" {
+			ret[key] = utils.StringPtr(val)
+		}
+	}
+
+	return ret
+}
+
+// getScanningJobInstance returns the scanner virtual machine that was created
+// for the scan result, or nil if there is none.
+func (c *Client) getScanningJobInstance(ctx context.Context, resourceGroup, vmName, scanResultID string) (types.Instance, error) {
+	vm, err := c.vmClient.Get(ctx, resourceGroup, vmName, "")
+	if err != nil {
+		if vm.Response.Response != nil && vm.StatusCode == http.StatusNotFound {
+			// nolint:nilnil
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get virtual machine %s: %v", vmName, err)
+	}
+
+	// The name is derived from the scanned snapshot, make sure that the
+	// virtual machine belongs to this scan result.
+	if tag, ok := vm.Tags[c.azureConfig.ScanResultIDTagKey]; !ok || tag == nil || *tag != scanResultID {
+		return nil, fmt.Errorf("virtual machine %s already exists for another scan result", vmName)
+	}
+
+	if vm.VirtualMachineProperties != nil && vm.ProvisioningState != nil && *vm.ProvisioningState == "Failed" {
+		return nil, fmt.Errorf("virtual machine %s is in a failed provisioning state", vmName)
+	}
+
+	resource, err := autorestazure.ParseResourceID(*vm.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse virtual machine ID: %v", err)
+	}
+
+	return c.newInstance(vm, resource), nil
+}
+
+// convertMaxPrice converts the spot max price to the Azure format where -1
+// means that the virtual machine should not be evicted for price reasons.
+func convertMaxPrice(maxPrice *string) (float64, error) {
+	if maxPrice == nil || *maxPrice == "" {
+		return -1, nil
+	}
+
+	price, err := strconv.ParseFloat(*maxPrice, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse max price %q: %v", *maxPrice, err)
+	}
+
+	return price, nil
+}
+
+func virtualMachineID(subscriptionID, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s", subscriptionID, resourceGroup, name)
+}
+
+func shortHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:8])
+}
+
+func isInResourceGroups(resourceGroups []string, resourceGroup string) bool {
+	if len(resourceGroups) == 0 {
+		return true
+	}
+
+	for _, rg := range resourceGroups {
+		// Azure resource group names are case-insensitive.
+		if strings.EqualFold(rg, resourceGroup) {
+			return true
+		}
+	}
+	return false
+}
+
+func getPowerState(statuses *[]compute.InstanceViewStatus) string {
+	if statuses == nil {
+		return ""
+	}
+
+	for _, status := range *statuses {
+		if status.Code != nil && strings.HasPrefix(*status.Code, "PowerState/") {
+			return *status.Code
+		}
+	}
+	return ""
+}
+
+func isPowerStateToScan(powerState string, scanStopped bool) bool {
+	switch powerState {
+	case powerStateRunning:
+		return true
+	case powerStateStopped, powerStateDeallocated:
+		return scanStopped
+	default:
+		return false
+	}
+}
+
+// matchesTagSelector checks if the tags match the tag selector, all of the
+// tags should match unless matchAnyTag is set and then one of them is enough.
+func matchesTagSelector(tags []Tag, matchAnyTag bool, instanceTags map[string]*string) bool {
+	if !matchAnyTag || len(tags) == 0 {
+		return hasIncludeTags(tags, instanceTags)
+	}
+	for _, tag := range tags {
+		if hasIncludeTags([]Tag{tag}, instanceTags) {
+			return true
+		}
+	}
+	return false
+}
+
+// AND logic - if tags = {tag1:val1, tag2:val2},
+// then an instance will be included only if it has ALL these tags ({tag1:val1, tag2:val2}).
+func hasIncludeTags(tags []Tag, instanceTags map[string]*string) bool {
+	for _, tag := range tags {
+		val, ok := instanceTags[tag.Key]
+		if !ok || val == nil {
+			return false
+		}
+		if *val != tag.Val {
+			return false
+		}
+	}
+	return true
+}
+
+// AND logic - if excludeTags = {tag1:val1, tag2:val2},
+// then an instance will be excluded only if it has ALL these tags ({tag1:val1, tag2:val2}).
+func hasExcludeTags(excludeTags []Tag, instanceTags map[string]*string) bool {
+	if len(excludeTags) == 0 {
+		return false
+	}
+	if len(instanceTags) == 0 {
+		return false
+	}
+
+	return hasIncludeTags(excludeTags, instanceTags)
+}
+
+// createSnapshot creates an incremental snapshot in the scanner resource group
+// for the scanning job.
+func (c *Client) createSnapshot(ctx context.Context, location string, creationData compute.CreationData, job types.JobInfo) (*SnapshotImpl, error) {
+	snapshotName := fmt.Sprintf("vmclarity-snapshot-%s", uuid.NewString())
+	resourceGroup := c.azureConfig.ScannerResourceGroup
+
+	_, err := c.snapshotsClient.CreateOrUpdate(ctx, resourceGroup, snapshotName, compute.Snapshot{
+		Location: &location,
+		Tags:     c.createJobResourceTags(job),
+		SnapshotProperties: &compute.SnapshotProperties{
+			CreationData: &creationData,
+			Incremental:  utils.BoolPtr(true),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot: %v", err)
+	}
+
+	return &SnapshotImpl{
+		client:        c,
+		id:            snapshotID(c.azureConfig.SubscriptionID, resourceGroup, snapshotName),
+		name:          snapshotName,
+		resourceGroup: resourceGroup,
+		location:      location,
+		job:           job,
+	}, nil
+}
+
+func snapshotID(subscriptionID, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/snapshots/%s", subscriptionID, resourceGroup, name)
+}
+
+func diskID(subscriptionID, resourceGroup, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/disks/%s", subscriptionID, resourceGroup, name)
+}
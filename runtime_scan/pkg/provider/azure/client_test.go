@@ -0,0 +1,284 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func Test_hasExcludeTags(t *testing.T) {
+	type args struct {
+		excludeTags  []Tag
+		instanceTags map[string]*string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no exclude tags",
+			args: args{
+				excludeTags: nil,
+				instanceTags: map[string]*string{
+					"key1": utils.StringPtr("val1"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "no instance tags",
+			args: args{
+				excludeTags: []Tag{
+					{Key: "key1", Val: "val1"},
+				},
+				instanceTags: nil,
+			},
+			want: false,
+		},
+		{
+			name: "instance has all exclude tags",
+			args: args{
+				excludeTags: []Tag{
+					{Key: "key1", Val: "val1"},
+					{Key: "key2", Val: "val2"},
+				},
+				instanceTags: map[string]*string{
+					"key1": utils.StringPtr("val1"),
+					"key2": utils.StringPtr("val2"),
+					"key3": utils.StringPtr("val3"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "instance has only some of the exclude tags",
+			args: args{
+				excludeTags: []Tag{
+					{Key: "key1", Val: "val1"},
+					{Key: "key2", Val: "val2"},
+				},
+				instanceTags: map[string]*string{
+					"key1": utils.StringPtr("val1"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "instance tag value does not match",
+			args: args{
+				excludeTags: []Tag{
+					{Key: "key1", Val: "val1"},
+				},
+				instanceTags: map[string]*string{
+					"key1": utils.StringPtr("val2"),
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasExcludeTags(tt.args.excludeTags, tt.args.instanceTags); got != tt.want {
+				t.Errorf("hasExcludeTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_matchesTagSelector(t *testing.T) {
+	type args struct {
+		tags         []Tag
+		matchAnyTag  bool
+		instanceTags map[string]*string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "AND - instance has all of the tags",
+			args: args{
+				tags: []Tag{
+					{Key: "key1", Val: "val1"},
+					{Key: "key2", Val: "val2"},
+				},
+				instanceTags: map[string]*string{
+					"key1": utils.StringPtr("val1"),
+					"key2": utils.StringPtr("val2"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "AND - instance has only some of the tags",
+			args: args{
+				tags: []Tag{
+					{Key: "key1", Val: "val1"},
+					{Key: "key2", Val: "val2"},
+				},
+				instanceTags: map[string]*string{
+					"key1": utils.StringPtr("val1"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "OR - instance has only some of the tags",
+			args: args{
+				tags: []Tag{
+					{Key: "key1", Val: "val1"},
+					{Key: "key2", Val: "val2"},
+				},
+				matchAnyTag: true,
+				instanceTags: map[string]*string{
+					"key2": utils.StringPtr("val2"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "OR - instance has none of the tags",
+			args: args{
+				tags: []Tag{
+					{Key: "key1", Val: "val1"},
+					{Key: "key2", Val: "val2"},
+				},
+				matchAnyTag: true,
+				instanceTags: map[string]*string{
+					"key1": nil,
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesTagSelector(tt.args.tags, tt.args.matchAnyTag, tt.args.instanceTags); got != tt.want {
+				t.Errorf("matchesTagSelector() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isPowerStateToScan(t *testing.T) {
+	tests := []struct {
+		name        string
+		powerState  string
+		scanStopped bool
+		want        bool
+	}{
+		{
+			name:       "running",
+			powerState: powerStateRunning,
+			want:       true,
+		},
+		{
+			name:        "deallocated without scan stopped",
+			powerState:  powerStateDeallocated,
+			scanStopped: false,
+			want:        false,
+		},
+		{
+			name:        "deallocated with scan stopped",
+			powerState:  powerStateDeallocated,
+			scanStopped: true,
+			want:        true,
+		},
+		{
+			name:        "stopped with scan stopped",
+			powerState:  powerStateStopped,
+			scanStopped: true,
+			want:        true,
+		},
+		{
+			name:        "starting",
+			powerState:  "PowerState/starting",
+			scanStopped: true,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isPowerStateToScan(tt.powerState, tt.scanStopped); got != tt.want {
+				t.Errorf("isPowerStateToScan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getPowerState(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses *[]compute.InstanceViewStatus
+		want     string
+	}{
+		{
+			name:     "nil statuses",
+			statuses: nil,
+			want:     "",
+		},
+		{
+			name: "power state found",
+			statuses: &[]compute.InstanceViewStatus{
+				{Code: utils.StringPtr("ProvisioningState/succeeded")},
+				{Code: utils.StringPtr(powerStateRunning)},
+			},
+			want: powerStateRunning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getPowerState(tt.statuses); got != tt.want {
+				t.Errorf("getPowerState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getFreeLun(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataDisks []compute.DataDisk
+		want      int32
+	}{
+		{
+			name:      "no data disks",
+			dataDisks: nil,
+			want:      0,
+		},
+		{
+			name: "gap in used luns",
+			dataDisks: []compute.DataDisk{
+				{Lun: utils.Int32Ptr(0)},
+				{Lun: utils.Int32Ptr(2)},
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getFreeLun(tt.dataDisks); got != tt.want {
+				t.Errorf("getFreeLun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
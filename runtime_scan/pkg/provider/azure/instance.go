@@ -0,0 +1,216 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	autorestazure "github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type InstanceImpl struct {
+	client        *Client
+	id            string
+	name          string
+	resourceGroup string
+	location      string
+	zone          string
+}
+
+func (i *InstanceImpl) GetID() string {
+	return i.id
+}
+
+func (i *InstanceImpl) GetLocation() string {
+	return i.location
+}
+
+func (i *InstanceImpl) GetAvailabilityZone() string {
+	return i.zone
+}
+
+func (i *InstanceImpl) GetProvider() models.CloudProvider {
+	return models.Azure
+}
+
+func (i *InstanceImpl) GetRootVolume(ctx context.Context) (types.Volume, error) {
+	vm, err := i.client.vmClient.Get(ctx, i.resourceGroup, i.name, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get virtual machine: %v", err)
+	}
+
+	return i.getOsDiskVolume(vm)
+}
+
+func (i *InstanceImpl) GetVolumes(ctx context.Context) ([]types.Volume, error) {
+	vm, err := i.client.vmClient.Get(ctx, i.resourceGroup, i.name, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get virtual machine: %v", err)
+	}
+
+	rootVolume, err := i.getOsDiskVolume(vm)
+	if err != nil {
+		return nil, err
+	}
+	volumes := []types.Volume{rootVolume}
+
+	if vm.StorageProfile.DataDisks == nil {
+		return volumes, nil
+	}
+	for _, dataDisk := range *vm.StorageProfile.DataDisks {
+		// Unmanaged disks can't be snapshotted as managed disks.
+		if dataDisk.ManagedDisk == nil || dataDisk.ManagedDisk.ID == nil {
+			continue
+		}
+		volume, err := i.newVolume(*dataDisk.ManagedDisk.ID)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, volume)
+	}
+
+	return volumes, nil
+}
+
+func (i *InstanceImpl) getOsDiskVolume(vm compute.VirtualMachine) (*VolumeImpl, error) {
+	if vm.VirtualMachineProperties == nil || vm.StorageProfile == nil || vm.StorageProfile.OsDisk == nil {
+		return nil, fmt.Errorf("virtual machine has no os disk")
+	}
+	osDisk := vm.StorageProfile.OsDisk
+	if osDisk.ManagedDisk == nil || osDisk.ManagedDisk.ID == nil {
+		return nil, fmt.Errorf("virtual machine os disk is not a managed disk")
+	}
+
+	return i.newVolume(*osDisk.ManagedDisk.ID)
+}
+
+func (i *InstanceImpl) newVolume(diskID string) (*VolumeImpl, error) {
+	resource, err := autorestazure.ParseResourceID(diskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse disk ID. id=%v: %v", diskID, err)
+	}
+
+	return &VolumeImpl{
+		client:        i.client,
+		id:            diskID,
+		name:          resource.ResourceName,
+		resourceGroup: resource.ResourceGroup,
+		location:      i.location,
+	}, nil
+}
+
+func (i *InstanceImpl) WaitForReady(ctx context.Context) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, utils.DefaultResourceReadyWaitTimeoutMin*time.Minute)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
+			vm, err := i.client.vmClient.Get(ctxWithTimeout, i.resourceGroup, i.name, "")
+			if err != nil {
+				return fmt.Errorf("failed to get virtual machine. instanceID=%v: %v", i.id, err)
+			}
+			if vm.VirtualMachineProperties == nil || vm.ProvisioningState == nil {
+				continue
+			}
+			if strings.EqualFold(*vm.ProvisioningState, provisioningStateSucceeded) {
+				return nil
+			}
+			if strings.EqualFold(*vm.ProvisioningState, provisioningStateFailed) {
+				return fmt.Errorf("virtual machine provisioning failed. instanceID=%v", i.id)
+			}
+		case <-ctxWithTimeout.Done():
+			return fmt.Errorf("timeout: %v", ctxWithTimeout.Err())
+		}
+	}
+}
+
+func (i *InstanceImpl) Delete(ctx context.Context) error {
+	if i == nil {
+		return nil
+	}
+
+	// The network interface and the os disk are deleted together with the
+	// virtual machine.
+	future, err := i.client.vmClient.Delete(ctx, i.resourceGroup, i.name, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete virtual machine: %v", err)
+	}
+	// Wait for the deletion so that the attached volume is released and
+	// can be deleted afterwards.
+	if err := future.WaitForCompletionRef(ctx, i.client.vmClient.Client); err != nil {
+		return fmt.Errorf("failed to wait for virtual machine deletion: %v", err)
+	}
+
+	return nil
+}
+
+func (i *InstanceImpl) AttachVolume(ctx context.Context, volume types.Volume, _ string) error {
+	// Azure does not support choosing the device name of a data disk, the
+	// disk is attached to the first free LUN instead.
+	vm, err := i.client.vmClient.Get(ctx, i.resourceGroup, i.name, "")
+	if err != nil {
+		return fmt.Errorf("failed to get virtual machine: %v", err)
+	}
+
+	var dataDisks []compute.DataDisk
+	if vm.VirtualMachineProperties != nil && vm.StorageProfile != nil && vm.StorageProfile.DataDisks != nil {
+		dataDisks = *vm.StorageProfile.DataDisks
+	}
+	dataDisks = append(dataDisks, compute.DataDisk{
+		Lun:          utils.PointerTo(getFreeLun(dataDisks)),
+		CreateOption: compute.DiskCreateOptionTypesAttach,
+		ManagedDisk: &compute.ManagedDiskParameters{
+			ID: utils.StringPtr(volume.GetID()),
+		},
+	})
+
+	_, err = i.client.vmClient.Update(ctx, i.resourceGroup, i.name, compute.VirtualMachineUpdate{
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			StorageProfile: &compute.StorageProfile{
+				DataDisks: &dataDisks,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach volume: %v", err)
+	}
+
+	return nil
+}
+
+func getFreeLun(dataDisks []compute.DataDisk) int32 {
+	used := make(map[int32]bool, len(dataDisks))
+	for _, disk := range dataDisks {
+		if disk.Lun != nil {
+			used[*disk.Lun] = true
+		}
+	}
+
+	var lun int32
+	for used[lun] {
+		lun++
+	}
+	return lun
+}
@@ -0,0 +1,124 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	autorestazure "github.com/Azure/go-autorest/autorest/azure"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+)
+
+// ListJobResources lists the resources of the scanning jobs. The scanner
+// virtual machines, disks and snapshots are all created in the scanner
+// resource group.
+func (c *Client) ListJobResources(ctx context.Context) ([]types.JobResource, error) {
+	resourceGroup := c.azureConfig.ScannerResourceGroup
+
+	var ret []types.JobResource
+	vms, err := c.listAllVirtualMachines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, vm := range vms {
+		job := c.getJobInfo(vm.Tags)
+		if job.ScanResultID == "" {
+			continue
+		}
+		resource, err := autorestazure.ParseResourceID(*vm.ID)
+		if err != nil {
+			log.Warnf("Failed to parse virtual machine ID. id=%v: %v", *vm.ID, err)
+			continue
+		}
+		if !strings.EqualFold(resource.ResourceGroup, resourceGroup) {
+			continue
+		}
+		ret = append(ret, types.JobResource{
+			JobInfo:  job,
+			Instance: c.newInstance(vm, resource),
+		})
+	}
+
+	disksIter, err := c.disksClient.ListByResourceGroupComplete(ctx, resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disks: %v", err)
+	}
+	for disksIter.NotDone() {
+		disk := disksIter.Value()
+		job := c.getJobInfo(disk.Tags)
+		if job.ScanResultID != "" && disk.ID != nil && disk.Name != nil && disk.Location != nil {
+			ret = append(ret, types.JobResource{
+				JobInfo: job,
+				Volume: &VolumeImpl{
+					client:        c,
+					id:            *disk.ID,
+					name:          *disk.Name,
+					resourceGroup: resourceGroup,
+					location:      *disk.Location,
+				},
+			})
+		}
+		if err := disksIter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get next disks page: %v", err)
+		}
+	}
+
+	snapshotsIter, err := c.snapshotsClient.ListByResourceGroupComplete(ctx, resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %v", err)
+	}
+	for snapshotsIter.NotDone() {
+		snapshot := snapshotsIter.Value()
+		job := c.getJobInfo(snapshot.Tags)
+		if job.ScanResultID != "" && snapshot.ID != nil && snapshot.Name != nil && snapshot.Location != nil {
+			ret = append(ret, types.JobResource{
+				JobInfo: job,
+				Snapshot: &SnapshotImpl{
+					client:        c,
+					id:            *snapshot.ID,
+					name:          *snapshot.Name,
+					resourceGroup: resourceGroup,
+					location:      *snapshot.Location,
+					job:           job,
+				},
+			})
+		}
+		if err := snapshotsIter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get next snapshots page: %v", err)
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *Client) getJobInfo(tags map[string]*string) types.JobInfo {
+	return types.JobInfo{
+		ScanID:       getTagValue(tags, c.azureConfig.ScanIDTagKey),
+		ScanResultID: getTagValue(tags, c.azureConfig.ScanResultIDTagKey),
+		TargetID:     getTagValue(tags, c.azureConfig.TargetIDTagKey),
+	}
+}
+
+func getTagValue(tags map[string]*string, key string) string {
+	if val, ok := tags[key]; ok && val != nil {
+		return *val
+	}
+	return ""
+}
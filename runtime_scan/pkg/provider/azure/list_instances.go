@@ -0,0 +1,88 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// ListInstances lists all the virtual machines of the subscription in the
+// location, or in all the locations if it isn't set, regardless of any scan
+// scope.
+func (c *Client) ListInstances(ctx context.Context, location string) ([]models.ProviderInstance, error) {
+	var ret []models.ProviderInstance
+
+	// The status only listing returns the power state of all the virtual
+	// machines at once, instead of getting the instance view of each one.
+	iter, err := c.vmClient.ListAllComplete(ctx, "true", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all virtual machines: %v", err)
+	}
+	for iter.NotDone() {
+		vm := iter.Value()
+		if vm.ID != nil && (location == "" || strings.EqualFold(utils.ValueOrZero(vm.Location), location)) {
+			ret = append(ret, convertToAPIProviderInstance(vm))
+		}
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get next virtual machines page: %v", err)
+		}
+	}
+
+	return ret, nil
+}
+
+func convertToAPIProviderInstance(vm compute.VirtualMachine) models.ProviderInstance {
+	ret := models.ProviderInstance{
+		InstanceID:       vm.ID,
+		InstanceProvider: utils.PointerTo(models.Azure),
+		Location:         vm.Location,
+		Tags:             convertToAPITags(vm.Tags),
+	}
+	if vm.Zones != nil && len(*vm.Zones) > 0 {
+		ret.AvailabilityZone = utils.PointerTo((*vm.Zones)[0])
+	}
+	if vm.VirtualMachineProperties != nil && vm.VirtualMachineProperties.InstanceView != nil {
+		if powerState := getPowerState(vm.VirtualMachineProperties.InstanceView.Statuses); powerState != "" {
+			ret.State = utils.PointerTo(strings.TrimPrefix(powerState, "PowerState/"))
+		}
+	}
+
+	return ret
+}
+
+// convertToAPITags converts the tags ordered by key.
+func convertToAPITags(tags map[string]*string) *[]models.Tag {
+	ret := make([]models.Tag, 0, len(tags))
+	for key, value := range tags {
+		ret = append(ret, models.Tag{
+			Key:   key,
+			Value: utils.ValueOrZero(value),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Key < ret[j].Key
+	})
+
+	return &ret
+}
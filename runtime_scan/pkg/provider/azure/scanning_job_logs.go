@@ -0,0 +1,29 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+)
+
+// GetScanningJobLogs isn't supported, the scanner virtual machines are
+// created without boot diagnostics so Azure doesn't keep their console
+// output.
+func (c *Client) GetScanningJobLogs(_ context.Context, _, _ string) (string, error) {
+	return "", provider.ErrNotSupported
+}
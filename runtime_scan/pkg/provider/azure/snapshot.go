@@ -0,0 +1,167 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	autorestazure "github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type SnapshotImpl struct {
+	client        *Client
+	id            string
+	name          string
+	resourceGroup string
+	location      string
+	// job is the scanning job the snapshot was taken for, its copies and
+	// volumes are tagged with it.
+	job types.JobInfo
+}
+
+// GetSnapshot gets the snapshot by its resource ID, the snapshot may be in
+// any resource group of the subscription. The region is ignored since the
+// location of the snapshot is known.
+func (c *Client) GetSnapshot(ctx context.Context, _, snapshotID string, job types.JobInfo) (types.Snapshot, error) {
+	resource, err := autorestazure.ParseResourceID(snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot ID. id=%v: %v", snapshotID, err)
+	}
+	snapshot, err := c.snapshotsClient.Get(ctx, resource.ResourceGroup, resource.ResourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot. snapshotID=%v: %v", snapshotID, err)
+	}
+	if snapshot.Location == nil {
+		return nil, fmt.Errorf("location of snapshot is not set. snapshotID=%v", snapshotID)
+	}
+
+	return &SnapshotImpl{
+		client:        c,
+		id:            snapshotID,
+		name:          resource.ResourceName,
+		resourceGroup: resource.ResourceGroup,
+		location:      *snapshot.Location,
+		job:           job,
+	}, nil
+}
+
+func (s *SnapshotImpl) GetID() string {
+	return s.id
+}
+
+func (s *SnapshotImpl) GetRegion() string {
+	return s.location
+}
+
+func (s *SnapshotImpl) GetSize(ctx context.Context) (int64, error) {
+	snapshot, err := s.client.snapshotsClient.Get(ctx, s.resourceGroup, s.name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get snapshot. snapshotID=%v: %v", s.id, err)
+	}
+	if snapshot.SnapshotProperties == nil || snapshot.DiskSizeGB == nil {
+		return 0, fmt.Errorf("disk size of snapshot is not set. snapshotID=%v", s.id)
+	}
+
+	return int64(*snapshot.DiskSizeGB), nil
+}
+
+func (s *SnapshotImpl) Copy(ctx context.Context, dstRegion string) (types.Snapshot, error) {
+	// Incremental snapshots can only be copied to another region by using
+	// the CopyStart create option, which copies the data in the background.
+	snapshot, err := s.client.createSnapshot(ctx, dstRegion, compute.CreationData{
+		CreateOption:     compute.DiskCreateOptionCopyStart,
+		SourceResourceID: &s.id,
+	}, s.job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy snapshot: %v", err)
+	}
+
+	return snapshot, nil
+}
+
+func (s *SnapshotImpl) Delete(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+
+	_, err := s.client.snapshotsClient.Delete(ctx, s.resourceGroup, s.name)
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot: %v", err)
+	}
+
+	return nil
+}
+
+func (s *SnapshotImpl) WaitForReady(ctx context.Context) error {
+	for {
+		select {
+		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
+			snapshot, err := s.client.snapshotsClient.Get(ctx, s.resourceGroup, s.name)
+			if err != nil {
+				return fmt.Errorf("failed to get snapshot. snapshotID=%v: %v", s.id, err)
+			}
+			if snapshot.SnapshotProperties == nil || snapshot.ProvisioningState == nil {
+				continue
+			}
+			if strings.EqualFold(*snapshot.ProvisioningState, provisioningStateSucceeded) {
+				return nil
+			}
+			if strings.EqualFold(*snapshot.ProvisioningState, provisioningStateFailed) {
+				return fmt.Errorf("snapshot provisioning failed. snapshotID=%v", s.id)
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for snapshot ready was canceled: %v", ctx.Err())
+		}
+	}
+}
+
+func (s *SnapshotImpl) CreateVolume(ctx context.Context, availabilityZone string) (types.Volume, error) {
+	diskName := fmt.Sprintf("vmclarity-volume-%s", shortHash(s.id))
+	disk := compute.Disk{
+		Location: &s.location,
+		Tags:     s.client.createJobResourceTags(s.job),
+		DiskProperties: &compute.DiskProperties{
+			CreationData: &compute.CreationData{
+				CreateOption:     compute.DiskCreateOptionCopy,
+				SourceResourceID: &s.id,
+			},
+		},
+	}
+	if availabilityZone != "" {
+		disk.Zones = &[]string{availabilityZone}
+	}
+
+	resourceGroup := s.client.azureConfig.ScannerResourceGroup
+	_, err := s.client.disksClient.CreateOrUpdate(ctx, resourceGroup, diskName, disk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume: %v", err)
+	}
+
+	return &VolumeImpl{
+		client:        s.client,
+		id:            diskID(s.client.azureConfig.SubscriptionID, resourceGroup, diskName),
+		name:          diskName,
+		resourceGroup: resourceGroup,
+		location:      s.location,
+	}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type VolumeImpl struct {
+	client        *Client
+	id            string
+	name          string
+	resourceGroup string
+	location      string
+}
+
+func (v *VolumeImpl) GetID() string {
+	return v.id
+}
+
+func (v *VolumeImpl) TakeSnapshot(ctx context.Context, job types.JobInfo) (types.Snapshot, error) {
+	snapshot, err := v.client.createSnapshot(ctx, v.location, compute.CreationData{
+		CreateOption:     compute.DiskCreateOptionCopy,
+		SourceResourceID: &v.id,
+	}, job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot: %v", err)
+	}
+
+	return snapshot, nil
+}
+
+func (v *VolumeImpl) WaitForReady(ctx context.Context) error {
+	return v.waitForDiskState(ctx, compute.DiskStateUnattached)
+}
+
+func (v *VolumeImpl) WaitForAttached(ctx context.Context) error {
+	return v.waitForDiskState(ctx, compute.DiskStateAttached)
+}
+
+func (v *VolumeImpl) waitForDiskState(ctx context.Context, state compute.DiskState) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, utils.DefaultResourceReadyWaitTimeoutMin*time.Minute)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
+			disk, err := v.client.disksClient.Get(ctxWithTimeout, v.resourceGroup, v.name)
+			if err != nil {
+				return fmt.Errorf("failed to get disk. volumeID=%v: %v", v.id, err)
+			}
+			if disk.DiskProperties == nil || disk.ProvisioningState == nil {
+				continue
+			}
+			if strings.EqualFold(*disk.ProvisioningState, provisioningStateFailed) {
+				return fmt.Errorf("disk provisioning failed. volumeID=%v", v.id)
+			}
+			if strings.EqualFold(*disk.ProvisioningState, provisioningStateSucceeded) && disk.DiskState == state {
+				return nil
+			}
+		case <-ctxWithTimeout.Done():
+			return fmt.Errorf("waiting for volume %s was canceled: %v", state, ctxWithTimeout.Err())
+		}
+	}
+}
+
+func (v *VolumeImpl) Delete(ctx context.Context) error {
+	if v == nil {
+		return nil
+	}
+
+	_, err := v.client.disksClient.Delete(ctx, v.resourceGroup, v.name)
+	if err != nil {
+		return fmt.Errorf("failed to delete volume: %v", err)
+	}
+
+	return nil
+}
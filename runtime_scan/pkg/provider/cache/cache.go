@@ -0,0 +1,120 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	cacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vmclarity",
+		Subsystem: "provider_describe_cache",
+		Name:      "hits_total",
+		Help:      "The number of provider describe results served from the cache.",
+	}, []string{"cache"})
+	cacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vmclarity",
+		Subsystem: "provider_describe_cache",
+		Name:      "misses_total",
+		Help:      "The number of provider describe results loaded from the provider.",
+	}, []string{"cache"})
+)
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTLCache is a read-through cache of provider describe results keyed by
+// resource ID. Entries expire after the TTL so the TTL must be kept shorter
+// than the interval in which a state change is expected to be observed.
+// Mutations of a resource must Invalidate its entry.
+type TTLCache[V any] struct {
+	ttl     time.Duration
+	entries map[string]entry[V]
+	hits    prometheus.Counter
+	misses  prometheus.Counter
+	now     func() time.Time
+
+	mu sync.Mutex
+}
+
+// New creates a cache named name (used as the metrics label). A ttl of 0
+// disables the cache, every lookup is then loaded from the provider.
+func New[V any](name string, ttl time.Duration) *TTLCache[V] {
+	return &TTLCache[V]{
+		ttl:     ttl,
+		entries: make(map[string]entry[V]),
+		hits:    cacheHits.WithLabelValues(name),
+		misses:  cacheMisses.WithLabelValues(name),
+		now:     time.Now,
+	}
+}
+
+// GetOrLoad returns the cached value of key, or loads and caches it if it is
+// missing or expired. Load errors are not cached.
+func (c *TTLCache[V]) GetOrLoad(key string, load func() (V, error)) (V, error) {
+	if c.ttl > 0 {
+		c.mu.Lock()
+		e, ok := c.entries[key]
+		c.mu.Unlock()
+		if ok && c.now().Before(e.expiresAt) {
+			c.hits.Inc()
+			return e.value, nil
+		}
+	}
+
+	c.misses.Inc()
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	if c.ttl > 0 {
+		c.mu.Lock()
+		c.entries[key] = entry[V]{
+			value:     value,
+			expiresAt: c.now().Add(c.ttl),
+		}
+		c.evictExpiredLocked()
+		c.mu.Unlock()
+	}
+
+	return value, nil
+}
+
+// Invalidate removes the cached value of key, the next lookup loads it from
+// the provider.
+func (c *TTLCache[V]) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+func (c *TTLCache[V]) evictExpiredLocked() {
+	now := c.now()
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
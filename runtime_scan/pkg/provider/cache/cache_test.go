@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTTLCache_GetOrLoad(t *testing.T) {
+	now := time.Now()
+	c := New[string]("test", time.Second)
+	c.now = func() time.Time { return now }
+
+	loads := 0
+	load := func() (string, error) {
+		loads++
+		return "value", nil
+	}
+
+	assertLoad := func(step string, wantLoads int) {
+		t.Helper()
+		got, err := c.GetOrLoad("key", load)
+		if err != nil {
+			t.Fatalf("%s: GetOrLoad() error = %v", step, err)
+		}
+		if got != "value" {
+			t.Errorf("%s: GetOrLoad() = %v, want value", step, got)
+		}
+		if loads != wantLoads {
+			t.Errorf("%s: loads = %v, want %v", step, loads, wantLoads)
+		}
+	}
+
+	assertLoad("first lookup is loaded", 1)
+	assertLoad("second lookup is cached", 1)
+
+	now = now.Add(time.Second)
+	assertLoad("expired entry is loaded", 2)
+
+	c.Invalidate("key")
+	assertLoad("invalidated entry is loaded", 3)
+	assertLoad("reloaded entry is cached", 3)
+}
+
+func TestTTLCache_GetOrLoadError(t *testing.T) {
+	c := New[string]("test_error", time.Minute)
+
+	loads := 0
+	if _, err := c.GetOrLoad("key", func() (string, error) {
+		loads++
+		return "", errors.New("throttled")
+	}); err == nil {
+		t.Fatalf("GetOrLoad() expected an error")
+	}
+	if _, err := c.GetOrLoad("key", func() (string, error) {
+		loads++
+		return "value", nil
+	}); err != nil {
+		t.Fatalf("GetOrLoad() error = %v", err)
+	}
+	if loads != 2 {
+		t.Errorf("errors must not be cached, loads = %v, want 2", loads)
+	}
+}
+
+func TestTTLCache_Disabled(t *testing.T) {
+	c := New[string]("test_disabled", 0)
+
+	loads := 0
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetOrLoad("key", func() (string, error) {
+			loads++
+			return "value", nil
+		}); err != nil {
+			t.Fatalf("GetOrLoad() error = %v", err)
+		}
+	}
+	if loads != 3 {
+		t.Errorf("disabled cache must always load, loads = %v, want 3", loads)
+	}
+}
@@ -17,25 +17,84 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
 )
 
+// ErrNotSupported is returned by the operations which the provider doesn't
+// support.
+var ErrNotSupported = errors.New("not supported by the provider")
+
 type ScanningJobConfig struct {
-	ScannerImage                  string // Scanner Container Image to use containing the vmclarity-cli and tools
-	ScannerCLIConfig              string // Scanner CLI config yaml (families config yaml)
-	VMClarityAddress              string // The backend address for the scanner CLI to export too
-	ScanResultID                  string // The ID of the ScanResult that the scanner CLI should update
-	KeyPairName                   string // The name of the key pair to set on the instance, ignored if not set, used mainly for debugging.
+	ScannerImage                  string   // Scanner Container Image to use containing the vmclarity-cli and tools
+	ScannerCLIConfig              string   // Scanner CLI config yaml (families config yaml)
+	VMClarityAddress              string   // The backend address for the scanner CLI to export too
+	ScanID                        string   // The ID of the Scan the job is part of
+	ScanResultID                  string   // The ID of the ScanResult that the scanner CLI should update
+	TargetID                      string   // The ID of the Target that is scanned
+	KeyPairName                   string   // The name of the key pair to set on the instance, ignored if not set, used mainly for debugging.
+	PartitionsToScan              []string // The partitions of the attached volume that the scanner CLI should scan, all of them if not set
 	ScannerInstanceCreationConfig *models.ScannerInstanceCreationConfig
+	InstanceType                  string                     // The instance type of the scanner instance, the provider's configured instance type is used if not set
+	CABundle                      string                     // The PEM CA bundle trusted by the scanner instance on top of the system certificates, ignored if not set
+	ScannerProxy                  *models.ScannerProxyConfig // The proxy the scanner instance egresses through, no proxy is used if not set
+	DirectReadVolumes             []DirectReadVolume         // The scratch volumes the scanner instance reads the snapshots directly to instead of attached volumes, the root volume first, volumes are attached if not set
+}
+
+// DirectReadVolume is an empty scratch volume the scanner instance is launched
+// with and reads the blocks of a snapshot directly to. It is deleted with the
+// scanner instance.
+type DirectReadVolume struct {
+	SnapshotID string // The snapshot which the scanner instance reads to the volume
+	Size       int64  // The size of the volume in GB, the size of the snapshotted volume
+	DeviceName string // The device name the volume is attached with
+}
+
+// ScannerImagePullCredentials returns the credentials to pull the scanner
+// image with, nil if it's pulled anonymously.
+func (c ScanningJobConfig) ScannerImagePullCredentials() *models.ScannerImagePullCredentials {
+	if c.ScannerInstanceCreationConfig == nil {
+		return nil
+	}
+	return c.ScannerInstanceCreationConfig.ScannerImagePullCredentials
+}
+
+// JobInfo returns the info of the job the resources are tagged with.
+func (c ScanningJobConfig) JobInfo() types.JobInfo {
+	return types.JobInfo{
+		ScanID:       c.ScanID,
+		ScanResultID: c.ScanResultID,
+		TargetID:     c.TargetID,
+	}
 }
 
 type Client interface {
-	// RunScanningJob - run a scanning job.
+	// RunScanningJob - run a scanning job. It must be safe to retry: if a
+	// scanner instance was already launched for config.ScanResultID it is
+	// returned instead of launching a new one.
 	RunScanningJob(ctx context.Context, region, id string, config ScanningJobConfig) (types.Instance, error)
+	// GetScanningJobLogs - get the console output of the scanner instance
+	// of a scanning job in the location, returns ErrNotSupported if the
+	// provider doesn't keep it.
+	GetScanningJobLogs(ctx context.Context, location, instanceID string) (string, error)
+	// ListJobResources - list the scanner instances, volumes and snapshots
+	// of the scanning jobs, which are tagged with the info of their job.
+	ListJobResources(ctx context.Context) ([]types.JobResource, error)
+	// CheckConnectivity - check that the provider is reachable with the
+	// configured credentials using a cheap read only call.
+	CheckConnectivity(ctx context.Context) error
 	// DiscoverScopes - List all scopes
 	DiscoverScopes(ctx context.Context) (*models.Scopes, error)
 	// DiscoverInstances - list VM instances in the account according to the scan scope.
 	DiscoverInstances(ctx context.Context, scanScope *models.ScanScopeType) ([]types.Instance, error)
+	// ListInstances - list all the VM instances in the account in the
+	// location, or in all the locations if not set, regardless of any scan
+	// scope, to preview what the provider discovers.
+	ListInstances(ctx context.Context, location string) ([]models.ProviderInstance, error)
+	// GetSnapshot - get an existing snapshot in the region to scan instead
+	// of taking a new one. Its copies and volumes are tagged with the info
+	// of the job.
+	GetSnapshot(ctx context.Context, region, snapshotID string, job types.JobInfo) (types.Snapshot, error)
 }
@@ -0,0 +1,449 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/cloudinit"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/gcp"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+)
+
+type Client struct {
+	service   *compute.Service
+	gcpConfig *gcp.Config
+}
+
+var (
+	// GCP label keys and values must be lowercase.
+	labelKey        = "owner"
+	labelVal        = "vmclarity"
+	vmclarityLabels = map[string]string{
+		labelKey: labelVal,
+	}
+)
+
+func Create(ctx context.Context, config *gcp.Config) (*Client, error) {
+	if config.ProjectID == "" {
+		return nil, fmt.Errorf("%s is not set", gcp.GCPProjectID)
+	}
+
+	// Uses the application default credentials.
+	service, err := compute.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compute service: %v", err)
+	}
+
+	return &Client{
+		service:   service,
+		gcpConfig: config,
+	}, nil
+}
+
+func (c *Client) CheckConnectivity(ctx context.Context) error {
+	_, err := c.service.Zones.List(c.gcpConfig.ProjectID).MaxResults(1).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to list zones: %v", err)
+	}
+
+	return nil
+}
+
+func (c *Client) DiscoverScopes(ctx context.Context) (*models.Scopes, error) {
+	var zones []string
+	err := c.service.Zones.List(c.gcpConfig.ProjectID).Pages(ctx, func(page *compute.ZoneList) error {
+		for _, zone := range page.Items {
+			if zone.Status == zoneStatusUp {
+				zones = append(zones, zone.Name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list zones: %v", err)
+	}
+	sort.Strings(zones)
+
+	scopes := models.ScopeType{}
+	err = scopes.FromGcpProjectScope(models.GcpProjectScope{
+		ProjectID: &c.gcpConfig.ProjectID,
+		Zones:     &zones,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("FromGcpProjectScope failed: %w", err)
+	}
+
+	return &models.Scopes{
+		ScopeInfo: &scopes,
+	}, nil
+}
+
+func (c *Client) DiscoverInstances(ctx context.Context, scanScope *models.ScanScopeType) ([]types.Instance, error) {
+	gcpScanScope, err := scanScope.AsGcpScanScope()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert as gcp scope: %v", err)
+	}
+
+	scope := convertFromAPIScanScope(&gcpScanScope)
+
+	ret := make([]types.Instance, 0)
+	err = c.service.Instances.AggregatedList(c.gcpConfig.ProjectID).Pages(ctx, func(page *compute.InstanceAggregatedList) error {
+		for _, scopedList := range page.Items {
+			for _, instance := range scopedList.Instances {
+				zone := lastURLSegment(instance.Zone)
+				if !isInZones(scope.Zones, zone) {
+					continue
+				}
+				if !isStatusToScan(instance.Status, scope.ScanStopped) {
+					continue
+				}
+				if !matchesTagSelector(scope.TagSelector, scope.MatchAnyTag, instance.Labels) || hasExcludeTags(scope.ExcludeTags, instance.Labels) {
+					continue
+				}
+				ret = append(ret, &InstanceImpl{
+					client: c,
+					name:   instance.Name,
+					zone:   zone,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %v", err)
+	}
+
+	return ret, nil
+}
+
+func convertFromAPIScanScope(scope *models.GcpScanScope) *ScanScope {
+	var zones []string
+	if scope.Zones != nil {
+		zones = *scope.Zones
+	}
+
+	return &ScanScope{
+		Zones:       zones,
+		ScanStopped: convertBool(scope.ShouldScanStoppedInstances),
+		TagSelector: convertFromAPITags(scope.InstanceTagSelector),
+		MatchAnyTag: isTagSelectorOperatorOr(scope.InstanceTagSelectorOperator),
+		ExcludeTags: convertFromAPITags(scope.InstanceTagExclusion),
+	}
+}
+
+func isTagSelectorOperatorOr(operator *models.TagSelectorOperator) bool {
+	return operator != nil && *operator == models.OR
+}
+
+func convertFromAPITags(tags *[]models.Tag) []Tag {
+	var ret []Tag
+	if tags != nil {
+		for _, tag := range *tags {
+			ret = append(ret, Tag{
+				Key: tag.Key,
+				Val: tag.Value,
+			})
+		}
+	}
+
+	return ret
+}
+
+func convertBool(all *bool) bool {
+	if all != nil {
+		return *all
+	}
+	return false
+}
+
+func (c *Client) RunScanningJob(ctx context.Context, _, id string, config provider.ScanningJobConfig) (types.Instance, error) {
+	cloudInitData := cloudinit.Data{
+		ScannerCLIConfig:            config.ScannerCLIConfig,
+		ScannerImage:                config.ScannerImage,
+		ScannerImagePullCredentials: config.ScannerImagePullCredentials(),
+		ServerAddress:               config.VMClarityAddress,
+		ScanResultID:                config.ScanResultID,
+		PartitionsToScan:            config.PartitionsToScan,
+		CABundle:                    config.CABundle,
+		ScannerProxy:                config.ScannerProxy,
+	}
+	userData, err := cloudinit.GenerateCloudInit(cloudInitData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate cloud-init: %v", err)
+	}
+
+	// The scanner instances are always created in the scanner zone, the
+	// snapshot is available there regardless of its region.
+	zone := c.gcpConfig.ScannerZone
+	instanceName := fmt.Sprintf("vmclarity-scanner-%s", shortHash(id))
+
+	// A previous attempt may have inserted the instance before failing, in
+	// that case reuse it instead of creating a duplicate.
+	existingInstance, err := c.getScanningJobInstance(ctx, zone, instanceName, config.ScanResultID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing scanner instance: %v", err)
+	}
+	if existingInstance != nil {
+		log.Infof("Scanner instance already exists. scanResultID=%v, name=%v", config.ScanResultID, instanceName)
+		return existingInstance, nil
+	}
+
+	machineType := c.gcpConfig.ScannerMachineType
+	if config.InstanceType != "" {
+		machineType = config.InstanceType
+	}
+
+	instance := &compute.Instance{
+		Name:        instanceName,
+		MachineType: fmt.Sprintf("zones/%s/machineTypes/%s", zone, machineType),
+		Labels:      c.createJobResourceLabels(config.JobInfo()),
+		Disks: []*compute.AttachedDisk{
+			{
+				Boot:       true,
+				AutoDelete: true,
+				InitializeParams: &compute.AttachedDiskInitializeParams{
+					SourceImage: c.gcpConfig.ScannerSourceImage,
+					Labels:      c.createJobResourceLabels(config.JobInfo()),
+				},
+			},
+		},
+		NetworkInterfaces: []*compute.NetworkInterface{
+			{
+				Subnetwork: c.gcpConfig.ScannerSubnetwork,
+			},
+		},
+		Metadata: &compute.Metadata{
+			Items: []*compute.MetadataItems{
+				{
+					// cloud-init reads the user data from this metadata key.
+					Key:   "user-data",
+					Value: &userData,
+				},
+			},
+		},
+	}
+
+	// Use spot instances if there is a configuration for it. GCP does not
+	// support setting a max price for spot instances.
+	if config.ScannerInstanceCreationConfig != nil && config.ScannerInstanceCreationConfig.UseSpotInstances {
+		instance.Scheduling = &compute.Scheduling{
+			ProvisioningModel:         "SPOT",
+			InstanceTerminationAction: "DELETE",
+		}
+	}
+
+	op, err := c.service.Instances.Insert(c.gcpConfig.ProjectID, zone, instance).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert instance: %v", err)
+	}
+	if err := getOperationError(op); err != nil {
+		return nil, fmt.Errorf("failed to insert instance: %v", err)
+	}
+
+	return &InstanceImpl{
+		client: c,
+		name:   instanceName,
+		zone:   zone,
+	}, nil
+}
+
+// createJobResourceLabels returns the labels of a resource of the scanning
+// job. The resources are labeled with the info of the job so that they can be
+// traced in the console, and so that leaked resources are found by the
+// orphaned resource reaper.
+func (c *Client) createJobResourceLabels(job types.JobInfo) map[string]string {
+	ret := make(map[string]string, len(vmclarityLabels)+3)
+	for key, val := range vmclarityLabels {
+		ret[key] = val
+	}
+	// The IDs are lowercase UUIDs which are valid label values.
+	for key, val := range map[string]string{
+		c.gcpConfig.ScanIDLabelKey:       job.ScanID,
+		c.gcpConfig.ScanResultIDLabelKey: job.ScanResultID,
+		c.gcpConfig.TargetIDLabelKey:     job.TargetID,
+	} {
+		if key != "" && val != "" {
+			ret[key] = val
+		}
+	}
+
+	return ret
+}
+
+// getScanningJobInstance returns the scanner instance that was inserted for
+// the scan result, or nil if there is none.
+func (c *Client) getScanningJobInstance(ctx context.Context, zone, instanceName, scanResultID string) (types.Instance, error) {
+	instance, err := c.service.Instances.Get(c.gcpConfig.ProjectID, zone, instanceName).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			// nolint:nilnil
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get instance %s: %v", instanceName, err)
+	}
+
+	// The name is derived from the scanned snapshot, make sure that the
+	// instance belongs to this scan result.
+	if instance.Labels[c.gcpConfig.ScanResultIDLabelKey] != scanResultID {
+		return nil, fmt.Errorf("instance %s already exists for another scan result", instanceName)
+	}
+
+	switch instance.Status {
+	case "STOPPING", "TERMINATED", "SUSPENDING", "SUSPENDED":
+		return nil, fmt.Errorf("instance %s exists but is %s", instanceName, instance.Status)
+	}
+
+	return &InstanceImpl{
+		client: c,
+		name:   instanceName,
+		zone:   zone,
+	}, nil
+}
+
+// waitForZoneOperation waits until the zonal operation is done.
+func (c *Client) waitForZoneOperation(ctx context.Context, zone string, op *compute.Operation) error {
+	name := op.Name
+	for op.Status != operationStatusDone {
+		var err error
+		// Wait returns when the operation is done or after about 2 minutes.
+		op, err = c.service.ZoneOperations.Wait(c.gcpConfig.ProjectID, zone, name).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("failed to wait for operation %s: %v", name, err)
+		}
+	}
+
+	return getOperationError(op)
+}
+
+func getOperationError(op *compute.Operation) error {
+	if op.Error == nil || len(op.Error.Errors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(op.Error.Errors))
+	for _, e := range op.Error.Errors {
+		messages = append(messages, e.Message)
+	}
+	return fmt.Errorf("operation %s failed: %s", op.Name, strings.Join(messages, "; "))
+}
+
+func isNotFound(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// lastURLSegment returns the name of the resource from its URL, for example
+// https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a
+// returns us-central1-a.
+func lastURLSegment(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+// zoneFromDiskURL returns the zone of a disk from its URL, for example
+// https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/disks/d
+// returns us-central1-a.
+func zoneFromDiskURL(url string) string {
+	parts := strings.Split(url, "/")
+	for i := range parts {
+		if parts[i] == "zones" && i+1 < len(parts) {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+func shortHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:8])
+}
+
+func isInZones(zones []string, zone string) bool {
+	if len(zones) == 0 {
+		return true
+	}
+
+	for _, z := range zones {
+		if z == zone {
+			return true
+		}
+	}
+	return false
+}
+
+func isStatusToScan(status string, scanStopped bool) bool {
+	switch status {
+	case instanceStatusRunning:
+		return true
+	case instanceStatusTerminated, instanceStatusSuspended:
+		// Stopped instances have the TERMINATED status in GCP.
+		return scanStopped
+	default:
+		return false
+	}
+}
+
+// matchesTagSelector checks if the labels match the tag selector, all of the
+// tags should match unless matchAnyTag is set and then one of them is enough.
+func matchesTagSelector(tags []Tag, matchAnyTag bool, labels map[string]string) bool {
+	if !matchAnyTag || len(tags) == 0 {
+		return hasIncludeTags(tags, labels)
+	}
+	for _, tag := range tags {
+		if hasIncludeTags([]Tag{tag}, labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// AND logic - if tags = {tag1:val1, tag2:val2},
+// then an instance will be included only if it has ALL these labels ({tag1:val1, tag2:val2}).
+func hasIncludeTags(tags []Tag, labels map[string]string) bool {
+	for _, tag := range tags {
+		val, ok := labels[tag.Key]
+		if !ok || val != tag.Val {
+			return false
+		}
+	}
+	return true
+}
+
+// AND logic - if excludeTags = {tag1:val1, tag2:val2},
+// then an instance will be excluded only if it has ALL these labels ({tag1:val1, tag2:val2}).
+func hasExcludeTags(excludeTags []Tag, labels map[string]string) bool {
+	if len(excludeTags) == 0 {
+		return false
+	}
+	if len(labels) == 0 {
+		return false
+	}
+
+	return hasIncludeTags(excludeTags, labels)
+}
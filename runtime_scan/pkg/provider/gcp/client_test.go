@@ -0,0 +1,199 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"testing"
+)
+
+func Test_isStatusToScan(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		scanStopped bool
+		want        bool
+	}{
+		{
+			name:   "running",
+			status: instanceStatusRunning,
+			want:   true,
+		},
+		{
+			name:        "terminated without scan stopped",
+			status:      instanceStatusTerminated,
+			scanStopped: false,
+			want:        false,
+		},
+		{
+			name:        "terminated with scan stopped",
+			status:      instanceStatusTerminated,
+			scanStopped: true,
+			want:        true,
+		},
+		{
+			name:        "provisioning",
+			status:      "PROVISIONING",
+			scanStopped: true,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isStatusToScan(tt.status, tt.scanStopped); got != tt.want {
+				t.Errorf("isStatusToScan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_hasExcludeTags(t *testing.T) {
+	type args struct {
+		excludeTags []Tag
+		labels      map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no exclude tags",
+			args: args{
+				excludeTags: nil,
+				labels:      map[string]string{"key1": "val1"},
+			},
+			want: false,
+		},
+		{
+			name: "no labels",
+			args: args{
+				excludeTags: []Tag{{Key: "key1", Val: "val1"}},
+				labels:      nil,
+			},
+			want: false,
+		},
+		{
+			name: "instance has all exclude tags",
+			args: args{
+				excludeTags: []Tag{{Key: "key1", Val: "val1"}, {Key: "key2", Val: "val2"}},
+				labels:      map[string]string{"key1": "val1", "key2": "val2", "key3": "val3"},
+			},
+			want: true,
+		},
+		{
+			name: "instance has only some of the exclude tags",
+			args: args{
+				excludeTags: []Tag{{Key: "key1", Val: "val1"}, {Key: "key2", Val: "val2"}},
+				labels:      map[string]string{"key1": "val1"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasExcludeTags(tt.args.excludeTags, tt.args.labels); got != tt.want {
+				t.Errorf("hasExcludeTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_matchesTagSelector(t *testing.T) {
+	type args struct {
+		tags        []Tag
+		matchAnyTag bool
+		labels      map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no tags",
+			args: args{
+				tags:        nil,
+				matchAnyTag: true,
+				labels:      map[string]string{"key1": "val1"},
+			},
+			want: true,
+		},
+		{
+			name: "AND - instance has only some of the tags",
+			args: args{
+				tags:   []Tag{{Key: "key1", Val: "val1"}, {Key: "key2", Val: "val2"}},
+				labels: map[string]string{"key1": "val1"},
+			},
+			want: false,
+		},
+		{
+			name: "OR - instance has only some of the tags",
+			args: args{
+				tags:        []Tag{{Key: "key1", Val: "val1"}, {Key: "key2", Val: "val2"}},
+				matchAnyTag: true,
+				labels:      map[string]string{"key2": "val2"},
+			},
+			want: true,
+		},
+		{
+			name: "OR - instance has none of the tags",
+			args: args{
+				tags:        []Tag{{Key: "key1", Val: "val1"}, {Key: "key2", Val: "val2"}},
+				matchAnyTag: true,
+				labels:      map[string]string{"key1": "other", "key3": "val3"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesTagSelector(tt.args.tags, tt.args.matchAnyTag, tt.args.labels); got != tt.want {
+				t.Errorf("matchesTagSelector() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_zoneFromDiskURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{
+			name: "full url",
+			url:  "https://www.googleapis.com/compute/v1/projects/project/zones/us-central1-a/disks/disk-1",
+			want: "us-central1-a",
+		},
+		{
+			name: "partial url",
+			url:  "projects/project/zones/europe-west1-b/disks/disk-1",
+			want: "europe-west1-b",
+		},
+		{
+			name: "no zone",
+			url:  "global/snapshots/snapshot-1",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := zoneFromDiskURL(tt.url); got != tt.want {
+				t.Errorf("zoneFromDiskURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
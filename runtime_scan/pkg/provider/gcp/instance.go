@@ -0,0 +1,160 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/api/compute/v1"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/gcp"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type InstanceImpl struct {
+	client *Client
+	name   string
+	zone   string
+}
+
+func (i *InstanceImpl) GetID() string {
+	return i.name
+}
+
+func (i *InstanceImpl) GetLocation() string {
+	return i.zone
+}
+
+func (i *InstanceImpl) GetAvailabilityZone() string {
+	return i.zone
+}
+
+func (i *InstanceImpl) GetProvider() models.CloudProvider {
+	return models.GCP
+}
+
+func (i *InstanceImpl) GetRootVolume(ctx context.Context) (types.Volume, error) {
+	instance, err := i.client.service.Instances.Get(i.client.gcpConfig.ProjectID, i.zone, i.name).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance: %v", err)
+	}
+
+	for _, disk := range instance.Disks {
+		if disk.Boot {
+			return i.newVolume(disk), nil
+		}
+	}
+	return nil, fmt.Errorf("failed to find boot disk")
+}
+
+func (i *InstanceImpl) GetVolumes(ctx context.Context) ([]types.Volume, error) {
+	instance, err := i.client.service.Instances.Get(i.client.gcpConfig.ProjectID, i.zone, i.name).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance: %v", err)
+	}
+
+	var rootVolume types.Volume
+	var dataVolumes []types.Volume
+	for _, disk := range instance.Disks {
+		// Only persistent disks can be snapshotted, scratch disks are
+		// local SSDs.
+		if disk.Type != attachedDiskTypePersistent {
+			continue
+		}
+		if disk.Boot {
+			rootVolume = i.newVolume(disk)
+			continue
+		}
+		dataVolumes = append(dataVolumes, i.newVolume(disk))
+	}
+	if rootVolume == nil {
+		return nil, fmt.Errorf("failed to find boot disk")
+	}
+
+	return append([]types.Volume{rootVolume}, dataVolumes...), nil
+}
+
+func (i *InstanceImpl) newVolume(disk *compute.AttachedDisk) *VolumeImpl {
+	zone := zoneFromDiskURL(disk.Source)
+	return &VolumeImpl{
+		client: i.client,
+		name:   lastURLSegment(disk.Source),
+		zone:   zone,
+		region: gcp.ZoneToRegion(zone),
+	}
+}
+
+func (i *InstanceImpl) WaitForReady(ctx context.Context) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, utils.DefaultResourceReadyWaitTimeoutMin*time.Minute)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
+			instance, err := i.client.service.Instances.Get(i.client.gcpConfig.ProjectID, i.zone, i.name).Context(ctxWithTimeout).Do()
+			if err != nil {
+				if isNotFound(err) {
+					// The instance insert operation is still in progress.
+					continue
+				}
+				return fmt.Errorf("failed to get instance. instanceID=%v: %v", i.name, err)
+			}
+			if instance.Status == instanceStatusRunning {
+				return nil
+			}
+		case <-ctxWithTimeout.Done():
+			return fmt.Errorf("timeout: %v", ctxWithTimeout.Err())
+		}
+	}
+}
+
+func (i *InstanceImpl) Delete(ctx context.Context) error {
+	if i == nil {
+		return nil
+	}
+
+	op, err := i.client.service.Instances.Delete(i.client.gcpConfig.ProjectID, i.zone, i.name).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to delete instance: %v", err)
+	}
+	// Wait for the deletion so that the attached volume is released and
+	// can be deleted afterwards.
+	if err := i.client.waitForZoneOperation(ctx, i.zone, op); err != nil {
+		return fmt.Errorf("failed to wait for instance deletion: %v", err)
+	}
+
+	return nil
+}
+
+func (i *InstanceImpl) AttachVolume(ctx context.Context, volume types.Volume, deviceName string) error {
+	// The volume is created in the instance zone.
+	op, err := i.client.service.Instances.AttachDisk(i.client.gcpConfig.ProjectID, i.zone, i.name, &compute.AttachedDisk{
+		Source:     fmt.Sprintf("projects/%s/zones/%s/disks/%s", i.client.gcpConfig.ProjectID, i.zone, volume.GetID()),
+		DeviceName: deviceName,
+	}).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to attach volume: %v", err)
+	}
+	if err := getOperationError(op); err != nil {
+		return fmt.Errorf("failed to attach volume: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/compute/v1"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/gcp"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+)
+
+// ListJobResources lists the resources of the scanning jobs in the project.
+func (c *Client) ListJobResources(ctx context.Context) ([]types.JobResource, error) {
+	filter := fmt.Sprintf("labels.%s:*", c.gcpConfig.ScanResultIDLabelKey)
+
+	var ret []types.JobResource
+	err := c.service.Instances.AggregatedList(c.gcpConfig.ProjectID).Filter(filter).Pages(ctx, func(page *compute.InstanceAggregatedList) error {
+		for _, scopedList := range page.Items {
+			for _, instance := range scopedList.Instances {
+				ret = append(ret, types.JobResource{
+					JobInfo: c.getJobInfo(instance.Labels),
+					Instance: &InstanceImpl{
+						client: c,
+						name:   instance.Name,
+						zone:   lastURLSegment(instance.Zone),
+					},
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %v", err)
+	}
+
+	err = c.service.Disks.AggregatedList(c.gcpConfig.ProjectID).Filter(filter).Pages(ctx, func(page *compute.DiskAggregatedList) error {
+		for _, scopedList := range page.Items {
+			for _, disk := range scopedList.Disks {
+				zone := lastURLSegment(disk.Zone)
+				ret = append(ret, types.JobResource{
+					JobInfo: c.getJobInfo(disk.Labels),
+					Volume: &VolumeImpl{
+						client: c,
+						name:   disk.Name,
+						zone:   zone,
+						region: gcp.ZoneToRegion(zone),
+					},
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disks: %v", err)
+	}
+
+	err = c.service.Snapshots.List(c.gcpConfig.ProjectID).Filter(filter).Pages(ctx, func(page *compute.SnapshotList) error {
+		for _, snapshot := range page.Items {
+			job := c.getJobInfo(snapshot.Labels)
+			ret = append(ret, types.JobResource{
+				JobInfo: job,
+				Snapshot: &SnapshotImpl{
+					client: c,
+					name:   snapshot.Name,
+					region: c.gcpConfig.ScannerRegion(),
+					job:    job,
+				},
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %v", err)
+	}
+
+	return ret, nil
+}
+
+func (c *Client) getJobInfo(labels map[string]string) types.JobInfo {
+	return types.JobInfo{
+		ScanID:       labels[c.gcpConfig.ScanIDLabelKey],
+		ScanResultID: labels[c.gcpConfig.ScanResultIDLabelKey],
+		TargetID:     labels[c.gcpConfig.TargetIDLabelKey],
+	}
+}
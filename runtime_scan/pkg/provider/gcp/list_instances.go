@@ -0,0 +1,91 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/api/compute/v1"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// ListInstances lists all the instances of the project in the zone, or in all
+// the zones if it isn't set, regardless of any scan scope.
+func (c *Client) ListInstances(ctx context.Context, location string) ([]models.ProviderInstance, error) {
+	var ret []models.ProviderInstance
+
+	if location != "" {
+		err := c.service.Instances.List(c.gcpConfig.ProjectID, location).Pages(ctx, func(page *compute.InstanceList) error {
+			for _, instance := range page.Items {
+				ret = append(ret, convertToAPIProviderInstance(instance))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instances. zone=%v: %v", location, err)
+		}
+		return ret, nil
+	}
+
+	err := c.service.Instances.AggregatedList(c.gcpConfig.ProjectID).Pages(ctx, func(page *compute.InstanceAggregatedList) error {
+		for _, scopedList := range page.Items {
+			for _, instance := range scopedList.Instances {
+				ret = append(ret, convertToAPIProviderInstance(instance))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %v", err)
+	}
+
+	return ret, nil
+}
+
+// convertToAPIProviderInstance converts the instance, which is identified by
+// its name and zone.
+func convertToAPIProviderInstance(instance *compute.Instance) models.ProviderInstance {
+	zone := lastURLSegment(instance.Zone)
+
+	return models.ProviderInstance{
+		InstanceID:       utils.PointerTo(instance.Name),
+		InstanceProvider: utils.PointerTo(models.GCP),
+		Location:         utils.PointerTo(zone),
+		AvailabilityZone: utils.PointerTo(zone),
+		State:            utils.PointerTo(instance.Status),
+		Tags:             convertToAPITags(instance.Labels),
+	}
+}
+
+// convertToAPITags converts the labels ordered by key.
+func convertToAPITags(labels map[string]string) *[]models.Tag {
+	ret := make([]models.Tag, 0, len(labels))
+	for key, value := range labels {
+		ret = append(ret, models.Tag{
+			Key:   key,
+			Value: value,
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Key < ret[j].Key
+	})
+
+	return &ret
+}
@@ -0,0 +1,32 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetScanningJobLogs returns the serial port output of the scanner instance
+// in the zone.
+func (c *Client) GetScanningJobLogs(ctx context.Context, zone, instanceID string) (string, error) {
+	out, err := c.service.Instances.GetSerialPortOutput(c.gcpConfig.ProjectID, zone, instanceID).Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to get serial port output: %v", err)
+	}
+
+	return out.Contents, nil
+}
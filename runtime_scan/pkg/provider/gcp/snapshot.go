@@ -0,0 +1,146 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type SnapshotImpl struct {
+	client *Client
+	name   string
+	region string
+	// copied is set for a snapshot returned by Copy, which shares the
+	// underlying snapshot with the source snapshot.
+	copied bool
+	// job is the scanning job the snapshot was taken for, its volumes are
+	// labeled with it.
+	job types.JobInfo
+}
+
+// GetSnapshot gets the snapshot by its name. GCP snapshots are global
+// resources, so the snapshot is located in the region of the scanner.
+func (c *Client) GetSnapshot(ctx context.Context, _, snapshotID string, job types.JobInfo) (types.Snapshot, error) {
+	snapshot, err := c.service.Snapshots.Get(c.gcpConfig.ProjectID, snapshotID).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot. snapshotID=%v: %v", snapshotID, err)
+	}
+
+	return &SnapshotImpl{
+		client: c,
+		name:   snapshot.Name,
+		region: c.gcpConfig.ScannerRegion(),
+		job:    job,
+	}, nil
+}
+
+func (s *SnapshotImpl) GetID() string {
+	return s.name
+}
+
+func (s *SnapshotImpl) GetRegion() string {
+	return s.region
+}
+
+func (s *SnapshotImpl) GetSize(ctx context.Context) (int64, error) {
+	snapshot, err := s.client.service.Snapshots.Get(s.client.gcpConfig.ProjectID, s.name).Context(ctx).Do()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get snapshot. snapshotID=%v: %v", s.name, err)
+	}
+
+	return snapshot.DiskSizeGb, nil
+}
+
+// Copy returns the snapshot in the destination region. GCP snapshots are
+// global resources that can be used to create disks in any region, so no
+// data is copied and the returned snapshot refers to the same snapshot.
+func (s *SnapshotImpl) Copy(_ context.Context, dstRegion string) (types.Snapshot, error) {
+	return &SnapshotImpl{
+		client: s.client,
+		name:   s.name,
+		region: dstRegion,
+		copied: true,
+		job:    s.job,
+	}, nil
+}
+
+func (s *SnapshotImpl) Delete(ctx context.Context) error {
+	if s == nil || s.copied {
+		// The snapshot is deleted together with the source snapshot.
+		return nil
+	}
+
+	_, err := s.client.service.Snapshots.Delete(s.client.gcpConfig.ProjectID, s.name).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot: %v", err)
+	}
+
+	return nil
+}
+
+func (s *SnapshotImpl) WaitForReady(ctx context.Context) error {
+	for {
+		select {
+		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
+			snapshot, err := s.client.service.Snapshots.Get(s.client.gcpConfig.ProjectID, s.name).Context(ctx).Do()
+			if err != nil {
+				if isNotFound(err) {
+					// The snapshot create operation is still in progress.
+					continue
+				}
+				return fmt.Errorf("failed to get snapshot. snapshotID=%v: %v", s.name, err)
+			}
+			switch snapshot.Status {
+			case snapshotStatusReady:
+				return nil
+			case snapshotStatusFailed:
+				return fmt.Errorf("snapshot creation failed. snapshotID=%v", s.name)
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for snapshot ready was canceled: %v", ctx.Err())
+		}
+	}
+}
+
+func (s *SnapshotImpl) CreateVolume(ctx context.Context, availabilityZone string) (types.Volume, error) {
+	diskName := fmt.Sprintf("vmclarity-volume-%s", uuid.NewString())
+	op, err := s.client.service.Disks.Insert(s.client.gcpConfig.ProjectID, availabilityZone, &compute.Disk{
+		Name:           diskName,
+		SourceSnapshot: fmt.Sprintf("global/snapshots/%s", s.name),
+		Labels:         s.client.createJobResourceLabels(s.job),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume: %v", err)
+	}
+	if err := getOperationError(op); err != nil {
+		return nil, fmt.Errorf("failed to create volume: %v", err)
+	}
+
+	return &VolumeImpl{
+		client: s.client,
+		name:   diskName,
+		zone:   availabilityZone,
+		region: s.region,
+	}, nil
+}
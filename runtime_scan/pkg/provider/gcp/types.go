@@ -0,0 +1,50 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+const (
+	instanceStatusRunning    = "RUNNING"
+	instanceStatusTerminated = "TERMINATED"
+	instanceStatusSuspended  = "SUSPENDED"
+
+	diskStatusReady      = "READY"
+	diskStatusFailed     = "FAILED"
+	snapshotStatusReady  = "READY"
+	snapshotStatusFailed = "FAILED"
+	zoneStatusUp         = "UP"
+	operationStatusDone  = "DONE"
+
+	attachedDiskTypePersistent = "PERSISTENT"
+)
+
+type ScanScope struct {
+	// Only targets in these zones will be selected for scanning.
+	// If empty, all zones in the project are selected.
+	Zones       []string
+	ScanStopped bool
+	// Only targets that have these labels will be selected for scanning within the selected scan scope.
+	// Multiple labels will be treated as an AND operator, or as an OR operator if MatchAnyTag is set.
+	TagSelector []Tag
+	MatchAnyTag bool
+	// Targets that have these labels will be excluded from the scan, even if they match the tag selector.
+	// Multiple labels will be treated as an AND operator.
+	ExcludeTags []Tag
+}
+
+type Tag struct {
+	Key string
+	Val string
+}
@@ -0,0 +1,133 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type VolumeImpl struct {
+	client *Client
+	name   string
+	zone   string
+	region string
+}
+
+func (v *VolumeImpl) GetID() string {
+	return v.name
+}
+
+func (v *VolumeImpl) TakeSnapshot(ctx context.Context, job types.JobInfo) (types.Snapshot, error) {
+	snapshotName := fmt.Sprintf("vmclarity-snapshot-%s", uuid.NewString())
+	op, err := v.client.service.Disks.CreateSnapshot(v.client.gcpConfig.ProjectID, v.zone, v.name, &compute.Snapshot{
+		Name:   snapshotName,
+		Labels: v.client.createJobResourceLabels(job),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot: %v", err)
+	}
+	if err := getOperationError(op); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot: %v", err)
+	}
+
+	return &SnapshotImpl{
+		client: v.client,
+		name:   snapshotName,
+		region: v.region,
+		job:    job,
+	}, nil
+}
+
+func (v *VolumeImpl) WaitForReady(ctx context.Context) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, utils.DefaultResourceReadyWaitTimeoutMin*time.Minute)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
+			disk, err := v.getDisk(ctxWithTimeout)
+			if err != nil {
+				return err
+			}
+			if disk == nil {
+				continue
+			}
+			switch disk.Status {
+			case diskStatusReady:
+				return nil
+			case diskStatusFailed:
+				return fmt.Errorf("disk creation failed. volumeID=%v", v.name)
+			}
+		case <-ctxWithTimeout.Done():
+			return fmt.Errorf("waiting for volume ready was canceled: %v", ctxWithTimeout.Err())
+		}
+	}
+}
+
+func (v *VolumeImpl) WaitForAttached(ctx context.Context) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, utils.DefaultResourceReadyWaitTimeoutMin*time.Minute)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(utils.DefaultResourceReadyCheckIntervalSec * time.Second):
+			disk, err := v.getDisk(ctxWithTimeout)
+			if err != nil {
+				return err
+			}
+			// Users lists the instances the disk is attached to.
+			if disk != nil && len(disk.Users) > 0 {
+				return nil
+			}
+		case <-ctxWithTimeout.Done():
+			return fmt.Errorf("waiting for volume attached was canceled: %v", ctxWithTimeout.Err())
+		}
+	}
+}
+
+// getDisk returns nil without an error if the disk doesn't exist yet.
+func (v *VolumeImpl) getDisk(ctx context.Context) (*compute.Disk, error) {
+	disk, err := v.client.service.Disks.Get(v.client.gcpConfig.ProjectID, v.zone, v.name).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil // nolint:nilnil
+		}
+		return nil, fmt.Errorf("failed to get disk. volumeID=%v: %v", v.name, err)
+	}
+
+	return disk, nil
+}
+
+func (v *VolumeImpl) Delete(ctx context.Context) error {
+	if v == nil {
+		return nil
+	}
+
+	_, err := v.client.service.Disks.Delete(v.client.gcpConfig.ProjectID, v.zone, v.name).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to delete volume: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrBudgetExhausted is returned when launching a scanning job after the
+// scanner instance hours budget of the scan was used up.
+var ErrBudgetExhausted = errors.New("scanner instance hours budget exhausted")
+
+// instanceHoursBudget tracks the scanner instance hours used by the jobs of
+// a scan against the budget of the scan. A job uses the budget from the
+// launch of its scanner instance until it is torn down, or until it is
+// handled when the job is kept according to the delete job policy. A nil
+// budget is unlimited.
+type instanceHoursBudget struct {
+	limit time.Duration
+	// used is the time used by the jobs which are not running anymore.
+	used time.Duration
+	// running is the launch time of the running jobs by target ID.
+	running map[string]time.Time
+	now     func() time.Time
+
+	mu sync.Mutex
+}
+
+func newInstanceHoursBudget(maxScannerInstanceHours *float64) *instanceHoursBudget {
+	if maxScannerInstanceHours == nil || *maxScannerInstanceHours <= 0 {
+		return nil
+	}
+
+	return &instanceHoursBudget{
+		limit:   time.Duration(*maxScannerInstanceHours * float64(time.Hour)),
+		running: map[string]time.Time{},
+		now:     time.Now,
+	}
+}
+
+// Allow returns ErrBudgetExhausted if the budget was used up.
+func (b *instanceHoursBudget) Allow() error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.usedLocked() >= b.limit {
+		return ErrBudgetExhausted
+	}
+	return nil
+}
+
+// Launched records the launch of the scanner instance of the target's job.
+func (b *instanceHoursBudget) Launched(targetID string) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.running[targetID]; !ok {
+		b.running[targetID] = b.now()
+	}
+}
+
+// Terminated records that the job of the target stopped using the budget.
+// It does nothing if the job wasn't launched.
+func (b *instanceHoursBudget) Terminated(targetID string) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	launchTime, ok := b.running[targetID]
+	if !ok {
+		return
+	}
+	b.used += b.now().Sub(launchTime)
+	delete(b.running, targetID)
+}
+
+// Used returns the time used by the jobs so far, including the running ones.
+func (b *instanceHoursBudget) Used() time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.usedLocked()
+}
+
+func (b *instanceHoursBudget) usedLocked() time.Duration {
+	used := b.used
+	now := b.now()
+	for _, launchTime := range b.running {
+		used += now.Sub(launchTime)
+	}
+	return used
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func TestInstanceHoursBudget(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	budget := newInstanceHoursBudget(utils.PointerTo[float64](1.5))
+	budget.now = func() time.Time { return now }
+
+	if err := budget.Allow(); err != nil {
+		t.Fatalf("Allow() unexpected error = %v", err)
+	}
+
+	budget.Launched("target-1")
+	budget.Launched("target-2")
+	now = start.Add(30 * time.Minute)
+	budget.Terminated("target-1")
+	budget.Terminated("not-launched")
+
+	if got, want := budget.Used(), time.Hour; got != want {
+		t.Errorf("Used() = %v, want %v", got, want)
+	}
+	if err := budget.Allow(); err != nil {
+		t.Fatalf("Allow() unexpected error = %v", err)
+	}
+
+	now = start.Add(time.Hour)
+	if got, want := budget.Used(), 90*time.Minute; got != want {
+		t.Errorf("Used() = %v, want %v", got, want)
+	}
+	if err := budget.Allow(); !errors.Is(err, ErrBudgetExhausted) {
+		t.Errorf("Allow() error = %v, want %v", err, ErrBudgetExhausted)
+	}
+
+	budget.Terminated("target-2")
+	if got, want := budget.Used(), 90*time.Minute; got != want {
+		t.Errorf("Used() = %v, want %v", got, want)
+	}
+}
+
+func TestInstanceHoursBudget_Unlimited(t *testing.T) {
+	tests := []struct {
+		name                    string
+		maxScannerInstanceHours *float64
+	}{
+		{
+			name:                    "not set",
+			maxScannerInstanceHours: nil,
+		},
+		{
+			name:                    "zero",
+			maxScannerInstanceHours: utils.PointerTo[float64](0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := newInstanceHoursBudget(tt.maxScannerInstanceHours)
+			budget.Launched("target")
+			budget.Terminated("target")
+			if err := budget.Allow(); err != nil {
+				t.Errorf("Allow() unexpected error = %v", err)
+			}
+			if got := budget.Used(); got != 0 {
+				t.Errorf("Used() = %v, want 0", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+)
+
+// applyFamiliesConfigOverlay deep-merges the user provided overlay YAML over
+// the generated families configuration YAML. Maps are merged recursively,
+// any other value in the overlay (including lists) replaces the generated one.
+// The merged result is validated to still be a valid families configuration.
+func applyFamiliesConfigOverlay(generated []byte, overlay []byte) ([]byte, error) {
+	var base map[string]interface{}
+	if err := yaml.Unmarshal(generated, &base); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal generated families config: %w", err)
+	}
+
+	var override map[string]interface{}
+	if err := yaml.Unmarshal(overlay, &override); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scanner config overlay: %w", err)
+	}
+
+	merged, err := yaml.Marshal(mergeConfigMaps(base, override))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal merged families config: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(merged))
+	decoder.KnownFields(true)
+	var famConfig families.Config
+	if err := decoder.Decode(&famConfig); err != nil {
+		return nil, fmt.Errorf("invalid families config after applying scanner config overlay: %w", err)
+	}
+
+	return merged, nil
+}
+
+func mergeConfigMaps(base, override map[string]interface{}) map[string]interface{} {
+	if base == nil {
+		base = make(map[string]interface{}, len(override))
+	}
+
+	for key, overrideValue := range override {
+		overrideMap, overrideIsMap := overrideValue.(map[string]interface{})
+		baseMap, baseIsMap := base[key].(map[string]interface{})
+		if overrideIsMap && baseIsMap {
+			base[key] = mergeConfigMaps(baseMap, overrideMap)
+			continue
+		}
+		base[key] = overrideValue
+	}
+
+	return base
+}
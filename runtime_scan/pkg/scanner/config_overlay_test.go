@@ -0,0 +1,140 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v3"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+	secretscommon "github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
+	gitleaksconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks/config"
+)
+
+func Test_applyFamiliesConfigOverlay(t *testing.T) {
+	generated, err := yaml.Marshal(families.Config{
+		Secrets: secrets.Config{
+			Enabled:      true,
+			ScannersList: []string{"gitleaks"},
+			ScannersConfig: &secretscommon.ScannersConfig{
+				Gitleaks: gitleaksconfig.Config{
+					BinaryPath: "/usr/local/bin/gitleaks",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal generated config: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		overlay string
+		want    secrets.Config
+		wantErr bool
+	}{
+		{
+			name:    "Empty overlay",
+			overlay: "",
+			want: secrets.Config{
+				Enabled:      true,
+				ScannersList: []string{"gitleaks"},
+				Inputs:       []secrets.Input{},
+				ScannersConfig: &secretscommon.ScannersConfig{
+					Gitleaks: gitleaksconfig.Config{
+						BinaryPath: "/usr/local/bin/gitleaks",
+					},
+				},
+			},
+		},
+		{
+			name: "Nested value is overridden, siblings are kept",
+			overlay: `
+secrets:
+  scanners_config:
+    gitleaks:
+      binary_path: /opt/gitleaks
+`,
+			want: secrets.Config{
+				Enabled:      true,
+				ScannersList: []string{"gitleaks"},
+				Inputs:       []secrets.Input{},
+				ScannersConfig: &secretscommon.ScannersConfig{
+					Gitleaks: gitleaksconfig.Config{
+						BinaryPath: "/opt/gitleaks",
+					},
+				},
+			},
+		},
+		{
+			name: "Lists are replaced",
+			overlay: `
+secrets:
+  scanners_list: []
+`,
+			want: secrets.Config{
+				Enabled:      true,
+				ScannersList: []string{},
+				Inputs:       []secrets.Input{},
+				ScannersConfig: &secretscommon.ScannersConfig{
+					Gitleaks: gitleaksconfig.Config{
+						BinaryPath: "/usr/local/bin/gitleaks",
+					},
+				},
+			},
+		},
+		{
+			name: "Unknown field",
+			overlay: `
+secrets:
+  not_a_field: true
+`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid type",
+			overlay: "secrets:\n  enabled: [true]\n",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid yaml",
+			overlay: "secrets: [",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyFamiliesConfigOverlay(generated, []byte(tt.overlay))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyFamiliesConfigOverlay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var gotConfig families.Config
+			if err := yaml.Unmarshal(got, &gotConfig); err != nil {
+				t.Fatalf("failed to unmarshal merged config: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, gotConfig.Secrets); diff != "" {
+				t.Errorf("applyFamiliesConfigOverlay() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,16 +22,17 @@ import (
 	"time"
 
 	"github.com/anchore/syft/syft/source"
+	"github.com/cenkalti/backoff"
 	kubeclarityConfig "github.com/openclarity/kubeclarity/shared/pkg/config"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/circuitbreaker"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/config"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
 	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
-	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 	"github.com/openclarity/vmclarity/shared/pkg/families"
 	familiesExploits "github.com/openclarity/vmclarity/shared/pkg/families/exploits"
 	exploitsCommon "github.com/openclarity/vmclarity/shared/pkg/families/exploits/common"
@@ -39,21 +40,28 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
 	malwareconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/config"
 	malwarecommon "github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	yaraconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/yara/config"
 	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/registry"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
 	chkrootkitConfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit/config"
 	rootkitsCommon "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/common"
+	rkhunterConfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/rkhunter/config"
 	familiesSbom "github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
 	gitleaksconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks/config"
+	trufflehogconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/trufflehog/config"
+	familiesTypes "github.com/openclarity/vmclarity/shared/pkg/families/types"
 	familiesVulnerabilities "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+	osvconfig "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/osv/config"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 // TODO this code is taken from KubeClarity, we can make improvements base on the discussions here: https://github.com/openclarity/vmclarity/pull/3
 
 const (
+	// TrivyTimeout is the default timeout of trivy in seconds, used when not set on the scan config.
 	TrivyTimeout       = 300
 	GrypeServerTimeout = 2 * time.Minute
 
@@ -65,54 +73,98 @@ const (
 // nolint:cyclop,gocognit
 func (s *Scanner) jobBatchManagement(ctx context.Context) {
 	s.Lock()
-	targetIDToScanData := s.targetIDToScanData
+	// The scan data of the targets is snapshotted under the lock, the
+	// targets are dispatched from the slice and the results of their jobs
+	// are matched by ID, so that s.targetIDToScanData isn't read after the
+	// scan started.
+	targets := make([]*scanData, 0, len(s.targetIDToScanData))
+	targetIDToScanData := make(map[string]*scanData, len(s.targetIDToScanData))
+	for targetID, data := range s.targetIDToScanData {
+		targets = append(targets, data)
+		targetIDToScanData[targetID] = data
+	}
 	// Since this value has a default in the API, I assume it is safe to dereference it.
 	numberOfWorkers := *s.scanConfig.MaxParallelScanners
 	s.Unlock()
 
-	// queue of scan data
-	q := make(chan *scanData)
+	// Without any jobs the loop below never completes the scan.
+	if len(targets) == 0 {
+		s.completeScanWithNoTargets(ctx)
+		return
+	}
+
 	// done channel takes the result of the job
-	done := make(chan string)
+	done := make(chan jobResult)
+	// summaryUpdates channel takes the findings of the families of a job which have completed so far
+	summaryUpdates := make(chan partialSummary)
+
+	// stop the scan if it gets aborted
+	abortCtx, cancelAbortWatch := context.WithCancel(ctx)
+	defer cancelAbortWatch()
+	go s.watchAbort(abortCtx)
 
 	// spawn workers
-	for i := 0; i < numberOfWorkers; i++ {
-		go s.worker(ctx, q, i, done, s.killSignal)
-	}
+	pool := newWorkerPool(s.config, numberOfWorkers, s.metrics.workers)
+	pool.start(func(workNumber int) {
+		s.worker(ctx, pool, workNumber, done, summaryUpdates, s.killSignal)
+	})
 
-	// send all scan data on scan data queue, for workers to pick it up.
+	// submit all scan data to the worker pool, for workers to pick it up.
+	queued := len(targets)
+	s.metrics.queueDepth.Add(float64(queued))
 	go func() {
-		for _, data := range targetIDToScanData {
-			select {
-			case q <- data:
-			case <-s.killSignal:
+		// The targets left in the queue of a canceled scan aren't
+		// waiting anymore.
+		defer func() {
+			s.metrics.queueDepth.Sub(float64(queued))
+		}()
+		for _, data := range targets {
+			if !pool.submit(data, s.killSignal) {
 				log.WithFields(s.logFields).Debugf("Scan process was canceled. targetID=%v, scanID=%v", data.targetInstance.TargetID, s.scanID)
 				return
 			}
+			queued--
+			s.metrics.queueDepth.Dec()
 		}
 	}()
 
 	anyJobsFailed := false
+	anyJobsTimedOut := false
+	anyBudgetExhausted := false
 	numberOfCompletedJobs := 0
 	scanComplete := false
 	for !scanComplete {
 		var scan *models.Scan
 		var err error
 		select {
-		case targetID := <-done:
+		case update := <-summaryUpdates:
+			data := targetIDToScanData[update.targetID]
+			if err := s.addPartialSummary(ctx, data, update.summary); err != nil {
+				log.WithFields(s.logFields).Errorf("Failed to update scan summary with partial results of target %s: %v", update.targetID, err)
+			}
+			continue
+		case result := <-done:
 			numberOfCompletedJobs = numberOfCompletedJobs + 1
-			data := targetIDToScanData[targetID]
-			if !data.success {
+			data := targetIDToScanData[result.targetID]
+			if result.budgetExhausted {
+				anyBudgetExhausted = true
+			} else if !result.success {
 				anyJobsFailed = true
+				s.metrics.jobsFailed.Inc()
+			} else {
+				s.metrics.jobsCompleted.Inc()
+			}
+			if result.timeout {
+				anyJobsTimedOut = true
 			}
 
-			scan, err = s.createScanWithUpdatedSummary(ctx, *data)
+			scan, err = s.createScanWithUpdatedSummary(ctx, data)
 			if err != nil {
 				log.WithFields(s.logFields).Errorf("Failed to create a scan with updated summary: %v", err)
 				scan = &models.Scan{}
 			}
 
-			if numberOfCompletedJobs == len(targetIDToScanData) {
+			if numberOfCompletedJobs == len(targets) {
 				scanComplete = true
 
 				scan.EndTime = utils.PointerTo(time.Now())
@@ -133,6 +185,14 @@ func (s *Scanner) jobBatchManagement(ctx context.Context) {
 					break
 				}
 
+				if anyJobsTimedOut {
+					log.Warning("Scan is failed")
+					scan.State = utils.PointerTo(models.ScanStateFailed)
+					scan.StateMessage = utils.PointerTo(fmt.Sprintf("One or more ScanJobs timed out after %s", s.getJobTimeout()))
+					scan.StateReason = utils.PointerTo(models.ScanStateReasonTimedOut)
+					break
+				}
+
 				if anyJobsFailed {
 					log.Warning("Scan is failed")
 					scan.State = utils.PointerTo(models.ScanStateFailed)
@@ -141,6 +201,14 @@ func (s *Scanner) jobBatchManagement(ctx context.Context) {
 					break
 				}
 
+				if anyBudgetExhausted {
+					log.Warning("Scan is completed, the scanner instance hours budget was exhausted")
+					scan.State = utils.PointerTo(models.ScanStateDone)
+					scan.StateMessage = utils.PointerTo(fmt.Sprintf("The scanner instance hours budget was exhausted after %.2f hours, one or more targets were not scanned", s.budget.Used().Hours()))
+					scan.StateReason = utils.PointerTo(models.ScanStateReasonBudgetExhausted)
+					break
+				}
+
 				log.Info("Scan is completed")
 				scan.State = utils.PointerTo(models.ScanStateDone)
 				scan.StateMessage = utils.PointerTo("All scan jobs completed")
@@ -149,10 +217,17 @@ func (s *Scanner) jobBatchManagement(ctx context.Context) {
 		case <-s.killSignal:
 			t := time.Now()
 			reason := models.ScanStateReasonTimedOut
+			message := "Scan was canceled or timed out"
+			s.Lock()
+			if s.aborted {
+				reason = models.ScanStateReasonAborted
+				message = "User initiated"
+			}
+			s.Unlock()
 			scan = &models.Scan{
 				EndTime:      &t,
 				State:        runtimeScanUtils.PointerTo(models.ScanStateFailed),
-				StateMessage: runtimeScanUtils.StringPtr("Scan was canceled or timed out"),
+				StateMessage: runtimeScanUtils.StringPtr(message),
 				StateReason:  &reason,
 			}
 			scanComplete = true
@@ -164,10 +239,22 @@ func (s *Scanner) jobBatchManagement(ctx context.Context) {
 		if err != nil {
 			log.WithFields(s.logFields).Errorf("failed to patch the scan ID=%s: %v", s.scanID, err)
 		}
+
+		if scanComplete {
+			s.notifyScanCompleted(ctx, scan)
+		}
 	}
+
+	// The workers of a canceled scan clean up the jobs they were running
+	// before they halt, and the workers of a completed scan are idle. Stop
+	// the pool and wait for them so that the caller knows when no resources
+	// are left behind.
+	pool.stop()
+	pool.wait()
+	log.WithFields(s.logFields).Debugf("All workers halted. scanID=%v", s.scanID)
 }
 
-func (s *Scanner) createScanWithUpdatedSummary(ctx context.Context, data scanData) (*models.Scan, error) {
+func (s *Scanner) createScanWithUpdatedSummary(ctx context.Context, data *scanData) (*models.Scan, error) {
 	scan, err := s.backendClient.GetScan(ctx, s.scanID, models.GetScansScanIDParams{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get scan to update status: %v", err)
@@ -178,48 +265,164 @@ func (s *Scanner) createScanWithUpdatedSummary(ctx context.Context, data scanDat
 		return nil, fmt.Errorf("failed to get result summary to update status: %v", err)
 	}
 
-	// Update the scan summary with the summary from the completed scan result
-	scan.Summary.JobsCompleted = runtimeScanUtils.IntPtr(*scan.Summary.JobsCompleted + 1)
-	scan.Summary.JobsLeftToRun = runtimeScanUtils.IntPtr(*scan.Summary.JobsLeftToRun - 1)
-	scan.Summary.TotalExploits = runtimeScanUtils.IntPtr(*scan.Summary.TotalExploits + *scanResultSummary.TotalExploits)
-	scan.Summary.TotalMalware = runtimeScanUtils.IntPtr(*scan.Summary.TotalMalware + *scanResultSummary.TotalMalware)
-	scan.Summary.TotalMisconfigurations = runtimeScanUtils.IntPtr(*scan.Summary.TotalMisconfigurations + *scanResultSummary.TotalMisconfigurations)
-	scan.Summary.TotalPackages = runtimeScanUtils.IntPtr(*scan.Summary.TotalPackages + *scanResultSummary.TotalPackages)
-	scan.Summary.TotalRootkits = runtimeScanUtils.IntPtr(*scan.Summary.TotalRootkits + *scanResultSummary.TotalRootkits)
-	scan.Summary.TotalSecrets = runtimeScanUtils.IntPtr(*scan.Summary.TotalSecrets + *scanResultSummary.TotalSecrets)
-	scan.Summary.TotalVulnerabilities = &models.VulnerabilityScanSummary{
-		TotalCriticalVulnerabilities:   runtimeScanUtils.IntPtr(*scan.Summary.TotalVulnerabilities.TotalCriticalVulnerabilities + *scanResultSummary.TotalVulnerabilities.TotalCriticalVulnerabilities),
-		TotalHighVulnerabilities:       runtimeScanUtils.IntPtr(*scan.Summary.TotalVulnerabilities.TotalHighVulnerabilities + *scanResultSummary.TotalVulnerabilities.TotalHighVulnerabilities),
-		TotalLowVulnerabilities:        runtimeScanUtils.IntPtr(*scan.Summary.TotalVulnerabilities.TotalLowVulnerabilities + *scanResultSummary.TotalVulnerabilities.TotalLowVulnerabilities),
-		TotalMediumVulnerabilities:     runtimeScanUtils.IntPtr(*scan.Summary.TotalVulnerabilities.TotalMediumVulnerabilities + *scanResultSummary.TotalVulnerabilities.TotalMediumVulnerabilities),
-		TotalNegligibleVulnerabilities: runtimeScanUtils.IntPtr(*scan.Summary.TotalVulnerabilities.TotalCriticalVulnerabilities + *scanResultSummary.TotalVulnerabilities.TotalNegligibleVulnerabilities),
+	ignoredSummary, err := s.getIgnoredFindingsSummary(ctx, data.scanResultID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ignored findings to update status: %v", err)
+	}
+
+	if scan.Summary == nil {
+		scan.Summary = &models.ScanSummary{}
+	}
+
+	// Update the scan summary with the summary from the completed scan result,
+	// excluding the ignored findings and the findings which were already added
+	// by partial updates. The ignored findings are counted separately.
+	scan.Summary.JobsCompleted = runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(scan.Summary.JobsCompleted) + 1)
+	scan.Summary.JobsLeftToRun = runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(scan.Summary.JobsLeftToRun) - 1)
+	scanResultSummary = diffScanFindingsSummary(scanResultSummary, ignoredSummary)
+	setScanFindingsSummary(scan.Summary, addScanFindingsSummary(getScanFindingsSummary(scan.Summary), diffScanFindingsSummary(scanResultSummary, data.reportedSummary)))
+	if ignoredSummary != nil {
+		scan.Summary.IgnoredFindings = addScanFindingsSummary(scan.Summary.IgnoredFindings, ignoredSummary)
 	}
 
 	return scan, nil
 }
 
-// worker waits for data on the queue, runs a scan job and waits for results from that scan job. Upon completion, done is notified to the caller.
-func (s *Scanner) worker(ctx context.Context, queue chan *scanData, workNumber int, done chan string, ks chan bool) {
+// getIgnoredFindingsSummary returns the totals of the findings of the scan
+// result which match the global ignore rules or the ignore rules of the scan
+// config, or nil if there are no ignore rules.
+func (s *Scanner) getIgnoredFindingsSummary(ctx context.Context, scanResultID string) (*models.ScanFindingsSummary, error) {
+	var ignoreRules []models.IgnoreRule
+
+	globalIgnoreRules, err := s.backendClient.GetIgnoreRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get global ignore rules: %v", err)
+	}
+	if globalIgnoreRules.Rules != nil {
+		ignoreRules = append(ignoreRules, *globalIgnoreRules.Rules...)
+	}
+	if s.scanConfig.IgnoreRules != nil {
+		ignoreRules = append(ignoreRules, *s.scanConfig.IgnoreRules...)
+	}
+
+	if len(ignoreRules) == 0 {
+		return nil, nil
+	}
+
+	scanResult, err := s.backendClient.GetScanResult(ctx, scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: runtimeScanUtils.StringPtr("vulnerabilities,secrets,misconfigurations"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan result findings: %v", err)
+	}
+
+	return utils.GetIgnoredFindingsSummary(ignoreRules, scanResult), nil
+}
+
+// addPartialSummary adds to the scan summary the findings of the target's
+// completed families which were not reported yet.
+func (s *Scanner) addPartialSummary(ctx context.Context, data *scanData, summary *models.ScanFindingsSummary) error {
+	scan, err := s.backendClient.GetScan(ctx, s.scanID, models.GetScansScanIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get scan to update summary: %v", err)
+	}
+
+	if scan.Summary == nil {
+		scan.Summary = &models.ScanSummary{}
+	}
+	setScanFindingsSummary(scan.Summary, addScanFindingsSummary(getScanFindingsSummary(scan.Summary), diffScanFindingsSummary(summary, data.reportedSummary)))
+
+	err = s.backendClient.PatchScan(ctx, s.scanID, &models.Scan{Summary: scan.Summary})
+	if err != nil {
+		return fmt.Errorf("failed to patch scan summary: %v", err)
+	}
+	data.reportedSummary = summary
+
+	return nil
+}
+
+// watchAbort polls the state of the scan and stops the scan once it is aborted,
+// which stops dispatching jobs and tears down the jobs which are still running.
+func (s *Scanner) watchAbort(ctx context.Context) {
+	ticker := time.NewTicker(s.config.JobResultsPollingInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
-		case data := <-queue:
-			job, err := s.handleScanData(ctx, data, ks)
+		case <-ticker.C:
+			scan, err := s.backendClient.GetScan(ctx, s.scanID, models.GetScansScanIDParams{
+				Select: runtimeScanUtils.StringPtr("state"),
+			})
+			if err != nil {
+				log.WithFields(s.logFields).Errorf("Failed to get scan state. scanID=%v: %v", s.scanID, err)
+				break
+			}
+
+			if state, ok := scan.GetState(); ok && state == models.ScanStateAborted {
+				log.WithFields(s.logFields).Infof("Scan was aborted, stopping running jobs. scanID=%v", s.scanID)
+				s.Lock()
+				s.aborted = true
+				s.Unlock()
+				s.Clear()
+				return
+			}
+		case <-ctx.Done():
+			return
+		case <-s.killSignal:
+			return
+		}
+	}
+}
+
+// worker waits for data on the queue of the pool, runs a scan job and waits for results from that scan job. Upon completion, done is notified to the caller.
+// The worker exits once it was idle for the idle timeout of the pool, unless the pool is at its min workers, or once the pool is stopped.
+func (s *Scanner) worker(ctx context.Context, pool *workerPool, workNumber int, done chan jobResult, summaryUpdates chan partialSummary, ks chan bool) {
+	for {
+		select {
+		case data := <-pool.queue:
+			s.metrics.workersBusy.Inc()
+			job, err := s.handleScanData(ctx, data, summaryUpdates, ks)
 			if err != nil {
 				log.WithFields(s.logFields).Error(err)
-				err := s.SetTargetScanStatusCompletionError(ctx, data.scanResultID, err.Error())
+				if errors.Is(err, circuitbreaker.ErrOpen) || errors.Is(err, ErrBudgetExhausted) {
+					// The region is being shed or the scan can't launch
+					// any more jobs, leave the target to a later scan.
+					err = s.SetTargetScanStatusNotScanned(ctx, data.scanResultID, err.Error())
+				} else {
+					err = s.SetTargetScanStatusCompletionError(ctx, data.scanResultID, err.Error())
+				}
 				if err != nil {
-					log.WithFields(s.logFields).Errorf("Couldn't set completion error for target scan status. targetID=%v, scanID=%v: %v",
+					log.WithFields(s.logFields).Errorf("Couldn't set error for target scan status. targetID=%v, scanID=%v: %v",
 						data.targetInstance.TargetID, s.scanID, err)
 					// TODO: Should we retry?
 				}
 			}
 			s.deleteJobIfNeeded(ctx, job, data.success, data.completed)
+			if job != nil && !data.completed && s.isDrained() {
+				// The job of the target was deleted, leave the target to
+				// a later scan instead of waiting for its result.
+				err = s.SetTargetScanStatusNotScanned(ctx, data.scanResultID, errScanDrained.Error())
+				if err != nil {
+					log.WithFields(s.logFields).Errorf("Couldn't set target scan status as not scanned. targetID=%v, scanID=%v: %v",
+						data.targetInstance.TargetID, s.scanID, err)
+				}
+			}
+			s.budget.Terminated(data.targetInstance.TargetID)
+			s.metrics.workersBusy.Dec()
 
 			select {
-			case done <- data.targetInstance.TargetID:
+			case done <- data.result():
 			case <-ks:
 				log.WithFields(s.logFields).Infof("Instance scan was canceled. targetID=%v", data.targetInstance.TargetID)
 			}
+		case <-pool.idle():
+			if pool.release() {
+				log.WithFields(s.logFields).Debugf("worker #%v exited after being idle for %v", workNumber, pool.idleTimeout)
+				return
+			}
+		case <-pool.done():
+			log.WithFields(s.logFields).Debugf("worker #%v stopped", workNumber)
+			return
 		case <-ks:
 			log.WithFields(s.logFields).Debugf("worker #%v halted", workNumber)
 			return
@@ -227,7 +430,7 @@ func (s *Scanner) worker(ctx context.Context, queue chan *scanData, workNumber i
 	}
 }
 
-func (s *Scanner) handleScanData(ctx context.Context, data *scanData, ks chan bool) (*types.Job, error) {
+func (s *Scanner) handleScanData(ctx context.Context, data *scanData, summaryUpdates chan partialSummary, ks chan bool) (*types.Job, error) {
 	var job types.Job
 
 	scanResultStatus, err := s.backendClient.GetScanResultStatus(ctx, data.scanResultID)
@@ -242,35 +445,46 @@ func (s *Scanner) handleScanData(ctx context.Context, data *scanData, ks chan bo
 
 	switch state {
 	case models.INIT:
-		job, err = s.runJob(ctx, data)
+		job, err = s.runJobWithRetry(ctx, data)
 		if err != nil {
-			s.Lock()
 			data.success = false
 			data.completed = true
-			s.Unlock()
-			return nil, fmt.Errorf("failed to run scan job for target %s: %v", data.targetInstance.TargetID, err)
+			data.budgetExhausted = errors.Is(err, ErrBudgetExhausted)
+			return nil, fmt.Errorf("failed to run scan job for target %s: %w", data.targetInstance.TargetID, err)
 		}
-		fallthrough
 	case models.ATTACHED, models.INPROGRESS, models.ABORTED:
-		s.waitForResult(ctx, data, ks)
-		if data.timeout {
-			return nil, fmt.Errorf("scan job for target %s timed out: %v", data.targetInstance.TargetID, err)
+		// The job was launched before the orchestrator was restarted,
+		// restore it so that it is cleaned up once it completes.
+		job, err = s.restoreJob(ctx, data)
+		if err != nil {
+			log.WithFields(s.logFields).Warnf("Failed to restore the job of target %s, it is left to the orphan reaper: %v",
+				data.targetInstance.TargetID, err)
 		}
 	case models.DONE, models.NOTSCANNED:
+		data.completed = true
+		return &job, nil
+	}
+
+	s.waitForResult(ctx, data, summaryUpdates, ks)
+	if data.timeout {
+		return nil, fmt.Errorf("scan job for target %s timed out: %v", data.targetInstance.TargetID, err)
 	}
 
 	return &job, nil
 }
 
 // nolint:cyclop
-func (s *Scanner) waitForResult(ctx context.Context, data *scanData, ks chan bool) {
+func (s *Scanner) waitForResult(ctx context.Context, data *scanData, summaryUpdates chan partialSummary, ks chan bool) {
 	log.WithFields(s.logFields).Infof("Waiting for result. targetID=%+v", data.targetInstance.TargetID)
-	timer := time.NewTicker(s.config.JobResultsPollingInterval)
+	pollingBackOff := s.newResultPollingBackOff()
+	timer := time.NewTimer(pollingBackOff.NextBackOff())
 	defer timer.Stop()
 
-	ctx, cancel := context.WithTimeout(ctx, s.config.JobResultTimeout)
+	jobTimeout := s.getJobTimeout()
+	ctx, cancel := context.WithTimeout(ctx, jobTimeout)
 	defer cancel()
 
+	var lastState models.TargetScanStateState
 	for {
 		select {
 		case <-timer.C:
@@ -279,6 +493,7 @@ func (s *Scanner) waitForResult(ctx context.Context, data *scanData, ks chan boo
 			scanResultStatus, err := s.backendClient.GetScanResultStatus(ctx, data.scanResultID)
 			if err != nil {
 				log.WithFields(s.logFields).Errorf("Failed to get target scan status. scanID=%v, target id=%s: %v", s.scanID, data.targetInstance.TargetID, err)
+				timer.Reset(pollingBackOff.NextBackOff())
 				break
 			}
 
@@ -287,29 +502,37 @@ func (s *Scanner) waitForResult(ctx context.Context, data *scanData, ks chan boo
 				log.WithFields(s.logFields).Errorf("Cannot determine state of ScanResult with id %s", data.scanResultID)
 			}
 
+			// Poll more often again once the job makes progress.
+			if state != lastState {
+				pollingBackOff.Reset()
+				lastState = state
+			}
+			timer.Reset(pollingBackOff.NextBackOff())
+
 			switch state {
 			case models.INIT, models.ATTACHED, models.INPROGRESS:
 				log.WithFields(s.logFields).Infof("Scan for target is still running. scan result id=%v, scan id=%v, target id=%s, state=%v",
 					data.scanResultID, s.scanID, data.targetInstance.TargetID, state)
+				s.reportPartialSummary(ctx, data, scanResultStatus, summaryUpdates, ks)
 			case models.ABORTED:
 				log.WithFields(s.logFields).Infof("Scan for target is aborted. Waiting for partial results to be reported back. scan result id=%v, scan id=%v, target id=%s, state=%v",
 					data.scanResultID, s.scanID, data.targetInstance.TargetID, state)
+				s.reportPartialSummary(ctx, data, scanResultStatus, summaryUpdates, ks)
 			case models.DONE, models.NOTSCANNED:
 				log.WithFields(s.logFields).Infof("Scan for target is completed. scan result id=%v, scan id=%v, target id=%s, state=%v",
 					data.scanResultID, s.scanID, data.targetInstance.TargetID, state)
-				s.Lock()
+				// Notify before returning, so that the target events are
+				// sent before the scan completion event.
+				s.notifyTargetScanCompleted(ctx, data)
 				data.success = !scanStatusHasErrors(scanResultStatus)
 				data.completed = true
-				s.Unlock()
 				return
 			}
 		case <-ctx.Done():
-			log.WithFields(s.logFields).Infof("Job has timed out. targetID=%v", data.targetInstance.TargetID)
-			s.Lock()
+			log.WithFields(s.logFields).Infof("Job has timed out after %s. targetID=%v", jobTimeout, data.targetInstance.TargetID)
 			data.success = false
 			data.completed = true
 			data.timeout = true
-			s.Unlock()
 			return
 		case <-ks:
 			log.WithFields(s.logFields).Infof("Instance scan was canceled. targetID=%v", data.targetInstance.TargetID)
@@ -318,6 +541,61 @@ func (s *Scanner) waitForResult(ctx context.Context, data *scanData, ks chan boo
 	}
 }
 
+// reportPartialSummary sends the findings of the target's completed families
+// to jobBatchManagement when more families have completed since the last report.
+func (s *Scanner) reportPartialSummary(ctx context.Context, data *scanData, status *models.TargetScanStatus, summaryUpdates chan partialSummary, ks chan bool) {
+	completedFamilies := countCompletedFamilies(status)
+	if completedFamilies <= data.completedFamilies {
+		return
+	}
+
+	scanResultSummary, err := s.backendClient.GetScanResultSummary(ctx, data.scanResultID)
+	if err != nil {
+		log.WithFields(s.logFields).Errorf("Failed to get target scan summary. scanID=%v, target id=%s: %v", s.scanID, data.targetInstance.TargetID, err)
+		return
+	}
+
+	select {
+	case summaryUpdates <- partialSummary{
+		targetID: data.targetInstance.TargetID,
+		summary:  completedFamiliesSummary(status, scanResultSummary),
+	}:
+		data.completedFamilies = completedFamilies
+	case <-ks:
+	}
+}
+
+// newResultPollingBackOff returns the intervals to poll the results of a job
+// at, which grow exponentially up to JobResultsPollingMaxInterval if it's set.
+func (s *Scanner) newResultPollingBackOff() backoff.BackOff {
+	interval := s.config.JobResultsPollingInterval
+	if s.config.JobResultsPollingMaxInterval <= interval {
+		return backoff.NewConstantBackOff(interval)
+	}
+
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = interval
+	b.MaxInterval = s.config.JobResultsPollingMaxInterval
+	if s.config.JobResultsPollingMultiplier > 1 {
+		b.Multiplier = s.config.JobResultsPollingMultiplier
+	}
+	b.RandomizationFactor = 0
+	// Polling stops with the job timeout.
+	b.MaxElapsedTime = 0
+	b.Reset()
+
+	return b
+}
+
+// getJobTimeout returns the timeout of a scan job, the per scan config timeout
+// if set, otherwise the global job result timeout.
+func (s *Scanner) getJobTimeout() time.Duration {
+	if s.scanConfig != nil && s.scanConfig.ScanJobTimeoutSeconds != nil && *s.scanConfig.ScanJobTimeoutSeconds > 0 {
+		return time.Duration(*s.scanConfig.ScanJobTimeoutSeconds) * time.Second
+	}
+	return s.config.JobResultTimeout
+}
+
 func scanStatusHasErrors(status *models.TargetScanStatus) bool {
 	if status.General.Errors != nil && len(*status.General.Errors) > 0 {
 		return true
@@ -326,14 +604,102 @@ func scanStatusHasErrors(status *models.TargetScanStatus) bool {
 	return false
 }
 
+// runJobWithRetry runs the scanning job of the target, rerunning it with
+// fresh infrastructure with an exponential backoff on failure. A failed job
+// is torn down by runJob, so every attempt starts from scratch. Jobs aren't
+// rerun once the circuit breaker of the target's region is open or the
+// scanner instance hours budget of the scan is used up.
+func (s *Scanner) runJobWithRetry(ctx context.Context, data *scanData) (types.Job, error) {
+	var retryBackOff backoff.BackOff = &backoff.StopBackOff{}
+	if s.config.MaxJobRetries > 0 {
+		expBackOff := backoff.NewExponentialBackOff()
+		expBackOff.InitialInterval = s.config.JobRetryInterval
+		// The retries are bounded by the max retries.
+		expBackOff.MaxElapsedTime = 0
+		retryBackOff = backoff.WithMaxRetries(expBackOff, uint64(s.config.MaxJobRetries))
+	}
+	retryBackOff = backoff.WithContext(retryBackOff, ctx)
+
+	var job types.Job
+	var retries int
+	run := func() error {
+		var err error
+		job, err = s.runJob(ctx, data)
+		if errors.Is(err, circuitbreaker.ErrOpen) || errors.Is(err, ErrBudgetExhausted) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}
+	notify := func(err error, retryIn time.Duration) {
+		retries++
+		log.WithFields(s.logFields).Warnf("Failed to run scan job, retrying in %s (retry %d/%d). targetID=%v: %v",
+			retryIn, retries, s.config.MaxJobRetries, data.targetInstance.TargetID, err)
+	}
+	if err := backoff.RetryNotify(run, retryBackOff, notify); err != nil {
+		if retries > 0 {
+			return types.Job{}, fmt.Errorf("failed after %d retries, last error: %w", retries, err)
+		}
+		return types.Job{}, err
+	}
+
+	return job, nil
+}
+
+// runJob launches the scanning job of the target and attaches the snapshots
+// of its volumes to it. Launching fails fast with circuitbreaker.ErrOpen
+// while the circuit breaker of the target's region is open, and with
+// ErrBudgetExhausted once the scanner instance hours budget is used up.
+func (s *Scanner) runJob(ctx context.Context, data *scanData) (types.Job, error) {
+	instanceToScan := data.targetInstance.Instance
+
+	familiesConfiguration, err := s.generateFamiliesConfigurationYaml()
+	if err != nil {
+		return types.Job{}, fmt.Errorf("failed to generate scanner configuration yaml: %w", err)
+	}
+
+	if err := s.budget.Allow(); err != nil {
+		return types.Job{}, fmt.Errorf("failed to run scanner job for instance id %v: %w", instanceToScan.GetID(), err)
+	}
+
+	breaker := s.circuitBreakers.Get(instanceToScan.GetLocation())
+	if err := breaker.Allow(); err != nil {
+		return types.Job{}, fmt.Errorf("failed to run scanner job for instance id %v in %v: %w",
+			instanceToScan.GetID(), instanceToScan.GetLocation(), err)
+	}
+
+	job, err := s.launchJob(ctx, data, familiesConfiguration)
+	if ctx.Err() != nil {
+		// A canceled launch tells nothing about the health of the region.
+		breaker.Cancel()
+	} else {
+		breaker.Done(err)
+	}
+	if err != nil {
+		return types.Job{}, err
+	}
+
+	s.persistJobResources(ctx, data, job)
+
+	// mark attached state in the backend.
+	err = s.backendClient.PatchTargetScanStatus(ctx, data.scanResultID, &models.TargetScanStatus{
+		General: &models.TargetScanState{
+			State: runtimeScanUtils.PointerTo(models.ATTACHED),
+		},
+	})
+	if err != nil {
+		s.deleteJob(ctx, &job)
+		s.budget.Terminated(data.targetInstance.TargetID)
+		return types.Job{}, fmt.Errorf("failed to patch target scan status: %v", err)
+	}
+
+	return job, nil
+}
+
 // TODO: need to understand how to destroy the job in case the scanner dies until it gets the results
 // We can put the targetID on the scanner VM for easy deletion.
 // nolint:cyclop
-func (s *Scanner) runJob(ctx context.Context, data *scanData) (types.Job, error) {
+func (s *Scanner) launchJob(ctx context.Context, data *scanData, familiesConfiguration string) (types.Job, error) {
 	var launchInstance types.Instance
-	var launchSnapshot types.Snapshot
-	var cpySnapshot types.Snapshot
-	var snapshot types.Snapshot
 	var job types.Job
 	var err error
 
@@ -344,120 +710,543 @@ func (s *Scanner) runJob(ctx context.Context, data *scanData) (types.Job, error)
 	defer func() {
 		if err != nil {
 			s.deleteJob(ctx, &job)
+			s.budget.Terminated(data.targetInstance.TargetID)
 		}
 	}()
 
-	volume, err := instanceToScan.GetRootVolume(ctx)
-	if err != nil {
-		return types.Job{}, fmt.Errorf("failed to get root volume of an instance %v: %v", instanceToScan.GetID(), err)
+	jobInfo := types.JobInfo{
+		ScanID:       s.scanID,
+		ScanResultID: data.scanResultID,
+		TargetID:     data.targetInstance.TargetID,
 	}
 
-	snapshot, err = volume.TakeSnapshot(ctx)
+	volumes, err := s.getVolumesToScan(ctx, instanceToScan)
 	if err != nil {
-		return types.Job{}, fmt.Errorf("failed to take snapshot of a volume: %v", err)
-	}
-	job.SrcSnapshot = snapshot
-	launchSnapshot = snapshot
-
-	waitContext, waitCancel := context.WithTimeout(ctx, SnapshotCreationTimeout)
-	defer waitCancel()
-	if err = snapshot.WaitForReady(waitContext); err != nil {
-		return types.Job{}, fmt.Errorf("failed to wait for snapshot to be ready. snapshotID=%v: %v", snapshot.GetID(), err)
+		return types.Job{}, err
 	}
 
-	// we need the snapshot to be in the scanner region in order to create
-	// a volume and attach it.
-	if s.config.Region != snapshot.GetRegion() {
-		cpySnapshot, err = snapshot.Copy(ctx, s.config.Region)
-		if err != nil {
-			return types.Job{}, fmt.Errorf("failed to copy snapshot. snapshotID=%v: %v", snapshot.GetID(), err)
+	// we need the snapshots to be in the scanner region in order to create
+	// volumes and attach them. An existing snapshot provided by the scan
+	// config is used for the root volume instead of taking a new one.
+	existingSnapshotID := s.getExistingSnapshotID(instanceToScan.GetID())
+	for i, volume := range volumes {
+		var jobVolume types.JobVolume
+		if i == 0 && existingSnapshotID != "" {
+			jobVolume, err = s.existingSnapshotVolume(ctx, instanceToScan, existingSnapshotID, jobInfo)
+		} else {
+			jobVolume, err = s.snapshotVolume(ctx, volume, jobInfo)
 		}
-		job.DstSnapshot = cpySnapshot
-		launchSnapshot = cpySnapshot
-
-		// Copying snapshots between regions can take much longer than
-		// creating a snapshot normally
-		waitContext, waitCancel := context.WithTimeout(ctx, SnapshotCopyTimeout)
-		defer waitCancel()
-		if err = cpySnapshot.WaitForReady(waitContext); err != nil {
-			return types.Job{}, fmt.Errorf("failed to wait for snapshot to be ready. snapshotID=%v: %v", cpySnapshot.GetID(), err)
+		job.Volumes = append(job.Volumes, jobVolume)
+		if err != nil {
+			return types.Job{}, err
 		}
 	}
-
-	familiesConfiguration, err := s.generateFamiliesConfigurationYaml()
-	if err != nil {
-		return types.Job{}, fmt.Errorf("failed to generate scanner configuration yaml: %w", err)
+	if runtimeScanUtils.ValueOrZero(s.scanConfig.VerifySnapshotIntegrity) {
+		var checks []models.SnapshotIntegrityCheck
+		checks, err = s.verifySnapshotCopies(ctx, job.Volumes)
+		s.persistSnapshotIntegrity(ctx, data, checks)
+		if err != nil {
+			return types.Job{}, fmt.Errorf("failed to verify snapshot integrity: %w", err)
+		}
 	}
+	rootSnapshot := job.Volumes[0].LaunchSnapshot()
 
 	scanningJobConfig := provider.ScanningJobConfig{
 		ScannerImage:                  s.config.ScannerImage,
 		ScannerCLIConfig:              familiesConfiguration,
 		VMClarityAddress:              s.config.ScannerBackendAddress,
-		ScanResultID:                  data.scanResultID,
+		ScanID:                        jobInfo.ScanID,
+		ScanResultID:                  jobInfo.ScanResultID,
+		TargetID:                      jobInfo.TargetID,
 		KeyPairName:                   s.config.ScannerKeyPairName,
 		ScannerInstanceCreationConfig: s.scanConfig.ScannerInstanceCreationConfig,
+		PartitionsToScan:              runtimeScanUtils.ValueOrZero(s.scanConfig.PartitionsToScan),
+		InstanceType:                  s.getScannerInstanceType(ctx, rootSnapshot),
+		CABundle:                      s.config.CABundle,
+		ScannerProxy:                  scannerProxy(s.config, s.scanConfig.ScannerInstanceCreationConfig),
+		DirectReadVolumes:             s.directReadVolumes(ctx, job.Volumes),
 	}
-	launchInstance, err = s.providerClient.RunScanningJob(ctx, launchSnapshot.GetRegion(), launchSnapshot.GetID(), scanningJobConfig)
+	launchInstance, err = s.runScanningJobWithRetry(ctx, rootSnapshot, scanningJobConfig)
 	if err != nil {
 		return types.Job{}, fmt.Errorf("failed to launch a new instance: %v", err)
 	}
 	job.Instance = launchInstance
+	s.budget.Launched(data.targetInstance.TargetID)
 
-	// create a volume from the snapshot.
-	newVolume, err := launchSnapshot.CreateVolume(ctx, launchInstance.GetAvailabilityZone())
-	if err != nil {
-		return types.Job{}, fmt.Errorf("failed to create volume: %v", err)
+	// the scanner instance reads the snapshots by itself to the scratch
+	// volumes it was launched with, no volume is created nor attached.
+	if len(scanningJobConfig.DirectReadVolumes) > 0 {
+		return job, nil
+	}
+
+	// create the volumes from the snapshots.
+	volumeCreationDurations := make([]time.Duration, len(job.Volumes))
+	for i := range job.Volumes {
+		volumeStartTime := time.Now()
+		var newVolume types.Volume
+		err = s.providerLimiter.Do(ctx, func() error {
+			var createErr error
+			newVolume, createErr = job.Volumes[i].LaunchSnapshot().CreateVolume(ctx, launchInstance.GetAvailabilityZone())
+			return createErr
+		})
+		if err != nil {
+			return types.Job{}, fmt.Errorf("failed to create volume: %v", err)
+		}
+		job.Volumes[i].Volume = newVolume
+		volumeCreationDurations[i] = time.Since(volumeStartTime)
 	}
-	job.Volume = newVolume
 
 	// wait for instance to be in a running state.
-	if err := job.Instance.WaitForReady(ctx); err != nil {
+	if err = job.Instance.WaitForReady(ctx); err != nil {
 		return types.Job{}, fmt.Errorf("failed to wait for instance ready: %v", err)
 	}
 
-	// wait for volume to be available.
-	if err := newVolume.WaitForReady(ctx); err != nil {
-		return types.Job{}, fmt.Errorf("failed to wait for volume to be ready: %v", err)
+	deviceNames := newDeviceNameAllocator(s.config.DeviceName)
+	for i, jobVolume := range job.Volumes {
+		// wait for volume to be available.
+		volumeStartTime := time.Now()
+		if err = jobVolume.Volume.WaitForReady(ctx); err != nil {
+			return types.Job{}, fmt.Errorf("failed to wait for volume to be ready: %v", err)
+		}
+		// The wait for the instance in between isn't part of the volume
+		// creation.
+		volumeCreationDurations[i] += time.Since(volumeStartTime)
+		s.metrics.volumeCreationDuration.Observe(volumeCreationDurations[i].Seconds())
+
+		// attach the volume to the scanning job instance.
+		var deviceName string
+		deviceName, err = deviceNames.Allocate()
+		if err != nil {
+			return types.Job{}, fmt.Errorf("failed to attach volume: %v", err)
+		}
+		err = s.providerLimiter.Do(ctx, func() error {
+			return launchInstance.AttachVolume(ctx, jobVolume.Volume, deviceName)
+		})
+		if err != nil {
+			return types.Job{}, fmt.Errorf("failed to attach volume: %v", err)
+		}
+
+		// wait for the volume to be attached.
+		if err = jobVolume.Volume.WaitForAttached(ctx); err != nil {
+			return types.Job{}, fmt.Errorf("failed to wait for volume attached: %v", err)
+		}
+	}
+
+	return job, nil
+}
+
+// getScannerInstanceType returns the instance type of the scanner instance
+// according to the size of the snapshotted volume, or an empty string to use
+// the provider's configured instance type.
+func (s *Scanner) getScannerInstanceType(ctx context.Context, snapshot types.Snapshot) string {
+	creationConfig := s.scanConfig.ScannerInstanceCreationConfig
+	if creationConfig == nil || creationConfig.InstanceTypesByVolumeSize == nil || len(*creationConfig.InstanceTypesByVolumeSize) == 0 {
+		return ""
 	}
 
-	// attach the volume to the scanning job instance.
-	err = launchInstance.AttachVolume(ctx, newVolume, s.config.DeviceName)
+	size, err := snapshot.GetSize(ctx)
 	if err != nil {
-		return types.Job{}, fmt.Errorf("failed to attach volume: %v", err)
+		log.WithFields(s.logFields).Warningf("Using the default scanner instance type, failed to get snapshot size. snapshotID=%v: %v", snapshot.GetID(), err)
+		return ""
 	}
 
-	// wait for the volume to be attached.
-	if err := newVolume.WaitForAttached(ctx); err != nil {
-		return types.Job{}, fmt.Errorf("failed to wait for volume attached: %v", err)
+	return instanceTypeForVolumeSize(*creationConfig.InstanceTypesByVolumeSize, size)
+}
+
+// instanceTypeForVolumeSize returns the instance type of the entry with the
+// largest minimum volume size which isn't larger than sizeGB, or an empty
+// string if there is no such entry.
+func instanceTypeForVolumeSize(instanceTypes []models.ScannerInstanceTypeByVolumeSize, sizeGB int64) string {
+	var instanceType string
+	var minVolumeSizeGB int64 = -1
+	for _, t := range instanceTypes {
+		if t.MinVolumeSizeGB <= sizeGB && t.MinVolumeSizeGB > minVolumeSizeGB {
+			instanceType = t.InstanceType
+			minVolumeSizeGB = t.MinVolumeSizeGB
+		}
 	}
+	return instanceType
+}
 
-	// mark attached state in the backend.
-	err = s.backendClient.PatchTargetScanStatus(ctx, data.scanResultID, &models.TargetScanStatus{
-		General: &models.TargetScanState{
-			State: runtimeScanUtils.PointerTo(models.ATTACHED),
-		},
+// scannerProxy returns the proxy the scanner instance egresses through: each
+// field set in the proxy of the scanner instance creation config overrides the
+// one of the orchestrator config. It returns nil if no proxy is configured.
+func scannerProxy(scannerConfig *config.ScannerConfig, creationConfig *models.ScannerInstanceCreationConfig) *models.ScannerProxyConfig {
+	httpProxy, httpsProxy, noProxy := scannerConfig.ScannerHTTPProxy, scannerConfig.ScannerHTTPSProxy, scannerConfig.ScannerNoProxy
+	if creationConfig != nil && creationConfig.Proxy != nil {
+		if p := creationConfig.Proxy.HttpProxy; p != nil && *p != "" {
+			httpProxy = *p
+		}
+		if p := creationConfig.Proxy.HttpsProxy; p != nil && *p != "" {
+			httpsProxy = *p
+		}
+		if p := creationConfig.Proxy.NoProxy; p != nil && *p != "" {
+			noProxy = *p
+		}
+	}
+
+	if httpProxy == "" && httpsProxy == "" {
+		return nil
+	}
+
+	return &models.ScannerProxyConfig{
+		HttpProxy:  runtimeScanUtils.StringPtr(httpProxy),
+		HttpsProxy: runtimeScanUtils.StringPtr(httpsProxy),
+		NoProxy:    runtimeScanUtils.StringPtr(noProxy),
+	}
+}
+
+// directReadVolumes returns the scratch volumes the scanner instance reads the
+// snapshots of the job volumes directly to, the root volume first, when it can
+// read all of them directly. It returns nil to fall back to attaching volumes
+// created from the snapshots.
+func (s *Scanner) directReadVolumes(ctx context.Context, jobVolumes []types.JobVolume) []provider.DirectReadVolume {
+	if !s.config.SnapshotDirectRead {
+		return nil
+	}
+
+	deviceNames := newDeviceNameAllocator(s.config.DeviceName)
+	volumes := make([]provider.DirectReadVolume, 0, len(jobVolumes))
+	for _, jobVolume := range jobVolumes {
+		snapshot := jobVolume.LaunchSnapshot()
+		directReadSnapshot, ok := snapshot.(types.DirectReadSnapshot)
+		if !ok {
+			log.WithFields(s.logFields).Infof("Attaching volumes, the provider doesn't support reading snapshots directly. snapshotID=%v", snapshot.GetID())
+			return nil
+		}
+		supported, err := directReadSnapshot.SupportsDirectRead(ctx)
+		if err != nil {
+			log.WithFields(s.logFields).Warningf("Attaching volumes, failed to check whether the snapshot can be read directly. snapshotID=%v: %v", snapshot.GetID(), err)
+			return nil
+		}
+		if !supported {
+			log.WithFields(s.logFields).Infof("Attaching volumes, the snapshot can't be read directly. snapshotID=%v", snapshot.GetID())
+			return nil
+		}
+		size, err := snapshot.GetSize(ctx)
+		if err != nil {
+			log.WithFields(s.logFields).Warningf("Attaching volumes, failed to get the size of the snapshot. snapshotID=%v: %v", snapshot.GetID(), err)
+			return nil
+		}
+		deviceName, err := deviceNames.Allocate()
+		if err != nil {
+			log.WithFields(s.logFields).Warningf("Attaching volumes, failed to allocate a device name for the scratch volume of the snapshot. snapshotID=%v: %v", snapshot.GetID(), err)
+			return nil
+		}
+		volumes = append(volumes, provider.DirectReadVolume{
+			SnapshotID: snapshot.GetID(),
+			Size:       size,
+			DeviceName: deviceName,
+		})
+	}
+
+	return volumes
+}
+
+// getVolumesToScan returns the volumes of the instance to scan, the root
+// volume first. Only the root volume is scanned unless the scan config opts
+// into scanning all the volumes.
+func (s *Scanner) getVolumesToScan(ctx context.Context, instance types.Instance) ([]types.Volume, error) {
+	if !runtimeScanUtils.ValueOrZero(s.scanConfig.ScanAllVolumes) {
+		volume, err := instance.GetRootVolume(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get root volume of an instance %v: %v", instance.GetID(), err)
+		}
+		return []types.Volume{volume}, nil
+	}
+
+	volumes, err := instance.GetVolumes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volumes of an instance %v: %v", instance.GetID(), err)
+	}
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("no volumes were found for instance %v", instance.GetID())
+	}
+	return volumes, nil
+}
+
+// snapshotVolume takes a snapshot of the volume and copies it to the scanner
+// region if needed. The returned job volume holds the snapshots which were
+// created, also when an error is returned.
+func (s *Scanner) snapshotVolume(ctx context.Context, volume types.Volume, jobInfo types.JobInfo) (types.JobVolume, error) {
+	var jobVolume types.JobVolume
+
+	snapshotStartTime := time.Now()
+	var snapshot types.Snapshot
+	err := s.providerLimiter.Do(ctx, func() error {
+		var takeErr error
+		snapshot, takeErr = volume.TakeSnapshot(ctx, jobInfo)
+		return takeErr
 	})
 	if err != nil {
-		return types.Job{}, fmt.Errorf("failed to patch target scan status: %v", err)
+		return jobVolume, fmt.Errorf("failed to take snapshot of a volume: %v", err)
 	}
+	jobVolume.SrcSnapshot = snapshot
 
-	return job, nil
+	waitContext, waitCancel := context.WithTimeout(ctx, SnapshotCreationTimeout)
+	defer waitCancel()
+	if err = snapshot.WaitForReady(waitContext); err != nil {
+		return jobVolume, fmt.Errorf("failed to wait for snapshot to be ready. snapshotID=%v: %v", snapshot.GetID(), err)
+	}
+	s.metrics.snapshotCreationDuration.Observe(time.Since(snapshotStartTime).Seconds())
+
+	return s.copyToScannerRegionIfNeeded(ctx, jobVolume)
+}
+
+// existingSnapshotVolume uses the existing snapshot of the instance's root
+// volume instead of taking one, and copies it to the scanner region if
+// needed. The existing snapshot is ready, so it isn't waited for.
+func (s *Scanner) existingSnapshotVolume(ctx context.Context, instance types.Instance, snapshotID string, jobInfo types.JobInfo) (types.JobVolume, error) {
+	jobVolume := types.JobVolume{
+		ExistingSnapshot: true,
+	}
+
+	var snapshot types.Snapshot
+	err := s.providerLimiter.Do(ctx, func() error {
+		var getErr error
+		snapshot, getErr = s.providerClient.GetSnapshot(ctx, instance.GetLocation(), snapshotID, jobInfo)
+		return getErr
+	})
+	if err != nil {
+		return jobVolume, fmt.Errorf("failed to get existing snapshot. snapshotID=%v: %v", snapshotID, err)
+	}
+	jobVolume.SrcSnapshot = snapshot
+
+	return s.copyToScannerRegionIfNeeded(ctx, jobVolume)
+}
+
+// copyToScannerRegionIfNeeded copies the source snapshot of the job volume to
+// the scanner region if it is in another region.
+func (s *Scanner) copyToScannerRegionIfNeeded(ctx context.Context, jobVolume types.JobVolume) (types.JobVolume, error) {
+	snapshot := jobVolume.SrcSnapshot
+	if s.config.Region != snapshot.GetRegion() {
+		cpySnapshot, err := s.copySnapshotWithRetry(ctx, snapshot)
+		if err != nil {
+			return jobVolume, fmt.Errorf("failed to copy snapshot. snapshotID=%v: %v", snapshot.GetID(), err)
+		}
+		jobVolume.DstSnapshot = cpySnapshot
+	}
+
+	return jobVolume, nil
+}
+
+// getExistingSnapshotID returns the ID of the existing snapshot which the
+// scan config provides for the instance, or an empty string if none.
+func (s *Scanner) getExistingSnapshotID(instanceID string) string {
+	for _, snapshot := range runtimeScanUtils.ValueOrZero(s.scanConfig.ExistingSnapshots) {
+		if snapshot.InstanceID == instanceID {
+			return snapshot.SnapshotID
+		}
+	}
+	return ""
+}
+
+// deviceNameAllocator allocates the device names to attach the volumes to a
+// scanner instance with. The first volume is attached with the configured
+// device name and the other volumes with the following free ones, for example
+// xvdh, xvdi, xvdj.
+type deviceNameAllocator struct {
+	deviceName string
+	// used are the device names which were allocated or are known to be
+	// in use on the scanner instance.
+	used map[string]bool
+}
+
+func newDeviceNameAllocator(deviceName string, used ...string) *deviceNameAllocator {
+	allocator := &deviceNameAllocator{
+		deviceName: deviceName,
+		used:       make(map[string]bool, len(used)),
+	}
+	for _, name := range used {
+		allocator.used[name] = true
+	}
+
+	return allocator
+}
+
+// Allocate returns the next free device name.
+func (a *deviceNameAllocator) Allocate() (string, error) {
+	if !a.used[a.deviceName] {
+		a.used[a.deviceName] = true
+		return a.deviceName, nil
+	}
+
+	if a.deviceName == "" {
+		return "", fmt.Errorf("no device name is configured")
+	}
+	prefix, first := a.deviceName[:len(a.deviceName)-1], a.deviceName[len(a.deviceName)-1]
+	if first < 'a' || first > 'z' {
+		return "", fmt.Errorf("no device name follows %q", a.deviceName)
+	}
+
+	for last := first + 1; last <= 'z'; last++ {
+		name := prefix + string(last)
+		if !a.used[name] {
+			a.used[name] = true
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("no free device name follows %q", a.deviceName)
+}
+
+// runScanningJobWithRetry launches the scanning job, retrying with an
+// exponential backoff on failure. The provider doesn't launch a new scanner
+// instance if one already exists for the scan result, so retrying after a
+// failure that happened post launch doesn't create a duplicate.
+func (s *Scanner) runScanningJobWithRetry(ctx context.Context, snapshot types.Snapshot, config provider.ScanningJobConfig) (types.Instance, error) {
+	var retryBackOff backoff.BackOff = &backoff.StopBackOff{}
+	if s.config.ScanningJobLaunchMaxAttempts > 1 {
+		expBackOff := backoff.NewExponentialBackOff()
+		expBackOff.InitialInterval = s.config.ScanningJobLaunchRetryInterval
+		// The retries are bounded by the max attempts.
+		expBackOff.MaxElapsedTime = 0
+		retryBackOff = backoff.WithMaxRetries(expBackOff, uint64(s.config.ScanningJobLaunchMaxAttempts-1))
+	}
+	retryBackOff = backoff.WithContext(retryBackOff, ctx)
+
+	var instance types.Instance
+	launch := func() error {
+		return s.providerLimiter.Do(ctx, func() error { // nolint:wrapcheck
+			var err error
+			instance, err = s.providerClient.RunScanningJob(ctx, snapshot.GetRegion(), snapshot.GetID(), config)
+			return err // nolint:wrapcheck
+		})
+	}
+	notify := func(err error, retryIn time.Duration) {
+		log.Warnf("Failed to launch scanning job, retrying in %s. scanResultID=%v: %v", retryIn, config.ScanResultID, err)
+	}
+	if err := backoff.RetryNotify(launch, retryBackOff, notify); err != nil {
+		return nil, err // nolint:wrapcheck
+	}
+
+	return instance, nil
+}
+
+// copySnapshotWithRetry copies the snapshot to the scanner region and waits
+// for the copy to be ready, retrying with an exponential backoff on failure.
+// All the attempts together are bounded by SnapshotCopyTimeout. The copy of a
+// failed attempt is deleted before retrying.
+func (s *Scanner) copySnapshotWithRetry(ctx context.Context, snapshot types.Snapshot) (types.Snapshot, error) {
+	// Copying snapshots between regions can take much longer than
+	// creating a snapshot normally
+	copyContext, copyCancel := context.WithTimeout(ctx, SnapshotCopyTimeout)
+	defer copyCancel()
+
+	var retryBackOff backoff.BackOff = &backoff.StopBackOff{}
+	if s.config.SnapshotCopyRetries > 0 {
+		expBackOff := backoff.NewExponentialBackOff()
+		expBackOff.InitialInterval = s.config.SnapshotCopyRetryInterval
+		// The retries are bounded by the number of retries and the copy timeout.
+		expBackOff.MaxElapsedTime = 0
+		retryBackOff = backoff.WithMaxRetries(expBackOff, uint64(s.config.SnapshotCopyRetries))
+	}
+	retryBackOff = backoff.WithContext(retryBackOff, copyContext)
+
+	var cpySnapshot types.Snapshot
+	copySnapshot := func() error {
+		err := s.providerLimiter.Do(copyContext, func() error {
+			var copyErr error
+			cpySnapshot, copyErr = snapshot.Copy(copyContext, s.config.Region)
+			return copyErr // nolint:wrapcheck
+		})
+		if err != nil {
+			return err // nolint:wrapcheck
+		}
+
+		if err = cpySnapshot.WaitForReady(copyContext); err != nil {
+			if deleteErr := cpySnapshot.Delete(ctx); deleteErr != nil {
+				log.WithFields(s.logFields).Errorf("Failed to delete snapshot copy. snapshotID=%v: %v", cpySnapshot.GetID(), deleteErr)
+			}
+			return fmt.Errorf("failed to wait for snapshot to be ready. snapshotID=%v: %w", cpySnapshot.GetID(), err)
+		}
+		return nil
+	}
+	notify := func(err error, retryIn time.Duration) {
+		log.WithFields(s.logFields).Warnf("Failed to copy snapshot, retrying in %s. snapshotID=%v: %v", retryIn, snapshot.GetID(), err)
+	}
+	if err := backoff.RetryNotify(copySnapshot, retryBackOff, notify); err != nil {
+		return nil, err // nolint:wrapcheck
+	}
+
+	return cpySnapshot, nil
 }
 
 func (s *Scanner) generateFamiliesConfigurationYaml() (string, error) {
+	commandOptions := s.scanConfig.ScanFamiliesConfig.ScannerCommandOptions
 	famConfig := families.Config{
-		SBOM:            userSBOMConfigToFamiliesSbomConfig(s.scanConfig.ScanFamiliesConfig.Sbom),
-		Vulnerabilities: userVulnConfigToFamiliesVulnConfig(s.scanConfig.ScanFamiliesConfig.Vulnerabilities, s.config.TrivyServerAddress, s.config.GrypeServerAddress),
-		Secrets:         userSecretsConfigToFamiliesSecretsConfig(s.scanConfig.ScanFamiliesConfig.Secrets, s.config.GitleaksBinaryPath),
-		Exploits:        userExploitsConfigToFamiliesExploitsConfig(s.scanConfig.ScanFamiliesConfig.Exploits, s.config.ExploitsDBAddress),
+		SBOM: userSBOMConfigToFamiliesSbomConfig(s.scanConfig.ScanFamiliesConfig.Sbom),
+		Vulnerabilities: userVulnConfigToFamiliesVulnConfig(
+			s.scanConfig.ScanFamiliesConfig.Vulnerabilities,
+			s.config.TrivyServerAddress,
+			s.config.TrivyServerToken,
+			s.config.GrypeServerAddress,
+			s.config.GrypeServerTimeout,
+			s.config.GrypeDB,
+			osvconfig.Config{
+				CommandOptions: scannerCommandOptions(commandOptions, "osv"),
+				BinaryPath:     s.config.OSVScannerBinaryPath,
+			},
+			s.config.VulnerabilityAliasesSource,
+		),
+		Secrets: userSecretsConfigToFamiliesSecretsConfig(
+			s.scanConfig.ScanFamiliesConfig.Secrets,
+			gitleaksconfig.Config{
+				CommandOptions: scannerCommandOptions(commandOptions, "gitleaks"),
+				BinaryPath:     s.config.GitleaksBinaryPath,
+			},
+			trufflehogconfig.Config{
+				CommandOptions: scannerCommandOptions(commandOptions, "trufflehog"),
+				BinaryPath:     s.config.TrufflehogBinaryPath,
+			},
+		),
+		Exploits: userExploitsConfigToFamiliesExploitsConfig(
+			s.scanConfig.ScanFamiliesConfig.Exploits,
+			exploitdbConfig.Config{
+				BaseURL:     s.config.ExploitsDBAddress,
+				LocalDBPath: s.config.ExploitsDBLocalPath,
+			},
+		),
 		Malware: userMalwareConfigToFamiliesMalwareConfig(
 			s.scanConfig.ScanFamiliesConfig.Malware,
-			s.config.ClamBinaryPath,
-			s.config.FreshclamBinaryPath,
-			s.config.AlternativeFreshclamMirrorURL,
+			malwareconfig.Config{
+				CommandOptions:                scannerCommandOptions(commandOptions, "clam"),
+				ClamScanBinaryPath:            s.config.ClamBinaryPath,
+				FreshclamBinaryPath:           s.config.FreshclamBinaryPath,
+				AlternativeFreshclamMirrorURL: s.config.AlternativeFreshclamMirrorURL,
+			},
+			yaraconfig.Config{
+				CommandOptions: scannerCommandOptions(commandOptions, "yara"),
+				BinaryPath:     s.config.YaraBinaryPath,
+				RulesPath:      s.config.YaraRulesPath,
+				RulesURL:       s.config.YaraRulesURL,
+			},
+		),
+		Misconfiguration: userMisconfigurationConfigToFamiliesMisconfigurationConfig(
+			s.scanConfig.ScanFamiliesConfig.Misconfigurations,
+			misconfigurationTypes.LynisConfig{
+				CommandOptions: scannerCommandOptions(commandOptions, "lynis"),
+				InstallPath:    s.config.LynisInstallPath,
+			},
+			misconfigurationTypes.KICSConfig{
+				CommandOptions:    scannerCommandOptions(commandOptions, "kics"),
+				BinaryPath:        s.config.KICSBinaryPath,
+				QueriesPath:       s.config.KICSQueriesPath,
+				SeverityThreshold: s.config.KICSSeverityThreshold,
+			},
 		),
-		Misconfiguration: userMisconfigurationConfigToFamiliesMisconfigurationConfig(s.scanConfig.ScanFamiliesConfig.Misconfigurations, s.config.LynisInstallPath),
-		Rootkits:         userRootkitsConfigToFamiliesRootkitsConfig(s.scanConfig.ScanFamiliesConfig.Rootkits, s.config.ChkrootkitBinaryPath),
+		Rootkits: userRootkitsConfigToFamiliesRootkitsConfig(
+			s.scanConfig.ScanFamiliesConfig.Rootkits,
+			chkrootkitConfig.Config{
+				CommandOptions: scannerCommandOptions(commandOptions, "chkrootkit"),
+				BinaryPath:     s.config.ChkrootkitBinaryPath,
+			},
+			rkhunterConfig.Config{
+				CommandOptions: scannerCommandOptions(commandOptions, "rkhunter"),
+				BinaryPath:     s.config.RkhunterBinaryPath,
+			},
+		),
+		MaxParallelScannersPerFamily: runtimeScanUtils.ValueOrZero(s.scanConfig.ScanFamiliesConfig.MaxParallelScannersPerFamily),
 	}
 
 	famConfigYaml, err := yaml.Marshal(famConfig)
@@ -465,39 +1254,83 @@ func (s *Scanner) generateFamiliesConfigurationYaml() (string, error) {
 		return "", fmt.Errorf("failed to marshal families config to yaml: %w", err)
 	}
 
+	if overlay := s.scanConfig.ScanFamiliesConfig.ScannerConfigOverlay; overlay != nil && *overlay != "" {
+		famConfigYaml, err = applyFamiliesConfigOverlay(famConfigYaml, []byte(*overlay))
+		if err != nil {
+			return "", fmt.Errorf("failed to apply scanner config overlay: %w", err)
+		}
+	}
+
+	if log.IsLevelEnabled(log.DebugLevel) {
+		// The config may hold credentials, only log it redacted.
+		if redacted, err := families.RedactConfigYAML(famConfigYaml); err == nil {
+			log.Debugf("Generated families config:\n%s", redacted)
+		}
+	}
+
 	return string(famConfigYaml), nil
 }
 
-func userRootkitsConfigToFamiliesRootkitsConfig(rootkitsConfig *models.RootkitsConfig, chkRootkitBinaryPath string) rootkits.Config {
+// generateRedactedFamiliesConfigurationYaml returns the families
+// configuration of the scanner jobs with the credentials redacted, so that it
+// can be persisted.
+func (s *Scanner) generateRedactedFamiliesConfigurationYaml() (string, error) {
+	familiesConfiguration, err := s.generateFamiliesConfigurationYaml()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate scanner configuration yaml: %w", err)
+	}
+	redacted, err := families.RedactConfigYAML([]byte(familiesConfiguration))
+	if err != nil {
+		return "", fmt.Errorf("failed to redact scanner configuration yaml: %w", err)
+	}
+
+	return string(redacted), nil
+}
+
+func userRootkitsConfigToFamiliesRootkitsConfig(
+	rootkitsConfig *models.RootkitsConfig,
+	chkrootkitScannerConfig chkrootkitConfig.Config,
+	rkhunterScannerConfig rkhunterConfig.Config,
+) rootkits.Config {
 	if rootkitsConfig == nil || rootkitsConfig.Enabled == nil || !*rootkitsConfig.Enabled {
 		return rootkits.Config{}
 	}
 
+	scannersList := rootkits.DefaultScanners
+	if rootkitsConfig.ScannersList != nil && len(*rootkitsConfig.ScannersList) > 0 {
+		scannersList = *rootkitsConfig.ScannersList
+	}
+
 	return rootkits.Config{
 		Enabled:      true,
-		ScannersList: []string{"chkrootkit"},
+		ScannersList: scannersList,
 		Inputs:       nil,
 		ScannersConfig: &rootkitsCommon.ScannersConfig{
-			Chkrootkit: chkrootkitConfig.Config{
-				BinaryPath: chkRootkitBinaryPath,
-			},
+			Chkrootkit: chkrootkitScannerConfig,
+			Rkhunter:   rkhunterScannerConfig,
 		},
 	}
 }
 
-func userSecretsConfigToFamiliesSecretsConfig(secretsConfig *models.SecretsConfig, gitleaksBinaryPath string) secrets.Config {
+func userSecretsConfigToFamiliesSecretsConfig(
+	secretsConfig *models.SecretsConfig,
+	gitleaksConfig gitleaksconfig.Config,
+	trufflehogConfig trufflehogconfig.Config,
+) secrets.Config {
 	if secretsConfig == nil || secretsConfig.Enabled == nil || !*secretsConfig.Enabled {
 		return secrets.Config{}
 	}
+	scannersList := secrets.DefaultScanners
+	if secretsConfig.ScannersList != nil && len(*secretsConfig.ScannersList) > 0 {
+		scannersList = *secretsConfig.ScannersList
+	}
 	return secrets.Config{
-		Enabled: true,
-		// TODO(idanf) This choice should come from the user's configuration
-		ScannersList: []string{"gitleaks"},
+		Enabled:      true,
+		ScannersList: scannersList,
 		Inputs:       nil, // rootfs directory will be determined by the CLI after mount.
 		ScannersConfig: &common.ScannersConfig{
-			Gitleaks: gitleaksconfig.Config{
-				BinaryPath: gitleaksBinaryPath,
-			},
+			Gitleaks:   gitleaksConfig,
+			Trufflehog: trufflehogConfig,
 		},
 	}
 }
@@ -506,43 +1339,72 @@ func userSBOMConfigToFamiliesSbomConfig(sbomConfig *models.SBOMConfig) familiesS
 	if sbomConfig == nil || sbomConfig.Enabled == nil || !*sbomConfig.Enabled {
 		return familiesSbom.Config{}
 	}
+	analyzersList := familiesSbom.DefaultAnalyzers
+	if sbomConfig.AnalyzersList != nil && len(*sbomConfig.AnalyzersList) > 0 {
+		analyzersList = *sbomConfig.AnalyzersList
+	}
+	var packageManagerHints []string
+	if hints := sbomConfig.PackageManagerHints; hints != nil {
+		var err error
+		packageManagerHints, err = familiesSbom.ResolvePackageManagerHints(runtimeScanUtils.ValueOrZero(hints.Distro), runtimeScanUtils.ValueOrZero(hints.PackageManagers))
+		if err != nil {
+			// The hints are validated by the backend, so this is not expected.
+			log.Warnf("Ignoring invalid package manager hints: %v", err)
+		}
+	}
 	return familiesSbom.Config{
-		Enabled: true,
-		// TODO(sambetts) This choice should come from the user's configuration
-		AnalyzersList: []string{"syft", "trivy"},
+		Enabled:       true,
+		AnalyzersList: analyzersList,
 		Inputs:        nil, // rootfs directory will be determined by the CLI after mount.
 		AnalyzersConfig: &kubeclarityConfig.Config{
-			// TODO(sambetts) The user needs to be able to provide this configuration
-			Registry: &kubeclarityConfig.Registry{},
+			Registry: userRegistryConfigToKubeclarityRegistry(sbomConfig.Registry),
 			Analyzer: &kubeclarityConfig.Analyzer{
 				OutputFormat: "cyclonedx",
 				TrivyConfig: kubeclarityConfig.AnalyzerTrivyConfig{
-					Timeout: TrivyTimeout,
+					Timeout: trivyTimeoutOrDefault(sbomConfig.TrivyTimeoutSeconds),
 				},
 			},
 		},
+		RegistryAuths:       userRegistryConfigToFamiliesRegistryAuths(sbomConfig.Registry),
+		PackageManagerHints: packageManagerHints,
 	}
 }
 
-func userMisconfigurationConfigToFamiliesMisconfigurationConfig(misconfigurationConfig *models.MisconfigurationsConfig, lynisInstallPath string) misconfigurationTypes.Config {
+func userMisconfigurationConfigToFamiliesMisconfigurationConfig(
+	misconfigurationConfig *models.MisconfigurationsConfig,
+	lynisConfig misconfigurationTypes.LynisConfig,
+	kicsConfig misconfigurationTypes.KICSConfig,
+) misconfigurationTypes.Config {
 	if misconfigurationConfig == nil || misconfigurationConfig.Enabled == nil || !*misconfigurationConfig.Enabled {
 		return misconfigurationTypes.Config{}
 	}
+
+	scannersList := misconfigurationTypes.DefaultScanners
+	if misconfigurationConfig.ScannersList != nil && len(*misconfigurationConfig.ScannersList) > 0 {
+		scannersList = *misconfigurationConfig.ScannersList
+	}
+
 	return misconfigurationTypes.Config{
-		Enabled: true,
-		// TODO(sambetts) This choice should come from the user's configuration
-		ScannersList: []string{"lynis"},
+		Enabled:      true,
+		ScannersList: scannersList,
 		Inputs:       nil, // rootfs directory will be determined by the CLI after mount.
 		ScannersConfig: misconfigurationTypes.ScannersConfig{
-			// TODO(sambetts) Add scanner configurations here as we add them like Lynis
-			Lynis: misconfigurationTypes.LynisConfig{
-				InstallPath: lynisInstallPath,
-			},
+			Lynis: lynisConfig,
+			KICS:  kicsConfig,
 		},
 	}
 }
 
-func userVulnConfigToFamiliesVulnConfig(vulnerabilitiesConfig *models.VulnerabilitiesConfig, trivyServerAddr string, grypeServerAddr string) familiesVulnerabilities.Config {
+func userVulnConfigToFamiliesVulnConfig(
+	vulnerabilitiesConfig *models.VulnerabilitiesConfig,
+	trivyServerAddr string,
+	trivyServerToken string,
+	grypeServerAddr string,
+	grypeServerTimeout time.Duration,
+	grypeDB config.GrypeDBConfig,
+	osvConfig osvconfig.Config,
+	aliasesSource string,
+) familiesVulnerabilities.Config {
 	if vulnerabilitiesConfig == nil || vulnerabilitiesConfig.Enabled == nil || !*vulnerabilitiesConfig.Enabled {
 		return familiesVulnerabilities.Config{}
 	}
@@ -553,41 +1415,115 @@ func userVulnConfigToFamiliesVulnConfig(vulnerabilitiesConfig *models.Vulnerabil
 			Mode: kubeclarityConfig.ModeRemote,
 			RemoteGrypeConfig: kubeclarityConfig.RemoteGrypeConfig{
 				GrypeServerAddress: grypeServerAddr,
-				GrypeServerTimeout: GrypeServerTimeout,
+				GrypeServerTimeout: grypeServerTimeoutOrDefault(vulnerabilitiesConfig.GrypeServerTimeoutSeconds, grypeServerTimeout),
 			},
 		}
 	} else {
 		grypeConfig = kubeclarityConfig.GrypeConfig{
 			Mode: kubeclarityConfig.ModeLocal,
 			LocalGrypeConfig: kubeclarityConfig.LocalGrypeConfig{
-				UpdateDB:   true,
-				DBRootDir:  "/tmp/",
-				ListingURL: "https://toolbox-data.anchore.io/grype/databases/listing.json",
+				UpdateDB:   grypeDB.UpdateDB,
+				DBRootDir:  grypeDB.RootDir,
+				ListingURL: grypeDB.ListingURL,
 				Scope:      source.SquashedScope,
 			},
 		}
 	}
 
+	scannersList := familiesVulnerabilities.DefaultScanners
+	if vulnerabilitiesConfig.ScannersList != nil && len(*vulnerabilitiesConfig.ScannersList) > 0 {
+		scannersList = *vulnerabilitiesConfig.ScannersList
+	}
+
 	return familiesVulnerabilities.Config{
-		Enabled: true,
-		// TODO(sambetts) This choice should come from the user's configuration
-		ScannersList:  []string{"grype", "trivy"},
+		Enabled:       true,
+		ScannersList:  scannersList,
 		InputFromSbom: false, // will be determined by the CLI.
 		ScannersConfig: &kubeclarityConfig.Config{
-			// TODO(sambetts) The user needs to be able to provide this configuration
-			Registry: &kubeclarityConfig.Registry{},
+			Registry: userRegistryConfigToKubeclarityRegistry(vulnerabilitiesConfig.Registry),
 			Scanner: &kubeclarityConfig.Scanner{
 				GrypeConfig: grypeConfig,
 				TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
-					Timeout:    TrivyTimeout,
-					ServerAddr: trivyServerAddr,
+					Timeout:     trivyTimeoutOrDefault(vulnerabilitiesConfig.TrivyTimeoutSeconds),
+					ServerAddr:  trivyServerAddr,
+					ServerToken: trivyServerToken,
 				},
 			},
 		},
+		RegistryAuths: userRegistryConfigToFamiliesRegistryAuths(vulnerabilitiesConfig.Registry),
+		AliasesSource: aliasesSource,
+		OSV:           osvConfig,
+	}
+}
+
+// scannerCommandOptions returns the extra arguments and environment variables
+// of the given scanner from the scan families config.
+func scannerCommandOptions(options *map[string]models.ScannerCommandOptions, scannerName string) familiesTypes.CommandOptions {
+	if options == nil {
+		return familiesTypes.CommandOptions{}
+	}
+	scannerOptions, ok := (*options)[scannerName]
+	if !ok {
+		return familiesTypes.CommandOptions{}
+	}
+	return familiesTypes.CommandOptions{
+		ExtraArgs: runtimeScanUtils.ValueOrZero(scannerOptions.ExtraArgs),
+		Env:       runtimeScanUtils.ValueOrZero(scannerOptions.Env),
+	}
+}
+
+func trivyTimeoutOrDefault(trivyTimeoutSeconds *int) int {
+	if trivyTimeoutSeconds == nil || *trivyTimeoutSeconds <= 0 {
+		return TrivyTimeout
+	}
+	return *trivyTimeoutSeconds
+}
+
+// grypeServerTimeoutOrDefault returns the timeout of the scan config if set,
+// otherwise the timeout of the orchestrator config if set, otherwise the
+// default timeout.
+func grypeServerTimeoutOrDefault(grypeServerTimeoutSeconds *int, grypeServerTimeout time.Duration) time.Duration {
+	if grypeServerTimeoutSeconds != nil && *grypeServerTimeoutSeconds > 0 {
+		return time.Duration(*grypeServerTimeoutSeconds) * time.Second
 	}
+	if grypeServerTimeout > 0 {
+		return grypeServerTimeout
+	}
+	return GrypeServerTimeout
+}
+
+func userRegistryConfigToKubeclarityRegistry(registryConfig *models.RegistryConfig) *kubeclarityConfig.Registry {
+	if registryConfig == nil {
+		return &kubeclarityConfig.Registry{}
+	}
+
+	// The auths are passed with userRegistryConfigToFamiliesRegistryAuths,
+	// the credentials of the kubeclarity auths aren't marshaled.
+	return &kubeclarityConfig.Registry{
+		SkipVerifyTLS: runtimeScanUtils.ValueOrZero(registryConfig.SkipVerifyTLS),
+		UseHTTP:       runtimeScanUtils.ValueOrZero(registryConfig.UseHTTP),
+	}
+}
+
+func userRegistryConfigToFamiliesRegistryAuths(registryConfig *models.RegistryConfig) []registry.Auth {
+	if registryConfig == nil || registryConfig.Auths == nil {
+		return nil
+	}
+
+	ret := make([]registry.Auth, 0, len(*registryConfig.Auths))
+	for _, auth := range *registryConfig.Auths {
+		ret = append(ret, registry.Auth{
+			Authority: auth.Authority,
+			Username:  runtimeScanUtils.ValueOrZero(auth.Username),
+			Password:  runtimeScanUtils.ValueOrZero(auth.Password),
+			Token:     runtimeScanUtils.ValueOrZero(auth.Token),
+		})
+	}
+
+	return ret
 }
 
-func userExploitsConfigToFamiliesExploitsConfig(exploitsConfig *models.ExploitsConfig, baseURL string) familiesExploits.Config {
+func userExploitsConfigToFamiliesExploitsConfig(exploitsConfig *models.ExploitsConfig, exploitDBConfig exploitdbConfig.Config) familiesExploits.Config {
 	if exploitsConfig == nil || exploitsConfig.Enabled == nil || !*exploitsConfig.Enabled {
 		return familiesExploits.Config{}
 	}
@@ -597,34 +1533,33 @@ func userExploitsConfigToFamiliesExploitsConfig(exploitsConfig *models.ExploitsC
 		ScannersList:  []string{"exploitdb"},
 		InputFromVuln: true,
 		ScannersConfig: &exploitsCommon.ScannersConfig{
-			ExploitDB: exploitdbConfig.Config{
-				BaseURL: baseURL,
-			},
+			ExploitDB: exploitDBConfig,
 		},
 	}
 }
 
 func userMalwareConfigToFamiliesMalwareConfig(
 	malwareConfig *models.MalwareConfig,
-	clamBinaryPath string,
-	freshclamBinaryPath string,
-	alternativeFreshclamMirrorURL string,
+	clamConfig malwareconfig.Config,
+	yaraConfig yaraconfig.Config,
 ) malware.Config {
 	if malwareConfig == nil || malwareConfig.Enabled == nil || !*malwareConfig.Enabled {
 		return malware.Config{}
 	}
 
-	log.Debugf("clam binary path: %s", clamBinaryPath)
+	scannersList := malware.DefaultScanners
+	if malwareConfig.ScannersList != nil && len(*malwareConfig.ScannersList) > 0 {
+		scannersList = *malwareConfig.ScannersList
+	}
+
+	log.Debugf("clam binary path: %s", clamConfig.ClamScanBinaryPath)
 	return malware.Config{
 		Enabled:      true,
-		ScannersList: []string{"clam"},
+		ScannersList: scannersList,
 		Inputs:       nil, // rootfs directory will be determined by the CLI after mount.
 		ScannersConfig: &malwarecommon.ScannersConfig{
-			Clam: malwareconfig.Config{
-				ClamScanBinaryPath:            clamBinaryPath,
-				FreshclamBinaryPath:           freshclamBinaryPath,
-				AlternativeFreshclamMirrorURL: alternativeFreshclamMirrorURL,
-			},
+			Clam: clamConfig,
+			Yara: yaraConfig,
 		},
 	}
 }
@@ -658,19 +1593,21 @@ func (s *Scanner) deleteJob(ctx context.Context, job *types.Job) {
 			log.Errorf("Failed to delete instance. instanceID=%v: %v", job.Instance.GetID(), err)
 		}
 	}
-	if job.SrcSnapshot != nil {
-		if err := job.SrcSnapshot.Delete(ctx); err != nil {
-			log.Errorf("Failed to delete source snapshot. snapshotID=%v: %v", job.SrcSnapshot.GetID(), err)
+	for _, jobVolume := range job.Volumes {
+		if jobVolume.SrcSnapshot != nil && !jobVolume.ExistingSnapshot {
+			if err := jobVolume.SrcSnapshot.Delete(ctx); err != nil {
+				log.Errorf("Failed to delete source snapshot. snapshotID=%v: %v", jobVolume.SrcSnapshot.GetID(), err)
+			}
 		}
-	}
-	if job.DstSnapshot != nil {
-		if err := job.DstSnapshot.Delete(ctx); err != nil {
-			log.Errorf("Failed to delete destination snapshot. snapshotID=%v: %v", job.DstSnapshot.GetID(), err)
+		if jobVolume.DstSnapshot != nil {
+			if err := jobVolume.DstSnapshot.Delete(ctx); err != nil {
+				log.Errorf("Failed to delete destination snapshot. snapshotID=%v: %v", jobVolume.DstSnapshot.GetID(), err)
+			}
 		}
-	}
-	if job.Volume != nil {
-		if err := job.Volume.Delete(ctx); err != nil {
-			log.Errorf("Failed to delete volume. volumeID=%v: %v", job.Volume.GetID(), err)
+		if jobVolume.Volume != nil {
+			if err := jobVolume.Volume.Delete(ctx); err != nil {
+				log.Errorf("Failed to delete volume. volumeID=%v: %v", jobVolume.Volume.GetID(), err)
+			}
 		}
 	}
 }
@@ -721,16 +1658,15 @@ func (s *Scanner) createInitTargetScanStatus(ctx context.Context, scanID, target
 			Id: targetID,
 		},
 	}
-	createdScanResult, err := s.backendClient.PostScanResult(ctx, scanResult)
-	if err != nil {
-		var conErr backendclient.ScanResultConflictError
-		if errors.As(err, &conErr) {
-			log.Infof("Scan results already exist. scan result id=%v.", *conErr.ConflictingScanResult.Id)
-			return *conErr.ConflictingScanResult.Id, nil
-		}
-		return "", fmt.Errorf("failed to post scan result: %v", err)
+	// The families config is only stored to debug the scanner job, failing to
+	// generate it here doesn't fail the scan result, the job will report it.
+	if familiesConfiguration, err := s.generateRedactedFamiliesConfigurationYaml(); err != nil {
+		log.WithFields(s.logFields).Warningf("Failed to generate families config of the scan result. targetID=%v: %v", targetID, err)
+	} else {
+		scanResult.FamiliesConfig = &familiesConfiguration
 	}
-	return *createdScanResult.Id, nil
+	// nolint:wrapcheck
+	return s.backendClient.UpsertScanResult(ctx, scanResult)
 }
 
 func createInitScanResultSummary() *models.ScanFindingsSummary {
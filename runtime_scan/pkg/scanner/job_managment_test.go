@@ -16,23 +16,53 @@
 package scanner
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/anchore/syft/syft/source"
 	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	kubeclarityConfig "github.com/openclarity/kubeclarity/shared/pkg/config"
+	"github.com/spf13/viper"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/circuitbreaker"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	familiesExploits "github.com/openclarity/vmclarity/shared/pkg/families/exploits"
+	exploitsCommon "github.com/openclarity/vmclarity/shared/pkg/families/exploits/common"
+	exploitdbConfig "github.com/openclarity/vmclarity/shared/pkg/families/exploits/exploitdb/config"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/config"
 	malwarecommon "github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	yaraconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/yara/config"
+	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/registry"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
+	chkrootkitConfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit/config"
+	rootkitsCommon "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/common"
+	rkhunterConfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/rkhunter/config"
 	familiesSbom "github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
 	secretscommon "github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
 	gitleaksconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks/config"
+	trufflehogconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/trufflehog/config"
+	familiesTypes "github.com/openclarity/vmclarity/shared/pkg/families/types"
 	familiesVulnerabilities "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+	osvconfig "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/osv/config"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -100,6 +130,82 @@ func Test_userSBOMConfigToFamiliesSbomConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Enabled with analyzers list",
+			args: args{
+				sbomConfig: &models.SBOMConfig{
+					Enabled:       utils.BoolPtr(true),
+					AnalyzersList: &[]string{"syft", "gomod"},
+				},
+			},
+			want: returns{
+				config: familiesSbom.Config{
+					Enabled:       true,
+					AnalyzersList: []string{"syft", "gomod"},
+					AnalyzersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Analyzer: &kubeclarityConfig.Analyzer{
+							OutputFormat: "cyclonedx",
+							TrivyConfig: kubeclarityConfig.AnalyzerTrivyConfig{
+								Timeout: TrivyTimeout,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Enabled with trivy timeout",
+			args: args{
+				sbomConfig: &models.SBOMConfig{
+					Enabled:             utils.BoolPtr(true),
+					TrivyTimeoutSeconds: utils.PointerTo(600),
+				},
+			},
+			want: returns{
+				config: familiesSbom.Config{
+					Enabled:       true,
+					AnalyzersList: []string{"syft", "trivy"},
+					AnalyzersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Analyzer: &kubeclarityConfig.Analyzer{
+							OutputFormat: "cyclonedx",
+							TrivyConfig: kubeclarityConfig.AnalyzerTrivyConfig{
+								Timeout: 600,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Enabled with package manager hints",
+			args: args{
+				sbomConfig: &models.SBOMConfig{
+					Enabled: utils.BoolPtr(true),
+					PackageManagerHints: &models.PackageManagerHints{
+						Distro:          utils.PointerTo("alpine"),
+						PackageManagers: &[]string{"rpm"},
+					},
+				},
+			},
+			want: returns{
+				config: familiesSbom.Config{
+					Enabled:       true,
+					AnalyzersList: []string{"syft", "trivy"},
+					AnalyzersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Analyzer: &kubeclarityConfig.Analyzer{
+							OutputFormat: "cyclonedx",
+							TrivyConfig: kubeclarityConfig.AnalyzerTrivyConfig{
+								Timeout: TrivyTimeout,
+							},
+						},
+					},
+					PackageManagerHints: []string{"apk", "rpm"},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -116,7 +222,12 @@ func Test_userVulnConfigToFamiliesVulnConfig(t *testing.T) {
 	type args struct {
 		vulnerabilitiesConfig *models.VulnerabilitiesConfig
 		trivyServerAddress    string
+		trivyServerToken      string
 		grypeServerAddress    string
+		grypeServerTimeout    time.Duration
+		grypeDB               _config.GrypeDBConfig
+		osvConfig             osvconfig.Config
+		aliasesSource         string
 	}
 	type returns struct {
 		config familiesVulnerabilities.Config
@@ -169,14 +280,17 @@ func Test_userVulnConfigToFamiliesVulnConfig(t *testing.T) {
 				},
 				trivyServerAddress: "http://10.0.0.1:9992",
 				grypeServerAddress: "",
+				grypeDB: _config.GrypeDBConfig{
+					UpdateDB:   true,
+					ListingURL: "https://toolbox-data.anchore.io/grype/databases/listing.json",
+					RootDir:    "/tmp/",
+				},
 			},
 			want: returns{
 				config: familiesVulnerabilities.Config{
-					Enabled: true,
-					// TODO(sambetts) This choice should come from the user's configuration
+					Enabled:      true,
 					ScannersList: []string{"grype", "trivy"},
 					ScannersConfig: &kubeclarityConfig.Config{
-						// TODO(sambetts) The user needs to be able to provide this configuration
 						Registry: &kubeclarityConfig.Registry{},
 						Scanner: &kubeclarityConfig.Scanner{
 							GrypeConfig: kubeclarityConfig.GrypeConfig{
@@ -197,6 +311,45 @@ func Test_userVulnConfigToFamiliesVulnConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Enabled with grype DB update disabled",
+			args: args{
+				vulnerabilitiesConfig: &models.VulnerabilitiesConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+				trivyServerAddress: "http://10.0.0.1:9992",
+				grypeServerAddress: "",
+				grypeDB: _config.GrypeDBConfig{
+					UpdateDB:   false,
+					ListingURL: "https://mirror.internal/grype/listing.json",
+					RootDir:    "/var/lib/grype/db/",
+				},
+			},
+			want: returns{
+				config: familiesVulnerabilities.Config{
+					Enabled:      true,
+					ScannersList: []string{"grype", "trivy"},
+					ScannersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Scanner: &kubeclarityConfig.Scanner{
+							GrypeConfig: kubeclarityConfig.GrypeConfig{
+								Mode: kubeclarityConfig.ModeLocal,
+								LocalGrypeConfig: kubeclarityConfig.LocalGrypeConfig{
+									UpdateDB:   false,
+									DBRootDir:  "/var/lib/grype/db/",
+									ListingURL: "https://mirror.internal/grype/listing.json",
+									Scope:      source.SquashedScope,
+								},
+							},
+							TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
+								Timeout:    TrivyTimeout,
+								ServerAddr: "http://10.0.0.1:9992",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Enabled with grype server",
 			args: args{
@@ -205,14 +358,13 @@ func Test_userVulnConfigToFamiliesVulnConfig(t *testing.T) {
 				},
 				trivyServerAddress: "http://10.0.0.1:9992",
 				grypeServerAddress: "10.0.0.1:9991",
+				aliasesSource:      "http://10.0.0.1:8888/aliases.json",
 			},
 			want: returns{
 				config: familiesVulnerabilities.Config{
-					Enabled: true,
-					// TODO(sambetts) This choice should come from the user's configuration
+					Enabled:      true,
 					ScannersList: []string{"grype", "trivy"},
 					ScannersConfig: &kubeclarityConfig.Config{
-						// TODO(sambetts) The user needs to be able to provide this configuration
 						Registry: &kubeclarityConfig.Registry{},
 						Scanner: &kubeclarityConfig.Scanner{
 							GrypeConfig: kubeclarityConfig.GrypeConfig{
@@ -228,391 +380,894 @@ func Test_userVulnConfigToFamiliesVulnConfig(t *testing.T) {
 							},
 						},
 					},
+					AliasesSource: "http://10.0.0.1:8888/aliases.json",
 				},
 			},
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := userVulnConfigToFamiliesVulnConfig(tt.args.vulnerabilitiesConfig, tt.args.trivyServerAddress, tt.args.grypeServerAddress)
-			if diff := cmp.Diff(tt.want.config, got); diff != "" {
-				t.Errorf("userVulnConfigToFamiliesVulnConfig() mismatch (-want +got):\n%s", diff)
-			}
-		})
-	}
-}
-
-func Test_userSecretsConfigToFamiliesSecretsConfig(t *testing.T) {
-	type args struct {
-		secretsConfig      *models.SecretsConfig
-		gitleaksBinaryPath string
-	}
-	tests := []struct {
-		name string
-		args args
-		want secrets.Config
-	}{
 		{
-			name: "no config",
+			name: "Enabled with scanners list",
 			args: args{
-				secretsConfig: nil,
+				vulnerabilitiesConfig: &models.VulnerabilitiesConfig{
+					Enabled:      utils.BoolPtr(true),
+					ScannersList: &[]string{"grype"},
+				},
+				trivyServerAddress: "http://10.0.0.1:9992",
+				grypeServerAddress: "10.0.0.1:9991",
 			},
-			want: secrets.Config{
-				Enabled: false,
+			want: returns{
+				config: familiesVulnerabilities.Config{
+					Enabled:      true,
+					ScannersList: []string{"grype"},
+					ScannersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Scanner: &kubeclarityConfig.Scanner{
+							GrypeConfig: kubeclarityConfig.GrypeConfig{
+								Mode: kubeclarityConfig.ModeRemote,
+								RemoteGrypeConfig: kubeclarityConfig.RemoteGrypeConfig{
+									GrypeServerAddress: "10.0.0.1:9991",
+									GrypeServerTimeout: 2 * time.Minute,
+								},
+							},
+							TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
+								Timeout:    TrivyTimeout,
+								ServerAddr: "http://10.0.0.1:9992",
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "no config enabled",
+			name: "Enabled with trivy timeout",
 			args: args{
-				secretsConfig: &models.SecretsConfig{
-					Enabled: nil,
+				vulnerabilitiesConfig: &models.VulnerabilitiesConfig{
+					Enabled:             utils.BoolPtr(true),
+					TrivyTimeoutSeconds: utils.PointerTo(900),
 				},
+				trivyServerAddress: "http://10.0.0.1:9992",
+				grypeServerAddress: "10.0.0.1:9991",
 			},
-			want: secrets.Config{
-				Enabled: false,
+			want: returns{
+				config: familiesVulnerabilities.Config{
+					Enabled:      true,
+					ScannersList: []string{"grype", "trivy"},
+					ScannersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Scanner: &kubeclarityConfig.Scanner{
+							GrypeConfig: kubeclarityConfig.GrypeConfig{
+								Mode: kubeclarityConfig.ModeRemote,
+								RemoteGrypeConfig: kubeclarityConfig.RemoteGrypeConfig{
+									GrypeServerAddress: "10.0.0.1:9991",
+									GrypeServerTimeout: 2 * time.Minute,
+								},
+							},
+							TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
+								Timeout:    900,
+								ServerAddr: "http://10.0.0.1:9992",
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "disabled",
+			name: "Enabled with grype server timeout",
 			args: args{
-				secretsConfig: &models.SecretsConfig{
-					Enabled: utils.BoolPtr(false),
+				vulnerabilitiesConfig: &models.VulnerabilitiesConfig{
+					Enabled:                   utils.BoolPtr(true),
+					GrypeServerTimeoutSeconds: utils.PointerTo(600),
 				},
+				trivyServerAddress: "http://10.0.0.1:9992",
+				grypeServerAddress: "10.0.0.1:9991",
+				grypeServerTimeout: 5 * time.Minute,
 			},
-			want: secrets.Config{
-				Enabled: false,
+			want: returns{
+				config: familiesVulnerabilities.Config{
+					Enabled:      true,
+					ScannersList: []string{"grype", "trivy"},
+					ScannersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Scanner: &kubeclarityConfig.Scanner{
+							GrypeConfig: kubeclarityConfig.GrypeConfig{
+								Mode: kubeclarityConfig.ModeRemote,
+								RemoteGrypeConfig: kubeclarityConfig.RemoteGrypeConfig{
+									GrypeServerAddress: "10.0.0.1:9991",
+									GrypeServerTimeout: 10 * time.Minute,
+								},
+							},
+							TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
+								Timeout:    TrivyTimeout,
+								ServerAddr: "http://10.0.0.1:9992",
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "enabled",
+			name: "Enabled with orchestrator grype server timeout",
 			args: args{
-				secretsConfig: &models.SecretsConfig{
+				vulnerabilitiesConfig: &models.VulnerabilitiesConfig{
 					Enabled: utils.BoolPtr(true),
 				},
-				gitleaksBinaryPath: "gitleaksBinaryPath",
+				trivyServerAddress: "http://10.0.0.1:9992",
+				grypeServerAddress: "10.0.0.1:9991",
+				grypeServerTimeout: 5 * time.Minute,
 			},
-			want: secrets.Config{
-				Enabled:      true,
-				ScannersList: []string{"gitleaks"},
-				ScannersConfig: &secretscommon.ScannersConfig{
-					Gitleaks: gitleaksconfig.Config{
-						BinaryPath: "gitleaksBinaryPath",
+			want: returns{
+				config: familiesVulnerabilities.Config{
+					Enabled:      true,
+					ScannersList: []string{"grype", "trivy"},
+					ScannersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Scanner: &kubeclarityConfig.Scanner{
+							GrypeConfig: kubeclarityConfig.GrypeConfig{
+								Mode: kubeclarityConfig.ModeRemote,
+								RemoteGrypeConfig: kubeclarityConfig.RemoteGrypeConfig{
+									GrypeServerAddress: "10.0.0.1:9991",
+									GrypeServerTimeout: 5 * time.Minute,
+								},
+							},
+							TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
+								Timeout:    TrivyTimeout,
+								ServerAddr: "http://10.0.0.1:9992",
+							},
+						},
 					},
 				},
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := userSecretsConfigToFamiliesSecretsConfig(tt.args.secretsConfig, tt.args.gitleaksBinaryPath)
-			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("userSecretsConfigToFamiliesSecretsConfig() mismatch (-want +got):\n%s", diff)
-			}
-		})
-	}
-}
-
-func Test_userMalwareConfigToFamiliesMalwareConfig(t *testing.T) {
-	type args struct {
-		malwareConfig                 *models.MalwareConfig
-		clamBinaryPath                string
-		freshclamBinaryPath           string
-		alternativeFreshclamMirrorURL string
-	}
-	tests := []struct {
-		name string
-		args args
-		want malware.Config
-	}{
-		{
-			name: "no config",
-			args: args{
-				malwareConfig: nil,
-			},
-			want: malware.Config{
-				Enabled: false,
-			},
-		},
 		{
-			name: "no config enabled",
+			name: "Enabled with trivy server token",
 			args: args{
-				malwareConfig: &models.MalwareConfig{
-					Enabled: nil,
+				vulnerabilitiesConfig: &models.VulnerabilitiesConfig{
+					Enabled: utils.BoolPtr(true),
 				},
+				trivyServerAddress: "http://10.0.0.1:9992",
+				trivyServerToken:   "token",
+				grypeServerAddress: "10.0.0.1:9991",
 			},
-			want: malware.Config{
-				Enabled: false,
-			},
-		},
-		{
-			name: "disabled",
-			args: args{
-				malwareConfig: &models.MalwareConfig{
-					Enabled: utils.BoolPtr(false),
+			want: returns{
+				config: familiesVulnerabilities.Config{
+					Enabled:      true,
+					ScannersList: []string{"grype", "trivy"},
+					ScannersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Scanner: &kubeclarityConfig.Scanner{
+							GrypeConfig: kubeclarityConfig.GrypeConfig{
+								Mode: kubeclarityConfig.ModeRemote,
+								RemoteGrypeConfig: kubeclarityConfig.RemoteGrypeConfig{
+									GrypeServerAddress: "10.0.0.1:9991",
+									GrypeServerTimeout: 2 * time.Minute,
+								},
+							},
+							TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
+								Timeout:     TrivyTimeout,
+								ServerAddr:  "http://10.0.0.1:9992",
+								ServerToken: "token",
+							},
+						},
+					},
 				},
 			},
-			want: malware.Config{
-				Enabled: false,
-			},
 		},
 		{
-			name: "enabled",
+			name: "Enabled with osv scanner",
 			args: args{
-				malwareConfig: &models.MalwareConfig{
-					Enabled: utils.BoolPtr(true),
+				vulnerabilitiesConfig: &models.VulnerabilitiesConfig{
+					Enabled:      utils.BoolPtr(true),
+					ScannersList: &[]string{"grype", "osv"},
+				},
+				trivyServerAddress: "http://10.0.0.1:9992",
+				grypeServerAddress: "10.0.0.1:9991",
+				osvConfig: osvconfig.Config{
+					CommandOptions: familiesTypes.CommandOptions{
+						ExtraArgs: []string{"--skip-git"},
+					},
+					BinaryPath: "/artifacts/osv-scanner",
 				},
-				clamBinaryPath:                "clamscan",
-				freshclamBinaryPath:           "freshclam",
-				alternativeFreshclamMirrorURL: "",
 			},
-			want: malware.Config{
-				Enabled:      true,
-				ScannersList: []string{"clam"},
-				ScannersConfig: &malwarecommon.ScannersConfig{
-					Clam: config.Config{
-						ClamScanBinaryPath:            "clamscan",
-						FreshclamBinaryPath:           "freshclam",
-						AlternativeFreshclamMirrorURL: "",
+			want: returns{
+				config: familiesVulnerabilities.Config{
+					Enabled:      true,
+					ScannersList: []string{"grype", "osv"},
+					ScannersConfig: &kubeclarityConfig.Config{
+						Registry: &kubeclarityConfig.Registry{},
+						Scanner: &kubeclarityConfig.Scanner{
+							GrypeConfig: kubeclarityConfig.GrypeConfig{
+								Mode: kubeclarityConfig.ModeRemote,
+								RemoteGrypeConfig: kubeclarityConfig.RemoteGrypeConfig{
+									GrypeServerAddress: "10.0.0.1:9991",
+									GrypeServerTimeout: 2 * time.Minute,
+								},
+							},
+							TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
+								Timeout:    TrivyTimeout,
+								ServerAddr: "http://10.0.0.1:9992",
+							},
+						},
+					},
+					OSV: osvconfig.Config{
+						CommandOptions: familiesTypes.CommandOptions{
+							ExtraArgs: []string{"--skip-git"},
+						},
+						BinaryPath: "/artifacts/osv-scanner",
 					},
 				},
 			},
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := userMalwareConfigToFamiliesMalwareConfig(
-				tt.args.malwareConfig,
-				tt.args.clamBinaryPath,
-				tt.args.freshclamBinaryPath,
-				tt.args.alternativeFreshclamMirrorURL,
-			)
-			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("userSecretsConfigToFamiliesSecretsConfig() mismatch (-want +got):\n%s", diff)
+			got := userVulnConfigToFamiliesVulnConfig(tt.args.vulnerabilitiesConfig, tt.args.trivyServerAddress, tt.args.trivyServerToken, tt.args.grypeServerAddress, tt.args.grypeServerTimeout, tt.args.grypeDB, tt.args.osvConfig, tt.args.aliasesSource)
+			if diff := cmp.Diff(tt.want.config, got); diff != "" {
+				t.Errorf("userVulnConfigToFamiliesVulnConfig() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_getInitScanStatusVulnerabilitiesStateFromEnabled(t *testing.T) {
-	type args struct {
-		config *models.VulnerabilitiesConfig
-	}
+func Test_userRegistryConfigToKubeclarityRegistry(t *testing.T) {
 	tests := []struct {
-		name string
-		args args
-		want *models.TargetScanStateState
+		name           string
+		registryConfig *models.RegistryConfig
+		want           *kubeclarityConfig.Registry
 	}{
 		{
-			name: "enabled",
-			args: args{
-				config: &models.VulnerabilitiesConfig{
-					Enabled: utils.BoolPtr(true),
-				},
-			},
-			want: stateToPointer(models.INIT),
+			name:           "No Registry Config",
+			registryConfig: nil,
+			want:           &kubeclarityConfig.Registry{},
 		},
 		{
-			name: "disabled",
-			args: args{
-				config: &models.VulnerabilitiesConfig{
-					Enabled: utils.BoolPtr(false),
-				},
+			name: "Flags only",
+			registryConfig: &models.RegistryConfig{
+				SkipVerifyTLS: utils.BoolPtr(true),
+				UseHTTP:       utils.BoolPtr(true),
+			},
+			want: &kubeclarityConfig.Registry{
+				SkipVerifyTLS: true,
+				UseHTTP:       true,
 			},
-			want: stateToPointer(models.NOTSCANNED),
 		},
 		{
-			name: "nil enabled",
-			args: args{
-				config: &models.VulnerabilitiesConfig{
-					Enabled: nil,
+			name: "Auths are passed separately",
+			registryConfig: &models.RegistryConfig{
+				UseHTTP: utils.BoolPtr(true),
+				Auths: &[]models.RegistryAuth{
+					{
+						Authority: "docker.io",
+						Username:  utils.StringPtr("user"),
+						Password:  utils.StringPtr("pass"),
+					},
 				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
-		},
-		{
-			name: "nil config",
-			args: args{
-				config: nil,
+			want: &kubeclarityConfig.Registry{
+				UseHTTP: true,
 			},
-			want: stateToPointer(models.NOTSCANNED),
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getInitScanStatusVulnerabilitiesStateFromEnabled(tt.args.config)
+			got := userRegistryConfigToKubeclarityRegistry(tt.registryConfig)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("getInitScanStatusVulnerabilitiesStateFromEnabled() mismatch (-want +got):\n%s", diff)
+				t.Errorf("userRegistryConfigToKubeclarityRegistry() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_getInitScanStatusSecretsStateFromEnabled(t *testing.T) {
-	type args struct {
-		config *models.SecretsConfig
-	}
+func Test_userRegistryConfigToFamiliesRegistryAuths(t *testing.T) {
 	tests := []struct {
-		name string
-		args args
-		want *models.TargetScanStateState
+		name           string
+		registryConfig *models.RegistryConfig
+		want           []registry.Auth
 	}{
 		{
-			name: "enabled",
-			args: args{
-				config: &models.SecretsConfig{
-					Enabled: utils.BoolPtr(true),
-				},
-			},
-			want: stateToPointer(models.INIT),
+			name:           "No Registry Config",
+			registryConfig: nil,
+			want:           nil,
 		},
 		{
-			name: "disabled",
-			args: args{
-				config: &models.SecretsConfig{
-					Enabled: utils.BoolPtr(false),
-				},
+			name: "No auths",
+			registryConfig: &models.RegistryConfig{
+				SkipVerifyTLS: utils.BoolPtr(true),
 			},
-			want: stateToPointer(models.NOTSCANNED),
+			want: nil,
 		},
 		{
-			name: "nil enabled",
-			args: args{
-				config: &models.SecretsConfig{
-					Enabled: nil,
+			name: "Username/password and token auths",
+			registryConfig: &models.RegistryConfig{
+				Auths: &[]models.RegistryAuth{
+					{
+						Authority: "docker.io",
+						Username:  utils.StringPtr("user"),
+						Password:  utils.StringPtr("pass"),
+					},
+					{
+						Authority: "registry.example.com",
+						Token:     utils.StringPtr("token"),
+					},
 				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
-		},
-		{
-			name: "nil config",
-			args: args{
-				config: nil,
+			want: []registry.Auth{
+				{
+					Authority: "docker.io",
+					Username:  "user",
+					Password:  "pass",
+				},
+				{
+					Authority: "registry.example.com",
+					Token:     "token",
+				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getInitScanStatusSecretsStateFromEnabled(tt.args.config)
+			got := userRegistryConfigToFamiliesRegistryAuths(tt.registryConfig)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("getInitScanStatusSecretsStateFromEnabled() mismatch (-want +got):\n%s", diff)
+				t.Errorf("userRegistryConfigToFamiliesRegistryAuths() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_getInitScanStatusSbomStateFromEnabled(t *testing.T) {
+func TestScanner_generateFamiliesConfigurationYamlRegistryAuths(t *testing.T) {
+	registryConfig := &models.RegistryConfig{
+		Auths: &[]models.RegistryAuth{
+			{
+				Authority: "docker.io",
+				Username:  utils.StringPtr("user"),
+				Password:  utils.StringPtr("s3cr3t-password"),
+			},
+			{
+				Authority: "registry.example.com",
+				Token:     utils.StringPtr("s3cr3t-token"),
+			},
+		},
+	}
+	s := &Scanner{
+		scanConfig: &models.ScanConfig{
+			ScanFamiliesConfig: &models.ScanFamiliesConfig{
+				Sbom: &models.SBOMConfig{
+					Enabled:  utils.BoolPtr(true),
+					Registry: registryConfig,
+				},
+				Vulnerabilities: &models.VulnerabilitiesConfig{
+					Enabled:  utils.BoolPtr(true),
+					Registry: registryConfig,
+				},
+			},
+		},
+		config: &_config.ScannerConfig{},
+	}
+
+	famConfigYaml, err := s.generateFamiliesConfigurationYaml()
+	if err != nil {
+		t.Fatalf("generateFamiliesConfigurationYaml() error = %v", err)
+	}
+
+	// Load the config back the way the CLI does.
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader(famConfigYaml)); err != nil {
+		t.Fatalf("failed to read the families config: %v", err)
+	}
+	famConfig := &families.Config{}
+	if err := v.Unmarshal(famConfig); err != nil {
+		t.Fatalf("failed to unmarshal the families config: %v", err)
+	}
+
+	want := []kubeclarityConfig.Auth{
+		{
+			Authority: "docker.io",
+			Username:  "user",
+			Password:  "s3cr3t-password",
+		},
+		{
+			Authority: "registry.example.com",
+			Token:     "s3cr3t-token",
+		},
+	}
+	sbomConfig := registry.WithAuths(famConfig.SBOM.AnalyzersConfig, famConfig.SBOM.RegistryAuths)
+	if diff := cmp.Diff(want, sbomConfig.Registry.Auths); diff != "" {
+		t.Errorf("SBOM registry auths mismatch (-want +got):\n%s", diff)
+	}
+	vulnerabilitiesConfig := registry.WithAuths(famConfig.Vulnerabilities.ScannersConfig, famConfig.Vulnerabilities.RegistryAuths)
+	if diff := cmp.Diff(want, vulnerabilitiesConfig.Registry.Auths); diff != "" {
+		t.Errorf("vulnerabilities registry auths mismatch (-want +got):\n%s", diff)
+	}
+
+	redacted, err := s.generateRedactedFamiliesConfigurationYaml()
+	if err != nil {
+		t.Fatalf("generateRedactedFamiliesConfigurationYaml() error = %v", err)
+	}
+	for _, secret := range []string{"s3cr3t-password", "s3cr3t-token"} {
+		if strings.Contains(redacted, secret) {
+			t.Errorf("generateRedactedFamiliesConfigurationYaml() leaked %q:\n%s", secret, redacted)
+		}
+	}
+}
+
+func Test_userSecretsConfigToFamiliesSecretsConfig(t *testing.T) {
 	type args struct {
-		config *models.SBOMConfig
+		secretsConfig    *models.SecretsConfig
+		gitleaksConfig   gitleaksconfig.Config
+		trufflehogConfig trufflehogconfig.Config
 	}
 	tests := []struct {
 		name string
 		args args
-		want *models.TargetScanStateState
+		want secrets.Config
 	}{
 		{
-			name: "enabled",
+			name: "no config",
 			args: args{
-				config: &models.SBOMConfig{
-					Enabled: utils.BoolPtr(true),
+				secretsConfig: nil,
+			},
+			want: secrets.Config{
+				Enabled: false,
+			},
+		},
+		{
+			name: "no config enabled",
+			args: args{
+				secretsConfig: &models.SecretsConfig{
+					Enabled: nil,
 				},
 			},
-			want: stateToPointer(models.INIT),
+			want: secrets.Config{
+				Enabled: false,
+			},
 		},
 		{
 			name: "disabled",
 			args: args{
-				config: &models.SBOMConfig{
+				secretsConfig: &models.SecretsConfig{
 					Enabled: utils.BoolPtr(false),
 				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
+			want: secrets.Config{
+				Enabled: false,
+			},
 		},
 		{
-			name: "nil enabled",
+			name: "enabled",
 			args: args{
-				config: &models.SBOMConfig{
-					Enabled: nil,
+				secretsConfig: &models.SecretsConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+				gitleaksConfig: gitleaksconfig.Config{
+					BinaryPath: "gitleaksBinaryPath",
+				},
+				trufflehogConfig: trufflehogconfig.Config{
+					BinaryPath: "trufflehogBinaryPath",
+				},
+			},
+			want: secrets.Config{
+				Enabled:      true,
+				ScannersList: []string{"gitleaks"},
+				ScannersConfig: &secretscommon.ScannersConfig{
+					Gitleaks: gitleaksconfig.Config{
+						BinaryPath: "gitleaksBinaryPath",
+					},
+					Trufflehog: trufflehogconfig.Config{
+						BinaryPath: "trufflehogBinaryPath",
+					},
 				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
 		},
 		{
-			name: "nil config",
+			name: "enabled with scanners list",
 			args: args{
-				config: nil,
+				secretsConfig: &models.SecretsConfig{
+					Enabled:      utils.BoolPtr(true),
+					ScannersList: &[]string{"trufflehog"},
+				},
+				gitleaksConfig: gitleaksconfig.Config{
+					BinaryPath: "gitleaksBinaryPath",
+				},
+				trufflehogConfig: trufflehogconfig.Config{
+					BinaryPath: "trufflehogBinaryPath",
+				},
+			},
+			want: secrets.Config{
+				Enabled:      true,
+				ScannersList: []string{"trufflehog"},
+				ScannersConfig: &secretscommon.ScannersConfig{
+					Gitleaks: gitleaksconfig.Config{
+						BinaryPath: "gitleaksBinaryPath",
+					},
+					Trufflehog: trufflehogconfig.Config{
+						BinaryPath: "trufflehogBinaryPath",
+					},
+				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getInitScanStatusSbomStateFromEnabled(tt.args.config)
+			got := userSecretsConfigToFamiliesSecretsConfig(tt.args.secretsConfig, tt.args.gitleaksConfig, tt.args.trufflehogConfig)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("getInitScanStatusSbomStateFromEnabled() mismatch (-want +got):\n%s", diff)
+				t.Errorf("userSecretsConfigToFamiliesSecretsConfig() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_getInitScanStatusRootkitsStateFromEnabled(t *testing.T) {
+func Test_userRootkitsConfigToFamiliesRootkitsConfig(t *testing.T) {
 	type args struct {
-		config *models.RootkitsConfig
+		rootkitsConfig   *models.RootkitsConfig
+		chkrootkitConfig chkrootkitConfig.Config
+		rkhunterConfig   rkhunterConfig.Config
 	}
 	tests := []struct {
 		name string
 		args args
-		want *models.TargetScanStateState
+		want rootkits.Config
 	}{
 		{
-			name: "enabled",
+			name: "no config",
 			args: args{
-				config: &models.RootkitsConfig{
-					Enabled: utils.BoolPtr(true),
-				},
+				rootkitsConfig: nil,
 			},
-			want: stateToPointer(models.INIT),
+			want: rootkits.Config{},
 		},
 		{
 			name: "disabled",
 			args: args{
-				config: &models.RootkitsConfig{
+				rootkitsConfig: &models.RootkitsConfig{
 					Enabled: utils.BoolPtr(false),
 				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
+			want: rootkits.Config{},
 		},
 		{
-			name: "nil enabled",
+			name: "enabled with default scanners",
 			args: args{
-				config: &models.RootkitsConfig{
-					Enabled: nil,
+				rootkitsConfig: &models.RootkitsConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+				chkrootkitConfig: chkrootkitConfig.Config{
+					BinaryPath: "chkrootkitBinaryPath",
+				},
+				rkhunterConfig: rkhunterConfig.Config{
+					BinaryPath: "rkhunterBinaryPath",
+				},
+			},
+			want: rootkits.Config{
+				Enabled:      true,
+				ScannersList: []string{"chkrootkit"},
+				ScannersConfig: &rootkitsCommon.ScannersConfig{
+					Chkrootkit: chkrootkitConfig.Config{
+						BinaryPath: "chkrootkitBinaryPath",
+					},
+					Rkhunter: rkhunterConfig.Config{
+						BinaryPath: "rkhunterBinaryPath",
+					},
 				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
 		},
 		{
-			name: "nil config",
+			name: "enabled with selected scanners",
 			args: args{
-				config: nil,
+				rootkitsConfig: &models.RootkitsConfig{
+					Enabled:      utils.BoolPtr(true),
+					ScannersList: &[]string{"chkrootkit", "rkhunter"},
+				},
+				chkrootkitConfig: chkrootkitConfig.Config{
+					BinaryPath: "chkrootkitBinaryPath",
+				},
+				rkhunterConfig: rkhunterConfig.Config{
+					BinaryPath: "rkhunterBinaryPath",
+				},
+			},
+			want: rootkits.Config{
+				Enabled:      true,
+				ScannersList: []string{"chkrootkit", "rkhunter"},
+				ScannersConfig: &rootkitsCommon.ScannersConfig{
+					Chkrootkit: chkrootkitConfig.Config{
+						BinaryPath: "chkrootkitBinaryPath",
+					},
+					Rkhunter: rkhunterConfig.Config{
+						BinaryPath: "rkhunterBinaryPath",
+					},
+				},
 			},
-			want: stateToPointer(models.NOTSCANNED),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getInitScanStatusRootkitsStateFromEnabled(tt.args.config)
+			got := userRootkitsConfigToFamiliesRootkitsConfig(tt.args.rootkitsConfig, tt.args.chkrootkitConfig, tt.args.rkhunterConfig)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("getInitScanStatusRootkitsStateFromEnabled() mismatch (-want +got):\n%s", diff)
+				t.Errorf("userRootkitsConfigToFamiliesRootkitsConfig() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_getInitScanStatusMisconfigurationsStateFromEnabled(t *testing.T) {
+func Test_userExploitsConfigToFamiliesExploitsConfig(t *testing.T) {
 	type args struct {
-		config *models.MisconfigurationsConfig
+		exploitsConfig  *models.ExploitsConfig
+		exploitDBConfig exploitdbConfig.Config
+	}
+	tests := []struct {
+		name string
+		args args
+		want familiesExploits.Config
+	}{
+		{
+			name: "no config",
+			args: args{
+				exploitsConfig: nil,
+			},
+			want: familiesExploits.Config{},
+		},
+		{
+			name: "disabled",
+			args: args{
+				exploitsConfig: &models.ExploitsConfig{
+					Enabled: utils.BoolPtr(false),
+				},
+			},
+			want: familiesExploits.Config{},
+		},
+		{
+			name: "enabled with exploit db server",
+			args: args{
+				exploitsConfig: &models.ExploitsConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+				exploitDBConfig: exploitdbConfig.Config{
+					BaseURL: "http://exploitdb:1326",
+				},
+			},
+			want: familiesExploits.Config{
+				Enabled:       true,
+				ScannersList:  []string{"exploitdb"},
+				InputFromVuln: true,
+				ScannersConfig: &exploitsCommon.ScannersConfig{
+					ExploitDB: exploitdbConfig.Config{
+						BaseURL: "http://exploitdb:1326",
+					},
+				},
+			},
+		},
+		{
+			name: "enabled with local exploit db",
+			args: args{
+				exploitsConfig: &models.ExploitsConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+				exploitDBConfig: exploitdbConfig.Config{
+					BaseURL:     "http://exploitdb:1326",
+					LocalDBPath: "/artifacts/go-exploitdb.sqlite3",
+				},
+			},
+			want: familiesExploits.Config{
+				Enabled:       true,
+				ScannersList:  []string{"exploitdb"},
+				InputFromVuln: true,
+				ScannersConfig: &exploitsCommon.ScannersConfig{
+					ExploitDB: exploitdbConfig.Config{
+						BaseURL:     "http://exploitdb:1326",
+						LocalDBPath: "/artifacts/go-exploitdb.sqlite3",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := userExploitsConfigToFamiliesExploitsConfig(tt.args.exploitsConfig, tt.args.exploitDBConfig)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("userExploitsConfigToFamiliesExploitsConfig() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_userMalwareConfigToFamiliesMalwareConfig(t *testing.T) {
+	type args struct {
+		malwareConfig *models.MalwareConfig
+		clamConfig    config.Config
+		yaraConfig    yaraconfig.Config
+	}
+	tests := []struct {
+		name string
+		args args
+		want malware.Config
+	}{
+		{
+			name: "no config",
+			args: args{
+				malwareConfig: nil,
+			},
+			want: malware.Config{
+				Enabled: false,
+			},
+		},
+		{
+			name: "no config enabled",
+			args: args{
+				malwareConfig: &models.MalwareConfig{
+					Enabled: nil,
+				},
+			},
+			want: malware.Config{
+				Enabled: false,
+			},
+		},
+		{
+			name: "disabled",
+			args: args{
+				malwareConfig: &models.MalwareConfig{
+					Enabled: utils.BoolPtr(false),
+				},
+			},
+			want: malware.Config{
+				Enabled: false,
+			},
+		},
+		{
+			name: "enabled",
+			args: args{
+				malwareConfig: &models.MalwareConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+				clamConfig: config.Config{
+					ClamScanBinaryPath:            "clamscan",
+					FreshclamBinaryPath:           "freshclam",
+					AlternativeFreshclamMirrorURL: "",
+				},
+			},
+			want: malware.Config{
+				Enabled:      true,
+				ScannersList: []string{"clam"},
+				ScannersConfig: &malwarecommon.ScannersConfig{
+					Clam: config.Config{
+						ClamScanBinaryPath:            "clamscan",
+						FreshclamBinaryPath:           "freshclam",
+						AlternativeFreshclamMirrorURL: "",
+					},
+				},
+			},
+		},
+		{
+			name: "enabled with yara",
+			args: args{
+				malwareConfig: &models.MalwareConfig{
+					Enabled:      utils.BoolPtr(true),
+					ScannersList: &[]string{"clam", "yara"},
+				},
+				clamConfig: config.Config{
+					ClamScanBinaryPath:  "clamscan",
+					FreshclamBinaryPath: "freshclam",
+				},
+				yaraConfig: yaraconfig.Config{
+					BinaryPath: "yara",
+					RulesPath:  "/etc/yara/rules",
+					RulesURL:   "http://rules.internal/implants.yar",
+				},
+			},
+			want: malware.Config{
+				Enabled:      true,
+				ScannersList: []string{"clam", "yara"},
+				ScannersConfig: &malwarecommon.ScannersConfig{
+					Clam: config.Config{
+						ClamScanBinaryPath:  "clamscan",
+						FreshclamBinaryPath: "freshclam",
+					},
+					Yara: yaraconfig.Config{
+						BinaryPath: "yara",
+						RulesPath:  "/etc/yara/rules",
+						RulesURL:   "http://rules.internal/implants.yar",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := userMalwareConfigToFamiliesMalwareConfig(
+				tt.args.malwareConfig,
+				tt.args.clamConfig,
+				tt.args.yaraConfig,
+			)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("userSecretsConfigToFamiliesSecretsConfig() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_userMisconfigurationConfigToFamiliesMisconfigurationConfig(t *testing.T) {
+	lynisConfig := misconfigurationTypes.LynisConfig{
+		InstallPath: "/artifacts/lynis",
+	}
+	kicsConfig := misconfigurationTypes.KICSConfig{
+		BinaryPath:        "/artifacts/kics",
+		QueriesPath:       "/artifacts/kics-queries",
+		SeverityThreshold: "MEDIUM",
+	}
+	type args struct {
+		misconfigurationConfig *models.MisconfigurationsConfig
+	}
+	tests := []struct {
+		name string
+		args args
+		want misconfigurationTypes.Config
+	}{
+		{
+			name: "no config",
+			args: args{
+				misconfigurationConfig: nil,
+			},
+			want: misconfigurationTypes.Config{
+				Enabled: false,
+			},
+		},
+		{
+			name: "disabled",
+			args: args{
+				misconfigurationConfig: &models.MisconfigurationsConfig{
+					Enabled: utils.BoolPtr(false),
+				},
+			},
+			want: misconfigurationTypes.Config{
+				Enabled: false,
+			},
+		},
+		{
+			name: "enabled with default scanners",
+			args: args{
+				misconfigurationConfig: &models.MisconfigurationsConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+			},
+			want: misconfigurationTypes.Config{
+				Enabled:      true,
+				ScannersList: []string{"lynis"},
+				ScannersConfig: misconfigurationTypes.ScannersConfig{
+					Lynis: lynisConfig,
+					KICS:  kicsConfig,
+				},
+			},
+		},
+		{
+			name: "enabled with kics",
+			args: args{
+				misconfigurationConfig: &models.MisconfigurationsConfig{
+					Enabled:      utils.BoolPtr(true),
+					ScannersList: &[]string{"lynis", "kics"},
+				},
+			},
+			want: misconfigurationTypes.Config{
+				Enabled:      true,
+				ScannersList: []string{"lynis", "kics"},
+				ScannersConfig: misconfigurationTypes.ScannersConfig{
+					Lynis: lynisConfig,
+					KICS:  kicsConfig,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := userMisconfigurationConfigToFamiliesMisconfigurationConfig(tt.args.misconfigurationConfig, lynisConfig, kicsConfig)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("userMisconfigurationConfigToFamiliesMisconfigurationConfig() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getInitScanStatusVulnerabilitiesStateFromEnabled(t *testing.T) {
+	type args struct {
+		config *models.VulnerabilitiesConfig
 	}
 	tests := []struct {
 		name string
@@ -622,7 +1277,7 @@ func Test_getInitScanStatusMisconfigurationsStateFromEnabled(t *testing.T) {
 		{
 			name: "enabled",
 			args: args{
-				config: &models.MisconfigurationsConfig{
+				config: &models.VulnerabilitiesConfig{
 					Enabled: utils.BoolPtr(true),
 				},
 			},
@@ -631,7 +1286,7 @@ func Test_getInitScanStatusMisconfigurationsStateFromEnabled(t *testing.T) {
 		{
 			name: "disabled",
 			args: args{
-				config: &models.MisconfigurationsConfig{
+				config: &models.VulnerabilitiesConfig{
 					Enabled: utils.BoolPtr(false),
 				},
 			},
@@ -640,7 +1295,7 @@ func Test_getInitScanStatusMisconfigurationsStateFromEnabled(t *testing.T) {
 		{
 			name: "nil enabled",
 			args: args{
-				config: &models.MisconfigurationsConfig{
+				config: &models.VulnerabilitiesConfig{
 					Enabled: nil,
 				},
 			},
@@ -656,17 +1311,17 @@ func Test_getInitScanStatusMisconfigurationsStateFromEnabled(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getInitScanStatusMisconfigurationsStateFromEnabled(tt.args.config)
+			got := getInitScanStatusVulnerabilitiesStateFromEnabled(tt.args.config)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("getInitScanStatusMisconfigurationsStateFromEnabled() mismatch (-want +got):\n%s", diff)
+				t.Errorf("getInitScanStatusVulnerabilitiesStateFromEnabled() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_getInitScanStatusMalwareStateFromEnabled(t *testing.T) {
+func Test_getInitScanStatusSecretsStateFromEnabled(t *testing.T) {
 	type args struct {
-		config *models.MalwareConfig
+		config *models.SecretsConfig
 	}
 	tests := []struct {
 		name string
@@ -676,7 +1331,7 @@ func Test_getInitScanStatusMalwareStateFromEnabled(t *testing.T) {
 		{
 			name: "enabled",
 			args: args{
-				config: &models.MalwareConfig{
+				config: &models.SecretsConfig{
 					Enabled: utils.BoolPtr(true),
 				},
 			},
@@ -685,7 +1340,7 @@ func Test_getInitScanStatusMalwareStateFromEnabled(t *testing.T) {
 		{
 			name: "disabled",
 			args: args{
-				config: &models.MalwareConfig{
+				config: &models.SecretsConfig{
 					Enabled: utils.BoolPtr(false),
 				},
 			},
@@ -694,7 +1349,7 @@ func Test_getInitScanStatusMalwareStateFromEnabled(t *testing.T) {
 		{
 			name: "nil enabled",
 			args: args{
-				config: &models.MalwareConfig{
+				config: &models.SecretsConfig{
 					Enabled: nil,
 				},
 			},
@@ -710,17 +1365,17 @@ func Test_getInitScanStatusMalwareStateFromEnabled(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getInitScanStatusMalwareStateFromEnabled(tt.args.config)
+			got := getInitScanStatusSecretsStateFromEnabled(tt.args.config)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("getInitScanStatusMalwareStateFromEnabled() mismatch (-want +got):\n%s", diff)
+				t.Errorf("getInitScanStatusSecretsStateFromEnabled() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func Test_getInitScanStatusExploitsStateFromEnabled(t *testing.T) {
+func Test_getInitScanStatusSbomStateFromEnabled(t *testing.T) {
 	type args struct {
-		config *models.ExploitsConfig
+		config *models.SBOMConfig
 	}
 	tests := []struct {
 		name string
@@ -730,7 +1385,7 @@ func Test_getInitScanStatusExploitsStateFromEnabled(t *testing.T) {
 		{
 			name: "enabled",
 			args: args{
-				config: &models.ExploitsConfig{
+				config: &models.SBOMConfig{
 					Enabled: utils.BoolPtr(true),
 				},
 			},
@@ -739,7 +1394,7 @@ func Test_getInitScanStatusExploitsStateFromEnabled(t *testing.T) {
 		{
 			name: "disabled",
 			args: args{
-				config: &models.ExploitsConfig{
+				config: &models.SBOMConfig{
 					Enabled: utils.BoolPtr(false),
 				},
 			},
@@ -748,7 +1403,7 @@ func Test_getInitScanStatusExploitsStateFromEnabled(t *testing.T) {
 		{
 			name: "nil enabled",
 			args: args{
-				config: &models.ExploitsConfig{
+				config: &models.SBOMConfig{
 					Enabled: nil,
 				},
 			},
@@ -764,10 +1419,1413 @@ func Test_getInitScanStatusExploitsStateFromEnabled(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := getInitScanStatusExploitsStateFromEnabled(tt.args.config)
+			got := getInitScanStatusSbomStateFromEnabled(tt.args.config)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("getInitScanStatusExploitsStateFromEnabled() mismatch (-want +got):\n%s", diff)
+				t.Errorf("getInitScanStatusSbomStateFromEnabled() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func Test_getInitScanStatusRootkitsStateFromEnabled(t *testing.T) {
+	type args struct {
+		config *models.RootkitsConfig
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.TargetScanStateState
+	}{
+		{
+			name: "enabled",
+			args: args{
+				config: &models.RootkitsConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+			},
+			want: stateToPointer(models.INIT),
+		},
+		{
+			name: "disabled",
+			args: args{
+				config: &models.RootkitsConfig{
+					Enabled: utils.BoolPtr(false),
+				},
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+		{
+			name: "nil enabled",
+			args: args{
+				config: &models.RootkitsConfig{
+					Enabled: nil,
+				},
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+		{
+			name: "nil config",
+			args: args{
+				config: nil,
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getInitScanStatusRootkitsStateFromEnabled(tt.args.config)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getInitScanStatusRootkitsStateFromEnabled() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getInitScanStatusMisconfigurationsStateFromEnabled(t *testing.T) {
+	type args struct {
+		config *models.MisconfigurationsConfig
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.TargetScanStateState
+	}{
+		{
+			name: "enabled",
+			args: args{
+				config: &models.MisconfigurationsConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+			},
+			want: stateToPointer(models.INIT),
+		},
+		{
+			name: "disabled",
+			args: args{
+				config: &models.MisconfigurationsConfig{
+					Enabled: utils.BoolPtr(false),
+				},
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+		{
+			name: "nil enabled",
+			args: args{
+				config: &models.MisconfigurationsConfig{
+					Enabled: nil,
+				},
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+		{
+			name: "nil config",
+			args: args{
+				config: nil,
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getInitScanStatusMisconfigurationsStateFromEnabled(tt.args.config)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getInitScanStatusMisconfigurationsStateFromEnabled() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getInitScanStatusMalwareStateFromEnabled(t *testing.T) {
+	type args struct {
+		config *models.MalwareConfig
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.TargetScanStateState
+	}{
+		{
+			name: "enabled",
+			args: args{
+				config: &models.MalwareConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+			},
+			want: stateToPointer(models.INIT),
+		},
+		{
+			name: "disabled",
+			args: args{
+				config: &models.MalwareConfig{
+					Enabled: utils.BoolPtr(false),
+				},
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+		{
+			name: "nil enabled",
+			args: args{
+				config: &models.MalwareConfig{
+					Enabled: nil,
+				},
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+		{
+			name: "nil config",
+			args: args{
+				config: nil,
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getInitScanStatusMalwareStateFromEnabled(tt.args.config)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getInitScanStatusMalwareStateFromEnabled() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getInitScanStatusExploitsStateFromEnabled(t *testing.T) {
+	type args struct {
+		config *models.ExploitsConfig
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.TargetScanStateState
+	}{
+		{
+			name: "enabled",
+			args: args{
+				config: &models.ExploitsConfig{
+					Enabled: utils.BoolPtr(true),
+				},
+			},
+			want: stateToPointer(models.INIT),
+		},
+		{
+			name: "disabled",
+			args: args{
+				config: &models.ExploitsConfig{
+					Enabled: utils.BoolPtr(false),
+				},
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+		{
+			name: "nil enabled",
+			args: args{
+				config: &models.ExploitsConfig{
+					Enabled: nil,
+				},
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+		{
+			name: "nil config",
+			args: args{
+				config: nil,
+			},
+			want: stateToPointer(models.NOTSCANNED),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getInitScanStatusExploitsStateFromEnabled(tt.args.config)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getInitScanStatusExploitsStateFromEnabled() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeSnapshot fails the first `copyFailures` copies, and the wait for the
+// first `waitFailures` successful copies to be ready.
+type fakeSnapshot struct {
+	types.Snapshot
+	copyFailures int
+	waitFailures int
+	copies       int
+	waits        int
+	deletes      int
+	source       *fakeSnapshot
+}
+
+func (s *fakeSnapshot) Copy(_ context.Context, _ string) (types.Snapshot, error) {
+	s.copies++
+	if s.copies <= s.copyFailures {
+		return nil, errors.New("request limit exceeded")
+	}
+	return &fakeSnapshot{source: s}, nil
+}
+
+func (s *fakeSnapshot) WaitForReady(_ context.Context) error {
+	s.source.waits++
+	if s.source.waits <= s.source.waitFailures {
+		return errors.New("snapshot is in error state")
+	}
+	return nil
+}
+
+func (s *fakeSnapshot) Delete(_ context.Context) error {
+	s.source.deletes++
+	return nil
+}
+
+func (s *fakeSnapshot) GetID() string {
+	return "snap-1"
+}
+
+func (s *fakeSnapshot) GetRegion() string {
+	return "us-east-1"
+}
+
+type fakeInstance struct {
+	types.Instance
+}
+
+// fakeProviderClient fails the first `failures` launches.
+type fakeProviderClient struct {
+	provider.Client
+	failures int
+	launches int
+	snapshot types.Snapshot
+}
+
+func (c *fakeProviderClient) GetSnapshot(_ context.Context, _, _ string, _ types.JobInfo) (types.Snapshot, error) {
+	return c.snapshot, nil
+}
+
+func (c *fakeProviderClient) RunScanningJob(_ context.Context, _, _ string, _ provider.ScanningJobConfig) (types.Instance, error) {
+	c.launches++
+	if c.launches <= c.failures {
+		return nil, errors.New("insufficient capacity")
+	}
+	return &fakeInstance{}, nil
+}
+
+// unreachableInstance fails to get its root volume as if its region was
+// unavailable.
+type unreachableInstance struct {
+	types.Instance
+	calls int
+}
+
+func (i *unreachableInstance) GetID() string {
+	return "i-1"
+}
+
+func (i *unreachableInstance) GetLocation() string {
+	return "us-east-1"
+}
+
+func (i *unreachableInstance) GetRootVolume(_ context.Context) (types.Volume, error) {
+	i.calls++
+	return nil, errors.New("service unavailable")
+}
+
+func TestScanner_runJobCircuitBreaker(t *testing.T) {
+	instance := &unreachableInstance{}
+	s := &Scanner{
+		circuitBreakers: circuitbreaker.NewRegistry(circuitbreaker.Config{
+			FailureThreshold: 2,
+			OpenDuration:     time.Hour,
+		}),
+		config: &_config.ScannerConfig{},
+		scanConfig: &models.ScanConfig{
+			ScanFamiliesConfig: &models.ScanFamiliesConfig{},
+		},
+	}
+	data := &scanData{
+		targetInstance: &types.TargetInstance{
+			TargetID: "target-1",
+			Instance: instance,
+		},
+		scanResultID: "scan-result-1",
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := s.runJob(context.Background(), data); err == nil || errors.Is(err, circuitbreaker.ErrOpen) {
+			t.Fatalf("runJob() attempt %d error = %v, want a provider error", i, err)
+		}
+	}
+
+	_, err := s.runJob(context.Background(), data)
+	if !errors.Is(err, circuitbreaker.ErrOpen) {
+		t.Fatalf("runJob() error = %v, want %v", err, circuitbreaker.ErrOpen)
+	}
+	if instance.calls != 2 {
+		t.Errorf("runJob() called the provider %d times, want 2", instance.calls)
+	}
+}
+
+func TestScanner_runJobWithRetry(t *testing.T) {
+	tests := []struct {
+		name             string
+		maxJobRetries    int
+		failureThreshold int
+		wantCalls        int
+		wantErr          string
+		wantErrOpen      bool
+	}{
+		{
+			name:          "no retries",
+			maxJobRetries: 0,
+			wantCalls:     1,
+			wantErr:       "failed to get root volume of an instance i-1: service unavailable",
+		},
+		{
+			name:          "retries with fresh infrastructure",
+			maxJobRetries: 2,
+			wantCalls:     3,
+			wantErr:       "failed after 2 retries, last error: failed to get root volume of an instance i-1: service unavailable",
+		},
+		{
+			name:             "stops retrying when the circuit breaker opens",
+			maxJobRetries:    5,
+			failureThreshold: 2,
+			wantCalls:        2,
+			wantErrOpen:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &unreachableInstance{}
+			s := &Scanner{
+				circuitBreakers: circuitbreaker.NewRegistry(circuitbreaker.Config{
+					FailureThreshold: tt.failureThreshold,
+					OpenDuration:     time.Hour,
+				}),
+				config: &_config.ScannerConfig{
+					MaxJobRetries:    tt.maxJobRetries,
+					JobRetryInterval: time.Millisecond,
+				},
+				scanConfig: &models.ScanConfig{
+					ScanFamiliesConfig: &models.ScanFamiliesConfig{},
+				},
+			}
+			data := &scanData{
+				targetInstance: &types.TargetInstance{
+					TargetID: "target-1",
+					Instance: instance,
+				},
+				scanResultID: "scan-result-1",
+			}
+
+			_, err := s.runJobWithRetry(context.Background(), data)
+			if err == nil {
+				t.Fatalf("runJobWithRetry() expected an error")
+			}
+			if tt.wantErrOpen {
+				if !errors.Is(err, circuitbreaker.ErrOpen) {
+					t.Errorf("runJobWithRetry() error = %v, want %v", err, circuitbreaker.ErrOpen)
+				}
+			} else if err.Error() != tt.wantErr {
+				t.Errorf("runJobWithRetry() error = %v, want %v", err, tt.wantErr)
+			}
+			if instance.calls != tt.wantCalls {
+				t.Errorf("runJobWithRetry() called the provider %d times, want %d", instance.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestScanner_runScanningJobWithRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxAttempts  int
+		failures     int
+		wantLaunches int
+		wantErr      bool
+	}{
+		{
+			name:         "succeeds on first attempt",
+			maxAttempts:  3,
+			failures:     0,
+			wantLaunches: 1,
+		},
+		{
+			name:         "succeeds after transient failures",
+			maxAttempts:  3,
+			failures:     2,
+			wantLaunches: 3,
+		},
+		{
+			name:         "fails after max attempts",
+			maxAttempts:  3,
+			failures:     5,
+			wantLaunches: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "no retries when max attempts is not set",
+			maxAttempts:  0,
+			failures:     1,
+			wantLaunches: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			providerClient := &fakeProviderClient{failures: tt.failures}
+			s := &Scanner{
+				providerClient: providerClient,
+				config: &_config.ScannerConfig{
+					ScanningJobLaunchMaxAttempts:   tt.maxAttempts,
+					ScanningJobLaunchRetryInterval: time.Millisecond,
+				},
+			}
+
+			instance, err := s.runScanningJobWithRetry(context.Background(), &fakeSnapshot{}, provider.ScanningJobConfig{ScanResultID: "scan-result-1"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runScanningJobWithRetry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && instance == nil {
+				t.Errorf("runScanningJobWithRetry() returned a nil instance")
+			}
+			if providerClient.launches != tt.wantLaunches {
+				t.Errorf("runScanningJobWithRetry() launches = %v, want %v", providerClient.launches, tt.wantLaunches)
+			}
+		})
+	}
+}
+
+func TestScanner_copySnapshotWithRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		retries      int
+		copyFailures int
+		waitFailures int
+		wantCopies   int
+		wantDeletes  int
+		wantErr      bool
+	}{
+		{
+			name:       "succeeds on first attempt",
+			retries:    3,
+			wantCopies: 1,
+		},
+		{
+			name:         "succeeds after copy failures",
+			retries:      3,
+			copyFailures: 2,
+			wantCopies:   3,
+		},
+		{
+			name:         "deletes the copy when it fails to become ready",
+			retries:      3,
+			waitFailures: 1,
+			wantCopies:   2,
+			wantDeletes:  1,
+		},
+		{
+			name:         "fails after max retries",
+			retries:      2,
+			copyFailures: 5,
+			wantCopies:   3,
+			wantErr:      true,
+		},
+		{
+			name:         "no retries when retries is not set",
+			retries:      0,
+			copyFailures: 1,
+			wantCopies:   1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := &fakeSnapshot{copyFailures: tt.copyFailures, waitFailures: tt.waitFailures}
+			s := &Scanner{
+				config: &_config.ScannerConfig{
+					Region:                    "us-west-1",
+					SnapshotCopyRetries:       tt.retries,
+					SnapshotCopyRetryInterval: time.Millisecond,
+				},
+			}
+
+			cpySnapshot, err := s.copySnapshotWithRetry(context.Background(), snapshot)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("copySnapshotWithRetry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && cpySnapshot == nil {
+				t.Errorf("copySnapshotWithRetry() returned a nil snapshot")
+			}
+			if snapshot.copies != tt.wantCopies {
+				t.Errorf("copySnapshotWithRetry() copies = %v, want %v", snapshot.copies, tt.wantCopies)
+			}
+			if snapshot.deletes != tt.wantDeletes {
+				t.Errorf("copySnapshotWithRetry() deletes = %v, want %v", snapshot.deletes, tt.wantDeletes)
+			}
+		})
+	}
+}
+
+func TestScanner_existingSnapshotVolume(t *testing.T) {
+	tests := []struct {
+		name        string
+		region      string
+		wantCopies  int
+		wantDeletes int
+	}{
+		{
+			name:   "snapshot in the scanner region",
+			region: "us-east-1",
+		},
+		{
+			name:        "only the copy in the scanner region is deleted",
+			region:      "us-west-1",
+			wantCopies:  1,
+			wantDeletes: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := &fakeSnapshot{}
+			// The deletes of the snapshot and its copies are counted together.
+			snapshot.source = snapshot
+			s := &Scanner{
+				config: &_config.ScannerConfig{
+					Region: tt.region,
+				},
+				providerClient: &fakeProviderClient{snapshot: snapshot},
+			}
+
+			jobVolume, err := s.existingSnapshotVolume(context.Background(), &unreachableInstance{}, "snap-1", types.JobInfo{})
+			if err != nil {
+				t.Fatalf("existingSnapshotVolume() error = %v", err)
+			}
+			if !jobVolume.ExistingSnapshot {
+				t.Errorf("existingSnapshotVolume() the job volume isn't marked with an existing snapshot")
+			}
+			if snapshot.copies != tt.wantCopies {
+				t.Errorf("existingSnapshotVolume() copies = %v, want %v", snapshot.copies, tt.wantCopies)
+			}
+
+			s.deleteJob(context.Background(), &types.Job{Volumes: []types.JobVolume{jobVolume}})
+			if snapshot.deletes != tt.wantDeletes {
+				t.Errorf("deleteJob() deletes = %v, want %v", snapshot.deletes, tt.wantDeletes)
+			}
+		})
+	}
+}
+
+func TestScanner_getExistingSnapshotID(t *testing.T) {
+	existingSnapshots := []models.ExistingSnapshot{
+		{InstanceID: "i-1", SnapshotID: "snap-1"},
+		{InstanceID: "i-2", SnapshotID: "snap-2"},
+	}
+	tests := []struct {
+		name              string
+		existingSnapshots *[]models.ExistingSnapshot
+		instanceID        string
+		want              string
+	}{
+		{
+			name:       "no existing snapshots",
+			instanceID: "i-1",
+			want:       "",
+		},
+		{
+			name:              "existing snapshot of the instance",
+			existingSnapshots: &existingSnapshots,
+			instanceID:        "i-2",
+			want:              "snap-2",
+		},
+		{
+			name:              "no existing snapshot of the instance",
+			existingSnapshots: &existingSnapshots,
+			instanceID:        "i-3",
+			want:              "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scanner{
+				scanConfig: &models.ScanConfig{
+					ExistingSnapshots: tt.existingSnapshots,
+				},
+			}
+			if got := s.getExistingSnapshotID(tt.instanceID); got != tt.want {
+				t.Errorf("getExistingSnapshotID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanner_getJobTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		scanConfig *models.ScanConfig
+		want       time.Duration
+	}{
+		{
+			name:       "nil scan config",
+			scanConfig: nil,
+			want:       2 * time.Hour,
+		},
+		{
+			name:       "timeout not set in scan config",
+			scanConfig: &models.ScanConfig{},
+			want:       2 * time.Hour,
+		},
+		{
+			name: "timeout set in scan config",
+			scanConfig: &models.ScanConfig{
+				ScanJobTimeoutSeconds: utils.PointerTo(300),
+			},
+			want: 5 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scanner{
+				scanConfig: tt.scanConfig,
+				config: &_config.ScannerConfig{
+					JobResultTimeout: 2 * time.Hour,
+				},
+			}
+			if got := s.getJobTimeout(); got != tt.want {
+				t.Errorf("getJobTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanner_newResultPollingBackOff(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *_config.ScannerConfig
+		want   []time.Duration
+	}{
+		{
+			name: "fixed interval by default",
+			config: &_config.ScannerConfig{
+				JobResultsPollingInterval: 30 * time.Second,
+			},
+			want: []time.Duration{30 * time.Second, 30 * time.Second, 30 * time.Second},
+		},
+		{
+			name: "exponential up to the max interval",
+			config: &_config.ScannerConfig{
+				JobResultsPollingInterval:    10 * time.Second,
+				JobResultsPollingMaxInterval: time.Minute,
+				JobResultsPollingMultiplier:  2,
+			},
+			want: []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scanner{
+				config: tt.config,
+			}
+			b := s.newResultPollingBackOff()
+			for i := 0; i < 2; i++ {
+				var got []time.Duration
+				for range tt.want {
+					got = append(got, b.NextBackOff())
+				}
+				if diff := cmp.Diff(tt.want, got); diff != "" {
+					t.Errorf("newResultPollingBackOff() intervals mismatch (-want +got):\n%s", diff)
+				}
+				// The intervals start over once reset.
+				b.Reset()
+			}
+		})
+	}
+}
+
+func Test_instanceTypeForVolumeSize(t *testing.T) {
+	instanceTypes := []models.ScannerInstanceTypeByVolumeSize{
+		{
+			MinVolumeSizeGB: 500,
+			InstanceType:    "t3.2xlarge",
+		},
+		{
+			MinVolumeSizeGB: 0,
+			InstanceType:    "t3.large",
+		},
+		{
+			MinVolumeSizeGB: 100,
+			InstanceType:    "t3.xlarge",
+		},
+	}
+	tests := []struct {
+		name          string
+		instanceTypes []models.ScannerInstanceTypeByVolumeSize
+		sizeGB        int64
+		want          string
+	}{
+		{
+			name:          "no instance types",
+			instanceTypes: nil,
+			sizeGB:        20,
+			want:          "",
+		},
+		{
+			name:          "smallest",
+			instanceTypes: instanceTypes,
+			sizeGB:        20,
+			want:          "t3.large",
+		},
+		{
+			name:          "exact minimum size",
+			instanceTypes: instanceTypes,
+			sizeGB:        100,
+			want:          "t3.xlarge",
+		},
+		{
+			name:          "largest",
+			instanceTypes: instanceTypes,
+			sizeGB:        2000,
+			want:          "t3.2xlarge",
+		},
+		{
+			name: "smaller than all minimum sizes",
+			instanceTypes: []models.ScannerInstanceTypeByVolumeSize{
+				{
+					MinVolumeSizeGB: 100,
+					InstanceType:    "t3.xlarge",
+				},
+			},
+			sizeGB: 20,
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := instanceTypeForVolumeSize(tt.instanceTypes, tt.sizeGB); got != tt.want {
+				t.Errorf("instanceTypeForVolumeSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_scannerProxy(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         *_config.ScannerConfig
+		creationConfig *models.ScannerInstanceCreationConfig
+		want           *models.ScannerProxyConfig
+	}{
+		{
+			name:           "no proxy",
+			config:         &_config.ScannerConfig{},
+			creationConfig: &models.ScannerInstanceCreationConfig{},
+			want:           nil,
+		},
+		{
+			name: "orchestrator proxy",
+			config: &_config.ScannerConfig{
+				ScannerHTTPProxy:  "http://proxy:3128",
+				ScannerHTTPSProxy: "http://proxy:3128",
+				ScannerNoProxy:    "10.0.0.1",
+			},
+			creationConfig: nil,
+			want: &models.ScannerProxyConfig{
+				HttpProxy:  utils.PointerTo("http://proxy:3128"),
+				HttpsProxy: utils.PointerTo("http://proxy:3128"),
+				NoProxy:    utils.PointerTo("10.0.0.1"),
+			},
+		},
+		{
+			name:   "scan proxy",
+			config: &_config.ScannerConfig{},
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				Proxy: &models.ScannerProxyConfig{
+					HttpsProxy: utils.PointerTo("socks5://proxy:1080"),
+				},
+			},
+			want: &models.ScannerProxyConfig{
+				HttpProxy:  utils.PointerTo(""),
+				HttpsProxy: utils.PointerTo("socks5://proxy:1080"),
+				NoProxy:    utils.PointerTo(""),
+			},
+		},
+		{
+			name: "scan proxy overrides the orchestrator proxy field by field",
+			config: &_config.ScannerConfig{
+				ScannerHTTPProxy:  "http://proxy:3128",
+				ScannerHTTPSProxy: "http://proxy:3128",
+				ScannerNoProxy:    "10.0.0.1",
+			},
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				Proxy: &models.ScannerProxyConfig{
+					HttpsProxy: utils.PointerTo("http://scan-proxy:3128"),
+					NoProxy:    utils.PointerTo(""),
+				},
+			},
+			want: &models.ScannerProxyConfig{
+				HttpProxy:  utils.PointerTo("http://proxy:3128"),
+				HttpsProxy: utils.PointerTo("http://scan-proxy:3128"),
+				NoProxy:    utils.PointerTo("10.0.0.1"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scannerProxy(tt.config, tt.creationConfig)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("scannerProxy() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeDirectReadSnapshot can be read directly unless `unsupported` is set.
+type fakeDirectReadSnapshot struct {
+	types.Snapshot
+	id          string
+	size        int64
+	unsupported bool
+	err         error
+	sizeErr     error
+}
+
+func (s *fakeDirectReadSnapshot) GetID() string {
+	return s.id
+}
+
+func (s *fakeDirectReadSnapshot) GetSize(_ context.Context) (int64, error) {
+	return s.size, s.sizeErr
+}
+
+func (s *fakeDirectReadSnapshot) SupportsDirectRead(_ context.Context) (bool, error) {
+	return !s.unsupported, s.err
+}
+
+func TestScanner_directReadVolumes(t *testing.T) {
+	tests := []struct {
+		name       string
+		directRead bool
+		jobVolumes []types.JobVolume
+		want       []provider.DirectReadVolume
+	}{
+		{
+			name: "direct read disabled",
+			jobVolumes: []types.JobVolume{
+				{SrcSnapshot: &fakeDirectReadSnapshot{id: "snap-1", size: 8}},
+			},
+			want: nil,
+		},
+		{
+			name:       "snapshots in the scanner region and copies",
+			directRead: true,
+			jobVolumes: []types.JobVolume{
+				{SrcSnapshot: &fakeDirectReadSnapshot{id: "snap-1", size: 8}},
+				{
+					SrcSnapshot: &fakeDirectReadSnapshot{id: "snap-2", size: 100},
+					DstSnapshot: &fakeDirectReadSnapshot{id: "snap-3", size: 100},
+				},
+			},
+			want: []provider.DirectReadVolume{
+				{SnapshotID: "snap-1", Size: 8, DeviceName: "xvdh"},
+				{SnapshotID: "snap-3", Size: 100, DeviceName: "xvdi"},
+			},
+		},
+		{
+			name:       "provider without direct read",
+			directRead: true,
+			jobVolumes: []types.JobVolume{
+				{SrcSnapshot: &fakeDirectReadSnapshot{id: "snap-1", size: 8}},
+				{SrcSnapshot: &fakeSnapshot{}},
+			},
+			want: nil,
+		},
+		{
+			name:       "snapshot which can't be read directly",
+			directRead: true,
+			jobVolumes: []types.JobVolume{
+				{SrcSnapshot: &fakeDirectReadSnapshot{id: "snap-1", size: 8}},
+				{SrcSnapshot: &fakeDirectReadSnapshot{id: "snap-2", size: 8, unsupported: true}},
+			},
+			want: nil,
+		},
+		{
+			name:       "failed to check the snapshot",
+			directRead: true,
+			jobVolumes: []types.JobVolume{
+				{SrcSnapshot: &fakeDirectReadSnapshot{id: "snap-1", err: errors.New("request limit exceeded")}},
+			},
+			want: nil,
+		},
+		{
+			name:       "failed to get the size of the snapshot",
+			directRead: true,
+			jobVolumes: []types.JobVolume{
+				{SrcSnapshot: &fakeDirectReadSnapshot{id: "snap-1", sizeErr: errors.New("request limit exceeded")}},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scanner{
+				config: &_config.ScannerConfig{
+					SnapshotDirectRead: tt.directRead,
+					DeviceName:         "xvdh",
+				},
+			}
+			got := s.directReadVolumes(context.Background(), tt.jobVolumes)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("directReadVolumes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_deviceNameAllocator_Allocate(t *testing.T) {
+	tests := []struct {
+		name        string
+		deviceName  string
+		used        []string
+		allocations int
+		want        []string
+		wantErr     bool
+	}{
+		{
+			name:        "single volume",
+			deviceName:  "xvdh",
+			allocations: 1,
+			want:        []string{"xvdh"},
+		},
+		{
+			name:        "following volumes",
+			deviceName:  "xvdh",
+			allocations: 3,
+			want:        []string{"xvdh", "xvdi", "xvdj"},
+		},
+		{
+			name:        "used device names are skipped",
+			deviceName:  "xvdh",
+			used:        []string{"xvdh", "xvdj"},
+			allocations: 2,
+			want:        []string{"xvdi", "xvdk"},
+		},
+		{
+			name:        "last device name",
+			deviceName:  "xvdy",
+			allocations: 2,
+			want:        []string{"xvdy", "xvdz"},
+		},
+		{
+			name:        "no more device names",
+			deviceName:  "xvdy",
+			allocations: 3,
+			want:        []string{"xvdy", "xvdz"},
+			wantErr:     true,
+		},
+		{
+			name:        "device name doesn't end with a letter",
+			deviceName:  "vmclarity-1",
+			allocations: 2,
+			want:        []string{"vmclarity-1"},
+			wantErr:     true,
+		},
+		{
+			name:        "no device name for a single volume",
+			deviceName:  "",
+			allocations: 1,
+			want:        []string{""},
+		},
+		{
+			name:        "no device name for the following volumes",
+			deviceName:  "",
+			allocations: 2,
+			want:        []string{""},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocator := newDeviceNameAllocator(tt.deviceName, tt.used...)
+			var got []string
+			var err error
+			for i := 0; i < tt.allocations; i++ {
+				var deviceName string
+				deviceName, err = allocator.Allocate()
+				if err != nil {
+					break
+				}
+				got = append(got, deviceName)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Allocate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Allocate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_scannerCommandOptions(t *testing.T) {
+	options := &map[string]models.ScannerCommandOptions{
+		"gitleaks": {
+			ExtraArgs: &[]string{"--config", "/etc/gitleaks.toml"},
+			Env:       &map[string]string{"GITLEAKS_CONFIG": "/etc/gitleaks.toml"},
+		},
+		"lynis": {},
+	}
+	tests := []struct {
+		name        string
+		options     *map[string]models.ScannerCommandOptions
+		scannerName string
+		want        familiesTypes.CommandOptions
+	}{
+		{
+			name:        "no options",
+			options:     nil,
+			scannerName: "gitleaks",
+			want:        familiesTypes.CommandOptions{},
+		},
+		{
+			name:        "scanner without options",
+			options:     options,
+			scannerName: "trufflehog",
+			want:        familiesTypes.CommandOptions{},
+		},
+		{
+			name:        "scanner with empty options",
+			options:     options,
+			scannerName: "lynis",
+			want:        familiesTypes.CommandOptions{},
+		},
+		{
+			name:        "scanner with options",
+			options:     options,
+			scannerName: "gitleaks",
+			want: familiesTypes.CommandOptions{
+				ExtraArgs: []string{"--config", "/etc/gitleaks.toml"},
+				Env:       map[string]string{"GITLEAKS_CONFIG": "/etc/gitleaks.toml"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scannerCommandOptions(tt.options, tt.scannerName)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("scannerCommandOptions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeScanBackend serves a running scan and the scan results of its targets,
+// whose jobs were launched before the orchestrator was restarted. A job
+// reports its first completed family on the second status poll and completes
+// on the third one, the jobs of the failing targets complete with errors.
+type fakeScanBackend struct {
+	failing map[string]bool
+	// hanging is set when the jobs never complete.
+	hanging bool
+
+	mu        sync.Mutex
+	polls     map[string]int
+	statuses  map[string]*models.TargetScanStatus // the statuses patched by the scanner
+	finalScan *models.Scan
+}
+
+func (b *fakeScanBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body interface{}
+	switch {
+	case r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/scanResults/"):
+		body = b.scanResult(path.Base(r.URL.Path), r.URL.Query().Get("$select"))
+	case r.Method == http.MethodPatch && strings.Contains(r.URL.Path, "/scanResults/"):
+		var scanResult models.TargetScanResult
+		if err := json.NewDecoder(r.Body).Decode(&scanResult); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		b.mu.Lock()
+		b.statuses[path.Base(r.URL.Path)] = scanResult.Status
+		b.mu.Unlock()
+		body = scanResult
+	case r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/scans/"):
+		body = models.Scan{
+			Id:    utils.PointerTo("scan-1"),
+			State: utils.PointerTo(models.ScanStateInProgress),
+		}
+	case r.Method == http.MethodPatch && strings.Contains(r.URL.Path, "/scans/"):
+		var scan models.Scan
+		if err := json.NewDecoder(r.Body).Decode(&scan); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if scan.EndTime != nil {
+			b.mu.Lock()
+			b.finalScan = &scan
+			b.mu.Unlock()
+		}
+		body = scan
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/ignoreRules"):
+		body = models.IgnoreRules{}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (b *fakeScanBackend) scanResult(scanResultID, selectFields string) models.TargetScanResult {
+	switch selectFields {
+	case "status":
+		b.mu.Lock()
+		b.polls[scanResultID]++
+		polls := b.polls[scanResultID]
+		patched := b.statuses[scanResultID]
+		b.mu.Unlock()
+
+		if patched != nil {
+			return models.TargetScanResult{Status: patched}
+		}
+		status := &models.TargetScanStatus{
+			General: &models.TargetScanState{State: utils.PointerTo(models.ATTACHED)},
+		}
+		switch {
+		case b.hanging:
+		case polls == 2:
+			status.General.State = utils.PointerTo(models.INPROGRESS)
+			status.Sbom = &models.TargetScanState{State: utils.PointerTo(models.DONE)}
+		case polls > 2:
+			status.General.State = utils.PointerTo(models.DONE)
+			if b.failing[scanResultID] {
+				status.General.Errors = &[]string{"failed"}
+			}
+		}
+		return models.TargetScanResult{Status: status}
+	case "summary":
+		return models.TargetScanResult{
+			Summary: &models.ScanFindingsSummary{TotalPackages: utils.PointerTo(1)},
+		}
+	default:
+		return models.TargetScanResult{}
+	}
+}
+
+// newFakeScan creates a scanner of a running scan of the given number of
+// targets, whose odd targets fail, served by the returned fake backend.
+func newFakeScan(t *testing.T, targets int) (*Scanner, *fakeScanBackend) {
+	t.Helper()
+
+	backend := &fakeScanBackend{
+		failing:  map[string]bool{},
+		polls:    map[string]int{},
+		statuses: map[string]*models.TargetScanStatus{},
+	}
+	targetIDToScanData := make(map[string]*scanData, targets)
+	for i := 0; i < targets; i++ {
+		targetID := fmt.Sprintf("target-%d", i)
+		scanResultID := fmt.Sprintf("result-%d", i)
+		targetIDToScanData[targetID] = &scanData{
+			targetInstance: &types.TargetInstance{TargetID: targetID},
+			scanResultID:   scanResultID,
+		}
+		if i%2 == 1 {
+			backend.failing[scanResultID] = true
+		}
+	}
+
+	server := httptest.NewServer(backend)
+	t.Cleanup(server.Close)
+	client, err := backendclient.Create(server.URL, backendclient.Config{})
+	if err != nil {
+		t.Fatalf("failed to create backend client: %v", err)
+	}
+
+	s := &Scanner{
+		targetIDToScanData: targetIDToScanData,
+		scanConfig: &models.ScanConfig{
+			MaxParallelScanners: utils.PointerTo(10),
+		},
+		killSignal:    make(chan bool),
+		metrics:       newScannerMetrics("test"),
+		backendClient: client,
+		scanID:        "scan-1",
+		config: &_config.ScannerConfig{
+			JobResultTimeout:          time.Minute,
+			JobResultsPollingInterval: time.Millisecond,
+			WorkerPoolMinWorkers:      1,
+			WorkerPoolIdleTimeout:     10 * time.Millisecond,
+		},
+	}
+	// Stop the workers left once the scan is completed.
+	t.Cleanup(s.Clear)
+
+	return s, backend
+}
+
+// runFakeScan runs the scan of newFakeScan and checks that all of its targets
+// were handled and that it failed because of its failing targets.
+func runFakeScan(t *testing.T, s *Scanner, backend *fakeScanBackend, targets int) {
+	t.Helper()
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		s.jobBatchManagement(context.Background())
+	}()
+	select {
+	case <-finished:
+	case <-time.After(time.Minute):
+		t.Fatalf("jobBatchManagement() didn't complete the scan")
+	}
+
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+	if backend.finalScan == nil {
+		t.Fatalf("jobBatchManagement() didn't patch the final state of the scan")
+	}
+	want := &models.Scan{
+		State:        utils.PointerTo(models.ScanStateFailed),
+		StateMessage: utils.PointerTo("One or more ScanJobs failed"),
+		StateReason:  utils.PointerTo(models.ScanStateReasonOneOrMoreTargetFailedToScan),
+	}
+	got := &models.Scan{
+		State:        backend.finalScan.State,
+		StateMessage: backend.finalScan.StateMessage,
+		StateReason:  backend.finalScan.StateReason,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("jobBatchManagement() final scan state mismatch (-want +got):\n%s", diff)
+	}
+	for scanResultID, polls := range backend.polls {
+		if polls < 3 {
+			t.Errorf("jobBatchManagement() the job of scan result %v wasn't waited for, polls = %v", scanResultID, polls)
+		}
+	}
+	if len(backend.polls) != targets {
+		t.Errorf("jobBatchManagement() handled %v targets, want %v", len(backend.polls), targets)
+	}
+	if workers := testutil.ToFloat64(s.metrics.workers); workers != 0 {
+		t.Errorf("jobBatchManagement() left %v workers running", workers)
+	}
+}
+
+func TestScanner_jobBatchManagementConcurrentTargets(t *testing.T) {
+	const targets = 50
+	s, backend := newFakeScan(t, targets)
+
+	runFakeScan(t, s, backend, targets)
+}
+
+func TestScanner_jobBatchManagementTargetsChangedWhileRunning(t *testing.T) {
+	const targets = 50
+	s, backend := newFakeScan(t, targets)
+
+	// Change the targets of the scanner once the jobs are being waited
+	// for, the running scan keeps handling the targets it started with.
+	changed := make(chan struct{})
+	go func() {
+		defer close(changed)
+		for {
+			backend.mu.Lock()
+			polled := len(backend.polls) > 0
+			backend.mu.Unlock()
+			if polled {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		s.Lock()
+		defer s.Unlock()
+		for targetID := range s.targetIDToScanData {
+			delete(s.targetIDToScanData, targetID)
+		}
+		s.targetIDToScanData["target-new"] = &scanData{
+			targetInstance: &types.TargetInstance{TargetID: "target-new"},
+			scanResultID:   "result-new",
+		}
+	}()
+
+	runFakeScan(t, s, backend, targets)
+	<-changed
+}
+
+func TestScanner_jobBatchManagementDrain(t *testing.T) {
+	const targets = 10
+	s, backend := newFakeScan(t, targets)
+	backend.hanging = true
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		s.jobBatchManagement(context.Background())
+	}()
+
+	// Drain the scan once the jobs of all the targets are waited for.
+	for {
+		backend.mu.Lock()
+		polled := len(backend.polls) == targets
+		backend.mu.Unlock()
+		if polled {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	s.Drain()
+	select {
+	case <-finished:
+	case <-time.After(time.Minute):
+		t.Fatalf("jobBatchManagement() didn't return once the scan was drained")
+	}
+
+	backend.mu.Lock()
+	for i := 0; i < targets; i++ {
+		scanResultID := fmt.Sprintf("result-%d", i)
+		status := backend.statuses[scanResultID]
+		if status == nil || status.General == nil {
+			t.Errorf("Drain() didn't patch the status of scan result %v", scanResultID)
+			continue
+		}
+		want := &models.TargetScanState{
+			State:  utils.PointerTo(models.NOTSCANNED),
+			Errors: &[]string{errScanDrained.Error()},
+		}
+		if diff := cmp.Diff(want, status.General); diff != "" {
+			t.Errorf("Drain() scan result %v status mismatch (-want +got):\n%s", scanResultID, diff)
+		}
+	}
+	backend.mu.Unlock()
+
+	// Once the orchestrator is started again, the drained targets are
+	// handled right away instead of waiting for the job timeout.
+	restored := &Scanner{
+		killSignal:    make(chan bool),
+		metrics:       newScannerMetrics("test"),
+		backendClient: s.backendClient,
+		scanID:        s.scanID,
+		config: &_config.ScannerConfig{
+			JobResultTimeout:          time.Hour,
+			JobResultsPollingInterval: time.Millisecond,
+		},
+	}
+	defer restored.Clear()
+	data := &scanData{
+		targetInstance: &types.TargetInstance{TargetID: "target-0"},
+		scanResultID:   "result-0",
+	}
+	handled := make(chan error)
+	go func() {
+		_, err := restored.handleScanData(context.Background(), data, make(chan partialSummary), restored.killSignal)
+		handled <- err
+	}()
+	select {
+	case err := <-handled:
+		if err != nil {
+			t.Errorf("handleScanData() error = %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("handleScanData() waited for the job of a drained target")
+	}
+	if !data.completed {
+		t.Errorf("handleScanData() didn't complete the drained target")
+	}
+}
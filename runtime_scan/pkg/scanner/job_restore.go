@@ -0,0 +1,146 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// persistJobResources saves the IDs of the resources of the job on the scan
+// result of the target, so that the job can be restored by an orchestrator
+// restarted while the job is running.
+func (s *Scanner) persistJobResources(ctx context.Context, data *scanData, job types.Job) {
+	err := s.backendClient.PatchScanResult(ctx, models.TargetScanResult{
+		JobResources: jobToScanJobResources(job),
+	}, data.scanResultID)
+	if err != nil {
+		// The orphan reaper cleans up the job if it can't be restored.
+		log.WithFields(s.logFields).Warnf("Failed to persist the job resources. scanResultID=%v: %v", data.scanResultID, err)
+	}
+}
+
+// restoreJob reconstructs the job of a target which was launched before the
+// orchestrator was restarted from the resource IDs persisted on its scan
+// result. Resources which no longer exist are left out of the job.
+func (s *Scanner) restoreJob(ctx context.Context, data *scanData) (types.Job, error) {
+	scanResult, err := s.backendClient.GetScanResult(ctx, data.scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.StringPtr("jobResources"),
+	})
+	if err != nil {
+		return types.Job{}, fmt.Errorf("failed to get the job resources of the scan result: %v", err)
+	}
+	if scanResult.JobResources == nil {
+		return types.Job{}, nil
+	}
+
+	resources, err := s.listJobResources(ctx)
+	if err != nil {
+		return types.Job{}, err
+	}
+
+	return scanJobResourcesToJob(*scanResult.JobResources, data.scanResultID, resources), nil
+}
+
+// listJobResources lists the resources of the scanning jobs once per scan,
+// since all the restored jobs of the scan are looked up in them.
+func (s *Scanner) listJobResources(ctx context.Context) ([]types.JobResource, error) {
+	s.jobResourcesOnce.Do(func() {
+		s.jobResources, s.jobResourcesErr = s.providerClient.ListJobResources(ctx)
+	})
+	if s.jobResourcesErr != nil {
+		return nil, fmt.Errorf("failed to list the job resources: %v", s.jobResourcesErr)
+	}
+	return s.jobResources, nil
+}
+
+func jobToScanJobResources(job types.Job) *models.ScanJobResources {
+	ret := &models.ScanJobResources{
+		Volumes: utils.PointerTo(make([]models.ScanJobVolumeResources, 0, len(job.Volumes))),
+	}
+	if job.Instance != nil {
+		ret.InstanceID = utils.StringPtr(job.Instance.GetID())
+		ret.InstanceLocation = utils.StringPtr(job.Instance.GetLocation())
+	}
+	for _, jobVolume := range job.Volumes {
+		volumeResources := models.ScanJobVolumeResources{
+			ExistingSnapshot: utils.BoolPtr(jobVolume.ExistingSnapshot),
+		}
+		if jobVolume.SrcSnapshot != nil {
+			volumeResources.SrcSnapshotID = utils.StringPtr(jobVolume.SrcSnapshot.GetID())
+		}
+		if jobVolume.DstSnapshot != nil {
+			volumeResources.DstSnapshotID = utils.StringPtr(jobVolume.DstSnapshot.GetID())
+		}
+		if jobVolume.Volume != nil {
+			volumeResources.VolumeID = utils.StringPtr(jobVolume.Volume.GetID())
+		}
+		*ret.Volumes = append(*ret.Volumes, volumeResources)
+	}
+
+	return ret
+}
+
+// scanJobResourcesToJob returns the job with the persisted resource IDs,
+// looked up in the resources of the scanning jobs of the scan result.
+func scanJobResourcesToJob(jobResources models.ScanJobResources, scanResultID string, resources []types.JobResource) types.Job {
+	instances := make(map[string]types.Instance)
+	volumes := make(map[string]types.Volume)
+	snapshots := make(map[string]types.Snapshot)
+	for _, resource := range resources {
+		if resource.ScanResultID != scanResultID {
+			continue
+		}
+		switch {
+		case resource.Instance != nil:
+			instances[resource.Instance.GetID()] = resource.Instance
+		case resource.Volume != nil:
+			volumes[resource.Volume.GetID()] = resource.Volume
+		case resource.Snapshot != nil:
+			snapshots[resource.Snapshot.GetID()] = resource.Snapshot
+		}
+	}
+
+	var job types.Job
+	if instance, ok := instances[utils.ValueOrZero(jobResources.InstanceID)]; ok {
+		job.Instance = instance
+	}
+	for _, volumeResources := range utils.ValueOrZero(jobResources.Volumes) {
+		// Existing snapshots aren't tagged with the job so they are never
+		// found, and never deleted with the job.
+		jobVolume := types.JobVolume{
+			ExistingSnapshot: utils.ValueOrZero(volumeResources.ExistingSnapshot),
+		}
+		if snapshot, ok := snapshots[utils.ValueOrZero(volumeResources.SrcSnapshotID)]; ok {
+			jobVolume.SrcSnapshot = snapshot
+		}
+		if snapshot, ok := snapshots[utils.ValueOrZero(volumeResources.DstSnapshotID)]; ok {
+			jobVolume.DstSnapshot = snapshot
+		}
+		if volume, ok := volumes[utils.ValueOrZero(volumeResources.VolumeID)]; ok {
+			jobVolume.Volume = volume
+		}
+		job.Volumes = append(job.Volumes, jobVolume)
+	}
+
+	return job
+}
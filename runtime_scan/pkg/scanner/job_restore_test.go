@@ -0,0 +1,169 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+type restoredInstance struct {
+	types.Instance
+	ID       string
+	Location string
+}
+
+func (i *restoredInstance) GetID() string {
+	return i.ID
+}
+
+func (i *restoredInstance) GetLocation() string {
+	return i.Location
+}
+
+type restoredVolume struct {
+	types.Volume
+	ID string
+}
+
+func (v *restoredVolume) GetID() string {
+	return v.ID
+}
+
+type restoredSnapshot struct {
+	types.Snapshot
+	ID string
+}
+
+func (s *restoredSnapshot) GetID() string {
+	return s.ID
+}
+
+func TestScanner_jobResourcesRestore(t *testing.T) {
+	instance := &restoredInstance{ID: "i-1", Location: "us-east-1"}
+	rootSrcSnapshot := &restoredSnapshot{ID: "snap-src-1"}
+	rootDstSnapshot := &restoredSnapshot{ID: "snap-dst-1"}
+	rootVolume := &restoredVolume{ID: "vol-1"}
+	existingSnapshot := &restoredSnapshot{ID: "snap-existing"}
+	dataVolume := &restoredVolume{ID: "vol-2"}
+
+	job := types.Job{
+		Instance: instance,
+		Volumes: []types.JobVolume{
+			{
+				SrcSnapshot: rootSrcSnapshot,
+				DstSnapshot: rootDstSnapshot,
+				Volume:      rootVolume,
+			},
+			{
+				SrcSnapshot:      existingSnapshot,
+				Volume:           dataVolume,
+				ExistingSnapshot: true,
+			},
+		},
+	}
+	jobInfo := types.JobInfo{ScanID: "scan-1", ScanResultID: "scan-result-1", TargetID: "target-1"}
+	otherJobInfo := types.JobInfo{ScanID: "scan-1", ScanResultID: "scan-result-2", TargetID: "target-2"}
+
+	persisted := jobToScanJobResources(job)
+	wantPersisted := &models.ScanJobResources{
+		InstanceID:       utils.StringPtr("i-1"),
+		InstanceLocation: utils.StringPtr("us-east-1"),
+		Volumes: &[]models.ScanJobVolumeResources{
+			{
+				SrcSnapshotID:    utils.StringPtr("snap-src-1"),
+				DstSnapshotID:    utils.StringPtr("snap-dst-1"),
+				VolumeID:         utils.StringPtr("vol-1"),
+				ExistingSnapshot: utils.BoolPtr(false),
+			},
+			{
+				SrcSnapshotID:    utils.StringPtr("snap-existing"),
+				VolumeID:         utils.StringPtr("vol-2"),
+				ExistingSnapshot: utils.BoolPtr(true),
+			},
+		},
+	}
+	if diff := cmp.Diff(wantPersisted, persisted); diff != "" {
+		t.Fatalf("jobToScanJobResources() mismatch (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		name      string
+		resources []types.JobResource
+		want      *models.ScanJobResources
+	}{
+		{
+			name: "all the resources exist",
+			resources: []types.JobResource{
+				{JobInfo: jobInfo, Instance: instance},
+				{JobInfo: jobInfo, Snapshot: rootSrcSnapshot},
+				{JobInfo: jobInfo, Snapshot: rootDstSnapshot},
+				{JobInfo: jobInfo, Volume: rootVolume},
+				{JobInfo: jobInfo, Volume: dataVolume},
+				{JobInfo: otherJobInfo, Volume: &restoredVolume{ID: "vol-3"}},
+			},
+			// The existing snapshot isn't a job resource so it's never
+			// restored, and never deleted with the job.
+			want: &models.ScanJobResources{
+				InstanceID:       utils.StringPtr("i-1"),
+				InstanceLocation: utils.StringPtr("us-east-1"),
+				Volumes: &[]models.ScanJobVolumeResources{
+					{
+						SrcSnapshotID:    utils.StringPtr("snap-src-1"),
+						DstSnapshotID:    utils.StringPtr("snap-dst-1"),
+						VolumeID:         utils.StringPtr("vol-1"),
+						ExistingSnapshot: utils.BoolPtr(false),
+					},
+					{
+						VolumeID:         utils.StringPtr("vol-2"),
+						ExistingSnapshot: utils.BoolPtr(true),
+					},
+				},
+			},
+		},
+		{
+			name: "resources of other jobs or already deleted are left out",
+			resources: []types.JobResource{
+				{JobInfo: otherJobInfo, Instance: instance},
+				{JobInfo: jobInfo, Volume: rootVolume},
+			},
+			want: &models.ScanJobResources{
+				Volumes: &[]models.ScanJobVolumeResources{
+					{
+						VolumeID:         utils.StringPtr("vol-1"),
+						ExistingSnapshot: utils.BoolPtr(false),
+					},
+					{
+						ExistingSnapshot: utils.BoolPtr(true),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restored := scanJobResourcesToJob(*persisted, "scan-result-1", tt.resources)
+			if diff := cmp.Diff(tt.want, jobToScanJobResources(restored)); diff != "" {
+				t.Errorf("scanJobResourcesToJob() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	metricsNamespace = "vmclarity"
+	metricsSubsystem = "scanner"
+)
+
+// The metrics are labeled with the provider type so that the providers can be
+// compared.
+var (
+	scansStarted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "scans_started_total",
+		Help:      "The number of scans started.",
+	}, []string{"provider"})
+	jobsCompleted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "jobs_completed_total",
+		Help:      "The number of scanning jobs which completed successfully.",
+	}, []string{"provider"})
+	jobsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "jobs_failed_total",
+		Help:      "The number of scanning jobs which failed or timed out.",
+	}, []string{"provider"})
+	queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "queue_depth",
+		Help:      "The number of targets of the running scans waiting for a free worker.",
+	}, []string{"provider"})
+	workers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "workers",
+		Help:      "The number of workers of the running scans, busy or idle.",
+	}, []string{"provider"})
+	workersBusy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "workers_busy",
+		Help:      "The number of workers of the running scans which are handling a target.",
+	}, []string{"provider"})
+	snapshotCreationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "snapshot_creation_duration_seconds",
+		Help:      "The time it took to take a snapshot of a target's root volume until it was ready.",
+		Buckets:   prometheus.ExponentialBuckets(5, 2, 10), // nolint:gomnd
+	}, []string{"provider"})
+	volumeCreationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "volume_creation_duration_seconds",
+		Help:      "The time it took to create a volume from a snapshot until it was ready.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10), // nolint:gomnd
+	}, []string{"provider"})
+)
+
+// scannerMetrics holds the metrics of a scanner, labeled with its provider.
+type scannerMetrics struct {
+	scansStarted             prometheus.Counter
+	jobsCompleted            prometheus.Counter
+	jobsFailed               prometheus.Counter
+	queueDepth               prometheus.Gauge
+	workers                  prometheus.Gauge
+	workersBusy              prometheus.Gauge
+	snapshotCreationDuration prometheus.Observer
+	volumeCreationDuration   prometheus.Observer
+}
+
+func newScannerMetrics(provider string) *scannerMetrics {
+	return &scannerMetrics{
+		scansStarted:             scansStarted.WithLabelValues(provider),
+		jobsCompleted:            jobsCompleted.WithLabelValues(provider),
+		jobsFailed:               jobsFailed.WithLabelValues(provider),
+		queueDepth:               queueDepth.WithLabelValues(provider),
+		workers:                  workers.WithLabelValues(provider),
+		workersBusy:              workersBusy.WithLabelValues(provider),
+		snapshotCreationDuration: snapshotCreationDuration.WithLabelValues(provider),
+		volumeCreationDuration:   volumeCreationDuration.WithLabelValues(provider),
+	}
+}
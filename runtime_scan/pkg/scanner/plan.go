@@ -0,0 +1,88 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// Plan plans a scan of the scan config without launching any
+// infrastructure: the targets matching its scope are discovered and the
+// families configuration of the scanning jobs is generated, but no scanning
+// job is run.
+func Plan(ctx context.Context, config *_config.ScannerConfig, providerClient provider.Client, scanConfig models.ScanConfigData) (*models.ScanPlan, error) {
+	if scanConfig.Scope == nil {
+		return nil, fmt.Errorf("scan config has no scope")
+	}
+	if scanConfig.ScanFamiliesConfig == nil {
+		return nil, fmt.Errorf("scan config has no families config")
+	}
+	familiesConfig, err := ResolveFamiliesPreset(scanConfig.ScanFamiliesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Scanner{
+		config: config,
+		scanConfig: &models.ScanConfig{
+			ScanFamiliesConfig: familiesConfig,
+		},
+	}
+	familiesConfiguration, err := s.generateRedactedFamiliesConfigurationYaml()
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := providerClient.DiscoverInstances(ctx, scanConfig.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover instances to scan: %v", err)
+	}
+	targets := make([]models.ScanPlanTarget, 0, len(instances))
+	for _, instance := range instances {
+		targets = append(targets, models.ScanPlanTarget{
+			InstanceID:       runtimeScanUtils.PointerTo(instance.GetID()),
+			InstanceProvider: runtimeScanUtils.PointerTo(instance.GetProvider()),
+			Location:         runtimeScanUtils.PointerTo(instance.GetLocation()),
+		})
+	}
+
+	return &models.ScanPlan{
+		Targets:          &targets,
+		Jobs:             runtimeScanUtils.PointerTo(len(targets)),
+		ParallelScanners: runtimeScanUtils.PointerTo(planParallelScanners(scanConfig.MaxParallelScanners, len(targets))),
+		FamiliesConfig:   runtimeScanUtils.PointerTo(familiesConfiguration),
+	}, nil
+}
+
+// planParallelScanners returns the number of scanning jobs which run in
+// parallel, which is bounded by the number of jobs. A single scanner runs at a
+// time if the max parallel scanners isn't set.
+func planParallelScanners(maxParallelScanners *int, jobs int) int {
+	parallelScanners := 1
+	if maxParallelScanners != nil && *maxParallelScanners > 0 {
+		parallelScanners = *maxParallelScanners
+	}
+	if jobs < parallelScanners {
+		return jobs
+	}
+	return parallelScanners
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+type planInstance struct {
+	types.Instance
+	id       string
+	location string
+}
+
+func (i *planInstance) GetID() string {
+	return i.id
+}
+
+func (i *planInstance) GetLocation() string {
+	return i.location
+}
+
+func (i *planInstance) GetProvider() models.CloudProvider {
+	return models.AWS
+}
+
+// planProviderClient discovers the same instances for any scope and fails
+// any attempt to launch a scanning job.
+type planProviderClient struct {
+	provider.Client
+	instances []types.Instance
+}
+
+func (c *planProviderClient) DiscoverInstances(_ context.Context, _ *models.ScanScopeType) ([]types.Instance, error) {
+	return c.instances, nil
+}
+
+func TestPlan(t *testing.T) {
+	providerClient := &planProviderClient{
+		instances: []types.Instance{
+			&planInstance{id: "i-1", location: "us-east-1"},
+			&planInstance{id: "i-2", location: "eu-west-1"},
+		},
+	}
+	scanConfig := models.ScanConfigData{
+		Scope:               &models.ScanScopeType{},
+		MaxParallelScanners: utils.PointerTo(5),
+		ScanFamiliesConfig: &models.ScanFamiliesConfig{
+			Sbom: &models.SBOMConfig{
+				Enabled: utils.PointerTo(true),
+			},
+		},
+	}
+
+	got, err := Plan(context.Background(), &_config.ScannerConfig{}, providerClient, scanConfig)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+
+	wantTargets := &[]models.ScanPlanTarget{
+		{
+			InstanceID:       utils.PointerTo("i-1"),
+			InstanceProvider: utils.PointerTo(models.AWS),
+			Location:         utils.PointerTo("us-east-1"),
+		},
+		{
+			InstanceID:       utils.PointerTo("i-2"),
+			InstanceProvider: utils.PointerTo(models.AWS),
+			Location:         utils.PointerTo("eu-west-1"),
+		},
+	}
+	if diff := cmp.Diff(wantTargets, got.Targets); diff != "" {
+		t.Errorf("Plan() targets mismatch (-want +got):\n%s", diff)
+	}
+	if *got.Jobs != 2 {
+		t.Errorf("Plan() jobs = %v, want 2", *got.Jobs)
+	}
+	if *got.ParallelScanners != 2 {
+		t.Errorf("Plan() parallel scanners = %v, want 2", *got.ParallelScanners)
+	}
+	if got.FamiliesConfig == nil || *got.FamiliesConfig == "" {
+		t.Errorf("Plan() families config is empty")
+	}
+}
+
+func TestPlan_invalidScanConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		scanConfig models.ScanConfigData
+	}{
+		{
+			name: "no scope",
+			scanConfig: models.ScanConfigData{
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{},
+			},
+		},
+		{
+			name: "no families config",
+			scanConfig: models.ScanConfigData{
+				Scope: &models.ScanScopeType{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Plan(context.Background(), &_config.ScannerConfig{}, &planProviderClient{}, tt.scanConfig); err == nil {
+				t.Errorf("Plan() expected an error")
+			}
+		})
+	}
+}
+
+func Test_planParallelScanners(t *testing.T) {
+	tests := []struct {
+		name                string
+		maxParallelScanners *int
+		jobs                int
+		want                int
+	}{
+		{
+			name:                "not set",
+			maxParallelScanners: nil,
+			jobs:                10,
+			want:                1,
+		},
+		{
+			name:                "bounded by max parallel scanners",
+			maxParallelScanners: utils.PointerTo(3),
+			jobs:                10,
+			want:                3,
+		},
+		{
+			name:                "bounded by jobs",
+			maxParallelScanners: utils.PointerTo(3),
+			jobs:                2,
+			want:                2,
+		},
+		{
+			name:                "no jobs",
+			maxParallelScanners: utils.PointerTo(3),
+			jobs:                0,
+			want:                0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := planParallelScanners(tt.maxParallelScanners, tt.jobs); got != tt.want {
+				t.Errorf("planParallelScanners() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// familiesPresets are the scan families configs a scan families config can be
+// based on with its preset.
+var familiesPresets = map[models.ScanFamiliesConfigPreset]models.ScanFamiliesConfig{
+	models.Quick: {
+		Sbom:            &models.SBOMConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+		Vulnerabilities: &models.VulnerabilitiesConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+	},
+	models.Full: {
+		Sbom:              &models.SBOMConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+		Vulnerabilities:   &models.VulnerabilitiesConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+		Secrets:           &models.SecretsConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+		Malware:           &models.MalwareConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+		Rootkits:          &models.RootkitsConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+		Misconfigurations: &models.MisconfigurationsConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+		Exploits:          &models.ExploitsConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+	},
+	models.SecretsOnly: {
+		Secrets: &models.SecretsConfig{Enabled: runtimeScanUtils.PointerTo(true)},
+	},
+}
+
+// ResolveFamiliesPreset returns the scan families config expanded from its
+// preset. The fields set in the scan families config override the ones of the
+// preset, the same way the scanner config overlay is merged. The scan families
+// config is returned as is if it has no preset.
+func ResolveFamiliesPreset(familiesConfig *models.ScanFamiliesConfig) (*models.ScanFamiliesConfig, error) {
+	if familiesConfig == nil || familiesConfig.Preset == nil {
+		return familiesConfig, nil
+	}
+
+	preset, ok := familiesPresets[*familiesConfig.Preset]
+	if !ok {
+		return nil, fmt.Errorf("unknown scan families preset %q", *familiesConfig.Preset)
+	}
+
+	base, err := familiesConfigToMap(preset)
+	if err != nil {
+		return nil, err
+	}
+	override, err := familiesConfigToMap(*familiesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := json.Marshal(mergeConfigMaps(base, override))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resolved scan families config: %w", err)
+	}
+	var resolved models.ScanFamiliesConfig
+	if err := json.Unmarshal(merged, &resolved); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resolved scan families config: %w", err)
+	}
+
+	return &resolved, nil
+}
+
+func familiesConfigToMap(familiesConfig models.ScanFamiliesConfig) (map[string]interface{}, error) {
+	b, err := json.Marshal(familiesConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scan families config: %w", err)
+	}
+	var ret map[string]interface{}
+	if err := json.Unmarshal(b, &ret); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scan families config: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,112 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func TestResolveFamiliesPreset(t *testing.T) {
+	tests := []struct {
+		name           string
+		familiesConfig *models.ScanFamiliesConfig
+		want           *models.ScanFamiliesConfig
+		wantErr        bool
+	}{
+		{
+			name: "no preset",
+			familiesConfig: &models.ScanFamiliesConfig{
+				Secrets: &models.SecretsConfig{Enabled: utils.PointerTo(true)},
+			},
+			want: &models.ScanFamiliesConfig{
+				Secrets: &models.SecretsConfig{Enabled: utils.PointerTo(true)},
+			},
+		},
+		{
+			name: "quick preset",
+			familiesConfig: &models.ScanFamiliesConfig{
+				Preset: utils.PointerTo(models.Quick),
+			},
+			want: &models.ScanFamiliesConfig{
+				Preset:          utils.PointerTo(models.Quick),
+				Sbom:            &models.SBOMConfig{Enabled: utils.PointerTo(true)},
+				Vulnerabilities: &models.VulnerabilitiesConfig{Enabled: utils.PointerTo(true)},
+			},
+		},
+		{
+			name: "overrides on top of a preset",
+			familiesConfig: &models.ScanFamiliesConfig{
+				Preset: utils.PointerTo(models.Full),
+				Vulnerabilities: &models.VulnerabilitiesConfig{
+					ScannersList:        &[]string{"grype"},
+					TrivyTimeoutSeconds: utils.PointerTo(600),
+				},
+				Exploits:                     &models.ExploitsConfig{Enabled: utils.PointerTo(false)},
+				MaxParallelScannersPerFamily: utils.PointerTo(1),
+			},
+			want: &models.ScanFamiliesConfig{
+				Preset: utils.PointerTo(models.Full),
+				Sbom:   &models.SBOMConfig{Enabled: utils.PointerTo(true)},
+				Vulnerabilities: &models.VulnerabilitiesConfig{
+					Enabled:             utils.PointerTo(true),
+					ScannersList:        &[]string{"grype"},
+					TrivyTimeoutSeconds: utils.PointerTo(600),
+				},
+				Secrets:                      &models.SecretsConfig{Enabled: utils.PointerTo(true)},
+				Malware:                      &models.MalwareConfig{Enabled: utils.PointerTo(true)},
+				Rootkits:                     &models.RootkitsConfig{Enabled: utils.PointerTo(true)},
+				Misconfigurations:            &models.MisconfigurationsConfig{Enabled: utils.PointerTo(true)},
+				Exploits:                     &models.ExploitsConfig{Enabled: utils.PointerTo(false)},
+				MaxParallelScannersPerFamily: utils.PointerTo(1),
+			},
+		},
+		{
+			name: "another family on top of a preset",
+			familiesConfig: &models.ScanFamiliesConfig{
+				Preset:  utils.PointerTo(models.SecretsOnly),
+				Malware: &models.MalwareConfig{Enabled: utils.PointerTo(true)},
+			},
+			want: &models.ScanFamiliesConfig{
+				Preset:  utils.PointerTo(models.SecretsOnly),
+				Secrets: &models.SecretsConfig{Enabled: utils.PointerTo(true)},
+				Malware: &models.MalwareConfig{Enabled: utils.PointerTo(true)},
+			},
+		},
+		{
+			name: "unknown preset",
+			familiesConfig: &models.ScanFamiliesConfig{
+				Preset: utils.PointerTo(models.ScanFamiliesConfigPreset("unknown")),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveFamiliesPreset(tt.familiesConfig)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveFamiliesPreset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ResolveFamiliesPreset() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package scanner
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -25,39 +26,96 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/circuitbreaker"
 	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/limiter"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/webhook"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 )
 
+// errScanCanceled is returned when the scan is deleted before it started.
+var errScanCanceled = errors.New("scan was canceled before it started")
+
+// errScanDrained is set as the error of the targets whose jobs were deleted
+// since the orchestrator was shut down before they completed.
+var errScanDrained = errors.New("the orchestrator was shut down before the scan job completed")
+
 type Scanner struct {
 	targetIDToScanData map[string]*scanData
 	scanConfig         *models.ScanConfig
 	killSignal         chan bool
+	killSignalOnce     sync.Once
+	aborted            bool // set when the scan was stopped because it was aborted
+	drained            bool // set when the scan was stopped because the orchestrator is shutting down
 	providerClient     provider.Client
+	circuitBreakers    *circuitbreaker.Registry
+	providerLimiter    *limiter.Limiter // limits the concurrent provider API calls of all the scans, nil when unlimited
+	budget             *instanceHoursBudget
+	notifier           webhook.Notifier // optional, nil when scan notifications are disabled
+	metrics            *scannerMetrics
 	logFields          log.Fields
 	backendClient      *backendclient.BackendClient
 	scanID             string
 	targetInstances    []*types.TargetInstance
 	config             *_config.ScannerConfig
 
+	// The job resources are listed once for all the jobs restored after
+	// the orchestrator was restarted.
+	jobResourcesOnce sync.Once
+	jobResources     []types.JobResource
+	jobResourcesErr  error
+
 	sync.Mutex
 }
 
 type scanData struct {
 	targetInstance *types.TargetInstance
 	scanResultID   string
-	success        bool // Needed for deletion policy in case we want to access the logs
-	timeout        bool
-	completed      bool
+	// The outcome of the job is owned by the worker handling the target,
+	// jobBatchManagement gets it with the result of the job.
+	success   bool // Needed for deletion policy in case we want to access the logs
+	timeout   bool
+	completed bool
+	// budgetExhausted is set when the job wasn't launched since the
+	// scanner instance hours budget of the scan was used up.
+	budgetExhausted bool
+	// completedFamilies is the number of families of the target which were
+	// reported as DONE so far, owned by the worker handling the target.
+	completedFamilies int
+	// reportedSummary is the part of the target's findings summary which was
+	// already added to the scan summary, owned by jobBatchManagement.
+	reportedSummary *models.ScanFindingsSummary
+}
+
+// jobResult is the outcome of the job of a target, sent by the worker which
+// handled the target to jobBatchManagement, so that the outcome isn't shared
+// between them.
+type jobResult struct {
+	targetID        string
+	success         bool
+	timeout         bool
+	budgetExhausted bool
+}
+
+func (d *scanData) result() jobResult {
+	return jobResult{
+		targetID:        d.targetInstance.TargetID,
+		success:         d.success,
+		timeout:         d.timeout,
+		budgetExhausted: d.budgetExhausted,
+	}
 }
 
 func CreateScanner(
 	config *_config.ScannerConfig,
 	providerClient provider.Client,
 	backendClient *backendclient.BackendClient,
+	circuitBreakers *circuitbreaker.Registry,
+	providerLimiter *limiter.Limiter,
+	notifier webhook.Notifier,
 	scanConfig *models.ScanConfig,
 	targetInstances []*types.TargetInstance,
 	scanID string,
@@ -67,6 +125,11 @@ func CreateScanner(
 		scanConfig:         scanConfig,
 		killSignal:         make(chan bool),
 		providerClient:     providerClient,
+		circuitBreakers:    circuitBreakers,
+		providerLimiter:    providerLimiter,
+		budget:             newInstanceHoursBudget(scanConfig.MaxScannerInstanceHours),
+		notifier:           notifier,
+		metrics:            newScannerMetrics(string(config.ProviderType)),
 		logFields:          log.Fields{"scanner id": uuid.NewV4().String()},
 		backendClient:      backendClient,
 		scanID:             scanID,
@@ -80,25 +143,18 @@ func CreateScanner(
 // nolint:cyclop,unparam
 func (s *Scanner) initScan(ctx context.Context) error {
 	targetIDToScanData := make(map[string]*scanData)
-
-	// Populate the target to scanData map and create ScanResult for each target.
 	for _, targetInstance := range s.targetInstances {
-		scanResultID, err := s.createInitTargetScanStatus(ctx, s.scanID, targetInstance.TargetID)
-		if err != nil {
-			return fmt.Errorf("failed to create an init scan result for instance id=%v, scan id=%v: %v", targetInstance.TargetID, s.scanID, err)
-		}
 		targetIDToScanData[targetInstance.TargetID] = &scanData{
 			targetInstance: targetInstance,
-			scanResultID:   scanResultID,
 			success:        false,
 			completed:      false,
 			timeout:        false,
 		}
 	}
 
-	s.targetIDToScanData = targetIDToScanData
-
-	// Move scan to "In Progress" and update the summary.
+	// Move scan to "In Progress" and update the summary. This is done
+	// before any ScanResult is created, so that a pending scan which was
+	// canceled doesn't leave partial scan results behind.
 	summary := createInitScanSummary()
 	summary.JobsLeftToRun = utils.PointerTo[int](len(targetIDToScanData))
 	scan := &models.Scan{
@@ -106,10 +162,24 @@ func (s *Scanner) initScan(ctx context.Context) error {
 		Summary: summary,
 	}
 	err := s.backendClient.PatchScan(ctx, s.scanID, scan)
+	if errors.Is(err, backendclient.ErrScanNotFound) {
+		return errScanCanceled
+	}
 	if err != nil {
 		return fmt.Errorf("failed to update scan: %v", err)
 	}
 
+	// Create ScanResult for each target.
+	for targetID, data := range targetIDToScanData {
+		scanResultID, err := s.createInitTargetScanStatus(ctx, s.scanID, targetID)
+		if err != nil {
+			return fmt.Errorf("failed to create an init scan result for instance id=%v, scan id=%v: %v", targetID, s.scanID, err)
+		}
+		data.scanResultID = scanResultID
+	}
+
+	s.targetIDToScanData = targetIDToScanData
+
 	log.WithFields(s.logFields).Infof("Total %d unique targets to scan", len(targetIDToScanData))
 
 	return nil
@@ -135,13 +205,30 @@ func createInitScanSummary() *models.ScanSummary {
 	}
 }
 
+// Scan runs the scan and returns once it is completed. If the scan is stopped
+// with Clear, Scan returns once the workers cleaned up the jobs they were
+// running.
 func (s *Scanner) Scan(ctx context.Context) {
+	if !s.startScan(ctx) {
+		return
+	}
+
+	s.jobBatchManagement(ctx)
+}
+
+// startScan initializes the scan and returns whether it has jobs to run.
+func (s *Scanner) startScan(ctx context.Context) bool {
 	s.Lock()
 	defer s.Unlock()
 
 	log.WithFields(s.logFields).Infof("Start scanning ID=%s", s.scanID)
+	s.metrics.scansStarted.Inc()
 
 	err := s.initScan(ctx)
+	if errors.Is(err, errScanCanceled) {
+		log.WithFields(s.logFields).Infof("Scan was canceled before it started. scanID=%s", s.scanID)
+		return false
+	}
 	if err != nil {
 		log.WithFields(s.logFields).Errorf("failed to init scan: %v", err)
 		scan := &models.Scan{
@@ -154,27 +241,84 @@ func (s *Scanner) Scan(ctx context.Context) {
 		if err != nil {
 			log.Errorf("failed to patch scan as failed ID=%s: %v", s.scanID, err)
 		}
-		return
+		return false
 	}
 
 	if len(s.targetIDToScanData) == 0 {
-		log.WithFields(s.logFields).Info("Nothing to scan")
-		t := time.Now()
-		reason := models.ScanStateReasonNothingToScan
-		scan := &models.Scan{
-			EndTime:      &t,
-			State:        utils.PointerTo(models.ScanStateDone),
-			StateMessage: utils.StringPtr("Nothing to scan"),
-			StateReason:  &reason,
-		}
-		err := s.backendClient.PatchScan(ctx, s.scanID, scan)
-		if err != nil {
-			log.Errorf("failed to patch scan as nothing to scan ID=%s: %v", s.scanID, err)
-		}
+		s.completeScanWithNoTargets(ctx)
+		return false
+	}
+
+	return true
+}
+
+// completeScanWithNoTargets completes a scan whose scan config scope doesn't
+// match any targets, according to the configured no targets policy.
+func (s *Scanner) completeScanWithNoTargets(ctx context.Context) {
+	log.WithFields(s.logFields).Infof("No targets matched the scan config scope, nothing to scan. scanID=%s", s.scanID)
+
+	scan := newNoTargetsScan(s.config.NoTargetsPolicy)
+	scan.EndTime = utils.PointerTo(time.Now())
+	err := s.backendClient.PatchScan(ctx, s.scanID, scan)
+	if err != nil {
+		log.Errorf("failed to patch scan as nothing to scan ID=%s: %v", s.scanID, err)
+	}
+
+	s.notifyScanCompleted(ctx, scan)
+}
+
+// notifyScanCompleted notifies the external systems about the final state of
+// the scan, which was just patched with the given scan.
+func (s *Scanner) notifyScanCompleted(ctx context.Context, scan *models.Scan) {
+	if s.notifier == nil {
 		return
 	}
 
-	go s.jobBatchManagement(ctx)
+	// The patched scan only holds the updated fields, so get the full scan
+	// with its summary and scan config.
+	fullScan, err := s.backendClient.GetScan(ctx, s.scanID, models.GetScansScanIDParams{})
+	if err != nil {
+		log.WithFields(s.logFields).Warnf("Failed to get scan %s for the completion notification, sending the final state only: %v", s.scanID, err)
+		fullScan = scan
+		fullScan.Id = &s.scanID
+	}
+
+	if err := s.notifier.ScanCompleted(ctx, fullScan); err != nil {
+		log.WithFields(s.logFields).Errorf("Failed to notify the completion of scan %s: %v", s.scanID, err)
+	}
+}
+
+// notifyTargetScanCompleted notifies the external systems that the scan of the
+// target reached its final state.
+func (s *Scanner) notifyTargetScanCompleted(ctx context.Context, data *scanData) {
+	if s.notifier == nil {
+		return
+	}
+
+	scanResult, err := s.backendClient.GetScanResult(ctx, data.scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.StringPtr("id,scan,target,status,summary"),
+	})
+	if err != nil {
+		log.WithFields(s.logFields).Errorf("Failed to get scan result %s for the target completion notification: %v", data.scanResultID, err)
+		return
+	}
+
+	if err := s.notifier.TargetScanCompleted(ctx, &scanResult); err != nil {
+		log.WithFields(s.logFields).Errorf("Failed to notify the completion of the scan of target %s: %v", data.targetInstance.TargetID, err)
+	}
+}
+
+func newNoTargetsScan(policy _config.NoTargetsPolicyType) *models.Scan {
+	state := models.ScanStateDone
+	if policy == _config.NoTargetsPolicyFailed {
+		state = models.ScanStateFailed
+	}
+
+	return &models.Scan{
+		State:        utils.PointerTo(state),
+		StateMessage: utils.StringPtr("No targets matched the scope of the scan config"),
+		StateReason:  utils.PointerTo(models.ScanStateReasonNothingToScan),
+	}
 }
 
 func (s *Scanner) SetTargetScanStatusCompletionError(ctx context.Context, scanResultID, errMsg string) error {
@@ -201,10 +345,55 @@ func (s *Scanner) SetTargetScanStatusCompletionError(ctx context.Context, scanRe
 	return nil
 }
 
+// SetTargetScanStatusNotScanned marks the target as not scanned, so that it
+// is scanned by a later scan.
+func (s *Scanner) SetTargetScanStatusNotScanned(ctx context.Context, scanResultID, errMsg string) error {
+	status, err := s.backendClient.GetScanResultStatus(ctx, scanResultID)
+	if err != nil {
+		return fmt.Errorf("failed to get a target scan status: %v", err)
+	}
+
+	var errors []string
+	if status.General.Errors != nil {
+		errors = *status.General.Errors
+	}
+	errors = append(errors, errMsg)
+	status.General.Errors = &errors
+	notScanned := models.NOTSCANNED
+	status.General.State = &notScanned
+
+	err = s.backendClient.PatchTargetScanStatus(ctx, scanResultID, status)
+	if err != nil {
+		return fmt.Errorf("failed to put target scan status: %v", err)
+	}
+
+	return nil
+}
+
 func (s *Scanner) Clear() {
 	s.Lock()
 	defer s.Unlock()
 
 	log.WithFields(s.logFields).Infof("Clearing...")
-	close(s.killSignal)
+	s.killSignalOnce.Do(func() {
+		close(s.killSignal)
+	})
+}
+
+// Drain stops the scan like Clear, but the workers also mark the targets whose
+// jobs they deleted as not scanned, so that once the orchestrator is started
+// again their scan results aren't waited for without a job to complete them.
+func (s *Scanner) Drain() {
+	s.Lock()
+	s.drained = true
+	s.Unlock()
+
+	s.Clear()
+}
+
+func (s *Scanner) isDrained() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.drained
 }
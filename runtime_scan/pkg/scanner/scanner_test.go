@@ -0,0 +1,61 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_newNoTargetsScan(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy _config.NoTargetsPolicyType
+		want   *models.Scan
+	}{
+		{
+			name:   "done policy",
+			policy: _config.NoTargetsPolicyDone,
+			want: &models.Scan{
+				State:        utils.PointerTo(models.ScanStateDone),
+				StateMessage: utils.StringPtr("No targets matched the scope of the scan config"),
+				StateReason:  utils.PointerTo(models.ScanStateReasonNothingToScan),
+			},
+		},
+		{
+			name:   "failed policy",
+			policy: _config.NoTargetsPolicyFailed,
+			want: &models.Scan{
+				State:        utils.PointerTo(models.ScanStateFailed),
+				StateMessage: utils.StringPtr("No targets matched the scope of the scan config"),
+				StateReason:  utils.PointerTo(models.ScanStateReasonNothingToScan),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newNoTargetsScan(tt.policy)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("newNoTargetsScan() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
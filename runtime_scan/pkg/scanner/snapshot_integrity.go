@@ -0,0 +1,118 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// ErrSnapshotIntegrity is returned when the copy of a snapshot doesn't match
+// the snapshot it was copied from.
+var ErrSnapshotIntegrity = errors.New("snapshot copy doesn't match its source")
+
+// verifySnapshotCopies compares the block checksums of the copies of the
+// snapshots in the scanner region with their source snapshots. It returns the
+// verifications of the copies, and ErrSnapshotIntegrity if any copy doesn't
+// match its source. The copies are reported as unsupported when the provider
+// doesn't expose the block checksums.
+func (s *Scanner) verifySnapshotCopies(ctx context.Context, jobVolumes []types.JobVolume) ([]models.SnapshotIntegrityCheck, error) {
+	var checks []models.SnapshotIntegrityCheck
+	var mismatchedSnapshotIDs []string
+	for _, jobVolume := range jobVolumes {
+		if jobVolume.DstSnapshot == nil {
+			continue
+		}
+		check := models.SnapshotIntegrityCheck{
+			SrcSnapshotID: utils.StringPtr(jobVolume.SrcSnapshot.GetID()),
+			DstSnapshotID: utils.StringPtr(jobVolume.DstSnapshot.GetID()),
+		}
+
+		src, srcOK := jobVolume.SrcSnapshot.(types.ChecksummedSnapshot)
+		dst, dstOK := jobVolume.DstSnapshot.(types.ChecksummedSnapshot)
+		if !srcOK || !dstOK {
+			log.WithFields(s.logFields).Infof("Skipping the integrity verification of snapshot copy, the provider doesn't expose block checksums. snapshotID=%v", *check.DstSnapshotID)
+			check.State = utils.PointerTo(models.UNSUPPORTED)
+			checks = append(checks, check)
+			continue
+		}
+
+		srcChecksums, err := src.GetBlockChecksums(ctx)
+		if err != nil {
+			return checks, fmt.Errorf("failed to get the block checksums of snapshot %v: %w", *check.SrcSnapshotID, err)
+		}
+		dstChecksums, err := dst.GetBlockChecksums(ctx)
+		if err != nil {
+			return checks, fmt.Errorf("failed to get the block checksums of snapshot %v: %w", *check.DstSnapshotID, err)
+		}
+
+		blocks, mismatchedBlocks := compareBlockChecksums(srcChecksums, dstChecksums)
+		check.Blocks = utils.PointerTo(blocks)
+		check.MismatchedBlocks = utils.PointerTo(mismatchedBlocks)
+		check.State = utils.PointerTo(models.VERIFIED)
+		if mismatchedBlocks > 0 {
+			check.State = utils.PointerTo(models.MISMATCH)
+			mismatchedSnapshotIDs = append(mismatchedSnapshotIDs, *check.DstSnapshotID)
+		}
+		checks = append(checks, check)
+	}
+
+	if len(mismatchedSnapshotIDs) > 0 {
+		return checks, fmt.Errorf("%w. snapshotIDs=%v", ErrSnapshotIntegrity, mismatchedSnapshotIDs)
+	}
+	return checks, nil
+}
+
+// compareBlockChecksums returns the number of blocks of the source snapshot
+// and the number of blocks which are missing or different in the copy, or
+// only written in the copy.
+func compareBlockChecksums(src, dst map[int32]string) (int, int) {
+	var mismatched int
+	for index, checksum := range src {
+		if dstChecksum, ok := dst[index]; !ok || dstChecksum != checksum {
+			mismatched++
+		}
+	}
+	for index := range dst {
+		if _, ok := src[index]; !ok {
+			mismatched++
+		}
+	}
+
+	return len(src), mismatched
+}
+
+// persistSnapshotIntegrity records the verifications of the snapshot copies on
+// the scan result of the target.
+func (s *Scanner) persistSnapshotIntegrity(ctx context.Context, data *scanData, checks []models.SnapshotIntegrityCheck) {
+	if len(checks) == 0 {
+		return
+	}
+
+	err := s.backendClient.PatchScanResult(ctx, models.TargetScanResult{
+		SnapshotIntegrity: &checks,
+	}, data.scanResultID)
+	if err != nil {
+		log.WithFields(s.logFields).Warnf("Failed to persist the snapshot integrity verifications. scanResultID=%v: %v", data.scanResultID, err)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+type fakeChecksummedSnapshot struct {
+	types.Snapshot
+	id        string
+	checksums map[int32]string
+	err       error
+}
+
+func (s *fakeChecksummedSnapshot) GetID() string {
+	return s.id
+}
+
+func (s *fakeChecksummedSnapshot) GetBlockChecksums(_ context.Context) (map[int32]string, error) {
+	return s.checksums, s.err
+}
+
+func Test_compareBlockChecksums(t *testing.T) {
+	tests := []struct {
+		name           string
+		src            map[int32]string
+		dst            map[int32]string
+		wantBlocks     int
+		wantMismatched int
+	}{
+		{
+			name:       "identical",
+			src:        map[int32]string{0: "a", 1: "b", 7: "c"},
+			dst:        map[int32]string{0: "a", 1: "b", 7: "c"},
+			wantBlocks: 3,
+		},
+		{
+			name:           "different block",
+			src:            map[int32]string{0: "a", 1: "b"},
+			dst:            map[int32]string{0: "a", 1: "x"},
+			wantBlocks:     2,
+			wantMismatched: 1,
+		},
+		{
+			name:           "block missing in the copy",
+			src:            map[int32]string{0: "a", 1: "b"},
+			dst:            map[int32]string{0: "a"},
+			wantBlocks:     2,
+			wantMismatched: 1,
+		},
+		{
+			name:           "block only written in the copy",
+			src:            map[int32]string{0: "a"},
+			dst:            map[int32]string{0: "a", 1: "b"},
+			wantBlocks:     1,
+			wantMismatched: 1,
+		},
+		{
+			name: "empty snapshots",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks, mismatched := compareBlockChecksums(tt.src, tt.dst)
+			if blocks != tt.wantBlocks {
+				t.Errorf("compareBlockChecksums() blocks = %v, want %v", blocks, tt.wantBlocks)
+			}
+			if mismatched != tt.wantMismatched {
+				t.Errorf("compareBlockChecksums() mismatched = %v, want %v", mismatched, tt.wantMismatched)
+			}
+		})
+	}
+}
+
+func TestScanner_verifySnapshotCopies(t *testing.T) {
+	checksums := map[int32]string{0: "a", 1: "b"}
+	tests := []struct {
+		name       string
+		jobVolumes []types.JobVolume
+		want       []models.SnapshotIntegrityCheck
+		wantErr    error
+	}{
+		{
+			name: "snapshot which wasn't copied",
+			jobVolumes: []types.JobVolume{
+				{SrcSnapshot: &fakeChecksummedSnapshot{id: "snap-1", checksums: checksums}},
+			},
+			want: nil,
+		},
+		{
+			name: "matching copy",
+			jobVolumes: []types.JobVolume{
+				{
+					SrcSnapshot: &fakeChecksummedSnapshot{id: "snap-1", checksums: checksums},
+					DstSnapshot: &fakeChecksummedSnapshot{id: "snap-2", checksums: checksums},
+				},
+			},
+			want: []models.SnapshotIntegrityCheck{
+				{
+					SrcSnapshotID:    utils.StringPtr("snap-1"),
+					DstSnapshotID:    utils.StringPtr("snap-2"),
+					State:            utils.PointerTo(models.VERIFIED),
+					Blocks:           utils.PointerTo(2),
+					MismatchedBlocks: utils.PointerTo(0),
+				},
+			},
+		},
+		{
+			name: "mismatching copy",
+			jobVolumes: []types.JobVolume{
+				{
+					SrcSnapshot: &fakeChecksummedSnapshot{id: "snap-1", checksums: checksums},
+					DstSnapshot: &fakeChecksummedSnapshot{id: "snap-2", checksums: map[int32]string{0: "a"}},
+				},
+				{
+					SrcSnapshot: &fakeChecksummedSnapshot{id: "snap-3", checksums: checksums},
+					DstSnapshot: &fakeChecksummedSnapshot{id: "snap-4", checksums: checksums},
+				},
+			},
+			want: []models.SnapshotIntegrityCheck{
+				{
+					SrcSnapshotID:    utils.StringPtr("snap-1"),
+					DstSnapshotID:    utils.StringPtr("snap-2"),
+					State:            utils.PointerTo(models.MISMATCH),
+					Blocks:           utils.PointerTo(2),
+					MismatchedBlocks: utils.PointerTo(1),
+				},
+				{
+					SrcSnapshotID:    utils.StringPtr("snap-3"),
+					DstSnapshotID:    utils.StringPtr("snap-4"),
+					State:            utils.PointerTo(models.VERIFIED),
+					Blocks:           utils.PointerTo(2),
+					MismatchedBlocks: utils.PointerTo(0),
+				},
+			},
+			wantErr: ErrSnapshotIntegrity,
+		},
+		{
+			name: "provider without block checksums",
+			jobVolumes: []types.JobVolume{
+				{
+					SrcSnapshot: &fakeSnapshot{},
+					DstSnapshot: &fakeChecksummedSnapshot{id: "snap-2", checksums: checksums},
+				},
+			},
+			want: []models.SnapshotIntegrityCheck{
+				{
+					SrcSnapshotID: utils.StringPtr("snap-1"),
+					DstSnapshotID: utils.StringPtr("snap-2"),
+					State:         utils.PointerTo(models.UNSUPPORTED),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scanner{}
+			got, err := s.verifySnapshotCopies(context.Background(), tt.jobVolumes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("verifySnapshotCopies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("verifySnapshotCopies() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScanner_verifySnapshotCopiesChecksumsError(t *testing.T) {
+	checksumsErr := errors.New("access denied")
+	s := &Scanner{}
+	_, err := s.verifySnapshotCopies(context.Background(), []types.JobVolume{
+		{
+			SrcSnapshot: &fakeChecksummedSnapshot{id: "snap-1", err: checksumsErr},
+			DstSnapshot: &fakeChecksummedSnapshot{id: "snap-2"},
+		},
+	})
+	if !errors.Is(err, checksumsErr) {
+		t.Errorf("verifySnapshotCopies() error = %v, want %v", err, checksumsErr)
+	}
+	if errors.Is(err, ErrSnapshotIntegrity) {
+		t.Errorf("verifySnapshotCopies() failing to get the checksums is reported as a mismatch")
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"github.com/openclarity/vmclarity/api/models"
+	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// partialSummary is sent by a worker whenever more families of a target
+// have completed. summary holds the findings of all the completed families
+// of the target so far and not only the newly completed ones.
+type partialSummary struct {
+	targetID string
+	summary  *models.ScanFindingsSummary
+}
+
+func isFamilyDone(state *models.TargetScanState) bool {
+	return state != nil && state.State != nil && *state.State == models.DONE
+}
+
+// countCompletedFamilies returns the number of families of the target scan
+// which are in the DONE state.
+func countCompletedFamilies(status *models.TargetScanStatus) int {
+	if status == nil {
+		return 0
+	}
+
+	count := 0
+	for _, state := range []*models.TargetScanState{
+		status.Exploits,
+		status.Malware,
+		status.Misconfigurations,
+		status.Rootkits,
+		status.Sbom,
+		status.Secrets,
+		status.Vulnerabilities,
+	} {
+		if isFamilyDone(state) {
+			count++
+		}
+	}
+	return count
+}
+
+// completedFamiliesSummary returns a summary which contains only the totals
+// of the families that are DONE according to status, the totals of families
+// which are still running are zero.
+func completedFamiliesSummary(status *models.TargetScanStatus, summary *models.ScanFindingsSummary) *models.ScanFindingsSummary {
+	ret := addScanFindingsSummary(nil, nil)
+	if status == nil || summary == nil {
+		return ret
+	}
+
+	if isFamilyDone(status.Exploits) {
+		ret.TotalExploits = runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(summary.TotalExploits))
+	}
+	if isFamilyDone(status.Malware) {
+		ret.TotalMalware = runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(summary.TotalMalware))
+	}
+	if isFamilyDone(status.Misconfigurations) {
+		ret.TotalMisconfigurations = runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(summary.TotalMisconfigurations))
+	}
+	if isFamilyDone(status.Rootkits) {
+		ret.TotalRootkits = runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(summary.TotalRootkits))
+	}
+	if isFamilyDone(status.Sbom) {
+		ret.TotalPackages = runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(summary.TotalPackages))
+	}
+	if isFamilyDone(status.Secrets) {
+		ret.TotalSecrets = runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(summary.TotalSecrets))
+	}
+	if isFamilyDone(status.Vulnerabilities) {
+		ret.TotalVulnerabilities = addVulnerabilityScanSummary(nil, summary.TotalVulnerabilities)
+	}
+
+	return ret
+}
+
+// addScanFindingsSummary returns a new summary which is the sum of a and b.
+// Nil summaries and nil totals are treated as zero.
+func addScanFindingsSummary(a, b *models.ScanFindingsSummary) *models.ScanFindingsSummary {
+	return combineScanFindingsSummary(a, b, 1)
+}
+
+// diffScanFindingsSummary returns a new summary which is a minus b. Nil
+// summaries and nil totals are treated as zero.
+func diffScanFindingsSummary(a, b *models.ScanFindingsSummary) *models.ScanFindingsSummary {
+	return combineScanFindingsSummary(a, b, -1)
+}
+
+func addVulnerabilityScanSummary(a, b *models.VulnerabilityScanSummary) *models.VulnerabilityScanSummary {
+	return combineVulnerabilityScanSummary(a, b, 1)
+}
+
+func combineScanFindingsSummary(a, b *models.ScanFindingsSummary, sign int) *models.ScanFindingsSummary {
+	if a == nil {
+		a = &models.ScanFindingsSummary{}
+	}
+	if b == nil {
+		b = &models.ScanFindingsSummary{}
+	}
+
+	combine := func(x, y *int) *int {
+		return runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(x) + sign*runtimeScanUtils.ValueOrZero(y))
+	}
+
+	return &models.ScanFindingsSummary{
+		TotalExploits:          combine(a.TotalExploits, b.TotalExploits),
+		TotalMalware:           combine(a.TotalMalware, b.TotalMalware),
+		TotalMisconfigurations: combine(a.TotalMisconfigurations, b.TotalMisconfigurations),
+		TotalPackages:          combine(a.TotalPackages, b.TotalPackages),
+		TotalRootkits:          combine(a.TotalRootkits, b.TotalRootkits),
+		TotalSecrets:           combine(a.TotalSecrets, b.TotalSecrets),
+		TotalVulnerabilities:   combineVulnerabilityScanSummary(a.TotalVulnerabilities, b.TotalVulnerabilities, sign),
+	}
+}
+
+func combineVulnerabilityScanSummary(a, b *models.VulnerabilityScanSummary, sign int) *models.VulnerabilityScanSummary {
+	if a == nil {
+		a = &models.VulnerabilityScanSummary{}
+	}
+	if b == nil {
+		b = &models.VulnerabilityScanSummary{}
+	}
+
+	combine := func(x, y *int) *int {
+		return runtimeScanUtils.IntPtr(runtimeScanUtils.ValueOrZero(x) + sign*runtimeScanUtils.ValueOrZero(y))
+	}
+
+	return &models.VulnerabilityScanSummary{
+		TotalCriticalVulnerabilities:   combine(a.TotalCriticalVulnerabilities, b.TotalCriticalVulnerabilities),
+		TotalHighVulnerabilities:       combine(a.TotalHighVulnerabilities, b.TotalHighVulnerabilities),
+		TotalLowVulnerabilities:        combine(a.TotalLowVulnerabilities, b.TotalLowVulnerabilities),
+		TotalMediumVulnerabilities:     combine(a.TotalMediumVulnerabilities, b.TotalMediumVulnerabilities),
+		TotalNegligibleVulnerabilities: combine(a.TotalNegligibleVulnerabilities, b.TotalNegligibleVulnerabilities),
+	}
+}
+
+// getScanFindingsSummary returns the findings totals of a scan summary.
+func getScanFindingsSummary(summary *models.ScanSummary) *models.ScanFindingsSummary {
+	return &models.ScanFindingsSummary{
+		TotalExploits:          summary.TotalExploits,
+		TotalMalware:           summary.TotalMalware,
+		TotalMisconfigurations: summary.TotalMisconfigurations,
+		TotalPackages:          summary.TotalPackages,
+		TotalRootkits:          summary.TotalRootkits,
+		TotalSecrets:           summary.TotalSecrets,
+		TotalVulnerabilities:   summary.TotalVulnerabilities,
+	}
+}
+
+// setScanFindingsSummary sets the findings totals of a scan summary.
+func setScanFindingsSummary(summary *models.ScanSummary, findings *models.ScanFindingsSummary) {
+	summary.TotalExploits = findings.TotalExploits
+	summary.TotalMalware = findings.TotalMalware
+	summary.TotalMisconfigurations = findings.TotalMisconfigurations
+	summary.TotalPackages = findings.TotalPackages
+	summary.TotalRootkits = findings.TotalRootkits
+	summary.TotalSecrets = findings.TotalSecrets
+	summary.TotalVulnerabilities = findings.TotalVulnerabilities
+}
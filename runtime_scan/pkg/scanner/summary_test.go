@@ -0,0 +1,183 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func zeroScanFindingsSummary() *models.ScanFindingsSummary {
+	return &models.ScanFindingsSummary{
+		TotalExploits:          utils.PointerTo(0),
+		TotalMalware:           utils.PointerTo(0),
+		TotalMisconfigurations: utils.PointerTo(0),
+		TotalPackages:          utils.PointerTo(0),
+		TotalRootkits:          utils.PointerTo(0),
+		TotalSecrets:           utils.PointerTo(0),
+		TotalVulnerabilities: &models.VulnerabilityScanSummary{
+			TotalCriticalVulnerabilities:   utils.PointerTo(0),
+			TotalHighVulnerabilities:       utils.PointerTo(0),
+			TotalLowVulnerabilities:        utils.PointerTo(0),
+			TotalMediumVulnerabilities:     utils.PointerTo(0),
+			TotalNegligibleVulnerabilities: utils.PointerTo(0),
+		},
+	}
+}
+
+func Test_completedFamiliesSummary(t *testing.T) {
+	done := &models.TargetScanState{State: utils.PointerTo(models.DONE)}
+	inProgress := &models.TargetScanState{State: utils.PointerTo(models.INPROGRESS)}
+	summary := &models.ScanFindingsSummary{
+		TotalPackages: utils.PointerTo(100),
+		TotalSecrets:  utils.PointerTo(3),
+		TotalVulnerabilities: &models.VulnerabilityScanSummary{
+			TotalCriticalVulnerabilities: utils.PointerTo(1),
+			TotalHighVulnerabilities:     utils.PointerTo(2),
+		},
+	}
+
+	type args struct {
+		status  *models.TargetScanStatus
+		summary *models.ScanFindingsSummary
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.ScanFindingsSummary
+	}{
+		{
+			name: "nil status",
+			args: args{
+				status:  nil,
+				summary: summary,
+			},
+			want: zeroScanFindingsSummary(),
+		},
+		{
+			name: "no completed families",
+			args: args{
+				status: &models.TargetScanStatus{
+					Sbom:            inProgress,
+					Secrets:         inProgress,
+					Vulnerabilities: inProgress,
+				},
+				summary: summary,
+			},
+			want: zeroScanFindingsSummary(),
+		},
+		{
+			name: "some completed families",
+			args: args{
+				status: &models.TargetScanStatus{
+					Sbom:            done,
+					Secrets:         inProgress,
+					Vulnerabilities: done,
+				},
+				summary: summary,
+			},
+			want: func() *models.ScanFindingsSummary {
+				ret := zeroScanFindingsSummary()
+				ret.TotalPackages = utils.PointerTo(100)
+				ret.TotalVulnerabilities.TotalCriticalVulnerabilities = utils.PointerTo(1)
+				ret.TotalVulnerabilities.TotalHighVulnerabilities = utils.PointerTo(2)
+				return ret
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := completedFamiliesSummary(tt.args.status, tt.args.summary)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("completedFamiliesSummary() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_countCompletedFamilies(t *testing.T) {
+	tests := []struct {
+		name   string
+		status *models.TargetScanStatus
+		want   int
+	}{
+		{
+			name:   "nil status",
+			status: nil,
+			want:   0,
+		},
+		{
+			name: "general state is ignored",
+			status: &models.TargetScanStatus{
+				General:  &models.TargetScanState{State: utils.PointerTo(models.DONE)},
+				Exploits: &models.TargetScanState{State: utils.PointerTo(models.DONE)},
+				Malware:  &models.TargetScanState{State: utils.PointerTo(models.NOTSCANNED)},
+				Rootkits: &models.TargetScanState{},
+				Secrets:  &models.TargetScanState{State: utils.PointerTo(models.DONE)},
+			},
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countCompletedFamilies(tt.status); got != tt.want {
+				t.Errorf("countCompletedFamilies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_partialSummariesAreNotDoubleCounted(t *testing.T) {
+	first := &models.ScanFindingsSummary{
+		TotalPackages: utils.PointerTo(10),
+	}
+	second := &models.ScanFindingsSummary{
+		TotalPackages: utils.PointerTo(10),
+		TotalVulnerabilities: &models.VulnerabilityScanSummary{
+			TotalNegligibleVulnerabilities: utils.PointerTo(4),
+		},
+	}
+	final := &models.ScanFindingsSummary{
+		TotalPackages: utils.PointerTo(10),
+		TotalSecrets:  utils.PointerTo(2),
+		TotalVulnerabilities: &models.VulnerabilityScanSummary{
+			TotalNegligibleVulnerabilities: utils.PointerTo(4),
+		},
+	}
+
+	// Apply the updates the same way as jobBatchManagement does, on top of
+	// findings already reported by another target.
+	scanSummary := &models.ScanFindingsSummary{
+		TotalPackages: utils.PointerTo(5),
+	}
+	var reported *models.ScanFindingsSummary
+	for _, update := range []*models.ScanFindingsSummary{first, second, final} {
+		scanSummary = addScanFindingsSummary(scanSummary, diffScanFindingsSummary(update, reported))
+		reported = update
+	}
+
+	want := zeroScanFindingsSummary()
+	want.TotalPackages = utils.PointerTo(15)
+	want.TotalSecrets = utils.PointerTo(2)
+	want.TotalVulnerabilities.TotalNegligibleVulnerabilities = utils.PointerTo(4)
+	if diff := cmp.Diff(want, scanSummary); diff != "" {
+		t.Errorf("scan summary mismatch (-want +got):\n%s", diff)
+	}
+}
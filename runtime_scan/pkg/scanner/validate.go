@@ -0,0 +1,326 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/openclarity/vmclarity/api/models"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/clam"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/yara"
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/kics"
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/lynis"
+	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/rkhunter"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/trufflehog"
+	familiesVulnerabilities "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/osv"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// scannerSetting is a setting of the orchestrator that a scanner needs to
+// run, for example the path of its binary in the scanner image.
+type scannerSetting struct {
+	name  string
+	value string
+}
+
+// ValidateScanConfig validates the scan config the way it's validated when a
+// scan of it runs, without launching any infrastructure: the scanners of the
+// enabled families must be supported and configured, the scope must be
+// discoverable on the provider and the scanner instance creation config and
+// ignore rules must be well formed.
+func ValidateScanConfig(ctx context.Context, config *_config.ScannerConfig, providerClient provider.Client, scanConfig models.ScanConfigData) *models.ScanConfigValidation {
+	problems := []models.ScanConfigProblem{}
+
+	if scanConfig.Scope == nil {
+		problems = append(problems, newScanConfigProblem("scope", "scope must be set"))
+	} else if _, err := providerClient.DiscoverInstances(ctx, scanConfig.Scope); err != nil {
+		problems = append(problems, newScanConfigProblem("scope", fmt.Sprintf("failed to discover instances: %v", err)))
+	}
+
+	if scanConfig.ScanFamiliesConfig == nil {
+		problems = append(problems, newScanConfigProblem("scanFamiliesConfig", "scan families config must be set"))
+	} else if familiesConfig, err := ResolveFamiliesPreset(scanConfig.ScanFamiliesConfig); err != nil {
+		problems = append(problems, newScanConfigProblem("scanFamiliesConfig.preset", err.Error()))
+	} else {
+		problems = append(problems, validateScanFamiliesConfig(config, familiesConfig)...)
+
+		s := &Scanner{
+			config: config,
+			scanConfig: &models.ScanConfig{
+				ScanFamiliesConfig: familiesConfig,
+			},
+		}
+		if _, err := s.generateFamiliesConfigurationYaml(); err != nil {
+			problems = append(problems, newScanConfigProblem("scanFamiliesConfig", err.Error()))
+		}
+	}
+
+	if scanConfig.ScannerInstanceCreationConfig != nil {
+		problems = append(problems, validateScannerInstanceCreationConfig(scanConfig.ScannerInstanceCreationConfig)...)
+	}
+
+	if scanConfig.IgnoreRules != nil {
+		for i, rule := range *scanConfig.IgnoreRules {
+			if err := utils.ValidateIgnoreRule(rule); err != nil {
+				problems = append(problems, newScanConfigProblem(fmt.Sprintf("ignoreRules[%d]", i), err.Error()))
+			}
+		}
+	}
+
+	return &models.ScanConfigValidation{
+		Valid:    runtimeScanUtils.PointerTo(len(problems) == 0),
+		Problems: &problems,
+	}
+}
+
+func validateScanFamiliesConfig(config *_config.ScannerConfig, familiesConfig *models.ScanFamiliesConfig) []models.ScanConfigProblem {
+	var problems []models.ScanConfigProblem
+
+	if c := familiesConfig.Vulnerabilities; c != nil && runtimeScanUtils.ValueOrZero(c.Enabled) {
+		problems = append(problems, validateScanners("scanFamiliesConfig.vulnerabilities", c.ScannersList, familiesVulnerabilities.DefaultScanners, map[string][]scannerSetting{
+			"grype":         nil,
+			"trivy":         nil,
+			osv.ScannerName: {{name: _config.OSVScannerBinaryPath, value: config.OSVScannerBinaryPath}},
+		})...)
+	}
+	if c := familiesConfig.Secrets; c != nil && runtimeScanUtils.ValueOrZero(c.Enabled) {
+		problems = append(problems, validateScanners("scanFamiliesConfig.secrets", c.ScannersList, secrets.DefaultScanners, map[string][]scannerSetting{
+			gitleaks.ScannerName:   {{name: _config.GitleaksBinaryPath, value: config.GitleaksBinaryPath}},
+			trufflehog.ScannerName: {{name: _config.TrufflehogBinaryPath, value: config.TrufflehogBinaryPath}},
+		})...)
+	}
+	if c := familiesConfig.Malware; c != nil && runtimeScanUtils.ValueOrZero(c.Enabled) {
+		problems = append(problems, validateScanners("scanFamiliesConfig.malware", c.ScannersList, malware.DefaultScanners, map[string][]scannerSetting{
+			clam.ScannerName: {{name: _config.ClamBinaryPath, value: config.ClamBinaryPath}},
+			yara.ScannerName: {
+				{name: _config.YaraBinaryPath, value: config.YaraBinaryPath},
+				{name: _config.YaraRulesPath + " or " + _config.YaraRulesURL, value: config.YaraRulesPath + config.YaraRulesURL},
+			},
+		})...)
+	}
+	if c := familiesConfig.Misconfigurations; c != nil && runtimeScanUtils.ValueOrZero(c.Enabled) {
+		problems = append(problems, validateScanners("scanFamiliesConfig.misconfigurations", c.ScannersList, misconfigurationTypes.DefaultScanners, map[string][]scannerSetting{
+			lynis.ScannerName: {{name: _config.LynisInstallPath, value: config.LynisInstallPath}},
+			kics.ScannerName:  {{name: _config.KICSBinaryPath, value: config.KICSBinaryPath}},
+		})...)
+	}
+	if c := familiesConfig.Rootkits; c != nil && runtimeScanUtils.ValueOrZero(c.Enabled) {
+		problems = append(problems, validateScanners("scanFamiliesConfig.rootkits", c.ScannersList, rootkits.DefaultScanners, map[string][]scannerSetting{
+			chkrootkit.ScannerName: {{name: _config.ChkrootkitBinaryPath, value: config.ChkrootkitBinaryPath}},
+			rkhunter.ScannerName:   {{name: _config.RkhunterBinaryPath, value: config.RkhunterBinaryPath}},
+		})...)
+	}
+	if c := familiesConfig.Exploits; c != nil && runtimeScanUtils.ValueOrZero(c.Enabled) && config.ExploitsDBAddress == "" && config.ExploitsDBLocalPath == "" {
+		problems = append(problems, newScanConfigProblem("scanFamiliesConfig.exploits",
+			fmt.Sprintf("exploits are enabled but neither %s nor %s is configured", _config.ExploitDBAddress, _config.ExploitDBLocalPath)))
+	}
+	if familiesConfig.ScannerCommandOptions != nil {
+		problems = append(problems, validateScannerCommandOptions(*familiesConfig.ScannerCommandOptions)...)
+	}
+
+	return problems
+}
+
+// commandOptionsScanners are the scanners which run a binary and so accept
+// extra arguments and environment variables.
+var commandOptionsScanners = map[string]bool{
+	osv.ScannerName:        true,
+	gitleaks.ScannerName:   true,
+	trufflehog.ScannerName: true,
+	clam.ScannerName:       true,
+	yara.ScannerName:       true,
+	lynis.ScannerName:      true,
+	kics.ScannerName:       true,
+	chkrootkit.ScannerName: true,
+	rkhunter.ScannerName:   true,
+}
+
+func validateScannerCommandOptions(options map[string]models.ScannerCommandOptions) []models.ScanConfigProblem {
+	var problems []models.ScanConfigProblem
+
+	scannerNames := make([]string, 0, len(options))
+	for scannerName := range options {
+		scannerNames = append(scannerNames, scannerName)
+	}
+	sort.Strings(scannerNames)
+
+	for _, scannerName := range scannerNames {
+		field := "scanFamiliesConfig.scannerCommandOptions." + scannerName
+		if !commandOptionsScanners[scannerName] {
+			problems = append(problems, newScanConfigProblem(field, fmt.Sprintf("scanner %q doesn't support command options", scannerName)))
+			continue
+		}
+		if err := scannerCommandOptions(&options, scannerName).Validate(); err != nil {
+			problems = append(problems, newScanConfigProblem(field, err.Error()))
+		}
+	}
+
+	return problems
+}
+
+// validateScanners checks that the scanners of a family, or its default
+// scanners if none is set, are supported and that the settings they need are
+// configured.
+func validateScanners(field string, scannersList *[]string, defaultScanners []string, supportedScanners map[string][]scannerSetting) []models.ScanConfigProblem {
+	var problems []models.ScanConfigProblem
+
+	scanners := defaultScanners
+	if scannersList != nil && len(*scannersList) > 0 {
+		scanners = *scannersList
+	}
+	for _, scanner := range scanners {
+		settings, ok := supportedScanners[scanner]
+		if !ok {
+			problems = append(problems, newScanConfigProblem(field+".scannersList", fmt.Sprintf("unsupported scanner %q", scanner)))
+			continue
+		}
+		for _, setting := range settings {
+			if setting.value == "" {
+				problems = append(problems, newScanConfigProblem(field+".scannersList",
+					fmt.Sprintf("scanner %q is enabled but %s isn't configured", scanner, setting.name)))
+			}
+		}
+	}
+
+	return problems
+}
+
+var instanceProfileARNRegexp = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:instance-profile/[\w+=,.@/-]+$`)
+
+func validateScannerInstanceCreationConfig(creationConfig *models.ScannerInstanceCreationConfig) []models.ScanConfigProblem {
+	var problems []models.ScanConfigProblem
+
+	if maxPrice := creationConfig.MaxPrice; maxPrice != nil && *maxPrice != "" {
+		if !creationConfig.UseSpotInstances {
+			problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.maxPrice", "max price is only used with spot instances"))
+		}
+		if price, err := strconv.ParseFloat(*maxPrice, 64); err != nil || price <= 0 {
+			problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.maxPrice", fmt.Sprintf("invalid max price %q", *maxPrice)))
+		}
+	}
+
+	if retryMaxAttempts := creationConfig.RetryMaxAttempts; retryMaxAttempts != nil && *retryMaxAttempts < 1 {
+		problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.retryMaxAttempts",
+			fmt.Sprintf("retry max attempts must be at least 1, got %d", *retryMaxAttempts)))
+	}
+
+	if hopLimit := creationConfig.InstanceMetadataHopLimit; hopLimit != nil && (*hopLimit < 1 || *hopLimit > 64) {
+		problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.instanceMetadataHopLimit",
+			fmt.Sprintf("instance metadata hop limit must be between 1 and 64, got %d", *hopLimit)))
+	}
+
+	if arn := creationConfig.InstanceProfileARN; arn != nil && *arn != "" && !instanceProfileARNRegexp.MatchString(*arn) {
+		problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.instanceProfileARN",
+			fmt.Sprintf("invalid instance profile ARN %q", *arn)))
+	}
+
+	if creds := creationConfig.ScannerImagePullCredentials; creds != nil {
+		switch creds.Method {
+		case models.Static:
+			if creds.Username == nil || *creds.Username == "" {
+				problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.scannerImagePullCredentials.username",
+					"username must be set for static credentials"))
+			}
+			if creds.Password == nil || *creds.Password == "" {
+				problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.scannerImagePullCredentials.password",
+					"password must be set for static credentials"))
+			}
+		case models.EcrInstanceProfile:
+			if creationConfig.InstanceProfileARN == nil || *creationConfig.InstanceProfileARN == "" {
+				problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.scannerImagePullCredentials.method",
+					"instance profile ARN must be set to pull the scanner image with the instance profile"))
+			}
+		}
+	}
+
+	if proxy := creationConfig.Proxy; proxy != nil {
+		for _, p := range []struct {
+			field string
+			value *string
+		}{
+			{field: "httpProxy", value: proxy.HttpProxy},
+			{field: "httpsProxy", value: proxy.HttpsProxy},
+		} {
+			if p.value == nil || *p.value == "" {
+				continue
+			}
+			if err := validateProxyURL(*p.value); err != nil {
+				problems = append(problems, newScanConfigProblem("scannerInstanceCreationConfig.proxy."+p.field, err.Error()))
+			}
+		}
+	}
+
+	if instanceTypes := creationConfig.InstanceTypesByVolumeSize; instanceTypes != nil {
+		minVolumeSizes := make(map[int64]bool, len(*instanceTypes))
+		for i, t := range *instanceTypes {
+			field := fmt.Sprintf("scannerInstanceCreationConfig.instanceTypesByVolumeSize[%d]", i)
+			if t.InstanceType == "" {
+				problems = append(problems, newScanConfigProblem(field+".instanceType", "instance type must be set"))
+			}
+			if t.MinVolumeSizeGB < 0 {
+				problems = append(problems, newScanConfigProblem(field+".minVolumeSizeGB",
+					fmt.Sprintf("min volume size must not be negative, got %d", t.MinVolumeSizeGB)))
+			}
+			if minVolumeSizes[t.MinVolumeSizeGB] {
+				problems = append(problems, newScanConfigProblem(field+".minVolumeSizeGB",
+					fmt.Sprintf("min volume size %d is set for more than one instance type", t.MinVolumeSizeGB)))
+			}
+			minVolumeSizes[t.MinVolumeSizeGB] = true
+		}
+	}
+
+	return problems
+}
+
+// validateProxyURL validates a proxy URL of the scanner instance, which the
+// docker daemon, the package manager and the scanners all have to support.
+func validateProxyURL(proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL %q", proxyURL)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return fmt.Errorf("invalid proxy URL %q, the scheme must be http, https, socks5 or socks5h", proxyURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid proxy URL %q, the host must be set", proxyURL)
+	}
+	return nil
+}
+
+func newScanConfigProblem(field, message string) models.ScanConfigProblem {
+	return models.ScanConfigProblem{
+		Field:   runtimeScanUtils.PointerTo(field),
+		Message: runtimeScanUtils.PointerTo(message),
+	}
+}
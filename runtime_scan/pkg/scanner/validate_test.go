@@ -0,0 +1,346 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+type unreachableProviderClient struct {
+	provider.Client
+}
+
+func (c *unreachableProviderClient) DiscoverInstances(_ context.Context, _ *models.ScanScopeType) ([]types.Instance, error) {
+	return nil, errors.New("region unreachable")
+}
+
+func TestValidateScanConfig(t *testing.T) {
+	config := &_config.ScannerConfig{
+		GitleaksBinaryPath: "/artifacts/gitleaks",
+		ClamBinaryPath:     "/usr/bin/clamscan",
+	}
+
+	tests := []struct {
+		name           string
+		providerClient provider.Client
+		scanConfig     models.ScanConfigData
+		want           *models.ScanConfigValidation
+	}{
+		{
+			name:           "valid",
+			providerClient: &planProviderClient{},
+			scanConfig: models.ScanConfigData{
+				Scope: &models.ScanScopeType{},
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{
+					Secrets: &models.SecretsConfig{
+						Enabled: utils.PointerTo(true),
+					},
+					Malware: &models.MalwareConfig{
+						Enabled: utils.PointerTo(true),
+					},
+				},
+			},
+			want: &models.ScanConfigValidation{
+				Valid:    utils.PointerTo(true),
+				Problems: &[]models.ScanConfigProblem{},
+			},
+		},
+		{
+			name:           "unreachable scope and unconfigured scanners",
+			providerClient: &unreachableProviderClient{},
+			scanConfig: models.ScanConfigData{
+				Scope: &models.ScanScopeType{},
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{
+					Secrets: &models.SecretsConfig{
+						Enabled:      utils.PointerTo(true),
+						ScannersList: &[]string{"trufflehog", "unknown"},
+					},
+					Rootkits: &models.RootkitsConfig{
+						Enabled: utils.PointerTo(false),
+					},
+				},
+				ScannerInstanceCreationConfig: &models.ScannerInstanceCreationConfig{
+					MaxPrice: utils.PointerTo("cheap"),
+				},
+				IgnoreRules: &[]models.IgnoreRule{
+					{VulnerabilityName: utils.PointerTo("CVE-2023-0001")},
+					{Reason: utils.PointerTo("accepted")},
+				},
+			},
+			want: &models.ScanConfigValidation{
+				Valid: utils.PointerTo(false),
+				Problems: &[]models.ScanConfigProblem{
+					{
+						Field:   utils.PointerTo("scope"),
+						Message: utils.PointerTo("failed to discover instances: region unreachable"),
+					},
+					{
+						Field:   utils.PointerTo("scanFamiliesConfig.secrets.scannersList"),
+						Message: utils.PointerTo(`scanner "trufflehog" is enabled but TRUFFLEHOG_BINARY_PATH isn't configured`),
+					},
+					{
+						Field:   utils.PointerTo("scanFamiliesConfig.secrets.scannersList"),
+						Message: utils.PointerTo(`unsupported scanner "unknown"`),
+					},
+					{
+						Field:   utils.PointerTo("scannerInstanceCreationConfig.maxPrice"),
+						Message: utils.PointerTo("max price is only used with spot instances"),
+					},
+					{
+						Field:   utils.PointerTo("scannerInstanceCreationConfig.maxPrice"),
+						Message: utils.PointerTo(`invalid max price "cheap"`),
+					},
+					{
+						Field:   utils.PointerTo("ignoreRules[1]"),
+						Message: utils.PointerTo("at least one of vulnerabilityName, path, secretRuleID or misconfigurationTestID must be set"),
+					},
+				},
+			},
+		},
+		{
+			name:           "families of the preset are validated",
+			providerClient: &planProviderClient{},
+			scanConfig: models.ScanConfigData{
+				Scope: &models.ScanScopeType{},
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{
+					Preset: utils.PointerTo(models.SecretsOnly),
+					Secrets: &models.SecretsConfig{
+						ScannersList: &[]string{"trufflehog"},
+					},
+				},
+			},
+			want: &models.ScanConfigValidation{
+				Valid: utils.PointerTo(false),
+				Problems: &[]models.ScanConfigProblem{
+					{
+						Field:   utils.PointerTo("scanFamiliesConfig.secrets.scannersList"),
+						Message: utils.PointerTo(`scanner "trufflehog" is enabled but TRUFFLEHOG_BINARY_PATH isn't configured`),
+					},
+				},
+			},
+		},
+		{
+			name:           "invalid scanner command options",
+			providerClient: &planProviderClient{},
+			scanConfig: models.ScanConfigData{
+				Scope: &models.ScanScopeType{},
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{
+					ScannerCommandOptions: &map[string]models.ScannerCommandOptions{
+						"gitleaks": {
+							ExtraArgs: &[]string{"--config", "/etc/gitleaks.toml"},
+						},
+						"grype": {
+							ExtraArgs: &[]string{"--only-fixed"},
+						},
+						"lynis": {
+							ExtraArgs: &[]string{"--profile", "/tmp/x; rm -rf /"},
+						},
+						"trufflehog": {
+							Env: &map[string]string{"LD_PRELOAD": "/tmp/lib.so"},
+						},
+					},
+				},
+			},
+			want: &models.ScanConfigValidation{
+				Valid: utils.PointerTo(false),
+				Problems: &[]models.ScanConfigProblem{
+					{
+						Field:   utils.PointerTo("scanFamiliesConfig.scannerCommandOptions.grype"),
+						Message: utils.PointerTo(`scanner "grype" doesn't support command options`),
+					},
+					{
+						Field:   utils.PointerTo("scanFamiliesConfig.scannerCommandOptions.lynis"),
+						Message: utils.PointerTo(`extra argument "/tmp/x; rm -rf /" contains unsafe characters`),
+					},
+					{
+						Field:   utils.PointerTo("scanFamiliesConfig.scannerCommandOptions.trufflehog"),
+						Message: utils.PointerTo("environment variable LD_PRELOAD is not allowed"),
+					},
+				},
+			},
+		},
+		{
+			name:           "missing scope and families config",
+			providerClient: &planProviderClient{},
+			scanConfig:     models.ScanConfigData{},
+			want: &models.ScanConfigValidation{
+				Valid: utils.PointerTo(false),
+				Problems: &[]models.ScanConfigProblem{
+					{
+						Field:   utils.PointerTo("scope"),
+						Message: utils.PointerTo("scope must be set"),
+					},
+					{
+						Field:   utils.PointerTo("scanFamiliesConfig"),
+						Message: utils.PointerTo("scan families config must be set"),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateScanConfig(context.Background(), config, tt.providerClient, tt.scanConfig)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ValidateScanConfig() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_validateScannerInstanceCreationConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		creationConfig *models.ScannerInstanceCreationConfig
+		want           []models.ScanConfigProblem
+	}{
+		{
+			name: "valid",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				MaxPrice:                 utils.PointerTo("0.5"),
+				RetryMaxAttempts:         utils.PointerTo(3),
+				UseSpotInstances:         true,
+				RequireIMDSv2:            utils.PointerTo(true),
+				InstanceMetadataHopLimit: utils.PointerTo(1),
+				InstanceProfileARN:       utils.PointerTo("arn:aws:iam::123456789012:instance-profile/VmClarityScanner"),
+				InstanceTypesByVolumeSize: &[]models.ScannerInstanceTypeByVolumeSize{
+					{InstanceType: "t3.large", MinVolumeSizeGB: 0},
+					{InstanceType: "t3.xlarge", MinVolumeSizeGB: 500},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				RetryMaxAttempts:         utils.PointerTo(0),
+				InstanceMetadataHopLimit: utils.PointerTo(65),
+				InstanceProfileARN:       utils.PointerTo("arn:aws:iam::123456789012:role/VmClarityScanner"),
+				InstanceTypesByVolumeSize: &[]models.ScannerInstanceTypeByVolumeSize{
+					{InstanceType: "t3.large", MinVolumeSizeGB: 100},
+					{InstanceType: "", MinVolumeSizeGB: 100},
+					{InstanceType: "t3.small", MinVolumeSizeGB: -1},
+				},
+			},
+			want: []models.ScanConfigProblem{
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.retryMaxAttempts"),
+					Message: utils.PointerTo("retry max attempts must be at least 1, got 0"),
+				},
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.instanceMetadataHopLimit"),
+					Message: utils.PointerTo("instance metadata hop limit must be between 1 and 64, got 65"),
+				},
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.instanceProfileARN"),
+					Message: utils.PointerTo(`invalid instance profile ARN "arn:aws:iam::123456789012:role/VmClarityScanner"`),
+				},
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.instanceTypesByVolumeSize[1].instanceType"),
+					Message: utils.PointerTo("instance type must be set"),
+				},
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.instanceTypesByVolumeSize[1].minVolumeSizeGB"),
+					Message: utils.PointerTo("min volume size 100 is set for more than one instance type"),
+				},
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.instanceTypesByVolumeSize[2].minVolumeSizeGB"),
+					Message: utils.PointerTo("min volume size must not be negative, got -1"),
+				},
+			},
+		},
+		{
+			name: "static image pull credentials without username and password",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				ScannerImagePullCredentials: &models.ScannerImagePullCredentials{
+					Method: models.Static,
+				},
+			},
+			want: []models.ScanConfigProblem{
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.scannerImagePullCredentials.username"),
+					Message: utils.PointerTo("username must be set for static credentials"),
+				},
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.scannerImagePullCredentials.password"),
+					Message: utils.PointerTo("password must be set for static credentials"),
+				},
+			},
+		},
+		{
+			name: "ecr instance profile image pull credentials without instance profile",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				ScannerImagePullCredentials: &models.ScannerImagePullCredentials{
+					Method: models.EcrInstanceProfile,
+				},
+			},
+			want: []models.ScanConfigProblem{
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.scannerImagePullCredentials.method"),
+					Message: utils.PointerTo("instance profile ARN must be set to pull the scanner image with the instance profile"),
+				},
+			},
+		},
+		{
+			name: "invalid proxy",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				Proxy: &models.ScannerProxyConfig{
+					HttpProxy:  utils.PointerTo("proxy:3128"),
+					HttpsProxy: utils.PointerTo("ftp://proxy:3128"),
+					NoProxy:    utils.PointerTo("10.0.0.1"),
+				},
+			},
+			want: []models.ScanConfigProblem{
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.proxy.httpProxy"),
+					Message: utils.PointerTo(`invalid proxy URL "proxy:3128", the scheme must be http, https, socks5 or socks5h`),
+				},
+				{
+					Field:   utils.PointerTo("scannerInstanceCreationConfig.proxy.httpsProxy"),
+					Message: utils.PointerTo(`invalid proxy URL "ftp://proxy:3128", the scheme must be http, https, socks5 or socks5h`),
+				},
+			},
+		},
+		{
+			name: "valid proxy",
+			creationConfig: &models.ScannerInstanceCreationConfig{
+				Proxy: &models.ScannerProxyConfig{
+					HttpProxy:  utils.PointerTo("http://proxy:3128"),
+					HttpsProxy: utils.PointerTo("socks5://proxy:1080"),
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateScannerInstanceCreationConfig(tt.creationConfig)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("validateScannerInstanceCreationConfig() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
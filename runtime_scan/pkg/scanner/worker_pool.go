@@ -0,0 +1,177 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+)
+
+// workerPool runs the workers which handle the targets of a scan. A scaling
+// pool starts a worker when a target is submitted while no worker is idle, up
+// to the max workers, and lets a worker exit once it was idle for the idle
+// timeout, down to the min workers. A fixed size pool starts the max workers
+// up front and they never exit while idle. All the workers exit once the pool
+// is stopped.
+type workerPool struct {
+	queue       chan *scanData
+	stopped     chan struct{}
+	stopOnce    sync.Once
+	min         int
+	max         int
+	idleTimeout time.Duration // zero for a fixed size pool
+	gauge       prometheus.Gauge
+	run         func(workNumber int)
+
+	// workers is the number of workers which didn't exit while idle, and
+	// started numbers the workers.
+	workers int
+	started int
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+}
+
+func newWorkerPool(config *_config.ScannerConfig, maxWorkers int, gauge prometheus.Gauge) *workerPool {
+	if maxWorkers < 1 {
+		maxWorkers = 1
+	}
+	pool := &workerPool{
+		queue:   make(chan *scanData),
+		stopped: make(chan struct{}),
+		min:     maxWorkers,
+		max:     maxWorkers,
+		gauge:   gauge,
+	}
+	if !config.WorkerPoolFixedSize && config.WorkerPoolIdleTimeout > 0 {
+		pool.idleTimeout = config.WorkerPoolIdleTimeout
+		// At least one worker is kept, so that a submitted target is
+		// always handled.
+		pool.min = config.WorkerPoolMinWorkers
+		if pool.min < 1 {
+			pool.min = 1
+		}
+		if pool.min > maxWorkers {
+			pool.min = maxWorkers
+		}
+	}
+
+	return pool
+}
+
+// start starts the min workers of the pool, the workers run the given
+// function until it returns.
+func (p *workerPool) start(run func(workNumber int)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.run = run
+	for p.workers < p.min {
+		p.startWorker()
+	}
+}
+
+// submit queues the target for a worker, and starts a new worker if none is
+// idle. It returns false if the kill signal was received, or the pool was
+// stopped, before a worker took the target.
+func (p *workerPool) submit(data *scanData, ks <-chan bool) bool {
+	select {
+	case p.queue <- data:
+		return true
+	default:
+	}
+
+	p.mu.Lock()
+	if p.workers < p.max {
+		p.startWorker()
+	}
+	p.mu.Unlock()
+
+	select {
+	case p.queue <- data:
+		return true
+	case <-ks:
+		return false
+	case <-p.stopped:
+		return false
+	}
+}
+
+// stop makes the workers exit, the targets they are handling are done first.
+// It's called once all the targets of the scan are done, so that the min
+// workers don't wait for targets after the scan returned.
+func (p *workerPool) stop() {
+	p.stopOnce.Do(func() {
+		close(p.stopped)
+	})
+}
+
+// done returns a channel which is closed once the pool is stopped.
+func (p *workerPool) done() <-chan struct{} {
+	return p.stopped
+}
+
+// idle returns a channel which fires once a worker waiting for a target was
+// idle for the idle timeout, it never fires in a fixed size pool.
+func (p *workerPool) idle() <-chan time.Time {
+	if p.idleTimeout == 0 {
+		return nil
+	}
+	return time.After(p.idleTimeout)
+}
+
+// release returns true if the idle worker must exit, which is the case as long
+// as the pool has more than the min workers.
+func (p *workerPool) release() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.workers <= p.min {
+		return false
+	}
+	p.workers--
+	return true
+}
+
+// wait waits for all the workers to exit.
+func (p *workerPool) wait() {
+	p.wg.Wait()
+}
+
+// size returns the number of workers which didn't exit while idle.
+func (p *workerPool) size() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.workers
+}
+
+// startWorker must be called with the lock held.
+func (p *workerPool) startWorker() {
+	workNumber := p.started
+	p.started++
+	p.workers++
+	p.wg.Add(1)
+	p.gauge.Inc()
+	go func() {
+		defer p.wg.Done()
+		defer p.gauge.Dec()
+		p.run(workNumber)
+	}()
+}
@@ -0,0 +1,260 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	_config "github.com/openclarity/vmclarity/runtime_scan/pkg/config"
+)
+
+func Test_newWorkerPool(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          *_config.ScannerConfig
+		maxWorkers      int
+		wantMin         int
+		wantMax         int
+		wantIdleTimeout time.Duration
+	}{
+		{
+			name: "scaling pool",
+			config: &_config.ScannerConfig{
+				WorkerPoolMinWorkers:  2,
+				WorkerPoolIdleTimeout: time.Minute,
+			},
+			maxWorkers:      10,
+			wantMin:         2,
+			wantMax:         10,
+			wantIdleTimeout: time.Minute,
+		},
+		{
+			name: "fixed size pool",
+			config: &_config.ScannerConfig{
+				WorkerPoolFixedSize:   true,
+				WorkerPoolMinWorkers:  2,
+				WorkerPoolIdleTimeout: time.Minute,
+			},
+			maxWorkers: 10,
+			wantMin:    10,
+			wantMax:    10,
+		},
+		{
+			name: "no idle timeout",
+			config: &_config.ScannerConfig{
+				WorkerPoolMinWorkers: 2,
+			},
+			maxWorkers: 10,
+			wantMin:    10,
+			wantMax:    10,
+		},
+		{
+			name: "at least one worker is kept",
+			config: &_config.ScannerConfig{
+				WorkerPoolIdleTimeout: time.Minute,
+			},
+			maxWorkers:      10,
+			wantMin:         1,
+			wantMax:         10,
+			wantIdleTimeout: time.Minute,
+		},
+		{
+			name: "min workers above the max workers",
+			config: &_config.ScannerConfig{
+				WorkerPoolMinWorkers:  20,
+				WorkerPoolIdleTimeout: time.Minute,
+			},
+			maxWorkers:      10,
+			wantMin:         10,
+			wantMax:         10,
+			wantIdleTimeout: time.Minute,
+		},
+		{
+			name: "no max workers",
+			config: &_config.ScannerConfig{
+				WorkerPoolIdleTimeout: time.Minute,
+			},
+			maxWorkers:      0,
+			wantMin:         1,
+			wantMax:         1,
+			wantIdleTimeout: time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := newWorkerPool(tt.config, tt.maxWorkers, prometheus.NewGauge(prometheus.GaugeOpts{Name: "workers"}))
+			if pool.min != tt.wantMin {
+				t.Errorf("newWorkerPool() min = %v, want %v", pool.min, tt.wantMin)
+			}
+			if pool.max != tt.wantMax {
+				t.Errorf("newWorkerPool() max = %v, want %v", pool.max, tt.wantMax)
+			}
+			if pool.idleTimeout != tt.wantIdleTimeout {
+				t.Errorf("newWorkerPool() idleTimeout = %v, want %v", pool.idleTimeout, tt.wantIdleTimeout)
+			}
+		})
+	}
+}
+
+// startTestWorkers starts the workers of the pool, which hold each target
+// until release is closed.
+func startTestWorkers(pool *workerPool, release, ks chan bool) {
+	pool.start(func(workNumber int) {
+		for {
+			select {
+			case <-pool.queue:
+				<-release
+			case <-pool.idle():
+				if pool.release() {
+					return
+				}
+			case <-pool.done():
+				return
+			case <-ks:
+				return
+			}
+		}
+	})
+}
+
+func TestWorkerPool_Scaling(t *testing.T) {
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "workers"})
+	pool := newWorkerPool(&_config.ScannerConfig{
+		WorkerPoolMinWorkers:  1,
+		WorkerPoolIdleTimeout: 10 * time.Millisecond,
+	}, 3, gauge)
+	release := make(chan bool)
+	ks := make(chan bool)
+	startTestWorkers(pool, release, ks)
+
+	if size := pool.size(); size != 1 {
+		t.Fatalf("start() size = %v, want 1", size)
+	}
+
+	// Every target is held by its own worker, so the pool scales up to
+	// the max workers.
+	for i := 0; i < 3; i++ {
+		if !pool.submit(&scanData{}, ks) {
+			t.Fatalf("submit() the target wasn't taken by a worker")
+		}
+	}
+	if size := pool.size(); size != 3 {
+		t.Errorf("submit() size = %v, want 3", size)
+	}
+
+	// A target submitted while all the workers are busy waits for a worker
+	// instead of exceeding the max workers.
+	submitted := make(chan bool)
+	go func() {
+		submitted <- pool.submit(&scanData{}, ks)
+	}()
+	select {
+	case <-submitted:
+		t.Fatalf("submit() the target was taken while all the workers are busy")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if size := pool.size(); size != 3 {
+		t.Errorf("submit() size = %v, want 3", size)
+	}
+
+	// Once the targets are done, the idle workers exit down to the min
+	// workers.
+	close(release)
+	if !<-submitted {
+		t.Fatalf("submit() the target wasn't taken by a worker")
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.size() > 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if size := pool.size(); size != 1 {
+		t.Errorf("idle workers didn't exit, size = %v, want 1", size)
+	}
+
+	close(ks)
+	pool.wait()
+	if workers := testutil.ToFloat64(gauge); workers != 0 {
+		t.Errorf("workers gauge = %v after all the workers exited, want 0", workers)
+	}
+}
+
+func TestWorkerPool_FixedSize(t *testing.T) {
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "workers"})
+	pool := newWorkerPool(&_config.ScannerConfig{
+		WorkerPoolFixedSize:   true,
+		WorkerPoolIdleTimeout: time.Millisecond,
+	}, 3, gauge)
+	release := make(chan bool)
+	ks := make(chan bool)
+	startTestWorkers(pool, release, ks)
+
+	if size := pool.size(); size != 3 {
+		t.Fatalf("start() size = %v, want 3", size)
+	}
+	if workers := testutil.ToFloat64(gauge); workers != 3 {
+		t.Errorf("workers gauge = %v, want 3", workers)
+	}
+	if pool.idle() != nil {
+		t.Errorf("idle() the workers of a fixed size pool exit while idle")
+	}
+
+	close(release)
+	close(ks)
+	pool.wait()
+	if size := pool.size(); size != 3 {
+		t.Errorf("size = %v after the kill signal, want 3", size)
+	}
+}
+
+func TestWorkerPool_Stop(t *testing.T) {
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "workers"})
+	pool := newWorkerPool(&_config.ScannerConfig{
+		WorkerPoolMinWorkers:  2,
+		WorkerPoolIdleTimeout: time.Hour,
+	}, 3, gauge)
+	release := make(chan bool)
+	ks := make(chan bool)
+	startTestWorkers(pool, release, ks)
+
+	// The min workers wait for targets until the pool is stopped, even
+	// after the targets are done.
+	if !pool.submit(&scanData{}, ks) {
+		t.Fatalf("submit() the target wasn't taken by a worker")
+	}
+	close(release)
+	pool.stop()
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		pool.wait()
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("stop() the workers didn't exit")
+	}
+	if workers := testutil.ToFloat64(gauge); workers != 0 {
+		t.Errorf("workers gauge = %v after the pool was stopped, want 0", workers)
+	}
+	if pool.submit(&scanData{}, ks) {
+		t.Errorf("submit() a target was taken after the pool was stopped")
+	}
+}
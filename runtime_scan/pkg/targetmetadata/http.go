@@ -0,0 +1,118 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetmetadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/targetmetadata"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// HTTPSource fetches the metadata of an instance with a GET request to
+// <SourceURL>/<instance ID>. The source is expected to answer with a JSON
+// document in the format of httpTargetMetadata, or 404 if it doesn't know the
+// instance.
+type HTTPSource struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+type httpTargetMetadata struct {
+	Owner       string            `json:"owner"`
+	Application string            `json:"application"`
+	Criticality string            `json:"criticality"`
+	Attributes  map[string]string `json:"attributes"`
+}
+
+func NewHTTPSource(config *targetmetadata.Config) *HTTPSource {
+	return &HTTPSource{
+		baseURL: strings.TrimSuffix(config.SourceURL, "/"),
+		token:   config.SourceToken,
+		client: &http.Client{
+			Timeout: config.SourceTimeout,
+		},
+	}
+}
+
+func (s *HTTPSource) GetTargetMetadata(ctx context.Context, instance types.Instance) (*models.TargetMetadata, error) {
+	reqURL := fmt.Sprintf("%s/%s", s.baseURL, url.PathEscape(instance.GetID()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata from %s: %v", reqURL, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// nolint:nilnil
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to get metadata from %s: unexpected status code %v", reqURL, resp.StatusCode)
+	}
+
+	var metadata httpTargetMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata from %s: %v", reqURL, err)
+	}
+
+	return convertHTTPTargetMetadata(metadata), nil
+}
+
+func convertHTTPTargetMetadata(metadata httpTargetMetadata) *models.TargetMetadata {
+	ret := &models.TargetMetadata{}
+	if metadata.Owner != "" {
+		ret.Owner = utils.PointerTo(metadata.Owner)
+	}
+	if metadata.Application != "" {
+		ret.Application = utils.PointerTo(metadata.Application)
+	}
+	if metadata.Criticality != "" {
+		ret.Criticality = utils.PointerTo(metadata.Criticality)
+	}
+	if len(metadata.Attributes) > 0 {
+		attributes := make([]models.Tag, 0, len(metadata.Attributes))
+		for key, value := range metadata.Attributes {
+			attributes = append(attributes, models.Tag{Key: key, Value: value})
+		}
+		// keep a stable order so unchanged metadata is stored the same.
+		sort.Slice(attributes, func(i, j int) bool {
+			return attributes[i].Key < attributes[j].Key
+		})
+		ret.Attributes = &attributes
+	}
+
+	return ret
+}
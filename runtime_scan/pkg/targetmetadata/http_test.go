@@ -0,0 +1,122 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetmetadata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/targetmetadata"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+type fakeInstance struct {
+	types.Instance
+	id string
+}
+
+func (i *fakeInstance) GetID() string {
+	return i.id
+}
+
+func TestHTTPSource_GetTargetMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/instances/i-123":
+			_, _ = w.Write([]byte(`{"owner": "team-a", "application": "billing", "criticality": "high", "attributes": {"env": "prod", "cost-center": "42"}}`))
+		case "/instances/i-partial":
+			_, _ = w.Write([]byte(`{"owner": "team-b"}`))
+		case "/instances/i-invalid":
+			_, _ = w.Write([]byte(`not json`))
+		case "/instances/i-error":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	source := NewHTTPSource(&targetmetadata.Config{
+		SourceURL:     server.URL + "/instances/",
+		SourceToken:   "token",
+		SourceTimeout: 5 * time.Second,
+	})
+
+	tests := []struct {
+		name       string
+		instanceID string
+		want       *models.TargetMetadata
+		wantErr    bool
+	}{
+		{
+			name:       "full metadata",
+			instanceID: "i-123",
+			want: &models.TargetMetadata{
+				Owner:       utils.PointerTo("team-a"),
+				Application: utils.PointerTo("billing"),
+				Criticality: utils.PointerTo("high"),
+				Attributes: &[]models.Tag{
+					{Key: "cost-center", Value: "42"},
+					{Key: "env", Value: "prod"},
+				},
+			},
+		},
+		{
+			name:       "partial metadata",
+			instanceID: "i-partial",
+			want: &models.TargetMetadata{
+				Owner: utils.PointerTo("team-b"),
+			},
+		},
+		{
+			name:       "unknown instance",
+			instanceID: "i-unknown",
+			want:       nil,
+		},
+		{
+			name:       "invalid body",
+			instanceID: "i-invalid",
+			wantErr:    true,
+		},
+		{
+			name:       "server error",
+			instanceID: "i-error",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := source.GetTargetMetadata(context.Background(), &fakeInstance{id: tt.instanceID})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetTargetMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetTargetMetadata() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetmetadata
+
+import (
+	"context"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/targetmetadata"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/types"
+)
+
+// Source provides business attributes (owner, application, criticality...)
+// of an instance, usually from an external asset inventory like a CMDB.
+type Source interface {
+	// GetTargetMetadata returns the metadata of the instance, or nil if the
+	// source has no metadata for it.
+	GetTargetMetadata(ctx context.Context, instance types.Instance) (*models.TargetMetadata, error)
+}
+
+// New returns the configured metadata source, or nil if no source is
+// configured.
+func New(config *targetmetadata.Config) Source {
+	if config == nil || config.SourceURL == "" {
+		return nil
+	}
+
+	return NewHTTPSource(config)
+}
@@ -17,15 +17,52 @@ package types
 
 import (
 	"context"
+
+	"github.com/openclarity/vmclarity/api/models"
 )
 
 // Job represents a scan process of a target.
 type Job struct {
 	// Resources that was created as part of the scan:
-	Instance    Instance // Instance the scanner job instance.
-	SrcSnapshot Snapshot // SrcSnapshot the snapshot of the target root volume.
+	Instance Instance    // Instance the scanner job instance.
+	Volumes  []JobVolume // Volumes the resources of each scanned volume of the target, the root volume first.
+}
+
+// JobVolume holds the resources created to scan a volume of the target.
+type JobVolume struct {
+	SrcSnapshot Snapshot // SrcSnapshot the snapshot of the target volume.
 	DstSnapshot Snapshot // DstSnapshot copy of SrcSnapshot in the scanner region.
 	Volume      Volume   // Volume created from the DstSnapshot to be attached to the scanner job.
+	// ExistingSnapshot is set if SrcSnapshot is an existing snapshot which
+	// was provided by the scan config instead of taken for the job, it isn't
+	// deleted with the job.
+	ExistingSnapshot bool
+}
+
+// LaunchSnapshot returns the snapshot in the scanner region which the volume
+// of the scanner job is created from.
+func (v JobVolume) LaunchSnapshot() Snapshot {
+	if v.DstSnapshot != nil {
+		return v.DstSnapshot
+	}
+	return v.SrcSnapshot
+}
+
+// JobInfo identifies the scanning job of a target in a scan. The provider
+// resources created for the job are tagged with it.
+type JobInfo struct {
+	ScanID       string
+	ScanResultID string
+	TargetID     string
+}
+
+// JobResource is a resource of a scanning job which is tagged with the
+// JobInfo of the job. Only one of the resources is set.
+type JobResource struct {
+	JobInfo
+	Instance Instance
+	Volume   Volume
+	Snapshot Snapshot
 }
 
 type ScanJobRunConfig struct {
@@ -40,7 +77,11 @@ type Instance interface {
 	GetID() string
 	GetLocation() string
 	GetRootVolume(ctx context.Context) (Volume, error)
+	// GetVolumes returns all the volumes attached to the instance, the
+	// root volume first.
+	GetVolumes(ctx context.Context) ([]Volume, error)
 	GetAvailabilityZone() string
+	GetProvider() models.CloudProvider
 	WaitForReady(ctx context.Context) error
 	Delete(ctx context.Context) error
 	AttachVolume(ctx context.Context, volume Volume, deviceName string) error
@@ -52,7 +93,9 @@ type TargetInstance struct {
 }
 
 type Volume interface {
-	TakeSnapshot(ctx context.Context) (Snapshot, error)
+	// TakeSnapshot takes a snapshot of the volume for the scanning job,
+	// the snapshot and its copies and volumes are tagged with the job info.
+	TakeSnapshot(ctx context.Context, job JobInfo) (Snapshot, error)
 	GetID() string
 	Delete(ctx context.Context) error
 	WaitForReady(ctx context.Context) error
@@ -62,12 +105,34 @@ type Volume interface {
 type Snapshot interface {
 	GetID() string
 	GetRegion() string
+	// GetSize returns the size of the snapshotted volume in GB.
+	GetSize(ctx context.Context) (int64, error)
 	Copy(ctx context.Context, dstRegion string) (Snapshot, error)
 	Delete(ctx context.Context) error
 	WaitForReady(ctx context.Context) error
 	CreateVolume(ctx context.Context, availabilityZone string) (Volume, error)
 }
 
+// DirectReadSnapshot is implemented by the snapshots whose blocks the scanner
+// instance can read directly from the provider, instead of attaching a volume
+// created from the snapshot.
+type DirectReadSnapshot interface {
+	Snapshot
+	// SupportsDirectRead returns whether the blocks of the snapshot can be
+	// read directly.
+	SupportsDirectRead(ctx context.Context) (bool, error)
+}
+
+// ChecksummedSnapshot is implemented by the snapshots whose block checksums
+// can be read from the provider, to verify that a copy of a snapshot matches
+// its source.
+type ChecksummedSnapshot interface {
+	Snapshot
+	// GetBlockChecksums returns the checksums of the blocks of the snapshot
+	// which hold data, by block index.
+	GetBlockChecksums(ctx context.Context) (map[int32]string, error)
+}
+
 type ScannerJobConfig struct {
 	DirectoryToScan      string               `json:"directory_to_scan"`
 	ServerToReport       string               `json:"server_to_report"`
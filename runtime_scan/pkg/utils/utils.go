@@ -38,3 +38,13 @@ func IntPtr(val int) *int {
 func PointerTo[T any](value T) *T {
 	return &value
 }
+
+// ValueOrZero returns the value pointed by ptr or the zero value of T if ptr
+// is nil.
+func ValueOrZero[T any](ptr *T) T {
+	if ptr == nil {
+		var zero T
+		return zero
+	}
+	return *ptr
+}
@@ -0,0 +1,157 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// AlertNotifier posts a formatted message to a Slack or MS Teams incoming
+// webhook when a completed scan has critical findings.
+type AlertNotifier struct {
+	format                           webhook.AlertFormat
+	findingTypes                     map[webhook.AlertFindingType]bool
+	criticalVulnerabilitiesThreshold int
+	client                           *HTTPNotifier
+}
+
+func NewAlertNotifier(config *webhook.Config) *AlertNotifier {
+	findingTypes := make(map[webhook.AlertFindingType]bool, len(config.Alert.FindingTypes))
+	for _, findingType := range config.Alert.FindingTypes {
+		findingTypes[findingType] = true
+	}
+
+	return &AlertNotifier{
+		format:                           config.Alert.Format,
+		findingTypes:                     findingTypes,
+		criticalVulnerabilitiesThreshold: config.Alert.CriticalVulnerabilitiesThreshold,
+		// The incoming webhooks don't verify signatures, so the
+		// secret of the scan events webhook isn't sent to them.
+		client: NewHTTPNotifier(&webhook.Config{
+			URL:           config.Alert.URL,
+			Timeout:       config.Timeout,
+			MaxAttempts:   config.MaxAttempts,
+			RetryInterval: config.RetryInterval,
+		}),
+	}
+}
+
+func (n *AlertNotifier) ScanCompleted(ctx context.Context, scan *models.Scan) error {
+	alerts := n.getAlerts(scan.Summary)
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	scanID := utils.ValueOrZero(scan.Id)
+	body, err := n.createMessage(scan, alerts)
+	if err != nil {
+		return fmt.Errorf("failed to create alert message: %v", err)
+	}
+
+	log.Infof("Sending alert of scan %s: %s", scanID, strings.Join(alerts, ", "))
+	return n.client.postWithRetry(ctx, ScanAlertEvent, scanID, body)
+}
+
+// TargetScanCompleted is a no-op, the alerts are sent once for the whole scan.
+func (n *AlertNotifier) TargetScanCompleted(context.Context, *models.TargetScanResult) error {
+	return nil
+}
+
+// getAlerts returns a description of each finding type in the summary which
+// triggers an alert.
+func (n *AlertNotifier) getAlerts(summary *models.ScanSummary) []string {
+	if summary == nil {
+		return nil
+	}
+
+	var alerts []string
+	if n.findingTypes[webhook.AlertFindingTypeVulnerabilities] && summary.TotalVulnerabilities != nil {
+		if critical := utils.ValueOrZero(summary.TotalVulnerabilities.TotalCriticalVulnerabilities); critical > n.criticalVulnerabilitiesThreshold {
+			alerts = append(alerts, fmt.Sprintf("%d critical vulnerabilities", critical))
+		}
+	}
+	if n.findingTypes[webhook.AlertFindingTypeMalware] {
+		if malware := utils.ValueOrZero(summary.TotalMalware); malware > 0 {
+			alerts = append(alerts, fmt.Sprintf("%d malware", malware))
+		}
+	}
+	if n.findingTypes[webhook.AlertFindingTypeRootkits] {
+		if rootkits := utils.ValueOrZero(summary.TotalRootkits); rootkits > 0 {
+			alerts = append(alerts, fmt.Sprintf("%d rootkits", rootkits))
+		}
+	}
+
+	return alerts
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// teamsMessage is a legacy actionable message card, which is what the
+// Teams incoming webhooks accept.
+type teamsMessage struct {
+	Type       string `json:"@type"`
+	Context    string `json:"@context"`
+	Summary    string `json:"summary"`
+	ThemeColor string `json:"themeColor"`
+	Title      string `json:"title"`
+	Text       string `json:"text"`
+}
+
+func (n *AlertNotifier) createMessage(scan *models.Scan, alerts []string) ([]byte, error) {
+	title := fmt.Sprintf("VMClarity scan %s found critical findings", utils.ValueOrZero(scan.Id))
+	if scan.ScanConfigSnapshot != nil && scan.ScanConfigSnapshot.Name != nil {
+		title = fmt.Sprintf("VMClarity scan %s of %s found critical findings", utils.ValueOrZero(scan.Id), *scan.ScanConfigSnapshot.Name)
+	}
+
+	switch n.format {
+	case webhook.AlertFormatSlack:
+		lines := []string{fmt.Sprintf(":rotating_light: *%s*", title)}
+		for _, alert := range alerts {
+			lines = append(lines, "• "+alert)
+		}
+		// nolint:wrapcheck
+		return json.Marshal(slackMessage{Text: strings.Join(lines, "\n")})
+	case webhook.AlertFormatTeams:
+		// Teams renders the text as markdown, which needs a blank line
+		// between the list items.
+		lines := make([]string, 0, len(alerts))
+		for _, alert := range alerts {
+			lines = append(lines, "- "+alert)
+		}
+		// nolint:wrapcheck
+		return json.Marshal(teamsMessage{
+			Type:       "MessageCard",
+			Context:    "http://schema.org/extensions",
+			Summary:    title,
+			ThemeColor: "D70000",
+			Title:      title,
+			Text:       strings.Join(lines, "\n\n"),
+		})
+	default:
+		return nil, fmt.Errorf("unsupported alert format %q", n.format)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+var allAlertFindingTypes = []webhook.AlertFindingType{
+	webhook.AlertFindingTypeVulnerabilities,
+	webhook.AlertFindingTypeMalware,
+	webhook.AlertFindingTypeRootkits,
+}
+
+func TestAlertNotifier_getAlerts(t *testing.T) {
+	tests := []struct {
+		name         string
+		findingTypes []webhook.AlertFindingType
+		threshold    int
+		summary      *models.ScanSummary
+		want         []string
+	}{
+		{
+			name:         "nil summary",
+			findingTypes: allAlertFindingTypes,
+			summary:      nil,
+			want:         nil,
+		},
+		{
+			name:         "no critical findings",
+			findingTypes: allAlertFindingTypes,
+			summary: &models.ScanSummary{
+				TotalMalware:  utils.PointerTo(0),
+				TotalRootkits: utils.PointerTo(0),
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalCriticalVulnerabilities: utils.PointerTo(0),
+					TotalHighVulnerabilities:     utils.PointerTo(10),
+				},
+			},
+			want: nil,
+		},
+		{
+			name:         "critical vulnerabilities within threshold",
+			findingTypes: allAlertFindingTypes,
+			threshold:    2,
+			summary: &models.ScanSummary{
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalCriticalVulnerabilities: utils.PointerTo(2),
+				},
+			},
+			want: nil,
+		},
+		{
+			name:         "all critical findings",
+			findingTypes: allAlertFindingTypes,
+			threshold:    2,
+			summary: &models.ScanSummary{
+				TotalMalware:  utils.PointerTo(1),
+				TotalRootkits: utils.PointerTo(2),
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalCriticalVulnerabilities: utils.PointerTo(3),
+				},
+			},
+			want: []string{"3 critical vulnerabilities", "1 malware", "2 rootkits"},
+		},
+		{
+			name:         "only configured finding types",
+			findingTypes: []webhook.AlertFindingType{webhook.AlertFindingTypeMalware},
+			summary: &models.ScanSummary{
+				TotalMalware:  utils.PointerTo(1),
+				TotalRootkits: utils.PointerTo(2),
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalCriticalVulnerabilities: utils.PointerTo(3),
+				},
+			},
+			want: []string{"1 malware"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NewAlertNotifier(&webhook.Config{
+				Alert: webhook.AlertConfig{
+					URL:                              "http://localhost",
+					Format:                           webhook.AlertFormatSlack,
+					FindingTypes:                     tt.findingTypes,
+					CriticalVulnerabilitiesThreshold: tt.threshold,
+				},
+			})
+			got := n.getAlerts(tt.summary)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getAlerts() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAlertNotifier_ScanCompleted(t *testing.T) {
+	scan := &models.Scan{
+		Id: utils.PointerTo("scan-1"),
+		ScanConfigSnapshot: &models.ScanConfigData{
+			Name: utils.PointerTo("daily"),
+		},
+		Summary: &models.ScanSummary{
+			TotalMalware: utils.PointerTo(1),
+			TotalVulnerabilities: &models.VulnerabilityScanSummary{
+				TotalCriticalVulnerabilities: utils.PointerTo(3),
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		format   webhook.AlertFormat
+		scan     *models.Scan
+		wantSent map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name:   "slack",
+			format: webhook.AlertFormatSlack,
+			scan:   scan,
+			wantSent: map[string]interface{}{
+				"text": ":rotating_light: *VMClarity scan scan-1 of daily found critical findings*\n• 3 critical vulnerabilities\n• 1 malware",
+			},
+		},
+		{
+			name:   "teams",
+			format: webhook.AlertFormatTeams,
+			scan:   scan,
+			wantSent: map[string]interface{}{
+				"@type":      "MessageCard",
+				"@context":   "http://schema.org/extensions",
+				"summary":    "VMClarity scan scan-1 of daily found critical findings",
+				"themeColor": "D70000",
+				"title":      "VMClarity scan scan-1 of daily found critical findings",
+				"text":       "- 3 critical vulnerabilities\n\n- 1 malware",
+			},
+		},
+		{
+			name:   "nothing to alert",
+			format: webhook.AlertFormatSlack,
+			scan: &models.Scan{
+				Id:      utils.PointerTo("scan-1"),
+				Summary: &models.ScanSummary{},
+			},
+			wantSent: nil,
+		},
+		{
+			name:     "unsupported format",
+			format:   "email",
+			scan:     scan,
+			wantSent: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+					t.Errorf("failed to decode message: %v", err)
+				}
+				if got := r.Header.Get(SignatureHeader); got != "" {
+					t.Errorf("%s = %v, want empty", SignatureHeader, got)
+				}
+			}))
+			defer server.Close()
+
+			n := NewAlertNotifier(&webhook.Config{
+				Secret:      "secret",
+				Timeout:     time.Second,
+				MaxAttempts: 1,
+				Alert: webhook.AlertConfig{
+					URL:          server.URL,
+					Format:       tt.format,
+					FindingTypes: allAlertFindingTypes,
+				},
+			})
+
+			err := n.ScanCompleted(context.Background(), tt.scan)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScanCompleted() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantSent, sent); diff != "" {
+				t.Errorf("ScanCompleted() sent mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+)
+
+// Dispatcher delivers the notifications to the notifier in the background
+// with a bounded number of workers and a bounded queue, so that a burst of
+// notifications or a slow webhook doesn't block the scans. The notifications
+// which don't fit in the queue are dropped according to the drop policy.
+type Dispatcher struct {
+	notifier    Notifier
+	concurrency int
+	dropPolicy  webhook.DropPolicy
+	queue       chan notification
+
+	// pending counts the queued and the in flight notifications.
+	pending sync.WaitGroup
+}
+
+// notification is a queued delivery of a scan event.
+type notification struct {
+	description string
+	notify      func(ctx context.Context) error
+}
+
+// NewDispatcher creates a dispatcher of the notifications to the notifier,
+// the notifications are delivered once it is started.
+func NewDispatcher(config webhook.DispatchConfig, notifier Notifier) *Dispatcher {
+	concurrency := config.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	queueSize := config.QueueSize
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	dropPolicy := config.DropPolicy
+	if dropPolicy != webhook.DropPolicyNewest {
+		dropPolicy = webhook.DropPolicyOldest
+	}
+
+	return &Dispatcher{
+		notifier:    notifier,
+		concurrency: concurrency,
+		dropPolicy:  dropPolicy,
+		queue:       make(chan notification, queueSize),
+	}
+}
+
+// Start starts the workers delivering the notifications until ctx is done.
+func (d *Dispatcher) Start(ctx context.Context) {
+	for i := 0; i < d.concurrency; i++ {
+		go d.worker(ctx)
+	}
+}
+
+// Drain waits until the queued notifications are delivered or ctx is done.
+func (d *Dispatcher) Drain(ctx context.Context) error {
+	drained := make(chan struct{})
+	go func() {
+		d.pending.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("notifications were not delivered: %w", ctx.Err())
+	}
+}
+
+// ScanCompleted queues the notification of the completed scan, it doesn't
+// wait for its delivery.
+func (d *Dispatcher) ScanCompleted(_ context.Context, scan *models.Scan) error {
+	d.enqueue(notification{
+		description: fmt.Sprintf("completion of scan %s", NewScanEvent(ScanCompletedEvent, scan).ScanID),
+		notify: func(ctx context.Context) error {
+			return d.notifier.ScanCompleted(ctx, scan)
+		},
+	})
+	return nil
+}
+
+// TargetScanCompleted queues the notification of the completed target scan,
+// it doesn't wait for its delivery.
+func (d *Dispatcher) TargetScanCompleted(_ context.Context, scanResult *models.TargetScanResult) error {
+	d.enqueue(notification{
+		description: fmt.Sprintf("completion of scan result %s", NewTargetScanEvent(TargetScanCompletedEvent, scanResult).ScanResultID),
+		notify: func(ctx context.Context) error {
+			return d.notifier.TargetScanCompleted(ctx, scanResult)
+		},
+	})
+	return nil
+}
+
+func (d *Dispatcher) enqueue(n notification) {
+	d.pending.Add(1)
+	for {
+		select {
+		case d.queue <- n:
+			return
+		default:
+		}
+
+		if d.dropPolicy == webhook.DropPolicyNewest {
+			d.drop(n)
+			return
+		}
+		// Make room for the new notification, another notification may
+		// take it before it is queued, so try again.
+		select {
+		case oldest := <-d.queue:
+			d.drop(oldest)
+		default:
+		}
+	}
+}
+
+func (d *Dispatcher) drop(n notification) {
+	log.Warnf("Notification queue is full, dropping the notification of the %s", n.description)
+	d.pending.Done()
+}
+
+func (d *Dispatcher) worker(ctx context.Context) {
+	for {
+		select {
+		case n := <-d.queue:
+			if err := n.notify(ctx); err != nil {
+				log.Errorf("Failed to deliver the notification of the %s: %v", n.description, err)
+			}
+			d.pending.Done()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// fakeNotifier records the completed scans it was notified about, blocking
+// each notification until release is closed.
+type fakeNotifier struct {
+	release chan struct{}
+
+	mu          sync.Mutex
+	scanIDs     []string
+	inFlight    int
+	maxInFlight int
+}
+
+func (n *fakeNotifier) ScanCompleted(_ context.Context, scan *models.Scan) error {
+	n.mu.Lock()
+	n.inFlight++
+	if n.inFlight > n.maxInFlight {
+		n.maxInFlight = n.inFlight
+	}
+	n.mu.Unlock()
+
+	<-n.release
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.inFlight--
+	n.scanIDs = append(n.scanIDs, *scan.Id)
+	return nil
+}
+
+func (n *fakeNotifier) TargetScanCompleted(context.Context, *models.TargetScanResult) error {
+	return nil
+}
+
+func TestDispatcher_Concurrency(t *testing.T) {
+	notifier := &fakeNotifier{release: make(chan struct{})}
+	d := NewDispatcher(webhook.DispatchConfig{
+		Concurrency: 2,
+		QueueSize:   10,
+		DropPolicy:  webhook.DropPolicyNewest,
+	}, notifier)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d.Start(ctx)
+
+	for i := 0; i < 10; i++ {
+		if err := d.ScanCompleted(ctx, &models.Scan{Id: utils.PointerTo("scan")}); err != nil {
+			t.Fatalf("ScanCompleted() error = %v", err)
+		}
+	}
+	// Let the workers pick up as many notifications as they can.
+	time.Sleep(50 * time.Millisecond)
+	close(notifier.release)
+
+	drainCtx, drainCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer drainCancel()
+	if err := d.Drain(drainCtx); err != nil {
+		t.Fatalf("Drain() error = %v", err)
+	}
+
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+	if len(notifier.scanIDs) != 10 {
+		t.Errorf("Dispatcher delivered %v notifications, want 10", len(notifier.scanIDs))
+	}
+	if notifier.maxInFlight != 2 {
+		t.Errorf("Dispatcher delivered %v notifications at once, want 2", notifier.maxInFlight)
+	}
+}
+
+func TestDispatcher_DropPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		dropPolicy webhook.DropPolicy
+		want       []string
+	}{
+		{
+			name:       "drop newest",
+			dropPolicy: webhook.DropPolicyNewest,
+			want:       []string{"scan-1", "scan-2"},
+		},
+		{
+			name:       "drop oldest",
+			dropPolicy: webhook.DropPolicyOldest,
+			want:       []string{"scan-3", "scan-4"},
+		},
+		{
+			name: "default drops oldest",
+			want: []string{"scan-3", "scan-4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifier := &fakeNotifier{release: make(chan struct{})}
+			close(notifier.release)
+			d := NewDispatcher(webhook.DispatchConfig{
+				Concurrency: 1,
+				QueueSize:   2,
+				DropPolicy:  tt.dropPolicy,
+			}, notifier)
+
+			// Fill the queue before the dispatcher is started.
+			for _, scanID := range []string{"scan-1", "scan-2", "scan-3", "scan-4"} {
+				if err := d.ScanCompleted(context.Background(), &models.Scan{Id: utils.PointerTo(scanID)}); err != nil {
+					t.Fatalf("ScanCompleted() error = %v", err)
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			d.Start(ctx)
+			if err := d.Drain(ctx); err != nil {
+				t.Fatalf("Drain() error = %v", err)
+			}
+
+			notifier.mu.Lock()
+			defer notifier.mu.Unlock()
+			if diff := cmp.Diff(tt.want, notifier.scanIDs); diff != "" {
+				t.Errorf("Dispatcher delivered notifications mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDispatcher_DrainCanceled(t *testing.T) {
+	d := NewDispatcher(webhook.DispatchConfig{Concurrency: 1, QueueSize: 1}, &fakeNotifier{})
+	if err := d.ScanCompleted(context.Background(), &models.Scan{Id: utils.PointerTo("scan-1")}); err != nil {
+		t.Fatalf("ScanCompleted() error = %v", err)
+	}
+
+	// The dispatcher isn't started, so the notification is never delivered.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := d.Drain(ctx); err == nil {
+		t.Errorf("Drain() expected an error for the undelivered notification")
+	}
+}
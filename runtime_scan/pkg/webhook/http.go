@@ -0,0 +1,149 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+)
+
+const (
+	// EventHeader holds the EventType of the request.
+	EventHeader = "X-VMClarity-Event"
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the request
+	// body keyed with the shared secret, prefixed with "sha256=".
+	SignatureHeader = "X-VMClarity-Signature"
+)
+
+// HTTPNotifier posts the scan events as JSON to the configured URL.
+type HTTPNotifier struct {
+	url           string
+	secret        []byte
+	maxAttempts   int
+	retryInterval time.Duration
+	client        *http.Client
+}
+
+func NewHTTPNotifier(config *webhook.Config) *HTTPNotifier {
+	return &HTTPNotifier{
+		url:           config.URL,
+		secret:        []byte(config.Secret),
+		maxAttempts:   config.MaxAttempts,
+		retryInterval: config.RetryInterval,
+		client: &http.Client{
+			Timeout: config.Timeout,
+		},
+	}
+}
+
+func (n *HTTPNotifier) ScanCompleted(ctx context.Context, scan *models.Scan) error {
+	return n.send(ctx, NewScanEvent(ScanCompletedEvent, scan))
+}
+
+// TargetScanCompleted is a no-op, the target scan events are posted to the
+// target webhook by the TargetNotifier.
+func (n *HTTPNotifier) TargetScanCompleted(context.Context, *models.TargetScanResult) error {
+	return nil
+}
+
+// send posts the event, retrying with an exponential backoff on failures up
+// to the max attempts.
+func (n *HTTPNotifier) send(ctx context.Context, event ScanEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %v", err)
+	}
+
+	return n.postWithRetry(ctx, event.Event, event.ScanID, body)
+}
+
+// postWithRetry posts the body of the scan event, retrying with an
+// exponential backoff on failures up to the max attempts.
+func (n *HTTPNotifier) postWithRetry(ctx context.Context, event EventType, scanID string, body []byte) error {
+	var retryBackOff backoff.BackOff = &backoff.StopBackOff{}
+	if n.maxAttempts > 1 {
+		expBackOff := backoff.NewExponentialBackOff()
+		expBackOff.InitialInterval = n.retryInterval
+		// The retries are bounded by the max attempts.
+		expBackOff.MaxElapsedTime = 0
+		retryBackOff = backoff.WithMaxRetries(expBackOff, uint64(n.maxAttempts-1))
+	}
+	retryBackOff = backoff.WithContext(retryBackOff, ctx)
+
+	post := func() error {
+		return n.post(ctx, event, body)
+	}
+	notify := func(err error, retryIn time.Duration) {
+		log.Warnf("Failed to send %s webhook of scan %s, retrying in %s: %v", event, scanID, retryIn, err)
+	}
+	if err := backoff.RetryNotify(post, retryBackOff, notify); err != nil {
+		return fmt.Errorf("failed to send %s webhook: %w", event, err)
+	}
+
+	return nil
+}
+
+func (n *HTTPNotifier) post(ctx context.Context, event EventType, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(fmt.Errorf("failed to create request: %v", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(event))
+	if len(n.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(n.secret, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to %s: %v", n.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		err := fmt.Errorf("failed to post to %s: unexpected status code %v", n.url, resp.StatusCode)
+		// Client errors won't be fixed by retrying the same request,
+		// except for rate limiting.
+		if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError &&
+			resp.StatusCode != http.StatusTooManyRequests {
+			return backoff.Permanent(err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Sign returns the value of the SignatureHeader for the body, receivers
+// verify it by computing the same HMAC-SHA256 with the shared secret.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
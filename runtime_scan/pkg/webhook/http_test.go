@@ -0,0 +1,154 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func TestHTTPNotifier_ScanCompleted(t *testing.T) {
+	scan := &models.Scan{
+		Id:    utils.PointerTo("scan-1"),
+		State: utils.PointerTo(models.ScanStateDone),
+	}
+
+	tests := []struct {
+		name         string
+		secret       string
+		maxAttempts  int
+		statusCodes  []int
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			name:         "sent on first attempt",
+			maxAttempts:  3,
+			statusCodes:  []int{http.StatusOK},
+			wantAttempts: 1,
+		},
+		{
+			name:         "signed with secret",
+			secret:       "secret",
+			maxAttempts:  3,
+			statusCodes:  []int{http.StatusNoContent},
+			wantAttempts: 1,
+		},
+		{
+			name:         "retried on server error",
+			maxAttempts:  3,
+			statusCodes:  []int{http.StatusInternalServerError, http.StatusOK},
+			wantAttempts: 2,
+		},
+		{
+			name:         "retried on too many requests",
+			maxAttempts:  3,
+			statusCodes:  []int{http.StatusTooManyRequests, http.StatusOK},
+			wantAttempts: 2,
+		},
+		{
+			name:         "not retried on client error",
+			maxAttempts:  3,
+			statusCodes:  []int{http.StatusBadRequest},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "not retried with single attempt",
+			maxAttempts:  1,
+			statusCodes:  []int{http.StatusInternalServerError},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "fails after max attempts",
+			maxAttempts:  3,
+			statusCodes:  []int{http.StatusBadGateway},
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := atomic.AddInt32(&attempts, 1)
+
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read body: %v", err)
+				}
+				if got := r.Header.Get(EventHeader); got != string(ScanCompletedEvent) {
+					t.Errorf("%s = %v, want %v", EventHeader, got, ScanCompletedEvent)
+				}
+				wantSignature := ""
+				if tt.secret != "" {
+					wantSignature = Sign([]byte(tt.secret), body)
+				}
+				if got := r.Header.Get(SignatureHeader); got != wantSignature {
+					t.Errorf("%s = %v, want %v", SignatureHeader, got, wantSignature)
+				}
+				var event ScanEvent
+				if err := json.Unmarshal(body, &event); err != nil {
+					t.Errorf("failed to unmarshal event: %v", err)
+				}
+				if event.ScanID != *scan.Id || event.Event != ScanCompletedEvent {
+					t.Errorf("unexpected event: %+v", event)
+				}
+
+				// Keep replying with the last status code.
+				if int(attempt) > len(tt.statusCodes) {
+					attempt = int32(len(tt.statusCodes))
+				}
+				w.WriteHeader(tt.statusCodes[attempt-1])
+			}))
+			defer server.Close()
+
+			n := NewHTTPNotifier(&webhook.Config{
+				URL:           server.URL,
+				Secret:        tt.secret,
+				Timeout:       time.Second,
+				MaxAttempts:   tt.maxAttempts,
+				RetryInterval: time.Millisecond,
+			})
+			err := n.ScanCompleted(context.Background(), scan)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScanCompleted() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := atomic.LoadInt32(&attempts); got != tt.wantAttempts {
+				t.Errorf("ScanCompleted() attempts = %v, want %v", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	// Computed with: echo -n '{"event":"scan.completed"}' | openssl dgst -sha256 -hmac secret
+	want := "sha256=9533707dc3e47c4c7df5d1ef9d5065cfa5afdbc7c769be5dd1b4c77f50a81377"
+	if got := Sign([]byte("secret"), []byte(`{"event":"scan.completed"}`)); got != want {
+		t.Errorf("Sign() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+)
+
+// EventType identifies the kind of event in the payload and the
+// X-VMClarity-Event header of a webhook request.
+type EventType string
+
+const (
+	// ScanCompletedEvent is sent once a scan reached its final state
+	// (Done or Failed, including aborted scans).
+	ScanCompletedEvent EventType = "scan.completed"
+	// ScanAlertEvent is sent to the alert webhook once a completed scan
+	// has critical findings.
+	ScanAlertEvent EventType = "scan.alert"
+	// TargetScanCompletedEvent is sent to the target webhook once the scan
+	// of a target reached its final state (Done or NotScanned).
+	TargetScanCompletedEvent EventType = "scan.target.completed"
+)
+
+// ScanEvent is the JSON payload posted to the webhook.
+type ScanEvent struct {
+	Event        EventType              `json:"event"`
+	ScanID       string                 `json:"scanID"`
+	ScanConfigID string                 `json:"scanConfigID,omitempty"`
+	State        models.ScanState       `json:"state"`
+	StateReason  models.ScanStateReason `json:"stateReason,omitempty"`
+	StateMessage string                 `json:"stateMessage,omitempty"`
+	StartTime    *time.Time             `json:"startTime,omitempty"`
+	EndTime      *time.Time             `json:"endTime,omitempty"`
+	Summary      *models.ScanSummary    `json:"summary,omitempty"`
+}
+
+// TargetScanEvent is the JSON payload posted to the target webhook.
+type TargetScanEvent struct {
+	Event        EventType                   `json:"event"`
+	ScanID       string                      `json:"scanID"`
+	ScanResultID string                      `json:"scanResultID"`
+	TargetID     string                      `json:"targetID"`
+	State        models.TargetScanStateState `json:"state"`
+	Errors       []string                    `json:"errors,omitempty"`
+	Summary      *models.ScanFindingsSummary `json:"summary,omitempty"`
+}
+
+// Notifier notifies external systems about scan events.
+type Notifier interface {
+	// ScanCompleted is called once a scan reached its final state.
+	ScanCompleted(ctx context.Context, scan *models.Scan) error
+	// TargetScanCompleted is called once the scan of a target reached its
+	// final state.
+	TargetScanCompleted(ctx context.Context, scanResult *models.TargetScanResult) error
+}
+
+// New returns a dispatcher of the notifications to the configured notifiers,
+// or nil if no webhook is configured.
+func New(config *webhook.Config) *Dispatcher {
+	if notifier := newNotifier(config); notifier != nil {
+		return NewDispatcher(config.Dispatch, notifier)
+	}
+	return nil
+}
+
+// newNotifier returns the configured notifiers, or nil if no webhook is
+// configured.
+func newNotifier(config *webhook.Config) Notifier {
+	if config == nil {
+		return nil
+	}
+
+	var notifiers Notifiers
+	if config.URL != "" {
+		notifiers = append(notifiers, NewHTTPNotifier(config))
+	}
+	if config.Alert.URL != "" {
+		notifiers = append(notifiers, NewAlertNotifier(config))
+	}
+	if config.TargetURL != "" {
+		notifiers = append(notifiers, NewTargetNotifier(config))
+	}
+
+	switch len(notifiers) {
+	case 0:
+		return nil
+	case 1:
+		return notifiers[0]
+	default:
+		return notifiers
+	}
+}
+
+// Notifiers notifies all the notifiers about the scan events.
+type Notifiers []Notifier
+
+func (n Notifiers) ScanCompleted(ctx context.Context, scan *models.Scan) error {
+	return n.notifyAll(func(notifier Notifier) error {
+		return notifier.ScanCompleted(ctx, scan)
+	})
+}
+
+func (n Notifiers) TargetScanCompleted(ctx context.Context, scanResult *models.TargetScanResult) error {
+	return n.notifyAll(func(notifier Notifier) error {
+		return notifier.TargetScanCompleted(ctx, scanResult)
+	})
+}
+
+func (n Notifiers) notifyAll(notify func(notifier Notifier) error) error {
+	var errs []string
+	for _, notifier := range n {
+		if err := notify(notifier); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to notify %d of %d notifiers: %s", len(errs), len(n), strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// NewScanEvent creates the payload of a scan event from the scan.
+func NewScanEvent(event EventType, scan *models.Scan) ScanEvent {
+	ret := ScanEvent{
+		Event:     event,
+		StartTime: scan.StartTime,
+		EndTime:   scan.EndTime,
+		Summary:   scan.Summary,
+	}
+	if scan.Id != nil {
+		ret.ScanID = *scan.Id
+	}
+	if scan.ScanConfig != nil {
+		ret.ScanConfigID = scan.ScanConfig.Id
+	}
+	if scan.State != nil {
+		ret.State = *scan.State
+	}
+	if scan.StateReason != nil {
+		ret.StateReason = *scan.StateReason
+	}
+	if scan.StateMessage != nil {
+		ret.StateMessage = *scan.StateMessage
+	}
+
+	return ret
+}
+
+// NewTargetScanEvent creates the payload of a target scan event from the scan
+// result of the target.
+func NewTargetScanEvent(event EventType, scanResult *models.TargetScanResult) TargetScanEvent {
+	ret := TargetScanEvent{
+		Event:   event,
+		Summary: scanResult.Summary,
+	}
+	if scanResult.Id != nil {
+		ret.ScanResultID = *scanResult.Id
+	}
+	if scanResult.Scan != nil {
+		ret.ScanID = scanResult.Scan.Id
+	}
+	if scanResult.Target != nil {
+		ret.TargetID = scanResult.Target.Id
+	}
+	if scanResult.Status != nil && scanResult.Status.General != nil {
+		if state, ok := scanResult.Status.General.GetState(); ok {
+			ret.State = state
+		}
+		if scanResult.Status.General.Errors != nil {
+			ret.Errors = *scanResult.Status.General.Errors
+		}
+	}
+
+	return ret
+}
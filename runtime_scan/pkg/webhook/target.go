@@ -0,0 +1,59 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+)
+
+// TargetNotifier posts the target scan events as JSON to the target webhook,
+// signed with the secret of the scan events webhook.
+type TargetNotifier struct {
+	client *HTTPNotifier
+}
+
+func NewTargetNotifier(config *webhook.Config) *TargetNotifier {
+	return &TargetNotifier{
+		client: NewHTTPNotifier(&webhook.Config{
+			URL:           config.TargetURL,
+			Secret:        config.Secret,
+			Timeout:       config.Timeout,
+			MaxAttempts:   config.MaxAttempts,
+			RetryInterval: config.RetryInterval,
+		}),
+	}
+}
+
+// ScanCompleted is a no-op, the scan events are posted to the scan webhook by
+// the HTTPNotifier.
+func (n *TargetNotifier) ScanCompleted(context.Context, *models.Scan) error {
+	return nil
+}
+
+func (n *TargetNotifier) TargetScanCompleted(ctx context.Context, scanResult *models.TargetScanResult) error {
+	event := NewTargetScanEvent(TargetScanCompletedEvent, scanResult)
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %v", err)
+	}
+
+	return n.client.postWithRetry(ctx, event.Event, event.ScanID, body)
+}
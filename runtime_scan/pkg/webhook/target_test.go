@@ -0,0 +1,163 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/config/webhook"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func TestNewTargetScanEvent(t *testing.T) {
+	summary := &models.ScanFindingsSummary{
+		TotalMalware: utils.PointerTo(2),
+	}
+
+	tests := []struct {
+		name       string
+		scanResult *models.TargetScanResult
+		want       TargetScanEvent
+	}{
+		{
+			name: "done",
+			scanResult: &models.TargetScanResult{
+				Id:     utils.PointerTo("result-1"),
+				Scan:   &models.ScanRelationship{Id: "scan-1"},
+				Target: &models.TargetRelationship{Id: "target-1"},
+				Status: &models.TargetScanStatus{
+					General: &models.TargetScanState{
+						State: utils.PointerTo(models.DONE),
+					},
+				},
+				Summary: summary,
+			},
+			want: TargetScanEvent{
+				Event:        TargetScanCompletedEvent,
+				ScanID:       "scan-1",
+				ScanResultID: "result-1",
+				TargetID:     "target-1",
+				State:        models.DONE,
+				Summary:      summary,
+			},
+		},
+		{
+			name: "done with errors",
+			scanResult: &models.TargetScanResult{
+				Id:     utils.PointerTo("result-1"),
+				Scan:   &models.ScanRelationship{Id: "scan-1"},
+				Target: &models.TargetRelationship{Id: "target-1"},
+				Status: &models.TargetScanStatus{
+					General: &models.TargetScanState{
+						State:  utils.PointerTo(models.DONE),
+						Errors: &[]string{"failed to mount volume"},
+					},
+				},
+			},
+			want: TargetScanEvent{
+				Event:        TargetScanCompletedEvent,
+				ScanID:       "scan-1",
+				ScanResultID: "result-1",
+				TargetID:     "target-1",
+				State:        models.DONE,
+				Errors:       []string{"failed to mount volume"},
+			},
+		},
+		{
+			name: "missing relationships and status",
+			scanResult: &models.TargetScanResult{
+				Id: utils.PointerTo("result-1"),
+			},
+			want: TargetScanEvent{
+				Event:        TargetScanCompletedEvent,
+				ScanResultID: "result-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTargetScanEvent(TargetScanCompletedEvent, tt.scanResult)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("NewTargetScanEvent() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTargetNotifier_TargetScanCompleted(t *testing.T) {
+	scanResult := &models.TargetScanResult{
+		Id:     utils.PointerTo("result-1"),
+		Scan:   &models.ScanRelationship{Id: "scan-1"},
+		Target: &models.TargetRelationship{Id: "target-1"},
+		Status: &models.TargetScanStatus{
+			General: &models.TargetScanState{
+				State: utils.PointerTo(models.NOTSCANNED),
+			},
+		},
+	}
+
+	var sent TargetScanEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read body: %v", err)
+		}
+		if got := r.Header.Get(EventHeader); got != string(TargetScanCompletedEvent) {
+			t.Errorf("%s = %v, want %v", EventHeader, got, TargetScanCompletedEvent)
+		}
+		if got, want := r.Header.Get(SignatureHeader), Sign([]byte("secret"), body); got != want {
+			t.Errorf("%s = %v, want %v", SignatureHeader, got, want)
+		}
+		if err := json.Unmarshal(body, &sent); err != nil {
+			t.Errorf("failed to unmarshal event: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	n := NewTargetNotifier(&webhook.Config{
+		URL:         "http://localhost",
+		TargetURL:   server.URL,
+		Secret:      "secret",
+		Timeout:     time.Second,
+		MaxAttempts: 1,
+	})
+	if err := n.ScanCompleted(context.Background(), &models.Scan{Id: utils.PointerTo("scan-1")}); err != nil {
+		t.Errorf("ScanCompleted() error = %v", err)
+	}
+	if err := n.TargetScanCompleted(context.Background(), scanResult); err != nil {
+		t.Fatalf("TargetScanCompleted() error = %v", err)
+	}
+
+	want := TargetScanEvent{
+		Event:        TargetScanCompletedEvent,
+		ScanID:       "scan-1",
+		ScanResultID: "result-1",
+		TargetID:     "target-1",
+		State:        models.NOTSCANNED,
+	}
+	if diff := cmp.Diff(want, sent); diff != "" {
+		t.Errorf("TargetScanCompleted() sent mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -18,9 +18,12 @@ package backendclient
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
+	log "github.com/sirupsen/logrus"
+
 	"github.com/openclarity/vmclarity/api/client"
 	"github.com/openclarity/vmclarity/api/models"
 	runtimeScanUtils "github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
@@ -31,8 +34,12 @@ type BackendClient struct {
 	apiClient client.ClientWithResponsesInterface
 }
 
-func Create(serverAddress string) (*BackendClient, error) {
-	apiClient, err := client.NewClientWithResponses(serverAddress)
+func Create(serverAddress string, config Config) (*BackendClient, error) {
+	doer, err := newRetryingDoer(config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create VMClarity API client. serverAddress=%v: %w", serverAddress, err)
+	}
+	apiClient, err := client.NewClientWithResponses(serverAddress, client.WithHTTPClient(doer))
 	if err != nil {
 		return nil, fmt.Errorf("unable to create VMClarity API client. serverAddress=%v: %w", serverAddress, err)
 	}
@@ -75,7 +82,40 @@ func (b *BackendClient) GetScanResult(ctx context.Context, scanResultID string,
 	}
 }
 
+// GetScanResults returns the scan results according to the given params. If
+// $top isn't set, the pages of the scan results which the backend truncates
+// are fetched until the last one, so that all the scan results are returned.
 func (b *BackendClient) GetScanResults(ctx context.Context, params models.GetScanResultsParams) (models.TargetScanResults, error) {
+	scanResults, err := b.getScanResultsPage(ctx, params)
+	if err != nil || params.Top != nil {
+		return scanResults, err
+	}
+
+	for scanResults.Truncated != nil && *scanResults.Truncated && scanResults.NextCursor != nil {
+		params.Skip = scanResults.NextCursor
+		// The count of the first page is kept.
+		params.Count = nil
+		page, err := b.getScanResultsPage(ctx, params)
+		if err != nil {
+			return models.TargetScanResults{}, err
+		}
+
+		if page.Items != nil {
+			var items []models.TargetScanResult
+			if scanResults.Items != nil {
+				items = *scanResults.Items
+			}
+			items = append(items, *page.Items...)
+			scanResults.Items = &items
+		}
+		scanResults.Truncated = page.Truncated
+		scanResults.NextCursor = page.NextCursor
+	}
+
+	return scanResults, nil
+}
+
+func (b *BackendClient) getScanResultsPage(ctx context.Context, params models.GetScanResultsParams) (models.TargetScanResults, error) {
 	newGetScanResultsError := func(err error) error {
 		return fmt.Errorf("failed to get scan results: %w", err)
 	}
@@ -138,7 +178,7 @@ func (b *BackendClient) PatchScanResult(ctx context.Context, scanResult models.T
 }
 
 func (b *BackendClient) PostScan(ctx context.Context, scan models.Scan) (*models.Scan, error) {
-	resp, err := b.apiClient.PostScansWithResponse(ctx, scan)
+	resp, err := b.apiClient.PostScansWithResponse(ctx, &models.PostScansParams{}, scan)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a scan: %v", err)
 	}
@@ -207,6 +247,22 @@ func (b *BackendClient) PostScanResult(ctx context.Context, scanResult models.Ta
 	}
 }
 
+// UpsertScanResult creates the scan result and returns its ID. If a scan
+// result of the same scan and target already exists, the ID of the existing
+// scan result is returned instead, it isn't updated.
+func (b *BackendClient) UpsertScanResult(ctx context.Context, scanResult models.TargetScanResult) (string, error) {
+	createdScanResult, err := b.PostScanResult(ctx, scanResult)
+	if err != nil {
+		var conErr ScanResultConflictError
+		if errors.As(err, &conErr) {
+			log.Infof("Scan results already exist. scan result id=%v.", *conErr.ConflictingScanResult.Id)
+			return *conErr.ConflictingScanResult.Id, nil
+		}
+		return "", fmt.Errorf("failed to post scan result: %v", err)
+	}
+	return *createdScanResult.Id, nil
+}
+
 func (b *BackendClient) PatchScan(ctx context.Context, scanID models.ScanID, scan *models.Scan) error {
 	resp, err := b.apiClient.PatchScansScanIDWithResponse(ctx, scanID, *scan)
 	if err != nil {
@@ -228,9 +284,9 @@ func (b *BackendClient) PatchScan(ctx context.Context, scanID models.ScanID, sca
 			return fmt.Errorf("failed to update a scan: empty body on not found")
 		}
 		if resp.JSON404 != nil && resp.JSON404.Message != nil {
-			return fmt.Errorf("failed to update a scan, not found: %v", *resp.JSON404.Message)
+			return fmt.Errorf("failed to update a scan: %w: %v", ErrScanNotFound, *resp.JSON404.Message)
 		}
-		return fmt.Errorf("failed to update a scan, not found")
+		return fmt.Errorf("failed to update a scan: %w", ErrScanNotFound)
 	default:
 		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
 			return fmt.Errorf("failed to update scan. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
@@ -312,9 +368,9 @@ func (b *BackendClient) GetScan(ctx context.Context, scanID string, params model
 			return nil, fmt.Errorf("failed to get a scan: empty body on not found")
 		}
 		if resp.JSON404 != nil && resp.JSON404.Message != nil {
-			return nil, fmt.Errorf("failed to get a scan, not found: %v", *resp.JSON404.Message)
+			return nil, fmt.Errorf("failed to get a scan: %w: %v", ErrScanNotFound, *resp.JSON404.Message)
 		}
-		return nil, fmt.Errorf("failed to get a scan, not found")
+		return nil, fmt.Errorf("failed to get a scan: %w", ErrScanNotFound)
 	default:
 		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
 			return nil, fmt.Errorf("failed to get a scan status. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
@@ -501,6 +557,25 @@ func (b *BackendClient) GetTarget(ctx context.Context, targetID string, params m
 	}
 }
 
+func (b *BackendClient) GetIgnoreRules(ctx context.Context) (*models.IgnoreRules, error) {
+	resp, err := b.apiClient.GetIgnoreRulesWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ignore rules: %v", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("no ignore rules: empty body")
+		}
+		return resp.JSON200, nil
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get ignore rules. status code=%v: %s", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get ignore rules. status code=%v", resp.StatusCode())
+	}
+}
+
 func (b *BackendClient) PutDiscoveryScopes(ctx context.Context, scope *models.Scopes) (*models.Scopes, error) {
 	resp, err := b.apiClient.PutDiscoveryScopesWithResponse(ctx, *scope)
 	if err != nil {
@@ -0,0 +1,143 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestBackendClient_UpsertScanResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "created",
+			statusCode: http.StatusCreated,
+			body:       `{"id":"created-id"}`,
+			want:       "created-id",
+		},
+		{
+			name:       "already exists",
+			statusCode: http.StatusConflict,
+			body:       `{"message":"conflict","targetScanResult":{"id":"existing-id"}}`,
+			want:       "existing-id",
+		},
+		{
+			name:       "conflict without the existing scan result",
+			statusCode: http.StatusConflict,
+			body:       `{"message":"conflict"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"message":"failed"}`,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := Create(server.URL, Config{})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			got, err := client.UpsertScanResult(context.Background(), models.TargetScanResult{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpsertScanResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UpsertScanResult() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackendClient_GetScanResults(t *testing.T) {
+	pages := map[string]string{
+		"":  `{"count":3,"truncated":true,"nextCursor":2,"items":[{"id":"1"},{"id":"2"}]}`,
+		"2": `{"items":[{"id":"3"}]}`,
+	}
+	tests := []struct {
+		name   string
+		params models.GetScanResultsParams
+		want   []string
+	}{
+		{
+			name:   "truncated pages are fetched",
+			params: models.GetScanResultsParams{},
+			want:   []string{"1", "2", "3"},
+		},
+		{
+			name:   "top is set",
+			params: models.GetScanResultsParams{Top: utils.PointerTo(2)},
+			want:   []string{"1", "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, ok := pages[r.URL.Query().Get("$skip")]
+				if !ok {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			client, err := Create(server.URL, Config{})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			got, err := client.GetScanResults(context.Background(), tt.params)
+			if err != nil {
+				t.Fatalf("GetScanResults() error = %v", err)
+			}
+			var ids []string
+			for _, scanResult := range *got.Items {
+				ids = append(ids, *scanResult.Id)
+			}
+			if diff := cmp.Diff(tt.want, ids); diff != "" {
+				t.Errorf("GetScanResults() mismatch (-want +got):\n%s", diff)
+			}
+			if got.Count == nil || *got.Count != 3 {
+				t.Errorf("GetScanResults() count = %v, want 3", got.Count)
+			}
+		})
+	}
+}
@@ -16,11 +16,16 @@
 package backendclient
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/openclarity/vmclarity/api/models"
 )
 
+// ErrScanNotFound is returned when the scan doesn't exist, for example because
+// it was deleted while it was pending.
+var ErrScanNotFound = errors.New("scan not found")
+
 type TargetConflictError struct {
 	ConflictingTarget *models.Target
 	Message           string
@@ -0,0 +1,178 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// Config configures the requests of the backend client.
+type Config struct {
+	Timeout       time.Duration // timeout of a single request attempt, unlimited if not positive
+	MaxAttempts   int           // number of attempts of a request which fails with a transient error
+	RetryInterval time.Duration // initial interval between the attempts, increased exponentially
+	CABundlePath  string        // PEM CA bundle trusted on top of the system certificates, e.g. of a TLS intercepting proxy
+}
+
+// retryingDoer sends the requests of the API client, retrying the requests
+// which fail with a connection error or with a status code of a backend which
+// is briefly unavailable. A request of a method which isn't idempotent, e.g.
+// creating a scan, is retried on a connection error only if it wasn't sent,
+// since the backend may have handled it.
+type retryingDoer struct {
+	client        *http.Client
+	maxAttempts   int
+	retryInterval time.Duration
+}
+
+func newRetryingDoer(config Config) (*retryingDoer, error) {
+	client, err := utils.NewHTTPClientWithCABundle(config.CABundlePath, config.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+	}
+
+	return &retryingDoer{
+		client:        client,
+		maxAttempts:   config.MaxAttempts,
+		retryInterval: config.RetryInterval,
+	}, nil
+}
+
+// isTransientStatusCode returns whether a request which failed with the status
+// code may succeed when it's retried.
+func isTransientStatusCode(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isIdempotentMethod returns whether sending a request of the method more than
+// once has the same effect as sending it once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *retryingDoer) Do(req *http.Request) (*http.Response, error) {
+	var retryBackOff backoff.BackOff = &backoff.StopBackOff{}
+	if d.maxAttempts > 1 {
+		expBackOff := backoff.NewExponentialBackOff()
+		expBackOff.InitialInterval = d.retryInterval
+		// The retries are bounded by the max attempts.
+		expBackOff.MaxElapsedTime = 0
+		retryBackOff = backoff.WithMaxRetries(expBackOff, uint64(d.maxAttempts-1))
+	}
+	retryBackOff = backoff.WithContext(retryBackOff, req.Context())
+
+	var resp *http.Response
+	attempts := 0
+	send := func() error {
+		attemptReq, err := d.newAttemptRequest(req, attempts)
+		attempts++
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		// The request may have reached the backend once its headers
+		// were written.
+		var wroteHeaders atomic.Bool
+		attemptReq = attemptReq.WithContext(httptrace.WithClientTrace(attemptReq.Context(), &httptrace.ClientTrace{
+			WroteHeaders: func() {
+				wroteHeaders.Store(true)
+			},
+		}))
+
+		resp, err = d.client.Do(attemptReq)
+		if err != nil {
+			if req.Context().Err() != nil {
+				return backoff.Permanent(err)
+			}
+			if wroteHeaders.Load() && !isIdempotentMethod(req.Method) {
+				return backoff.Permanent(fmt.Errorf("failed to send %s request which may have reached the backend: %w", req.Method, err))
+			}
+			return err
+		}
+
+		if !isTransientStatusCode(resp.StatusCode) {
+			return nil
+		}
+
+		// The response of the last attempt is returned to the caller,
+		// so its body is read before the connection is reused.
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %v", err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		return fmt.Errorf("status code=%v", resp.StatusCode)
+	}
+	notify := func(err error, retryIn time.Duration) {
+		// The backend is expected to be unavailable at times, the
+		// caller logs the error if all the attempts fail.
+		log.Debugf("Failed to send %s request to %s, retrying in %s: %v", req.Method, req.URL.Path, retryIn, err)
+	}
+
+	if err := backoff.RetryNotify(send, retryBackOff, notify); err != nil {
+		if resp != nil {
+			// Let the caller handle the status code of the last
+			// attempt like any other response.
+			return resp, nil
+		}
+		return nil, err // nolint:wrapcheck
+	}
+
+	return resp, nil
+}
+
+// newAttemptRequest returns the request to send on the given attempt, the body
+// of the request is sent again on the retries.
+func (d *retryingDoer) newAttemptRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("failed to retry %s request to %s: request body can't be sent again", req.Method, req.URL.Path)
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request body: %v", err)
+	}
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = body
+
+	return attemptReq, nil
+}
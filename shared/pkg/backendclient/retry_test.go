@@ -0,0 +1,222 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendclient
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_retryingDoer_Do(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxAttempts    int
+		statusCodes    []int // status codes of the attempts, the last one is repeated
+		wantStatusCode int
+		wantAttempts   int
+	}{
+		{
+			name:           "succeeds on first attempt",
+			maxAttempts:    3,
+			statusCodes:    []int{http.StatusOK},
+			wantStatusCode: http.StatusOK,
+			wantAttempts:   1,
+		},
+		{
+			name:           "succeeds after transient errors",
+			maxAttempts:    3,
+			statusCodes:    []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantStatusCode: http.StatusOK,
+			wantAttempts:   3,
+		},
+		{
+			name:           "returns last response when out of attempts",
+			maxAttempts:    3,
+			statusCodes:    []int{http.StatusServiceUnavailable},
+			wantStatusCode: http.StatusServiceUnavailable,
+			wantAttempts:   3,
+		},
+		{
+			name:           "server error is not retried",
+			maxAttempts:    3,
+			statusCodes:    []int{http.StatusInternalServerError},
+			wantStatusCode: http.StatusInternalServerError,
+			wantAttempts:   1,
+		},
+		{
+			name:           "client error is not retried",
+			maxAttempts:    3,
+			statusCodes:    []int{http.StatusConflict},
+			wantStatusCode: http.StatusConflict,
+			wantAttempts:   1,
+		},
+		{
+			name:           "retries disabled",
+			maxAttempts:    0,
+			statusCodes:    []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatusCode: http.StatusServiceUnavailable,
+			wantAttempts:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var bodies []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				mu.Lock()
+				attempt := len(bodies)
+				bodies = append(bodies, string(body))
+				mu.Unlock()
+
+				statusCode := tt.statusCodes[len(tt.statusCodes)-1]
+				if attempt < len(tt.statusCodes) {
+					statusCode = tt.statusCodes[attempt]
+				}
+				w.WriteHeader(statusCode)
+				_, _ = w.Write([]byte("response"))
+			}))
+			defer server.Close()
+
+			doer, err := newRetryingDoer(Config{
+				Timeout:       time.Second,
+				MaxAttempts:   tt.maxAttempts,
+				RetryInterval: time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("failed to create retrying doer: %v", err)
+			}
+			req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte("request")))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			resp, err := doer.Do(req)
+			if err != nil {
+				t.Fatalf("Do() unexpected error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("Do() status code = %v, want %v", resp.StatusCode, tt.wantStatusCode)
+			}
+			if body, _ := io.ReadAll(resp.Body); string(body) != "response" {
+				t.Errorf("Do() body = %q, want %q", body, "response")
+			}
+
+			wantBodies := make([]string, tt.wantAttempts)
+			for i := range wantBodies {
+				wantBodies[i] = "request"
+			}
+			if diff := cmp.Diff(wantBodies, bodies); diff != "" {
+				t.Errorf("Do() requests mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_retryingDoer_Do_connectionError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serverURL := server.URL
+	server.Close()
+
+	doer, err := newRetryingDoer(Config{
+		Timeout:       time.Second,
+		MaxAttempts:   2,
+		RetryInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("failed to create retrying doer: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodGet, serverURL, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	if _, err := doer.Do(req); err == nil {
+		t.Errorf("Do() expected an error when the server is unreachable")
+	}
+}
+
+func Test_retryingDoer_Do_connectionClosedAfterSend(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		wantAttempts int
+	}{
+		{
+			name:         "idempotent request is retried",
+			method:       http.MethodGet,
+			wantAttempts: 3,
+		},
+		{
+			name:         "non-idempotent request is not retried",
+			method:       http.MethodPost,
+			wantAttempts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var attempts int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.ReadAll(r.Body)
+				mu.Lock()
+				attempts++
+				mu.Unlock()
+
+				// Drop the connection after the request reached the server.
+				conn, _, err := w.(http.Hijacker).Hijack()
+				if err != nil {
+					t.Errorf("failed to hijack connection: %v", err)
+					return
+				}
+				_ = conn.Close()
+			}))
+			defer server.Close()
+
+			doer, err := newRetryingDoer(Config{
+				Timeout:       time.Second,
+				MaxAttempts:   3,
+				RetryInterval: time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("failed to create retrying doer: %v", err)
+			}
+			req, err := http.NewRequest(tt.method, server.URL, bytes.NewReader([]byte("request")))
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			if _, err := doer.Do(req); err == nil {
+				t.Errorf("Do() expected an error when the connection is closed")
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if attempts != tt.wantAttempts {
+				t.Errorf("Do() attempts = %v, want %v", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
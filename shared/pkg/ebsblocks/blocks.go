@@ -0,0 +1,81 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ebsblocks lists the blocks of the snapshots through the EBS direct
+// APIs, for the scanner which reads the blocks and the orchestrator which
+// compares their checksums.
+package ebsblocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
+	ebstypes "github.com/aws/aws-sdk-go-v2/service/ebs/types"
+
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const listSnapshotBlocksMaxResults = 10000
+
+// Client lists the blocks of the snapshots, it is implemented by *ebs.Client.
+type Client interface {
+	ListSnapshotBlocks(ctx context.Context, params *ebs.ListSnapshotBlocksInput, optFns ...func(*ebs.Options)) (*ebs.ListSnapshotBlocksOutput, error)
+}
+
+// SnapshotBlocks are the blocks of a snapshot which hold data.
+type SnapshotBlocks struct {
+	Blocks []ebstypes.Block
+	// The size of a block in bytes, and the size of the snapshotted volume
+	// in GiB, they are zero if not returned by the listing.
+	BlockSize  int64
+	VolumeSize int64
+}
+
+// ListSnapshotBlocks lists the blocks of the snapshot which hold data, going
+// through all the pages of the listing. The options are applied to every
+// request.
+func ListSnapshotBlocks(ctx context.Context, client Client, snapshotID string, optFns ...func(*ebs.Options)) (SnapshotBlocks, error) {
+	var snapshotBlocks SnapshotBlocks
+	var nextToken *string
+	for {
+		out, err := client.ListSnapshotBlocks(ctx, &ebs.ListSnapshotBlocksInput{
+			SnapshotId: &snapshotID,
+			MaxResults: utils.PointerTo[int32](listSnapshotBlocksMaxResults),
+			NextToken:  nextToken,
+		}, optFns...)
+		if err != nil {
+			return SnapshotBlocks{}, fmt.Errorf("failed to list the blocks of snapshot %s: %w", snapshotID, err)
+		}
+		for _, block := range out.Blocks {
+			if block.BlockIndex == nil {
+				return SnapshotBlocks{}, fmt.Errorf("index of a block of snapshot %s is not set", snapshotID)
+			}
+			snapshotBlocks.Blocks = append(snapshotBlocks.Blocks, block)
+		}
+		if out.BlockSize != nil {
+			snapshotBlocks.BlockSize = int64(*out.BlockSize)
+		}
+		if out.VolumeSize != nil {
+			snapshotBlocks.VolumeSize = *out.VolumeSize
+		}
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	return snapshotBlocks, nil
+}
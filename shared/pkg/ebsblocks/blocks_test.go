@@ -0,0 +1,151 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ebsblocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
+	ebstypes "github.com/aws/aws-sdk-go-v2/service/ebs/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// fakeClient lists a page of blocks per request, the next token is the index
+// of the next page.
+type fakeClient struct {
+	pages   []*ebs.ListSnapshotBlocksOutput
+	err     error
+	regions []string
+}
+
+func (c *fakeClient) ListSnapshotBlocks(_ context.Context, params *ebs.ListSnapshotBlocksInput, optFns ...func(*ebs.Options)) (*ebs.ListSnapshotBlocksOutput, error) {
+	var options ebs.Options
+	for _, optFn := range optFns {
+		optFn(&options)
+	}
+	c.regions = append(c.regions, options.Region)
+
+	if c.err != nil {
+		return nil, c.err
+	}
+	var page int
+	if params.NextToken != nil {
+		if _, err := fmt.Sscan(*params.NextToken, &page); err != nil {
+			return nil, err
+		}
+	}
+	out := *c.pages[page]
+	if page+1 < len(c.pages) {
+		out.NextToken = utils.PointerTo(fmt.Sprint(page + 1))
+	}
+	return &out, nil
+}
+
+func block(index int32) ebstypes.Block {
+	return ebstypes.Block{
+		BlockIndex: utils.PointerTo(index),
+		BlockToken: utils.PointerTo(fmt.Sprintf("token-%d", index)),
+	}
+}
+
+func TestListSnapshotBlocks(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *fakeClient
+		want    SnapshotBlocks
+		wantErr bool
+	}{
+		{
+			name: "blocks of all the pages",
+			client: &fakeClient{
+				pages: []*ebs.ListSnapshotBlocksOutput{
+					{
+						Blocks:     []ebstypes.Block{block(0), block(2)},
+						BlockSize:  utils.PointerTo[int32](524288),
+						VolumeSize: utils.PointerTo[int64](8),
+					},
+					{
+						Blocks:     []ebstypes.Block{block(7)},
+						BlockSize:  utils.PointerTo[int32](524288),
+						VolumeSize: utils.PointerTo[int64](8),
+					},
+				},
+			},
+			want: SnapshotBlocks{
+				Blocks:     []ebstypes.Block{block(0), block(2), block(7)},
+				BlockSize:  524288,
+				VolumeSize: 8,
+			},
+		},
+		{
+			name: "empty snapshot",
+			client: &fakeClient{
+				pages: []*ebs.ListSnapshotBlocksOutput{
+					{
+						BlockSize:  utils.PointerTo[int32](524288),
+						VolumeSize: utils.PointerTo[int64](8),
+					},
+				},
+			},
+			want: SnapshotBlocks{
+				BlockSize:  524288,
+				VolumeSize: 8,
+			},
+		},
+		{
+			name: "block without index",
+			client: &fakeClient{
+				pages: []*ebs.ListSnapshotBlocksOutput{
+					{
+						Blocks: []ebstypes.Block{block(0), {BlockToken: utils.PointerTo("token")}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "listing fails",
+			client: &fakeClient{
+				err: errors.New("request limit exceeded"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ListSnapshotBlocks(context.Background(), tt.client, "snap-1", func(options *ebs.Options) {
+				options.Region = "us-east-1"
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListSnapshotBlocks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreUnexported(ebstypes.Block{})); diff != "" {
+				t.Errorf("ListSnapshotBlocks() mismatch (-want +got):\n%s", diff)
+			}
+			for _, region := range tt.client.regions {
+				if region != "us-east-1" {
+					t.Errorf("ListSnapshotBlocks() region = %v, want us-east-1", region)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package families
+
+// SetCABundlePath sets the PEM CA bundle of the scanners which make their own
+// HTTPS calls, so that a single CA bundle, e.g. of a TLS intercepting proxy,
+// can be given to the scanner. The CA bundles set in the config of a scanner
+// are kept.
+func (c *Config) SetCABundlePath(caBundlePath string) {
+	setIfEmpty := func(value *string) {
+		if *value == "" {
+			*value = caBundlePath
+		}
+	}
+
+	setIfEmpty(&c.Vulnerabilities.CABundlePath)
+	if c.Malware.ScannersConfig != nil {
+		setIfEmpty(&c.Malware.ScannersConfig.Clam.CABundlePath)
+		setIfEmpty(&c.Malware.ScannersConfig.Yara.CABundlePath)
+	}
+	if c.Exploits.ScannersConfig != nil {
+		setIfEmpty(&c.Exploits.ScannersConfig.ExploitDB.CABundlePath)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package families
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
+	exploitsCommon "github.com/openclarity/vmclarity/shared/pkg/families/exploits/common"
+	exploitdbConfig "github.com/openclarity/vmclarity/shared/pkg/families/exploits/exploitdb/config"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	clamconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/config"
+	malwareCommon "github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	yaraconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/yara/config"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+)
+
+func TestConfig_SetCABundlePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+		want   *Config
+	}{
+		{
+			name:   "no scanners config",
+			config: &Config{},
+			want: &Config{
+				Vulnerabilities: vulnerabilities.Config{
+					CABundlePath: "/ca-bundle.pem",
+				},
+			},
+		},
+		{
+			name: "scanners config",
+			config: &Config{
+				Malware: malware.Config{
+					ScannersConfig: &malwareCommon.ScannersConfig{
+						Clam: clamconfig.Config{
+							CABundlePath: "/clam-ca-bundle.pem",
+						},
+					},
+				},
+				Exploits: exploits.Config{
+					ScannersConfig: &exploitsCommon.ScannersConfig{},
+				},
+			},
+			want: &Config{
+				Vulnerabilities: vulnerabilities.Config{
+					CABundlePath: "/ca-bundle.pem",
+				},
+				Malware: malware.Config{
+					ScannersConfig: &malwareCommon.ScannersConfig{
+						Clam: clamconfig.Config{
+							CABundlePath: "/clam-ca-bundle.pem",
+						},
+						Yara: yaraconfig.Config{
+							CABundlePath: "/ca-bundle.pem",
+						},
+					},
+				},
+				Exploits: exploits.Config{
+					ScannersConfig: &exploitsCommon.ScannersConfig{
+						ExploitDB: exploitdbConfig.Config{
+							CABundlePath: "/ca-bundle.pem",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.SetCABundlePath("/ca-bundle.pem")
+			if diff := cmp.Diff(tt.want, tt.config); diff != "" {
+				t.Errorf("SetCABundlePath() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -38,4 +38,10 @@ type Config struct {
 
 	// Enrichers
 	Exploits exploits.Config `json:"exploits" yaml:"exploits" mapstructure:"exploits"`
+
+	// MaxParallelScannersPerFamily is the maximum number of scanners of a
+	// family which run at the same time, the families themselves run one
+	// after the other. All the scanners of a family run at once if it isn't
+	// positive.
+	MaxParallelScannersPerFamily int `json:"max_parallel_scanners_per_family" yaml:"max_parallel_scanners_per_family" mapstructure:"max_parallel_scanners_per_family"`
 }
@@ -18,4 +18,10 @@ package config
 type Config struct {
 	// URL of the exploit db server
 	BaseURL string `yaml:"base_url" mapstructure:"base_url"`
+	// Path of a pre-synced go-exploitdb sqlite3 database, if set the
+	// exploits are read from it instead of the exploit db server
+	LocalDBPath string `yaml:"local_db_path" mapstructure:"local_db_path"`
+	// PEM CA bundle trusted on top of the system certificates when calling
+	// the exploit db server, e.g. of a TLS intercepting proxy
+	CABundlePath string `yaml:"ca_bundle_path" mapstructure:"ca_bundle_path"`
 }
@@ -40,7 +40,7 @@ func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.
 	return &Scanner{
 		name:       ScannerName,
 		logger:     logger.Dup().WithField("scanner", ScannerName),
-		config:     config.Config{BaseURL: conf.ExploitDB.BaseURL},
+		config:     conf.ExploitDB,
 		resultChan: resultChan,
 	}
 }
@@ -62,7 +62,7 @@ func (a *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 			return
 		}
 
-		// get exploits (get request to exploit db)
+		// get exploits (get request to exploit db or lookup in the local db)
 		exploits, err := a.getExploitsFromCVEIDs(cveIDs)
 		if err != nil {
 			a.sendResults(retResults, fmt.Errorf("failed to get exploits from cve ids: %w", err))
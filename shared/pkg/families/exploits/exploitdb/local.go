@@ -0,0 +1,65 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitdb
+
+import (
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	exploitdb "github.com/vulsio/go-exploitdb/db"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/exploits/common"
+)
+
+// localDBType is the type of the databases created by `go-exploitdb fetch`
+// with its default settings.
+const localDBType = "sqlite3"
+
+// getExploitsFromLocalDB looks up the exploits of the CVEs in a pre-synced
+// go-exploitdb database, so that exploits can be scanned without access to
+// the exploit db server or the internet.
+func getExploitsFromLocalDB(dbPath string, cveIDs []string) ([]common.Exploit, error) {
+	// Opening a missing database creates an empty one, which would report
+	// no exploits instead of failing.
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, fmt.Errorf("failed to find local exploit db: %w", err)
+	}
+
+	driver, locked, err := exploitdb.NewDB(localDBType, dbPath, false, exploitdb.Option{})
+	if err != nil {
+		if locked {
+			return nil, fmt.Errorf("local exploit db %s is locked: %w", dbPath, err)
+		}
+		return nil, fmt.Errorf("failed to open local exploit db %s: %w", dbPath, err)
+	}
+	defer func() {
+		if err := driver.CloseDB(); err != nil {
+			log.Errorf("Failed to close local exploit db %s: %v", dbPath, err)
+		}
+	}()
+
+	exploitsByCVEID, err := driver.GetExploitMultiByCveID(cveIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exploits from local exploit db: %w", err)
+	}
+
+	var ret []common.Exploit
+	for cveID, exps := range exploitsByCVEID {
+		ret = append(ret, convertToCommonExploits(exps, cveID)...)
+	}
+	return ret, nil
+}
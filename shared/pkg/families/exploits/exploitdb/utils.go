@@ -16,6 +16,7 @@
 package exploitdb
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,6 +30,7 @@ import (
 	exploitmodels "github.com/vulsio/go-exploitdb/models"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits/common"
+	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 // genWorkers generates goroutine
@@ -66,12 +68,20 @@ func stringToArray(str string) []string {
 }
 
 func (a *Scanner) getExploitsFromCVEIDs(cveIDs []string) ([]common.Exploit, error) {
+	if a.config.LocalDBPath != "" {
+		return getExploitsFromLocalDB(a.config.LocalDBPath, cveIDs)
+	}
+
 	var ret []common.Exploit
 	prefix, err := url.JoinPath(a.config.BaseURL, "cves")
 	if err != nil {
 		return nil, fmt.Errorf("failed to join URLPath: %w", err)
 	}
-	responses, err := getExploitsViaHTTP(cveIDs, prefix)
+	tlsConfig, err := sharedutils.NewTLSConfigWithCABundle(a.config.CABundlePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load CA bundle: %w", err)
+	}
+	responses, err := getExploitsViaHTTP(cveIDs, prefix, tlsConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get exploits via HTTP: %w", err)
 	}
@@ -100,7 +110,7 @@ const (
 	taskTimeoutSec   = 30
 )
 
-func getExploitsViaHTTP(cveIDs []string, urlPrefix string) ([]exploitResponse, error) {
+func getExploitsViaHTTP(cveIDs []string, urlPrefix string, tlsConfig *tls.Config) ([]exploitResponse, error) {
 	var responses []exploitResponse
 
 	numCVEs := len(cveIDs)
@@ -125,7 +135,7 @@ func getExploitsViaHTTP(cveIDs []string, urlPrefix string) ([]exploitResponse, e
 				return
 			}
 			log.Debugf("HTTP Request to %s", URL)
-			httpGetExploit(URL, req, resChan, errChan)
+			httpGetExploit(URL, req, tlsConfig, resChan, errChan)
 		}
 	}
 
@@ -148,14 +158,18 @@ func getExploitsViaHTTP(cveIDs []string, urlPrefix string) ([]exploitResponse, e
 	return responses, nil
 }
 
-func httpGetExploit(url string, req exploitRequest, resChan chan<- exploitResponse, errChan chan<- error) {
+func httpGetExploit(url string, req exploitRequest, tlsConfig *tls.Config, resChan chan<- exploitResponse, errChan chan<- error) {
 	var body string
 	var errs []error
 	var resp *http.Response
 	count, retryMax := 0, 3
 
 	f := func() error {
-		resp, body, errs = gorequest.New().Timeout(taskTimeoutSec * time.Second).Get(url).End()
+		request := gorequest.New().Timeout(taskTimeoutSec * time.Second)
+		if tlsConfig != nil {
+			request = request.TLSClientConfig(tlsConfig)
+		}
+		resp, body, errs = request.Get(url).End()
 		if 0 < len(errs) || resp == nil || resp.StatusCode != 200 {
 			count++
 			if count == retryMax {
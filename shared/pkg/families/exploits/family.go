@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 	"github.com/openclarity/kubeclarity/shared/pkg/utils"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
@@ -27,20 +26,22 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits/job"
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/jobs"
 	"github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
 )
 
 type Exploits struct {
-	conf   Config
-	logger *log.Entry
+	conf                Config
+	logger              *log.Entry
+	maxParallelScanners int
 }
 
 func (e Exploits) Run(res *results.Results) (interfaces.IsResults, error) {
 	e.logger.Info("Exploits Run...")
 
-	manager := job_manager.New(e.conf.ScannersList, e.conf.ScannersConfig, e.logger, job.Factory)
+	manager := jobs.New(e.conf.ScannersList, e.maxParallelScanners, e.conf.ScannersConfig, e.logger, job.Factory)
 	mergedResults := NewMergedExploits()
 
 	if e.conf.InputFromVuln {
@@ -105,9 +106,10 @@ func (e Exploits) GetType() types.FamilyType {
 // ensure types implement the requisite interfaces.
 var _ interfaces.Family = &Exploits{}
 
-func New(logger *log.Entry, conf Config) *Exploits {
+func New(logger *log.Entry, conf Config, maxParallelScanners int) *Exploits {
 	return &Exploits{
-		conf:   conf,
-		logger: logger.Dup().WithField("family", "exploits"),
+		conf:                conf,
+		logger:              logger.Dup().WithField("family", "exploits"),
+		maxParallelScanners: maxParallelScanners,
 	}
 }
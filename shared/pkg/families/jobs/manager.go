@@ -0,0 +1,84 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"fmt"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// Manager runs the scanners of a family like the kubeclarity job manager,
+// which runs all of them at once, but with at most maxParallel of them
+// running at the same time so that small scanner instances don't run out of
+// memory.
+type Manager struct {
+	jobNames    []string
+	maxParallel int
+	config      job_manager.IsConfig
+	logger      *log.Entry
+	factory     *job_manager.Factory
+}
+
+// New creates a Manager of the given jobs. All the jobs run at once if
+// maxParallel isn't positive.
+func New(jobNames []string, maxParallel int, config job_manager.IsConfig, logger *log.Entry, factory *job_manager.Factory) *Manager {
+	return &Manager{
+		jobNames:    jobNames,
+		maxParallel: maxParallel,
+		config:      config,
+		logger:      logger,
+		factory:     factory,
+	}
+}
+
+// Run runs the jobs in batches of at most maxParallel jobs and returns the
+// results of all of them by job name.
+func (m *Manager) Run(sourceType utils.SourceType, userInput string) (map[string]job_manager.Result, error) {
+	results := make(map[string]job_manager.Result, len(m.jobNames))
+	for _, batch := range batches(m.jobNames, m.maxParallel) {
+		batchResults, err := job_manager.New(batch, m.config, m.logger, m.factory).Run(sourceType, userInput)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run jobs %v: %w", batch, err)
+		}
+		for name, result := range batchResults {
+			results[name] = result
+		}
+	}
+
+	return results, nil
+}
+
+// batches splits the job names into batches of at most size names, or a
+// single batch if size isn't positive.
+func batches(jobNames []string, size int) [][]string {
+	if size <= 0 || size >= len(jobNames) {
+		return [][]string{jobNames}
+	}
+
+	ret := make([][]string, 0, (len(jobNames)+size-1)/size)
+	for start := 0; start < len(jobNames); start += size {
+		end := start + size
+		if end > len(jobNames) {
+			end = len(jobNames)
+		}
+		ret = append(ret, jobNames[start:end])
+	}
+
+	return ret
+}
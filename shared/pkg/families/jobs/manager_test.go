@@ -0,0 +1,63 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_batches(t *testing.T) {
+	tests := []struct {
+		name     string
+		jobNames []string
+		size     int
+		want     [][]string
+	}{
+		{
+			name:     "unlimited",
+			jobNames: []string{"grype", "trivy", "osv"},
+			size:     0,
+			want:     [][]string{{"grype", "trivy", "osv"}},
+		},
+		{
+			name:     "size larger than the jobs",
+			jobNames: []string{"grype", "trivy"},
+			size:     3,
+			want:     [][]string{{"grype", "trivy"}},
+		},
+		{
+			name:     "sequential",
+			jobNames: []string{"grype", "trivy", "osv"},
+			size:     1,
+			want:     [][]string{{"grype"}, {"trivy"}, {"osv"}},
+		},
+		{
+			name:     "last batch is smaller",
+			jobNames: []string{"grype", "trivy", "osv"},
+			size:     2,
+			want:     [][]string{{"grype", "trivy"}, {"osv"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, batches(tt.jobNames, tt.size)); diff != "" {
+				t.Errorf("batches() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -74,6 +74,11 @@ func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 		// Execute freshclam command
 		// nolint:gosec
 		freshclamCommand := exec.Command(s.config.FreshclamBinaryPath)
+		if s.config.CABundlePath != "" {
+			// freshclam downloads with libcurl, which reads the CA
+			// bundle from the environment.
+			freshclamCommand.Env = append(os.Environ(), "CURL_CA_BUNDLE="+s.config.CABundlePath)
+		}
 		freshclamOut, err := sharedutils.RunCommand(freshclamCommand)
 		if err != nil {
 			s.sendResults(retResults, fmt.Errorf("failed to run freshclam command: %s", err.Error()))
@@ -88,6 +93,10 @@ func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 		// Execute the clamscan command
 		// nolint:gosec
 		clamScanCommand := exec.Command(s.config.ClamScanBinaryPath, args...)
+		if err := s.config.CommandOptions.Apply(clamScanCommand); err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to prepare clamscan command: %v", err))
+			return
+		}
 		out, err := sharedutils.RunCommand(clamScanCommand)
 		if err != nil {
 			/* If the error is that malware was found, this is not an actual error, Clam returns
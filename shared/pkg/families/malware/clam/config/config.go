@@ -15,8 +15,15 @@
 
 package config
 
+import "github.com/openclarity/vmclarity/shared/pkg/families/types"
+
 type Config struct {
+	types.CommandOptions `yaml:",inline" mapstructure:",squash"`
+
 	ClamScanBinaryPath            string `yaml:"clamscan_binary_path" mapstructure:"clamscan_binary_path"`
 	FreshclamBinaryPath           string `yaml:"freshclam_binary_path" mapstructure:"freshclam_binary_path"`
 	AlternativeFreshclamMirrorURL string `yaml:"alternative_freshclam_mirror_url" mapstructure:"alternative_freshclam_mirror_url"`
+	// PEM CA bundle which freshclam verifies the mirror with, instead of
+	// the system certificates, e.g. of a TLS intercepting proxy.
+	CABundlePath string `yaml:"ca_bundle_path" mapstructure:"ca_bundle_path"`
 }
@@ -17,6 +17,12 @@ package malware
 
 import "github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
 
+// KnownScanners lists the malware scanners supported by the malware family.
+var KnownScanners = []string{"clam", "yara"}
+
+// DefaultScanners lists the malware scanners used when none are configured.
+var DefaultScanners = []string{"clam"}
+
 type Config struct {
 	Enabled        bool                   `yaml:"enabled" mapstructure:"enabled"`
 	ScannersList   []string               `yaml:"scanners_list" mapstructure:"scanners_list"`
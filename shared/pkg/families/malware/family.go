@@ -20,10 +20,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
-	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 	"github.com/openclarity/kubeclarity/shared/pkg/utils"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/jobs"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/job"
 	"github.com/openclarity/vmclarity/shared/pkg/families/results"
@@ -31,13 +31,14 @@ import (
 )
 
 type Malware struct {
-	conf   Config
-	logger *log.Entry
+	conf                Config
+	logger              *log.Entry
+	maxParallelScanners int
 }
 
 func (m Malware) Run(res *results.Results) (interfaces.IsResults, error) {
 	m.logger.Info("Malware Run...")
-	manager := job_manager.New(m.conf.ScannersList, m.conf.ScannersConfig, m.logger, job.Factory)
+	manager := jobs.New(m.conf.ScannersList, m.maxParallelScanners, m.conf.ScannersConfig, m.logger, job.Factory)
 	mergedResults := NewMergedResults()
 
 	for _, input := range m.conf.Inputs {
@@ -67,9 +68,10 @@ func (m Malware) GetType() types.FamilyType {
 // ensure types implement the requisite interfaces.
 var _ interfaces.Family = &Malware{}
 
-func New(logger *log.Entry, conf Config) *Malware {
+func New(logger *log.Entry, conf Config, maxParallelScanners int) *Malware {
 	return &Malware{
-		conf:   conf,
-		logger: logger.Dup().WithField("family", "malware"),
+		conf:                conf,
+		logger:              logger.Dup().WithField("family", "malware"),
+		maxParallelScanners: maxParallelScanners,
 	}
 }
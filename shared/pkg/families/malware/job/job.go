@@ -19,10 +19,12 @@ import (
 	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/clam"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/yara"
 )
 
 var Factory = job_manager.NewJobFactory()
 
 func init() {
 	Factory.Register(clam.ScannerName, clam.New)
+	Factory.Register(yara.ScannerName, yara.New)
 }
@@ -0,0 +1,34 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "github.com/openclarity/vmclarity/shared/pkg/families/types"
+
+type Config struct {
+	types.CommandOptions `yaml:",inline" mapstructure:",squash"`
+
+	BinaryPath string `yaml:"binary_path" mapstructure:"binary_path"`
+	// RulesPath is a YARA rules file, or a directory containing .yar/.yara
+	// rules files, on the scanner host.
+	RulesPath string `yaml:"rules_path" mapstructure:"rules_path"`
+	// RulesURL is an http(s) URL of a YARA rules file which is downloaded
+	// before the scan, e.g. from an internal rules server.
+	RulesURL string `yaml:"rules_url" mapstructure:"rules_url"`
+	// CABundlePath is a PEM CA bundle trusted on top of the system
+	// certificates when downloading the rules, e.g. of a TLS intercepting
+	// proxy.
+	CABundlePath string `yaml:"ca_bundle_path" mapstructure:"ca_bundle_path"`
+}
@@ -0,0 +1,97 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+)
+
+// UnknownMalwareType is reported for matches of rules without tags.
+const UnknownMalwareType = "UNKNOWN"
+
+// ParseYaraScanOutput parses the output of yara run with --print-tags, where
+// every match is printed on its own line in the following format:
+//
+//	<rule-name> [<tag>,<tag>,...] <path>
+//
+// The rule name is reported as the malware name and the first rule tag as
+// the malware type.
+func ParseYaraScanOutput(yaraOutput string) []common.DetectedMalware {
+	log.Debugf("Parsing yara output: %s", yaraOutput)
+
+	malwareInfoList := []common.DetectedMalware{}
+	for _, line := range strings.Split(yaraOutput, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		detectedMalware, ok := parseMatchLine(line)
+		if !ok {
+			log.Debugf("omitting invalid yara line: %s", line)
+			continue
+		}
+
+		malwareInfoList = append(malwareInfoList, detectedMalware)
+	}
+
+	return malwareInfoList
+}
+
+func parseMatchLine(line string) (common.DetectedMalware, bool) {
+	ruleName, rest, ok := strings.Cut(line, " ")
+	if !ok {
+		return common.DetectedMalware{}, false
+	}
+
+	malwareType := UnknownMalwareType
+	if strings.HasPrefix(rest, "[") {
+		var tags string
+		tags, rest, ok = strings.Cut(strings.TrimPrefix(rest, "["), "] ")
+		if !ok {
+			return common.DetectedMalware{}, false
+		}
+		if firstTag, _, _ := strings.Cut(tags, ","); firstTag != "" {
+			malwareType = strings.ToUpper(firstTag)
+		}
+	}
+
+	// The path is the remainder of the line as it may contain spaces.
+	path := strings.TrimSpace(rest)
+	if path == "" {
+		return common.DetectedMalware{}, false
+	}
+
+	return common.DetectedMalware{
+		MalwareName: ruleName,
+		MalwareType: malwareType,
+		Path:        path,
+	}, true
+}
+
+// CountInfectedFiles returns the number of distinct files with at least one
+// detected malware.
+func CountInfectedFiles(detectedMalware []common.DetectedMalware) int {
+	paths := make(map[string]struct{}, len(detectedMalware))
+	for _, malware := range detectedMalware {
+		paths[malware.Path] = struct{}{}
+	}
+	return len(paths)
+}
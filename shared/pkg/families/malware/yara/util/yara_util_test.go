@@ -0,0 +1,102 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+)
+
+func Test_ParseYaraScanOutput(t *testing.T) {
+	testCases := []struct {
+		name            string
+		yaraOutput      string
+		expectedMalware []common.DetectedMalware
+	}{
+		{
+			name: "Malware found",
+			yaraOutput: `
+				Implant_Loader [backdoor,apt] /usr/bin/loader
+				Implant_Loader [backdoor,apt] /path/with spaces/file.so
+				Suspicious_Strings [] /tmp/payload.sh
+			`,
+			expectedMalware: []common.DetectedMalware{
+				{
+					MalwareName: "Implant_Loader",
+					MalwareType: "BACKDOOR",
+					Path:        "/usr/bin/loader",
+				},
+				{
+					MalwareName: "Implant_Loader",
+					MalwareType: "BACKDOOR",
+					Path:        "/path/with spaces/file.so",
+				},
+				{
+					MalwareName: "Suspicious_Strings",
+					MalwareType: "UNKNOWN",
+					Path:        "/tmp/payload.sh",
+				},
+			},
+		},
+		{
+			name: "Output without tags",
+			yaraOutput: `
+				Suspicious_Strings /tmp/payload.sh
+			`,
+			expectedMalware: []common.DetectedMalware{
+				{
+					MalwareName: "Suspicious_Strings",
+					MalwareType: "UNKNOWN",
+					Path:        "/tmp/payload.sh",
+				},
+			},
+		},
+		{
+			name: "Invalid lines are skipped",
+			yaraOutput: `
+				Implant_Loader
+				Implant_Loader [backdoor
+			`,
+			expectedMalware: []common.DetectedMalware{},
+		},
+		{
+			name:            "No malware found",
+			yaraOutput:      "",
+			expectedMalware: []common.DetectedMalware{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ParseYaraScanOutput(tc.yaraOutput); !reflect.DeepEqual(got, tc.expectedMalware) {
+				t.Errorf("ParseYaraScanOutput() = %v, want %v", got, tc.expectedMalware)
+			}
+		})
+	}
+}
+
+func Test_CountInfectedFiles(t *testing.T) {
+	detectedMalware := []common.DetectedMalware{
+		{MalwareName: "a", Path: "/bin/a"},
+		{MalwareName: "b", Path: "/bin/a"},
+		{MalwareName: "a", Path: "/bin/b"},
+	}
+	if got := CountInfectedFiles(detectedMalware); got != 2 {
+		t.Errorf("CountInfectedFiles() = %v, want %v", got, 2)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yara
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/yara/config"
+	yarautil "github.com/openclarity/vmclarity/shared/pkg/families/malware/yara/util"
+	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	ScannerName = "yara"
+
+	rulesDownloadTimeout = 60 * time.Second
+)
+
+// rulesFileExtensions are the extensions of the YARA rules files which are
+// loaded from a rules directory.
+var rulesFileExtensions = []string{".yar", ".yara"}
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     config.Config
+	resultChan chan job_manager.Result
+}
+
+func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := common.Results{
+			Source:      userInput,
+			ScannerName: ScannerName,
+		}
+
+		if !s.isValidInputType(sourceType) {
+			retResults.Error = fmt.Errorf("received invalid input type for YARA scanner: %v", sourceType)
+			s.sendResults(retResults, nil)
+			return
+		}
+
+		s.logger.Debugf("yara binary path: %s", s.config.BinaryPath)
+
+		rulesFiles, cleanup, err := s.getRulesFiles()
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to get YARA rules: %v", err))
+			return
+		}
+		defer cleanup()
+
+		// Define the yara args to run, the rules files must come before
+		// the scanned directory.
+		args := []string{"--recursive", "--no-warnings", "--print-tags"}
+		args = append(args, rulesFiles...)
+		args = append(args, userInput)
+
+		s.logger.Infof("Running yara...")
+		startTime := time.Now()
+		// nolint:gosec
+		yaraCommand := exec.Command(s.config.BinaryPath, args...)
+		if err := s.config.CommandOptions.Apply(yaraCommand); err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to prepare yara command: %v", err))
+			return
+		}
+		out, err := sharedutils.RunCommand(yaraCommand)
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to run yara command: %v", err))
+			return
+		}
+
+		detectedMalware := yarautil.ParseYaraScanOutput(string(out))
+
+		retResults.Malware = detectedMalware
+		retResults.Summary = &common.ScanSummary{
+			InfectedFiles: yarautil.CountInfectedFiles(detectedMalware),
+			TimeTaken:     time.Since(startTime).Round(time.Millisecond).String(),
+		}
+
+		s.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	conf := c.(*common.ScannersConfig) // nolint:forcetypeassert
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     conf.Yara,
+		resultChan: resultChan,
+	}
+}
+
+// getRulesFiles returns the YARA rules files to scan with, downloading the
+// rules from the configured URL if needed. The returned cleanup function
+// removes any downloaded files.
+func (s *Scanner) getRulesFiles() ([]string, func(), error) {
+	var rulesFiles []string
+	cleanup := func() {}
+
+	if s.config.RulesPath != "" {
+		files, err := findRulesFiles(s.config.RulesPath)
+		if err != nil {
+			return nil, cleanup, err
+		}
+		rulesFiles = append(rulesFiles, files...)
+	}
+
+	if s.config.RulesURL != "" {
+		s.logger.Infof("Downloading YARA rules from %s", s.config.RulesURL)
+		file, err := downloadRules(s.config.RulesURL, s.config.CABundlePath)
+		if err != nil {
+			return nil, cleanup, err
+		}
+		cleanup = func() {
+			if err := os.Remove(file); err != nil {
+				s.logger.Warnf("Failed to remove downloaded YARA rules file %s: %v", file, err)
+			}
+		}
+		rulesFiles = append(rulesFiles, file)
+	}
+
+	if len(rulesFiles) == 0 {
+		return nil, cleanup, fmt.Errorf("no YARA rules were found, a rules path or rules URL must be configured")
+	}
+
+	return rulesFiles, cleanup, nil
+}
+
+// findRulesFiles returns the given rules file, or the rules files found in
+// the given directory.
+func findRulesFiles(rulesPath string) ([]string, error) {
+	info, err := os.Stat(rulesPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check YARA rules path %s: %v", rulesPath, err)
+	}
+	if !info.IsDir() {
+		return []string{rulesPath}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(rulesPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		for _, ext := range rulesFileExtensions {
+			if strings.EqualFold(filepath.Ext(path), ext) {
+				files = append(files, path)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk YARA rules directory %s: %v", rulesPath, err)
+	}
+
+	return files, nil
+}
+
+// downloadRules downloads the rules file from the given URL into a temporary
+// file and returns its path.
+func downloadRules(url, caBundlePath string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rulesDownloadTimeout)
+	defer cancel()
+
+	client, err := sharedutils.NewHTTPClientWithCABundle(caBundlePath, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to create YARA rules HTTP client: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create YARA rules request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download YARA rules from %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download YARA rules from %s: unexpected status %s", url, resp.Status)
+	}
+
+	file, err := os.CreateTemp("", "yara-rules-*.yar")
+	if err != nil {
+		return "", fmt.Errorf("failed to create YARA rules file: %v", err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		_ = os.Remove(file.Name())
+		return "", fmt.Errorf("failed to write YARA rules file: %v", err)
+	}
+
+	return file.Name(), nil
+}
+
+func (s *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.ROOTFS, utils.DIR:
+		return true
+	case utils.FILE, utils.IMAGE, utils.SBOM:
+		fallthrough
+	default:
+		s.logger.Infof("source type %v is not supported for yara, skipping.", sourceType)
+	}
+	return false
+}
+
+func (s *Scanner) sendResults(results common.Results, err error) {
+	if err != nil {
+		s.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case s.resultChan <- &results:
+	default:
+		s.logger.Error("Failed to send results on channel")
+	}
+}
@@ -46,31 +46,31 @@ func New(logger *log.Entry, config *Config) *Manager {
 	// Analyzers.
 	// SBOM MUST come before vulnerabilities.
 	if config.SBOM.Enabled {
-		manager.families = append(manager.families, sbom.New(logger, config.SBOM))
+		manager.families = append(manager.families, sbom.New(logger, config.SBOM, config.MaxParallelScannersPerFamily))
 	}
 
 	// Scanners.
 	// Vulnerabilities MUST be after SBOM to support the case it is configured to use the output from sbom.
 	if config.Vulnerabilities.Enabled {
-		manager.families = append(manager.families, vulnerabilities.New(logger, config.Vulnerabilities))
+		manager.families = append(manager.families, vulnerabilities.New(logger, config.Vulnerabilities, config.MaxParallelScannersPerFamily))
 	}
 	if config.Secrets.Enabled {
-		manager.families = append(manager.families, secrets.New(logger, config.Secrets))
+		manager.families = append(manager.families, secrets.New(logger, config.Secrets, config.MaxParallelScannersPerFamily))
 	}
 	if config.Rootkits.Enabled {
-		manager.families = append(manager.families, rootkits.New(logger, config.Rootkits))
+		manager.families = append(manager.families, rootkits.New(logger, config.Rootkits, config.MaxParallelScannersPerFamily))
 	}
 	if config.Malware.Enabled {
-		manager.families = append(manager.families, malware.New(logger, config.Malware))
+		manager.families = append(manager.families, malware.New(logger, config.Malware, config.MaxParallelScannersPerFamily))
 	}
 	if config.Misconfiguration.Enabled {
-		manager.families = append(manager.families, misconfiguration.New(logger, config.Misconfiguration))
+		manager.families = append(manager.families, misconfiguration.New(logger, config.Misconfiguration, config.MaxParallelScannersPerFamily))
 	}
 
 	// Enrichers.
 	// Exploits MUST be after Vulnerabilities to support the case it is configured to use the output from Vulnerabilities.
 	if config.Exploits.Enabled {
-		manager.families = append(manager.families, exploits.New(logger, config.Exploits))
+		manager.families = append(manager.families, exploits.New(logger, config.Exploits, config.MaxParallelScannersPerFamily))
 	}
 
 	return manager
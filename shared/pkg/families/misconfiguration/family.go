@@ -20,10 +20,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
-	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 	"github.com/openclarity/kubeclarity/shared/pkg/utils"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/jobs"
 	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/job"
 	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
 	"github.com/openclarity/vmclarity/shared/pkg/families/results"
@@ -31,8 +31,9 @@ import (
 )
 
 type Misconfiguration struct {
-	conf   misconfigurationTypes.Config
-	logger *log.Entry
+	conf                misconfigurationTypes.Config
+	logger              *log.Entry
+	maxParallelScanners int
 }
 
 func (m Misconfiguration) Run(res *results.Results) (interfaces.IsResults, error) {
@@ -40,7 +41,7 @@ func (m Misconfiguration) Run(res *results.Results) (interfaces.IsResults, error
 
 	results := NewResults()
 
-	manager := job_manager.New(m.conf.ScannersList, m.conf.ScannersConfig, m.logger, job.Factory)
+	manager := jobs.New(m.conf.ScannersList, m.maxParallelScanners, m.conf.ScannersConfig, m.logger, job.Factory)
 	for _, input := range m.conf.Inputs {
 		managerResults, err := manager.Run(utils.SourceType(input.InputType), input.Input)
 		if err != nil {
@@ -70,9 +71,10 @@ func (m Misconfiguration) GetType() types.FamilyType {
 // ensure types implement the requisite interfaces.
 var _ interfaces.Family = &Misconfiguration{}
 
-func New(logger *log.Entry, conf misconfigurationTypes.Config) *Misconfiguration {
+func New(logger *log.Entry, conf misconfigurationTypes.Config, maxParallelScanners int) *Misconfiguration {
 	return &Misconfiguration{
-		conf:   conf,
-		logger: logger.Dup().WithField("family", "misconfiguration"),
+		conf:                conf,
+		logger:              logger.Dup().WithField("family", "misconfiguration"),
+		maxParallelScanners: maxParallelScanners,
 	}
 }
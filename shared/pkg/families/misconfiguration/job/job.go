@@ -19,6 +19,7 @@ import (
 	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/fake"
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/kics"
 	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/lynis"
 )
 
@@ -27,4 +28,5 @@ var Factory = job_manager.NewJobFactory()
 func init() {
 	Factory.Register(fake.ScannerName, fake.New)
 	Factory.Register(lynis.ScannerName, lynis.New)
+	Factory.Register(kics.ScannerName, kics.New)
 }
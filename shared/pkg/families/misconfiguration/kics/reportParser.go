@@ -0,0 +1,121 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kics
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+)
+
+// severityOrder lists the KICS severities from the lowest to the highest.
+var severityOrder = []string{"TRACE", "INFO", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+// Report is the subset of the KICS JSON report (results.json) which is used.
+type Report struct {
+	Queries []Query `json:"queries"`
+}
+
+type Query struct {
+	QueryName   string `json:"query_name"`
+	QueryID     string `json:"query_id"`
+	Severity    string `json:"severity"`
+	Platform    string `json:"platform"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	Files       []File `json:"files"`
+}
+
+type File struct {
+	FileName      string `json:"file_name"`
+	Line          int    `json:"line"`
+	IssueType     string `json:"issue_type"`
+	ExpectedValue string `json:"expected_value"`
+	ActualValue   string `json:"actual_value"`
+}
+
+// ParseKICSReport reads the KICS JSON report at reportPath and converts the
+// results with a severity of at least severityThreshold to
+// misconfigurations.
+func ParseKICSReport(reportPath, severityThreshold string) ([]types.Misconfiguration, error) {
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report file: %w", err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal report: %w", err)
+	}
+
+	return reportToMisconfigurations(report, severityThreshold)
+}
+
+func reportToMisconfigurations(report Report, severityThreshold string) ([]types.Misconfiguration, error) {
+	threshold := 0
+	if severityThreshold != "" {
+		threshold = severityIndex(severityThreshold)
+		if threshold < 0 {
+			return nil, fmt.Errorf("unknown severity threshold %q, supported severities are: %s",
+				severityThreshold, strings.Join(severityOrder, ", "))
+		}
+	}
+
+	ret := []types.Misconfiguration{}
+	for _, query := range report.Queries {
+		// Unknown severities are always reported.
+		if severityIndex(query.Severity) < threshold && severityIndex(query.Severity) >= 0 {
+			continue
+		}
+
+		for _, file := range query.Files {
+			ret = append(ret, types.Misconfiguration{
+				ScannedPath:     file.FileName,
+				TestCategory:    fmt.Sprintf("%s/%s", query.Platform, query.Category),
+				TestID:          query.QueryID,
+				TestDescription: query.Description,
+				Severity:        toSeverity(query.Severity),
+				Message:         fmt.Sprintf("%s (line %d): %s", query.QueryName, file.Line, file.ActualValue),
+				Remediation:     file.ExpectedValue,
+			})
+		}
+	}
+
+	return ret, nil
+}
+
+func severityIndex(severity string) int {
+	for i, s := range severityOrder {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return -1
+}
+
+func toSeverity(severity string) types.Severity {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL", "HIGH":
+		return types.HighSeverity
+	case "MEDIUM":
+		return types.MediumSeverity
+	default:
+		return types.LowSeverity
+	}
+}
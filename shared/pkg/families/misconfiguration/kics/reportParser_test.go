@@ -0,0 +1,121 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kics
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+)
+
+func Test_reportToMisconfigurations(t *testing.T) {
+	report := Report{
+		Queries: []Query{
+			{
+				QueryName:   "Privileged Container",
+				QueryID:     "dd29336b-fe57-445b-a26e-e6aa867ae609",
+				Severity:    "HIGH",
+				Platform:    "Kubernetes",
+				Category:    "Insecure Configurations",
+				Description: "Privileged containers lack essential security restrictions",
+				Files: []File{
+					{
+						FileName:      "/mnt/etc/k8s/pod.yaml",
+						Line:          12,
+						ExpectedValue: "securityContext.privileged is false",
+						ActualValue:   "securityContext.privileged is true",
+					},
+				},
+			},
+			{
+				QueryName:   "Healthcheck Instruction Missing",
+				QueryID:     "b03a748a-542d-44f4-bb86-9199ab4fd2d5",
+				Severity:    "LOW",
+				Platform:    "Dockerfile",
+				Category:    "Insecure Configurations",
+				Description: "Ensure that HEALTHCHECK is being used",
+				Files: []File{
+					{
+						FileName:      "/mnt/app/Dockerfile",
+						Line:          1,
+						ExpectedValue: "Dockerfile contains instruction 'HEALTHCHECK'",
+						ActualValue:   "Dockerfile doesn't contain instruction 'HEALTHCHECK'",
+					},
+				},
+			},
+		},
+	}
+
+	privileged := types.Misconfiguration{
+		ScannedPath:     "/mnt/etc/k8s/pod.yaml",
+		TestCategory:    "Kubernetes/Insecure Configurations",
+		TestID:          "dd29336b-fe57-445b-a26e-e6aa867ae609",
+		TestDescription: "Privileged containers lack essential security restrictions",
+		Severity:        types.HighSeverity,
+		Message:         "Privileged Container (line 12): securityContext.privileged is true",
+		Remediation:     "securityContext.privileged is false",
+	}
+	healthcheck := types.Misconfiguration{
+		ScannedPath:     "/mnt/app/Dockerfile",
+		TestCategory:    "Dockerfile/Insecure Configurations",
+		TestID:          "b03a748a-542d-44f4-bb86-9199ab4fd2d5",
+		TestDescription: "Ensure that HEALTHCHECK is being used",
+		Severity:        types.LowSeverity,
+		Message:         "Healthcheck Instruction Missing (line 1): Dockerfile doesn't contain instruction 'HEALTHCHECK'",
+		Remediation:     "Dockerfile contains instruction 'HEALTHCHECK'",
+	}
+
+	tests := []struct {
+		name              string
+		severityThreshold string
+		want              []types.Misconfiguration
+		wantErr           bool
+	}{
+		{
+			name: "no threshold",
+			want: []types.Misconfiguration{privileged, healthcheck},
+		},
+		{
+			name:              "medium threshold",
+			severityThreshold: "medium",
+			want:              []types.Misconfiguration{privileged},
+		},
+		{
+			name:              "critical threshold",
+			severityThreshold: "CRITICAL",
+			want:              []types.Misconfiguration{},
+		},
+		{
+			name:              "unknown threshold",
+			severityThreshold: "SEVERE",
+			wantErr:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reportToMisconfigurations(report, tt.severityThreshold)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("reportToMisconfigurations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("reportToMisconfigurations() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kics
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	sharedUtils "github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	ScannerName = "kics"
+
+	reportName = "results"
+)
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     types.KICSConfig
+	resultChan chan job_manager.Result
+}
+
+func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	conf := c.(types.ScannersConfig) // nolint:forcetypeassert
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     conf.KICS,
+		resultChan: resultChan,
+	}
+}
+
+func (a *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := types.ScannerResult{
+			ScannerName: ScannerName,
+		}
+
+		// Validate this is an input type supported by the scanner,
+		// otherwise return skipped.
+		if !a.isValidInputType(sourceType) {
+			a.sendResults(retResults, nil)
+			return
+		}
+
+		reportDir, err := os.MkdirTemp("", "")
+		if err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to create temp directory: %w", err))
+			return
+		}
+		defer func() {
+			err := os.RemoveAll(reportDir)
+			if err != nil {
+				a.logger.Warningf("failed to remove temp directory: %v", err)
+			}
+		}()
+
+		// Build command:
+		// <binaryPath> scan \
+		//     --path <source> \
+		//     --output-path <reportDir> \
+		//     --output-name results \
+		//     --report-formats json \
+		//     --ignore-on-exit results \
+		//     --no-progress \
+		//     [--queries-path <queriesPath>]
+		args := []string{
+			"scan",
+			"--path",
+			userInput,
+			"--output-path",
+			reportDir,
+			"--output-name",
+			reportName,
+			"--report-formats",
+			"json",
+			// KICS exits with a non zero code when it finds
+			// results, that is not a failure of the scan.
+			"--ignore-on-exit",
+			"results",
+			"--no-progress",
+		}
+		if a.config.QueriesPath != "" {
+			args = append(args, "--queries-path", a.config.QueriesPath)
+		}
+		cmd := exec.Command(a.config.BinaryPath, args...) // nolint:gosec
+		if err := a.config.CommandOptions.Apply(cmd); err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to prepare kics command: %v", err))
+			return
+		}
+
+		a.logger.Infof("Running command: %v", cmd.String())
+		_, err = sharedUtils.RunCommand(cmd)
+		if err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to run command: %w", err))
+			return
+		}
+
+		reportPath := path.Join(reportDir, reportName+".json")
+		retResults.Misconfigurations, err = ParseKICSReport(reportPath, a.config.SeverityThreshold)
+		if err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to parse report file %v: %w", reportPath, err))
+			return
+		}
+
+		a.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+func (a *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.ROOTFS, utils.DIR:
+		return true
+	case utils.FILE, utils.IMAGE, utils.SBOM:
+		a.logger.Infof("source type %v is not supported for kics, skipping.", sourceType)
+	default:
+		a.logger.Infof("unknown source type %v, skipping.", sourceType)
+	}
+	return false
+}
+
+func (a *Scanner) sendResults(results types.ScannerResult, err error) {
+	if err != nil {
+		a.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case a.resultChan <- results:
+	default:
+		a.logger.Error("Failed to send results on channel")
+	}
+}
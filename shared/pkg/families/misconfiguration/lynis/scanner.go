@@ -103,6 +103,10 @@ func (a *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 			userInput,
 		}
 		cmd := exec.Command(lynisPath, args...) // nolint:gosec
+		if err := a.config.CommandOptions.Apply(cmd); err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to prepare lynis command: %v", err))
+			return
+		}
 
 		// Lynis requires that it is executed from inside of the lynis
 		// install directory. So change the working dir to the lynis
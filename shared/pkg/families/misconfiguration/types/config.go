@@ -15,6 +15,16 @@
 
 package types
 
+import familiestypes "github.com/openclarity/vmclarity/shared/pkg/families/types"
+
+// KnownScanners lists the misconfiguration scanners supported by the
+// misconfiguration family.
+var KnownScanners = []string{"lynis", "kics"}
+
+// DefaultScanners lists the misconfiguration scanners used when none are
+// configured.
+var DefaultScanners = []string{"lynis"}
+
 type Config struct {
 	Enabled        bool           `json:"enabled" yaml:"enabled" mapstructure:"enabled"`
 	ScannersList   []string       `yaml:"scanners_list" mapstructure:"scanners_list"`
@@ -35,10 +45,26 @@ type Input struct {
 //	Lynis LynisConfig `yaml:"lynis" mapstructure:"lynis"`
 type ScannersConfig struct {
 	Lynis LynisConfig `yaml:"lynis" mapstructure:"lynis"`
+	KICS  KICSConfig  `yaml:"kics" mapstructure:"kics"`
 }
 
 func (ScannersConfig) IsConfig() {}
 
 type LynisConfig struct {
+	familiestypes.CommandOptions `yaml:",inline" mapstructure:",squash"`
+
 	InstallPath string `yaml:"install_path" mapstructure:"install_path"`
 }
+
+type KICSConfig struct {
+	familiestypes.CommandOptions `yaml:",inline" mapstructure:",squash"`
+
+	BinaryPath string `yaml:"binary_path" mapstructure:"binary_path"`
+	// QueriesPath is the directory of the KICS queries (rules) to run, if
+	// not set the queries bundled with KICS are used.
+	QueriesPath string `yaml:"queries_path" mapstructure:"queries_path"`
+	// SeverityThreshold is the lowest KICS severity (TRACE, INFO, LOW,
+	// MEDIUM, HIGH or CRITICAL) that is reported, if not set all results
+	// are reported.
+	SeverityThreshold string `yaml:"severity_threshold" mapstructure:"severity_threshold"`
+}
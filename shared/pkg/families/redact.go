@@ -0,0 +1,161 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package families
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redactedValue = "<redacted>"
+
+// sensitiveKeySuffixes are the (lower cased) suffixes of the configuration
+// keys holding credentials, for example the password and token of a
+// registry auth.
+var sensitiveKeySuffixes = []string{"password", "token"}
+
+// sensitiveLogPatterns match the credentials which may show up in the logs of
+// the scanner, a match is replaced by its replacement.
+var sensitiveLogPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// Private keys.
+	{
+		pattern:     regexp.MustCompile(`(?s)(-----BEGIN [A-Z ]*PRIVATE KEY-----).*?-----END [A-Z ]*PRIVATE KEY-----`),
+		replacement: "${1}" + redactedValue,
+	},
+	// Values of sensitive keys, for example TRIVY_SERVER_TOKEN=... or
+	// "password": "...".
+	{
+		pattern:     regexp.MustCompile(`(?i)((?:password|passwd|secret|token|api[_-]?key|access[_-]?key)["']?\s*[:=]\s*["']?)[^\s"',&]+`),
+		replacement: "${1}" + redactedValue,
+	},
+	// Authorization headers.
+	{
+		pattern:     regexp.MustCompile(`(?i)(authorization:\s*(?:basic|bearer)\s+)\S+`),
+		replacement: "${1}" + redactedValue,
+	},
+	// User info of URLs.
+	{
+		pattern:     regexp.MustCompile(`://[^/\s@]+@`),
+		replacement: "://" + redactedValue + "@",
+	},
+	// AWS access key IDs.
+	{
+		pattern:     regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+		replacement: redactedValue,
+	},
+}
+
+// RedactConfigYAML returns a copy of the families configuration YAML where all
+// the credentials are masked so that it can be safely logged or persisted. The
+// credentials embedded in server addresses, as user info or as query
+// parameters, are masked too.
+func RedactConfigYAML(configYAML []byte) ([]byte, error) {
+	var config interface{}
+	if err := yaml.Unmarshal(configYAML, &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal families config: %w", err)
+	}
+
+	redacted, err := yaml.Marshal(redactValue(config))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal redacted families config: %w", err)
+	}
+
+	return redacted, nil
+}
+
+// RedactLogs returns a copy of the logs of a scanner where the credentials
+// are masked, so that they can be safely returned to the users.
+func RedactLogs(logs string) string {
+	for _, p := range sensitiveLogPatterns {
+		logs = p.pattern.ReplaceAllString(logs, p.replacement)
+	}
+	return logs
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if s, ok := val.(string); ok && s != "" && isSensitiveKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+	case string:
+		return redactURL(v)
+	}
+
+	return value
+}
+
+// redactURL masks the user info and the sensitive query parameters of value if
+// it's a URL, any other value is returned as is.
+func redactURL(value string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return value
+	}
+
+	redacted := false
+	if u.RawQuery != "" {
+		params := strings.Split(u.RawQuery, "&")
+		for i, param := range params {
+			key, _, found := strings.Cut(param, "=")
+			if unescaped, err := url.QueryUnescape(key); err == nil {
+				key = unescaped
+			}
+			if found && isSensitiveKey(key) {
+				params[i] = key + "=" + redactedValue
+				redacted = true
+			}
+		}
+		u.RawQuery = strings.Join(params, "&")
+	}
+
+	if u.User == nil {
+		if !redacted {
+			return value
+		}
+		return u.String()
+	}
+
+	// The user info is dropped and added back as is, url.URL would escape
+	// the redacted value.
+	u.User = nil
+	return u.Scheme + "://" + redactedValue + "@" + strings.TrimPrefix(u.String(), u.Scheme+"://")
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
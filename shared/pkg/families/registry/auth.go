@@ -0,0 +1,63 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"github.com/openclarity/kubeclarity/shared/pkg/config"
+)
+
+// Auth holds the credentials of a registry. The credentials of the
+// kubeclarity config.Auth are left out when it is marshaled, so they are
+// passed to the scanner with Auth and set on the kubeclarity config when the
+// family runs.
+type Auth struct {
+	Authority string `yaml:"authority" mapstructure:"authority"`
+	Username  string `yaml:"username,omitempty" mapstructure:"username"`
+	Password  string `yaml:"password,omitempty" mapstructure:"password"`
+	Token     string `yaml:"token,omitempty" mapstructure:"token"`
+}
+
+// WithAuths returns a copy of the kubeclarity config whose registry auths
+// include the given auths. The config is returned as is if there are none.
+func WithAuths(conf *config.Config, auths []Auth) *config.Config {
+	if len(auths) == 0 {
+		return conf
+	}
+
+	ret := &config.Config{}
+	if conf != nil {
+		*ret = *conf
+	}
+	registry := &config.Registry{}
+	if ret.Registry != nil {
+		*registry = *ret.Registry
+	}
+	registry.Auths = make([]config.Auth, 0, len(registry.Auths)+len(auths))
+	if ret.Registry != nil {
+		registry.Auths = append(registry.Auths, ret.Registry.Auths...)
+	}
+	for _, auth := range auths {
+		registry.Auths = append(registry.Auths, config.Auth{
+			Authority: auth.Authority,
+			Username:  auth.Username,
+			Password:  auth.Password,
+			Token:     auth.Token,
+		})
+	}
+	ret.Registry = registry
+
+	return ret
+}
@@ -0,0 +1,125 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openclarity/kubeclarity/shared/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+func TestWithAuths(t *testing.T) {
+	tests := []struct {
+		name  string
+		conf  *config.Config
+		auths []Auth
+		want  *config.Config
+	}{
+		{
+			name: "no auths",
+			conf: &config.Config{
+				Registry: &config.Registry{UseHTTP: true},
+			},
+			want: &config.Config{
+				Registry: &config.Registry{UseHTTP: true},
+			},
+		},
+		{
+			name: "nil config",
+			auths: []Auth{
+				{Authority: "registry.example.com", Token: "token"},
+			},
+			want: &config.Config{
+				Registry: &config.Registry{
+					Auths: []config.Auth{
+						{Authority: "registry.example.com", Token: "token"},
+					},
+				},
+			},
+		},
+		{
+			name: "auths added to the registry config",
+			conf: &config.Config{
+				Registry: &config.Registry{
+					SkipVerifyTLS: true,
+					Auths: []config.Auth{
+						{Authority: "gcr.io"},
+					},
+				},
+			},
+			auths: []Auth{
+				{Authority: "docker.io", Username: "user", Password: "pass"},
+			},
+			want: &config.Config{
+				Registry: &config.Registry{
+					SkipVerifyTLS: true,
+					Auths: []config.Auth{
+						{Authority: "gcr.io"},
+						{Authority: "docker.io", Username: "user", Password: "pass"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WithAuths(tt.conf, tt.auths)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("WithAuths() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithAuths_doesNotModifyConfig(t *testing.T) {
+	conf := &config.Config{
+		Registry: &config.Registry{
+			Auths: []config.Auth{{Authority: "gcr.io"}},
+		},
+	}
+	_ = WithAuths(conf, []Auth{{Authority: "docker.io", Token: "token"}})
+
+	want := &config.Config{
+		Registry: &config.Registry{
+			Auths: []config.Auth{{Authority: "gcr.io"}},
+		},
+	}
+	if diff := cmp.Diff(want, conf); diff != "" {
+		t.Errorf("WithAuths() modified the config (-want +got):\n%s", diff)
+	}
+}
+
+func TestAuth_yamlRoundTrip(t *testing.T) {
+	auths := []Auth{
+		{Authority: "docker.io", Username: "user", Password: "pass"},
+		{Authority: "registry.example.com", Token: "token"},
+	}
+
+	out, err := yaml.Marshal(auths)
+	if err != nil {
+		t.Fatalf("failed to marshal auths: %v", err)
+	}
+	var got []Auth
+	if err := yaml.Unmarshal(out, &got); err != nil {
+		t.Fatalf("failed to unmarshal auths: %v", err)
+	}
+
+	if diff := cmp.Diff(auths, got); diff != "" {
+		t.Errorf("Auth yaml round trip mismatch (-want +got):\n%s", diff)
+	}
+}
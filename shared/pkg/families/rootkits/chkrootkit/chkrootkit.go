@@ -65,6 +65,10 @@ func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 
 		// nolint:gosec
 		cmd := exec.Command(s.config.BinaryPath, args...)
+		if err := s.config.CommandOptions.Apply(cmd); err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to prepare chkrootkit command: %v", err))
+			return
+		}
 		s.logger.Infof("running chkrootkit command: %v", cmd.String())
 		out, err := sharedutils.RunCommand(cmd)
 		if err != nil {
@@ -15,10 +15,14 @@
 
 package common
 
-import "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit/config"
+import (
+	chkrootkitConfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit/config"
+	rkhunterConfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/rkhunter/config"
+)
 
 type ScannersConfig struct {
-	Chkrootkit config.Config `yaml:"chkrootkit" mapstructure:"chkrootkit"`
+	Chkrootkit chkrootkitConfig.Config `yaml:"chkrootkit" mapstructure:"chkrootkit"`
+	Rkhunter   rkhunterConfig.Config   `yaml:"rkhunter" mapstructure:"rkhunter"`
 }
 
 func (ScannersConfig) IsConfig() {}
@@ -19,6 +19,12 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/common"
 )
 
+// KnownScanners lists the rootkit scanners supported by the rootkits family.
+var KnownScanners = []string{"chkrootkit", "rkhunter"}
+
+// DefaultScanners lists the rootkit scanners used when none are configured.
+var DefaultScanners = []string{"chkrootkit"}
+
 type Config struct {
 	Enabled        bool                   `yaml:"enabled" mapstructure:"enabled"`
 	ScannersList   []string               `yaml:"scanners_list" mapstructure:"scanners_list"`
@@ -20,10 +20,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
-	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 	"github.com/openclarity/kubeclarity/shared/pkg/utils"
 
 	familiesinterface "github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/jobs"
 	familiesresults "github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/job"
@@ -31,14 +31,15 @@ import (
 )
 
 type Rootkits struct {
-	conf   Config
-	logger *log.Entry
+	conf                Config
+	logger              *log.Entry
+	maxParallelScanners int
 }
 
 func (r Rootkits) Run(res *familiesresults.Results) (familiesinterface.IsResults, error) {
 	r.logger.Info("Rootkits Run...")
 
-	manager := job_manager.New(r.conf.ScannersList, r.conf.ScannersConfig, r.logger, job.Factory)
+	manager := jobs.New(r.conf.ScannersList, r.maxParallelScanners, r.conf.ScannersConfig, r.logger, job.Factory)
 	mergedResults := NewMergedResults()
 
 	for _, input := range r.conf.Inputs {
@@ -67,9 +68,10 @@ func (r Rootkits) GetType() types.FamilyType {
 // ensure types implement the requisite interfaces.
 var _ familiesinterface.Family = &Rootkits{}
 
-func New(logger *log.Entry, conf Config) *Rootkits {
+func New(logger *log.Entry, conf Config, maxParallelScanners int) *Rootkits {
 	return &Rootkits{
-		conf:   conf,
-		logger: logger.Dup().WithField("family", "rootkits"),
+		conf:                conf,
+		logger:              logger.Dup().WithField("family", "rootkits"),
+		maxParallelScanners: maxParallelScanners,
 	}
 }
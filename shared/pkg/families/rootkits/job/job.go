@@ -19,10 +19,12 @@ import (
 	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/rkhunter"
 )
 
 var Factory = job_manager.NewJobFactory()
 
 func init() {
 	Factory.Register(chkrootkit.ScannerName, chkrootkit.New)
+	Factory.Register(rkhunter.ScannerName, rkhunter.New)
 }
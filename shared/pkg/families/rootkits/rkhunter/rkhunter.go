@@ -0,0 +1,184 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rkhunter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/rkhunter/config"
+	rkhunterutils "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/rkhunter/utils"
+	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const ScannerName = "rkhunter"
+
+// rkhunter exits with this code when the check completed and found warnings.
+const warningsFoundExitCode = 1
+
+// binDirs are the directories, relative to the scanned root, in which
+// rkhunter looks for the commands it checks.
+var binDirs = []string{"bin", "sbin", "usr/bin", "usr/sbin", "usr/local/bin", "usr/local/sbin"}
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     config.Config
+	resultChan chan job_manager.Result
+}
+
+func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := common.Results{
+			ScannedInput: userInput,
+			ScannerName:  ScannerName,
+		}
+
+		if !s.isValidInputType(sourceType) {
+			retResults.Error = fmt.Errorf("received invalid input type for rkhunter scanner: %v", sourceType)
+			s.sendResults(retResults, nil)
+			return
+		}
+
+		// validate that rkhunter binary exists
+		if _, err := os.Stat(s.config.BinaryPath); err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to find binary in %v: %v", s.config.BinaryPath, err))
+			return
+		}
+
+		// rkhunter has no option to change the root of the checked file
+		// system, so only the command and file property checks are pointed
+		// at the scanned input via --bindir. The remaining checks (e.g.
+		// known rootkit files) run against the paths of the local host.
+		args := []string{
+			"--check",
+			"--skip-keypress",
+			"--nocolors",
+			"--report-warnings-only",
+			"--noappend-log",
+			"--bindir",
+			toBinDirArg(userInput),
+		}
+
+		// nolint:gosec
+		cmd := exec.Command(s.config.BinaryPath, args...)
+		if err := s.config.CommandOptions.Apply(cmd); err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to prepare rkhunter command: %v", err))
+			return
+		}
+		s.logger.Infof("running rkhunter command: %v", cmd.String())
+		out, err := sharedutils.RunCommand(cmd)
+		if err != nil {
+			out, err = handleRunError(err)
+			if err != nil {
+				s.sendResults(retResults, fmt.Errorf("failed to run rkhunter command: %v", err))
+				return
+			}
+		}
+
+		rootkits, err := rkhunterutils.ParseRkhunterOutput(out)
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to parse rkhunter output: %v", err))
+			return
+		}
+
+		retResults.Rootkits = toResultsRootkits(rootkits)
+
+		s.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+// handleRunError returns the command output if the error only reports that
+// rkhunter found warnings.
+func handleRunError(err error) ([]byte, error) {
+	var cmdErr sharedutils.CmdRunError
+	if !errors.As(err, &cmdErr) {
+		return nil, err
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(cmdErr.Err, &exitErr) || exitErr.ExitCode() != warningsFoundExitCode {
+		return nil, err
+	}
+
+	return cmdErr.Stdout, nil
+}
+
+func toBinDirArg(root string) string {
+	dirs := make([]string, 0, len(binDirs))
+	for _, dir := range binDirs {
+		dirs = append(dirs, filepath.Join(root, dir))
+	}
+	return strings.Join(dirs, " ")
+}
+
+func toResultsRootkits(rootkits []rkhunterutils.Rootkit) []common.Rootkit {
+	ret := make([]common.Rootkit, 0, len(rootkits))
+	for _, rootkit := range rootkits {
+		ret = append(ret, common.Rootkit{
+			Message:     rootkit.Message,
+			RootkitName: rootkit.RkName,
+			RootkitType: rootkit.RkType,
+		})
+	}
+
+	return ret
+}
+
+func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	conf := c.(*common.ScannersConfig) // nolint:forcetypeassert
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     config.Config{BinaryPath: conf.Rkhunter.BinaryPath},
+		resultChan: resultChan,
+	}
+}
+
+func (s *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.DIR, utils.ROOTFS:
+		return true
+	case utils.FILE, utils.IMAGE, utils.SBOM:
+		fallthrough
+	default:
+		s.logger.Infof("source type %v is not supported for rkhunter, skipping.", sourceType)
+	}
+	return false
+}
+
+func (s *Scanner) sendResults(results common.Results, err error) {
+	if err != nil {
+		s.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case s.resultChan <- &results:
+	default:
+		s.logger.Error("Failed to send results on channel")
+	}
+}
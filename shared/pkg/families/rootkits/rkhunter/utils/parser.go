@@ -0,0 +1,97 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/types"
+)
+
+const warningPrefix = "Warning:"
+
+// rootkitNameRegex extracts the rootkit name from warnings like
+// "Found Xzibit Rootkit files or directories".
+var rootkitNameRegex = regexp.MustCompile(`(?i)^(?:found\s+|possible\s+)?(.+?)\s+rootkit\b`)
+
+type Rootkit struct {
+	RkType  types.RootkitType
+	RkName  string
+	Message string
+}
+
+// ParseRkhunterOutput parses the output of rkhunter run with
+// --report-warnings-only. Every warning starts with a "Warning:" line and
+// may be followed by indented lines listing the affected files.
+func ParseRkhunterOutput(rkhunterOutput []byte) ([]Rootkit, error) {
+	var warnings []string
+
+	outputScanner := bufio.NewScanner(bytes.NewBuffer(rkhunterOutput))
+	for outputScanner.Scan() {
+		line := outputScanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, warningPrefix) {
+			warnings = append(warnings, strings.TrimSpace(strings.TrimPrefix(line, warningPrefix)))
+			continue
+		}
+
+		if len(warnings) == 0 {
+			// Not part of a warning, e.g. a banner line.
+			continue
+		}
+		warnings[len(warnings)-1] = fmt.Sprintf("%s %s", warnings[len(warnings)-1], strings.TrimSpace(line))
+	}
+
+	if err := outputScanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan the output: %v", err)
+	}
+
+	rootkits := make([]Rootkit, 0, len(warnings))
+	for _, warning := range warnings {
+		rootkits = append(rootkits, warningToRootkit(warning))
+	}
+
+	return rootkits, nil
+}
+
+func warningToRootkit(warning string) Rootkit {
+	rootkit := Rootkit{
+		RkType:  types.UNKNOWN,
+		RkName:  "UNKNOWN",
+		Message: warning,
+	}
+
+	if match := rootkitNameRegex.FindStringSubmatch(warning); match != nil {
+		rootkit.RkName = match[1]
+	}
+
+	lowerWarning := strings.ToLower(warning)
+	switch {
+	case strings.Contains(lowerWarning, "kernel module") || strings.Contains(lowerWarning, "lkm"):
+		rootkit.RkType = types.KERNEL
+	case strings.HasPrefix(lowerWarning, "the command") || strings.HasPrefix(lowerWarning, "the file properties"):
+		rootkit.RkType = types.APPLICATION
+	}
+
+	return rootkit
+}
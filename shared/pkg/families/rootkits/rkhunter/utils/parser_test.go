@@ -0,0 +1,94 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/types"
+)
+
+func TestParseRkhunterOutput(t *testing.T) {
+	rkhunterOutput, err := os.ReadFile("testdata/rkhunter_output.txt")
+	assert.NilError(t, err)
+
+	type args struct {
+		rkhunterOutput []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []Rootkit
+		wantErr bool
+	}{
+		{
+			name: "sanity",
+			args: args{
+				rkhunterOutput: rkhunterOutput,
+			},
+			want: []Rootkit{
+				{
+					RkType:  types.APPLICATION,
+					RkName:  "UNKNOWN",
+					Message: "The command '/usr/bin/lwp-request' has been replaced by a script: /usr/bin/lwp-request: Perl script text executable",
+				},
+				{
+					RkType:  types.UNKNOWN,
+					RkName:  "Xzibit",
+					Message: "Found Xzibit Rootkit files or directories: /dev/dsx /dev/caca",
+				},
+				{
+					RkType:  types.UNKNOWN,
+					RkName:  "Showtee",
+					Message: "Possible Showtee rootkit installed",
+				},
+				{
+					RkType:  types.UNKNOWN,
+					RkName:  "UNKNOWN",
+					Message: "Hidden directory found: /etc/.java",
+				},
+				{
+					RkType:  types.KERNEL,
+					RkName:  "UNKNOWN",
+					Message: "Loaded kernel module 'hide_lkm' is suspicious",
+				},
+			},
+		},
+		{
+			name: "no warnings",
+			args: args{
+				rkhunterOutput: []byte("\n"),
+			},
+			want: []Rootkit{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRkhunterOutput(tt.args.rkhunterOutput)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRkhunterOutput() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseRkhunterOutput() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,7 +15,17 @@
 
 package sbom
 
-import "github.com/openclarity/kubeclarity/shared/pkg/config"
+import (
+	"github.com/openclarity/kubeclarity/shared/pkg/config"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/registry"
+)
+
+// KnownAnalyzers lists the SBOM analyzers supported by the SBOM family.
+var KnownAnalyzers = []string{"syft", "trivy", "gomod"}
+
+// DefaultAnalyzers lists the SBOM analyzers used when none are configured.
+var DefaultAnalyzers = []string{"syft", "trivy"}
 
 type Config struct {
 	Enabled         bool           `yaml:"enabled" mapstructure:"enabled"`
@@ -23,6 +33,13 @@ type Config struct {
 	Inputs          []Input        `yaml:"inputs" mapstructure:"inputs"`
 	MergeWith       []MergeWith    `yaml:"merge_with" mapstructure:"merge_with"`
 	AnalyzersConfig *config.Config `yaml:"analyzers_config" mapstructure:"analyzers_config"`
+	// RegistryAuths are the credentials of the registries, added to the
+	// AnalyzersConfig when the family runs.
+	RegistryAuths []registry.Auth `yaml:"registry_auths,omitempty" mapstructure:"registry_auths"`
+	// PackageManagerHints lists the package managers, from
+	// KnownPackageManagers, whose package databases are catalogued in
+	// addition to the configured analyzers.
+	PackageManagerHints []string `yaml:"package_manager_hints" mapstructure:"package_manager_hints"`
 }
 
 type Input struct {
@@ -23,18 +23,20 @@ import (
 	sharedanalyzer "github.com/openclarity/kubeclarity/shared/pkg/analyzer"
 	"github.com/openclarity/kubeclarity/shared/pkg/analyzer/job"
 	"github.com/openclarity/kubeclarity/shared/pkg/converter"
-	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 	"github.com/openclarity/kubeclarity/shared/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/jobs"
+	"github.com/openclarity/vmclarity/shared/pkg/families/registry"
 	familiesresults "github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 )
 
 type SBOM struct {
-	logger *log.Entry
-	conf   Config
+	logger              *log.Entry
+	conf                Config
+	maxParallelScanners int
 }
 
 func (s SBOM) Run(res *familiesresults.Results) (interfaces.IsResults, error) {
@@ -52,7 +54,8 @@ func (s SBOM) Run(res *familiesresults.Results) (interfaces.IsResults, error) {
 		return nil, fmt.Errorf("failed to generate hash for source %s: %v", s.conf.Inputs[0].Input, err)
 	}
 
-	manager := job_manager.New(s.conf.AnalyzersList, s.conf.AnalyzersConfig, s.logger, job.Factory)
+	analyzersConfig := registry.WithAuths(s.conf.AnalyzersConfig, s.conf.RegistryAuths)
+	manager := jobs.New(s.conf.AnalyzersList, s.maxParallelScanners, analyzersConfig, s.logger, job.Factory)
 	mergedResults := sharedanalyzer.NewMergedResults(utils.SourceType(s.conf.Inputs[0].InputType), hash)
 
 	for _, input := range s.conf.Inputs {
@@ -66,6 +69,15 @@ func (s SBOM) Run(res *familiesresults.Results) (interfaces.IsResults, error) {
 			s.logger.Infof("Merging result from %q", name)
 			mergedResults = mergedResults.Merge(result.(*sharedanalyzer.Results)) // nolint:forcetypeassert
 		}
+
+		if len(s.conf.PackageManagerHints) > 0 {
+			s.logger.Infof("Cataloging package managers %v of input %q", s.conf.PackageManagerHints, input.Input)
+			results, err := catalogPackageManagers(s.conf.PackageManagerHints, input.Input, utils.SourceType(input.InputType))
+			if err != nil {
+				return nil, fmt.Errorf("failed to catalog package managers of input %q: %v", input.Input, err)
+			}
+			mergedResults = mergedResults.Merge(results)
+		}
 	}
 
 	for i, with := range s.conf.MergeWith {
@@ -94,7 +106,8 @@ func (s SBOM) Run(res *familiesresults.Results) (interfaces.IsResults, error) {
 	s.logger.Info("SBOM Done...")
 
 	return &Results{
-		SBOM: cdxBom,
+		SBOM:                cdxBom,
+		PackageManagerHints: s.conf.PackageManagerHints,
 	}, nil
 }
 
@@ -105,9 +118,10 @@ func (s SBOM) GetType() types.FamilyType {
 // ensure types implement the requisite interfaces.
 var _ interfaces.Family = &SBOM{}
 
-func New(logger *log.Entry, conf Config) *SBOM {
+func New(logger *log.Entry, conf Config, maxParallelScanners int) *SBOM {
 	return &SBOM{
-		conf:   conf,
-		logger: logger.Dup().WithField("family", "sbom"),
+		conf:                conf,
+		logger:              logger.Dup().WithField("family", "sbom"),
+		maxParallelScanners: maxParallelScanners,
 	}
 }
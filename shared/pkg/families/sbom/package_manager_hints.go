@@ -0,0 +1,151 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/formats/cyclonedxjson"
+	"github.com/anchore/syft/syft/pkg/cataloger"
+	syftsbom "github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	sharedanalyzer "github.com/openclarity/kubeclarity/shared/pkg/analyzer"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+)
+
+const packageManagerHintsAnalyzerName = "package_manager_hints"
+
+// packageManagerCatalogers maps the supported package managers to the syft
+// cataloger which reads their package database.
+var packageManagerCatalogers = map[string]string{
+	"apk":     "apkdb",
+	"dpkg":    "dpkgdb",
+	"rpm":     "rpm",
+	"portage": "portage",
+	"alpm":    "alpmdb",
+}
+
+// distroPackageManagers maps the supported distributions to their package
+// manager.
+var distroPackageManagers = map[string]string{
+	"alpine":      "apk",
+	"wolfi":       "apk",
+	"debian":      "dpkg",
+	"ubuntu":      "dpkg",
+	"rhel":        "rpm",
+	"centos":      "rpm",
+	"fedora":      "rpm",
+	"amazonlinux": "rpm",
+	"rocky":       "rpm",
+	"almalinux":   "rpm",
+	"oraclelinux": "rpm",
+	"sles":        "rpm",
+	"opensuse":    "rpm",
+	"gentoo":      "portage",
+	"arch":        "alpm",
+}
+
+// KnownPackageManagers lists the package managers which can be hinted.
+var KnownPackageManagers = sortedKeys(packageManagerCatalogers)
+
+// KnownDistros lists the distributions which can be hinted.
+var KnownDistros = sortedKeys(distroPackageManagers)
+
+// ResolvePackageManagerHints returns the sorted and de-duplicated package
+// managers for the given distro and package managers hints.
+func ResolvePackageManagerHints(distro string, packageManagers []string) ([]string, error) {
+	resolved := make(map[string]struct{}, len(packageManagers)+1)
+
+	if distro != "" {
+		packageManager, ok := distroPackageManagers[strings.ToLower(distro)]
+		if !ok {
+			return nil, fmt.Errorf("unknown distro %q, supported distros are: %s", distro, strings.Join(KnownDistros, ", "))
+		}
+		resolved[packageManager] = struct{}{}
+	}
+
+	for _, packageManager := range packageManagers {
+		packageManager = strings.ToLower(packageManager)
+		if _, ok := packageManagerCatalogers[packageManager]; !ok {
+			return nil, fmt.Errorf("unknown package manager %q, supported package managers are: %s",
+				packageManager, strings.Join(KnownPackageManagers, ", "))
+		}
+		resolved[packageManager] = struct{}{}
+	}
+
+	if len(resolved) == 0 {
+		return nil, nil
+	}
+
+	return sortedKeys(resolved), nil
+}
+
+// catalogPackageManagers runs the syft catalogers of the hinted package
+// managers on the input directory and returns the results so that they can
+// be merged with the results of the analyzers.
+func catalogPackageManagers(packageManagers []string, input string, sourceType utils.SourceType) (*sharedanalyzer.Results, error) {
+	catalogers := make([]string, 0, len(packageManagers))
+	for _, packageManager := range packageManagers {
+		cat, ok := packageManagerCatalogers[packageManager]
+		if !ok {
+			return nil, fmt.Errorf("unknown package manager %q", packageManager)
+		}
+		catalogers = append(catalogers, cat)
+	}
+
+	src, err := source.NewFromDirectory(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source from %s: %v", input, err)
+	}
+
+	catalogerConfig := cataloger.DefaultConfig()
+	catalogerConfig.Catalogers = catalogers
+	packages, relationships, release, err := syft.CatalogPackages(&src, catalogerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to catalog packages: %v", err)
+	}
+
+	sbom := syftsbom.SBOM{
+		Artifacts: syftsbom.Artifacts{
+			Packages:          packages,
+			LinuxDistribution: release,
+		},
+		Relationships: relationships,
+		Source:        src.Metadata,
+		Descriptor: syftsbom.Descriptor{
+			Name: "syft",
+		},
+	}
+
+	cdxBOMBytes, err := syft.Encode(sbom, syft.FormatByID(cyclonedxjson.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode SBOM: %v", err)
+	}
+
+	return sharedanalyzer.CreateResults(cdxBOMBytes, packageManagerHintsAnalyzerName, input, sourceType), nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolvePackageManagerHints(t *testing.T) {
+	type args struct {
+		distro          string
+		packageManagers []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "no hints",
+			args: args{},
+			want: nil,
+		},
+		{
+			name: "distro only",
+			args: args{
+				distro: "Alpine",
+			},
+			want: []string{"apk"},
+		},
+		{
+			name: "distro and package managers are merged",
+			args: args{
+				distro:          "ubuntu",
+				packageManagers: []string{"rpm", "dpkg"},
+			},
+			want: []string{"dpkg", "rpm"},
+		},
+		{
+			name: "unknown distro",
+			args: args{
+				distro: "not-a-distro",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown package manager",
+			args: args{
+				packageManagers: []string{"apk", "not-a-package-manager"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolvePackageManagerHints(tt.args.distro, tt.args.packageManagers)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolvePackageManagerHints() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ResolvePackageManagerHints() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
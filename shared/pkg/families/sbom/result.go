@@ -25,6 +25,9 @@ import (
 
 type Results struct {
 	SBOM *cdx.BOM
+	// PackageManagerHints lists the package managers whose package
+	// databases were catalogued because they were hinted.
+	PackageManagerHints []string
 }
 
 func (*Results) IsResults() {}
@@ -17,10 +17,12 @@ package common
 
 import (
 	gitleaksconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks/config"
+	trufflehogconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/trufflehog/config"
 )
 
 type ScannersConfig struct {
-	Gitleaks gitleaksconfig.Config `yaml:"gitleaks" mapstructure:"gitleaks"`
+	Gitleaks   gitleaksconfig.Config   `yaml:"gitleaks" mapstructure:"gitleaks"`
+	Trufflehog trufflehogconfig.Config `yaml:"trufflehog" mapstructure:"trufflehog"`
 }
 
 func (ScannersConfig) IsConfig() {}
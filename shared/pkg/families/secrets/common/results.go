@@ -15,8 +15,8 @@
 
 package common
 
-// Results for now will be as the gitleaks results struct since it is our only secret scanner.
-// once another secret scanner is integrated, we will need to think of a common scheme.
+// Results use the gitleaks findings scheme, the findings of other scanners
+// are converted to it.
 type Results struct {
 	Findings    []Findings
 	Source      string
@@ -19,6 +19,12 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
 )
 
+// KnownScanners lists the secrets scanners supported by the secrets family.
+var KnownScanners = []string{"gitleaks", "trufflehog"}
+
+// DefaultScanners lists the secrets scanners used when none are configured.
+var DefaultScanners = []string{"gitleaks"}
+
 type Config struct {
 	Enabled        bool                   `yaml:"enabled" mapstructure:"enabled"`
 	ScannersList   []string               `yaml:"scanners_list" mapstructure:"scanners_list"`
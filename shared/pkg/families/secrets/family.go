@@ -20,10 +20,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
-	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 	"github.com/openclarity/kubeclarity/shared/pkg/utils"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/jobs"
 	familiesresults "github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/job"
@@ -31,14 +31,15 @@ import (
 )
 
 type Secrets struct {
-	conf   Config
-	logger *log.Entry
+	conf                Config
+	logger              *log.Entry
+	maxParallelScanners int
 }
 
 func (s Secrets) Run(res *familiesresults.Results) (interfaces.IsResults, error) {
 	s.logger.Info("Secrets Run...")
 
-	manager := job_manager.New(s.conf.ScannersList, s.conf.ScannersConfig, s.logger, job.Factory)
+	manager := jobs.New(s.conf.ScannersList, s.maxParallelScanners, s.conf.ScannersConfig, s.logger, job.Factory)
 	mergedResults := NewMergedResults()
 
 	for _, input := range s.conf.Inputs {
@@ -67,9 +68,10 @@ func (s Secrets) GetType() types.FamilyType {
 // ensure types implement the requisite interfaces.
 var _ interfaces.Family = &Secrets{}
 
-func New(logger *log.Entry, conf Config) *Secrets {
+func New(logger *log.Entry, conf Config, maxParallelScanners int) *Secrets {
 	return &Secrets{
-		conf:   conf,
-		logger: logger.Dup().WithField("family", "secrets"),
+		conf:                conf,
+		logger:              logger.Dup().WithField("family", "secrets"),
+		maxParallelScanners: maxParallelScanners,
 	}
 }
@@ -15,6 +15,10 @@
 
 package config
 
+import "github.com/openclarity/vmclarity/shared/pkg/families/types"
+
 type Config struct {
+	types.CommandOptions `yaml:",inline" mapstructure:",squash"`
+
 	BinaryPath string `yaml:"binary_path" mapstructure:"binary_path"`
 }
@@ -79,6 +79,10 @@ func (a *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 		// ./gitleaks detect --source=<source> --no-git -r <report-path> -f json --exit-code 0
 		// nolint:gosec
 		cmd := exec.Command(a.config.BinaryPath, "detect", fmt.Sprintf("--source=%v", userInput), "--no-git", "-r", reportPath, "-f", "json", "--exit-code", "0")
+		if err := a.config.CommandOptions.Apply(cmd); err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to prepare gitleaks command: %v", err))
+			return
+		}
 		a.logger.Infof("Running gitleaks command: %v", cmd.String())
 		_, err = sharedutils.RunCommand(cmd)
 		if err != nil {
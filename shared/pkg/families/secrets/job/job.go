@@ -19,10 +19,12 @@ import (
 	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/trufflehog"
 )
 
 var Factory = job_manager.NewJobFactory()
 
 func init() {
 	Factory.Register(gitleaks.ScannerName, gitleaks.New)
+	Factory.Register(trufflehog.ScannerName, trufflehog.New)
 }
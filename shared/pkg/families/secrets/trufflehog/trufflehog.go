@@ -0,0 +1,190 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trufflehog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
+	trufflehogconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/trufflehog/config"
+	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const ScannerName = "trufflehog"
+
+// verifiedTag is added to the tags of findings whose secret was verified
+// by trufflehog against the service it belongs to.
+const verifiedTag = "verified"
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     trufflehogconfig.Config
+	resultChan chan job_manager.Result
+}
+
+// finding is a single secret reported by trufflehog in its JSON output.
+type finding struct {
+	SourceMetadata struct {
+		Data struct {
+			Filesystem struct {
+				File string `json:"file"`
+				Line int    `json:"line"`
+			} `json:"Filesystem"`
+		} `json:"Data"`
+	} `json:"SourceMetadata"`
+	DetectorName string `json:"DetectorName"`
+	Verified     bool   `json:"Verified"`
+	Raw          string `json:"Raw"`
+	Redacted     string `json:"Redacted"`
+}
+
+func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	conf := c.(*common.ScannersConfig) // nolint:forcetypeassert
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     trufflehogconfig.Config{BinaryPath: conf.Trufflehog.BinaryPath},
+		resultChan: resultChan,
+	}
+}
+
+func (a *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := common.Results{
+			Source:      userInput,
+			ScannerName: ScannerName,
+		}
+		if !a.isValidInputType(sourceType) {
+			a.sendResults(retResults, nil)
+			return
+		}
+		// validate that trufflehog binary exists
+		if _, err := os.Stat(a.config.BinaryPath); err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to find binary in %v: %v", a.config.BinaryPath, err))
+			return
+		}
+
+		// ./trufflehog filesystem <source> --json --no-update
+		// nolint:gosec
+		cmd := exec.Command(a.config.BinaryPath, "filesystem", userInput, "--json", "--no-update")
+		if err := a.config.CommandOptions.Apply(cmd); err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to prepare trufflehog command: %v", err))
+			return
+		}
+		a.logger.Infof("Running trufflehog command: %v", cmd.String())
+		out, err := sharedutils.RunCommand(cmd)
+		if err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to run trufflehog command: %v", err))
+			return
+		}
+
+		findings, err := parseOutput(out)
+		if err != nil {
+			a.sendResults(retResults, fmt.Errorf("failed to parse trufflehog output: %v", err))
+			return
+		}
+		retResults.Findings = findings
+		a.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+// parseOutput converts the JSON lines output of trufflehog to findings.
+func parseOutput(out []byte) ([]common.Findings, error) {
+	var findings []common.Findings
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	// A line holds the raw secret, which can be larger than the default
+	// maximum token size.
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 10*1024*1024) // nolint:gomnd
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var f finding
+		if err := json.Unmarshal(line, &f); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal line %s: %v", line, err)
+		}
+		findings = append(findings, toFindings(f))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan the output: %v", err)
+	}
+
+	return findings, nil
+}
+
+func toFindings(f finding) common.Findings {
+	file := f.SourceMetadata.Data.Filesystem.File
+	line := f.SourceMetadata.Data.Filesystem.Line
+
+	var tags []string
+	if f.Verified {
+		tags = append(tags, verifiedTag)
+	}
+
+	return common.Findings{
+		Description: fmt.Sprintf("%s secret", f.DetectorName),
+		StartLine:   line,
+		EndLine:     line,
+		Match:       f.Redacted,
+		Secret:      f.Raw,
+		File:        file,
+		Tags:        tags,
+		RuleID:      f.DetectorName,
+		// Same format as the gitleaks fingerprint of a finding outside
+		// of a git repository.
+		Fingerprint: fmt.Sprintf("%s:%s:%d", file, f.DetectorName, line),
+	}
+}
+
+func (a *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.DIR, utils.ROOTFS:
+		return true
+	case utils.FILE, utils.IMAGE, utils.SBOM:
+		fallthrough
+	default:
+		a.logger.Infof("source type %v is not supported for trufflehog, skipping.", sourceType)
+	}
+	return false
+}
+
+func (a *Scanner) sendResults(results common.Results, err error) {
+	if err != nil {
+		a.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case a.resultChan <- &results:
+	default:
+		a.logger.Error("Failed to send results on channel")
+	}
+}
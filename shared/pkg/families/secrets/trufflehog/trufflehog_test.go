@@ -0,0 +1,85 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trufflehog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
+)
+
+func Test_parseOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		out     []byte
+		want    []common.Findings
+		wantErr bool
+	}{
+		{
+			name: "no findings",
+			out:  []byte(""),
+			want: nil,
+		},
+		{
+			name: "findings",
+			out: []byte(`{"SourceMetadata":{"Data":{"Filesystem":{"file":"/mnt/etc/aws.env","line":3}}},"SourceID":0,"SourceType":15,"SourceName":"trufflehog - filesystem","DetectorType":2,"DetectorName":"AWS","DecoderName":"PLAIN","Verified":true,"Raw":"AKIAEXAMPLE","Redacted":"AKIAEXAMPLE","ExtraData":null}
+
+{"SourceMetadata":{"Data":{"Filesystem":{"file":"/mnt/home/user/.npmrc","line":1}}},"SourceID":0,"SourceType":15,"SourceName":"trufflehog - filesystem","DetectorType":19,"DetectorName":"NpmToken","DecoderName":"PLAIN","Verified":false,"Raw":"npm_secret","Redacted":"","ExtraData":null}
+`),
+			want: []common.Findings{
+				{
+					Description: "AWS secret",
+					StartLine:   3,
+					EndLine:     3,
+					Match:       "AKIAEXAMPLE",
+					Secret:      "AKIAEXAMPLE",
+					File:        "/mnt/etc/aws.env",
+					Tags:        []string{"verified"},
+					RuleID:      "AWS",
+					Fingerprint: "/mnt/etc/aws.env:AWS:3",
+				},
+				{
+					Description: "NpmToken secret",
+					StartLine:   1,
+					EndLine:     1,
+					Secret:      "npm_secret",
+					File:        "/mnt/home/user/.npmrc",
+					RuleID:      "NpmToken",
+					Fingerprint: "/mnt/home/user/.npmrc:NpmToken:1",
+				},
+			},
+		},
+		{
+			name:    "invalid output",
+			out:     []byte("not json"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOutput(tt.out)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseOutput() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseOutput() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
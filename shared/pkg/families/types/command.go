@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// unsafeCommandChars are the characters which are rejected in the extra
+// arguments and environment variables of a scanner. Some scanners are shell
+// scripts which pass their arguments on to other commands, so shell
+// metacharacters could be used to run arbitrary commands on the scanner.
+const unsafeCommandChars = "\x00\n\r;&|`$<>\\\"'"
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// CommandOptions are extra arguments and environment variables which are
+// passed to the binary of a scanner on top of the ones the scanner sets
+// itself, to tune the scanner without code changes.
+type CommandOptions struct {
+	// ExtraArgs are appended to the arguments of the scanner command.
+	ExtraArgs []string `yaml:"extra_args,omitempty" mapstructure:"extra_args"`
+	// Env is added to the environment the scanner command inherits.
+	Env map[string]string `yaml:"env,omitempty" mapstructure:"env"`
+}
+
+// Validate checks that the arguments and the environment variables don't
+// contain shell metacharacters and that the environment variables don't
+// change which libraries the scanner loads.
+func (o CommandOptions) Validate() error {
+	for _, arg := range o.ExtraArgs {
+		if arg == "" {
+			return fmt.Errorf("extra argument must not be empty")
+		}
+		if strings.ContainsAny(arg, unsafeCommandChars) {
+			return fmt.Errorf("extra argument %q contains unsafe characters", arg)
+		}
+	}
+
+	for name, value := range o.Env {
+		if !envNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid environment variable name %q", name)
+		}
+		if strings.HasPrefix(name, "LD_") {
+			return fmt.Errorf("environment variable %s is not allowed", name)
+		}
+		if strings.ContainsAny(value, unsafeCommandChars) {
+			return fmt.Errorf("value of environment variable %s contains unsafe characters", name)
+		}
+	}
+
+	return nil
+}
+
+// Apply validates the options and adds them to the command.
+func (o CommandOptions) Apply(cmd *exec.Cmd) error {
+	if err := o.Validate(); err != nil {
+		return fmt.Errorf("invalid command options: %w", err)
+	}
+
+	cmd.Args = append(cmd.Args, o.ExtraArgs...)
+
+	if len(o.Env) > 0 {
+		if cmd.Env == nil {
+			cmd.Env = os.Environ()
+		}
+		names := make([]string, 0, len(o.Env))
+		for name := range o.Env {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			cmd.Env = append(cmd.Env, name+"="+o.Env[name])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCommandOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options CommandOptions
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			options: CommandOptions{},
+			wantErr: false,
+		},
+		{
+			name: "valid",
+			options: CommandOptions{
+				ExtraArgs: []string{"--config", "/etc/gitleaks.toml", "--profile=/etc/lynis/custom.prf"},
+				Env:       map[string]string{"HTTPS_PROXY": "http://proxy:3128"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "command separator in argument",
+			options: CommandOptions{
+				ExtraArgs: []string{"--config", "/etc/gitleaks.toml; rm -rf /"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "command substitution in argument",
+			options: CommandOptions{
+				ExtraArgs: []string{"$(id)"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty argument",
+			options: CommandOptions{
+				ExtraArgs: []string{""},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid environment variable name",
+			options: CommandOptions{
+				Env: map[string]string{"A=B": "c"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "library preloading",
+			options: CommandOptions{
+				Env: map[string]string{"LD_PRELOAD": "/tmp/lib.so"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pipe in environment variable value",
+			options: CommandOptions{
+				Env: map[string]string{"OPTS": "a | b"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.options.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommandOptions_Apply(t *testing.T) {
+	options := CommandOptions{
+		ExtraArgs: []string{"--config", "/etc/gitleaks.toml"},
+		Env:       map[string]string{"B": "2", "A": "1"},
+	}
+
+	cmd := exec.Command("/artifacts/gitleaks", "detect")
+	cmd.Env = []string{"HOME=/root"}
+	if err := options.Apply(cmd); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"/artifacts/gitleaks", "detect", "--config", "/etc/gitleaks.toml"}, cmd.Args); diff != "" {
+		t.Errorf("Apply() args mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"HOME=/root", "A=1", "B=2"}, cmd.Env); diff != "" {
+		t.Errorf("Apply() env mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerabilities
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const aliasesDownloadTimeout = 30 * time.Second
+
+// Aliases maps the vulnerability IDs reported by the different scanners
+// (GHSA, vendor advisories, etc.) to a canonical CVE ID.
+type Aliases map[string]string
+
+// LoadAliases loads the alias dataset from a local file path or from an
+// http(s) URL, so it can be served from an internal mirror in air-gapped
+// environments. The dataset is a JSON (or YAML) object of alias ID to
+// canonical CVE ID, for example: {"GHSA-jfh8-c2jp-5v3q": "CVE-2021-44228"}.
+// The certificates of the PEM CA bundle, if set, are trusted on top of the
+// system ones when downloading the dataset.
+func LoadAliases(source, caBundlePath string) (Aliases, error) {
+	data, err := readAliasesSource(source, caBundlePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]string
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal aliases: %v", err)
+	}
+
+	aliases := make(Aliases, len(raw))
+	for alias, canonical := range raw {
+		aliases[strings.ToUpper(alias)] = canonical
+	}
+
+	return aliases, nil
+}
+
+// Canonical returns the canonical ID of the given vulnerability ID, or the ID
+// itself if it has no known alias.
+func (a Aliases) Canonical(id string) string {
+	if canonical, ok := a[strings.ToUpper(id)]; ok {
+		return canonical
+	}
+	return id
+}
+
+func readAliasesSource(source, caBundlePath string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read aliases file %s: %v", source, err)
+		}
+		return data, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), aliasesDownloadTimeout)
+	defer cancel()
+
+	client, err := utils.NewHTTPClientWithCABundle(caBundlePath, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aliases HTTP client: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aliases request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download aliases from %s: %v", source, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download aliases from %s: unexpected status %s", source, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aliases response: %v", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,126 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerabilities
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadAliases(t *testing.T) {
+	const dataset = `{"GHSA-jfh8-c2jp-5v3q": "CVE-2021-44228", "DLA-3152-1": "CVE-2022-42889"}`
+	want := Aliases{
+		"GHSA-JFH8-C2JP-5V3Q": "CVE-2021-44228",
+		"DLA-3152-1":          "CVE-2022-42889",
+	}
+
+	aliasesFile := filepath.Join(t.TempDir(), "aliases.json")
+	if err := os.WriteFile(aliasesFile, []byte(dataset), 0o600); err != nil {
+		t.Fatalf("failed to write aliases file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/aliases.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(dataset))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		source  string
+		want    Aliases
+		wantErr bool
+	}{
+		{
+			name:   "local file",
+			source: aliasesFile,
+			want:   want,
+		},
+		{
+			name:   "url",
+			source: server.URL + "/aliases.json",
+			want:   want,
+		},
+		{
+			name:    "missing file",
+			source:  filepath.Join(t.TempDir(), "missing.json"),
+			wantErr: true,
+		},
+		{
+			name:    "url not found",
+			source:  server.URL + "/missing.json",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadAliases(tt.source, "")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadAliases() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("LoadAliases() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAliases_Canonical(t *testing.T) {
+	aliases := Aliases{
+		"GHSA-JFH8-C2JP-5V3Q": "CVE-2021-44228",
+	}
+
+	tests := []struct {
+		name    string
+		aliases Aliases
+		id      string
+		want    string
+	}{
+		{
+			name:    "alias",
+			aliases: aliases,
+			id:      "GHSA-jfh8-c2jp-5v3q",
+			want:    "CVE-2021-44228",
+		},
+		{
+			name:    "unknown id",
+			aliases: aliases,
+			id:      "CVE-2022-42889",
+			want:    "CVE-2022-42889",
+		},
+		{
+			name:    "nil aliases",
+			aliases: nil,
+			id:      "GHSA-jfh8-c2jp-5v3q",
+			want:    "GHSA-jfh8-c2jp-5v3q",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.aliases.Canonical(tt.id); got != tt.want {
+				t.Errorf("Canonical() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
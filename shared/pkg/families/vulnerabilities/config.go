@@ -18,15 +18,39 @@ package vulnerabilities
 import (
 	"github.com/openclarity/kubeclarity/shared/pkg/config"
 
+	"github.com/openclarity/vmclarity/shared/pkg/families/registry"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	osvconfig "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/osv/config"
 )
 
+// KnownScanners lists the vulnerability scanners supported by the
+// vulnerabilities family.
+var KnownScanners = []string{"grype", "trivy", "osv"}
+
+// DefaultScanners lists the vulnerability scanners used when none are
+// configured.
+var DefaultScanners = []string{"grype", "trivy"}
+
 type Config struct {
 	Enabled        bool           `yaml:"enabled" mapstructure:"enabled"`
 	ScannersList   []string       `yaml:"scanners_list" mapstructure:"scanners_list"`
 	Inputs         []Input        `yaml:"inputs" mapstructure:"inputs"`
 	InputFromSbom  bool           `yaml:"input_from_sbom" mapstructure:"input_from_sbom"`
 	ScannersConfig *config.Config `yaml:"scanners_config" mapstructure:"scanners_config"`
+	// RegistryAuths are the credentials of the registries, added to the
+	// ScannersConfig when the family runs.
+	RegistryAuths []registry.Auth `yaml:"registry_auths,omitempty" mapstructure:"registry_auths"`
+	// AliasesSource is a file path or an http(s) URL of a vulnerability
+	// alias dataset used to normalize the reported IDs to a canonical CVE.
+	// It is loaded on every run so that updates to it are picked up.
+	AliasesSource string `yaml:"aliases_source" mapstructure:"aliases_source"`
+	// CABundlePath is a PEM CA bundle trusted on top of the system
+	// certificates when downloading the aliases, e.g. of a TLS intercepting
+	// proxy.
+	CABundlePath string `yaml:"ca_bundle_path" mapstructure:"ca_bundle_path"`
+	// OSV configures the OSV scanner. It is kept outside of ScannersConfig
+	// since that is the kubeclarity scanners config.
+	OSV osvconfig.Config `yaml:"osv" mapstructure:"osv"`
 }
 
 type Input struct {
@@ -22,14 +22,20 @@ import (
 	"github.com/openclarity/kubeclarity/shared/pkg/config"
 	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 	sharedscanner "github.com/openclarity/kubeclarity/shared/pkg/scanner"
-	"github.com/openclarity/kubeclarity/shared/pkg/scanner/job"
+	"github.com/openclarity/kubeclarity/shared/pkg/scanner/dependency_track"
+	"github.com/openclarity/kubeclarity/shared/pkg/scanner/grype"
+	"github.com/openclarity/kubeclarity/shared/pkg/scanner/trivy"
 	"github.com/openclarity/kubeclarity/shared/pkg/utils"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/jobs"
+	"github.com/openclarity/vmclarity/shared/pkg/families/registry"
 	"github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/osv"
+	osvconfig "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/osv/config"
 )
 
 const (
@@ -37,15 +43,17 @@ const (
 )
 
 type Vulnerabilities struct {
-	logger         *log.Entry
-	conf           Config
-	ScannersConfig config.Config
+	logger              *log.Entry
+	conf                Config
+	ScannersConfig      config.Config
+	maxParallelScanners int
 }
 
 func (v Vulnerabilities) Run(res *results.Results) (interfaces.IsResults, error) {
 	v.logger.Info("Vulnerabilities Run...")
 
-	manager := job_manager.New(v.conf.ScannersList, v.conf.ScannersConfig, v.logger, job.Factory)
+	scannersConfig := registry.WithAuths(v.conf.ScannersConfig, v.conf.RegistryAuths)
+	manager := jobs.New(v.conf.ScannersList, v.maxParallelScanners, scannersConfig, v.logger, newJobFactory(v.conf.OSV))
 	mergedResults := sharedscanner.NewMergedResults()
 
 	if v.conf.InputFromSbom {
@@ -97,13 +105,36 @@ func (v Vulnerabilities) Run(res *results.Results) (interfaces.IsResults, error)
 		//})
 	}
 
+	var aliases Aliases
+	if v.conf.AliasesSource != "" {
+		var err error
+		aliases, err = LoadAliases(v.conf.AliasesSource, v.conf.CABundlePath)
+		if err != nil {
+			v.logger.Warnf("Failed to load vulnerability aliases, vulnerability IDs will not be normalized: %v", err)
+		}
+	}
+
 	v.logger.Info("Vulnerabilities Done...")
 
 	return &Results{
 		MergedResults: mergedResults,
+		Aliases:       aliases,
 	}, nil
 }
 
+// newJobFactory creates a factory of the kubeclarity scanners together with
+// the OSV scanner, which is created with its own config.
+func newJobFactory(osvConf osvconfig.Config) *job_manager.Factory {
+	factory := job_manager.NewJobFactory()
+	factory.Register(grype.ScannerName, grype.New)
+	factory.Register(dependency_track.ScannerName, dependency_track.New)
+	factory.Register(trivy.ScannerName, trivy.New)
+	factory.Register(osv.ScannerName, func(_ job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+		return osv.New(osvConf, logger, resultChan)
+	})
+	return factory
+}
+
 func (v Vulnerabilities) GetType() types.FamilyType {
 	return types.Vulnerabilities
 }
@@ -111,9 +142,10 @@ func (v Vulnerabilities) GetType() types.FamilyType {
 // ensure types implement the requisite interfaces.
 var _ interfaces.Family = &Vulnerabilities{}
 
-func New(logger *log.Entry, conf Config) *Vulnerabilities {
+func New(logger *log.Entry, conf Config, maxParallelScanners int) *Vulnerabilities {
 	return &Vulnerabilities{
-		logger: logger.Dup().WithField("family", "vulnerabilities"),
-		conf:   conf,
+		logger:              logger.Dup().WithField("family", "vulnerabilities"),
+		conf:                conf,
+		maxParallelScanners: maxParallelScanners,
 	}
 }
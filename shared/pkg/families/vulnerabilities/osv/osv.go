@@ -0,0 +1,345 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	sharedscanner "github.com/openclarity/kubeclarity/shared/pkg/scanner"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+
+	osvconfig "github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/osv/config"
+	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const ScannerName = "osv"
+
+const (
+	// vulnerabilitiesFoundExitCode is returned by osv-scanner when
+	// vulnerabilities were found.
+	vulnerabilitiesFoundExitCode = 1
+	// noPackagesFoundExitCode is returned by osv-scanner when the input
+	// doesn't contain any package it can scan.
+	noPackagesFoundExitCode = 128
+
+	// sbomFileName is the name the SBOM is copied to before it is passed to
+	// osv-scanner, which detects the SBOM format by the file name.
+	sbomFileName = "bom.cdx.json"
+
+	sbomSourceType = "sbom"
+)
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     osvconfig.Config
+	resultChan chan job_manager.Result
+}
+
+// output is the JSON output of osv-scanner.
+type output struct {
+	Results []struct {
+		Source struct {
+			Path string `json:"path"`
+			Type string `json:"type"`
+		} `json:"source"`
+		Packages []struct {
+			Package struct {
+				Name      string `json:"name"`
+				Version   string `json:"version"`
+				Ecosystem string `json:"ecosystem"`
+			} `json:"package"`
+			Vulnerabilities []vulnerability `json:"vulnerabilities"`
+		} `json:"packages"`
+	} `json:"results"`
+}
+
+type vulnerability struct {
+	ID         string   `json:"id"`
+	Aliases    []string `json:"aliases"`
+	Summary    string   `json:"summary"`
+	Details    string   `json:"details"`
+	References []struct {
+		URL string `json:"url"`
+	} `json:"references"`
+	Affected []struct {
+		Ranges []struct {
+			Events []struct {
+				Fixed string `json:"fixed"`
+			} `json:"events"`
+		} `json:"ranges"`
+	} `json:"affected"`
+	DatabaseSpecific struct {
+		Severity string `json:"severity"`
+	} `json:"database_specific"`
+}
+
+// New creates an osv-scanner job. Unlike the kubeclarity scanners the OSV
+// scanner has its own config, so it is created by a closure registered in
+// the vulnerabilities job factory instead of being registered directly.
+func New(conf osvconfig.Config, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     conf,
+		resultChan: resultChan,
+	}
+}
+
+func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := sharedscanner.Results{
+			ScannerInfo: sharedscanner.Info{
+				Name: ScannerName,
+			},
+		}
+		if !s.isValidInputType(sourceType) {
+			s.sendResults(retResults, nil)
+			return
+		}
+		// validate that osv-scanner binary exists
+		if _, err := os.Stat(s.config.BinaryPath); err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to find binary in %v: %v", s.config.BinaryPath, err))
+			return
+		}
+
+		args := []string{"--format", "json"}
+		if sourceType == utils.SBOM {
+			sbomDir, err := copySBOM(userInput)
+			if err != nil {
+				s.sendResults(retResults, fmt.Errorf("failed to prepare sbom: %v", err))
+				return
+			}
+			defer os.RemoveAll(sbomDir)
+			args = append(args, "--sbom", filepath.Join(sbomDir, sbomFileName))
+		} else {
+			args = append(args, "--recursive", userInput)
+		}
+
+		// ./osv-scanner --format json --sbom <sbom> | --recursive <dir>
+		// nolint:gosec
+		cmd := exec.Command(s.config.BinaryPath, args...)
+		if err := s.config.CommandOptions.Apply(cmd); err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to prepare osv-scanner command: %v", err))
+			return
+		}
+		s.logger.Infof("Running osv-scanner command: %v", cmd.String())
+		out, err := sharedutils.RunCommand(cmd)
+		if err != nil {
+			out, err = handleRunError(err)
+			if err != nil {
+				s.sendResults(retResults, fmt.Errorf("failed to run osv-scanner command: %v", err))
+				return
+			}
+		}
+
+		matches, err := parseOutput(out)
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to parse osv-scanner output: %v", err))
+			return
+		}
+		retResults.Matches = matches
+		s.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+// copySBOM copies the SBOM to a temporary directory under a file name that
+// osv-scanner recognizes as CycloneDX and returns the directory.
+func copySBOM(sbomPath string) (string, error) {
+	sbomBytes, err := os.ReadFile(sbomPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read sbom file: %v", err)
+	}
+
+	dir, err := os.MkdirTemp("", "osv-scanner")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp dir: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, sbomFileName), sbomBytes, 0600 /* read & write */); err != nil { // nolint:gomnd,gofumpt
+		_ = os.RemoveAll(dir)
+		return "", fmt.Errorf("failed to write sbom file: %v", err)
+	}
+
+	return dir, nil
+}
+
+// handleRunError returns the command output if the error only reports that
+// osv-scanner found vulnerabilities or didn't find any package.
+func handleRunError(err error) ([]byte, error) {
+	var cmdErr sharedutils.CmdRunError
+	if !errors.As(err, &cmdErr) {
+		return nil, err
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(cmdErr.Err, &exitErr) {
+		return nil, err
+	}
+
+	switch exitErr.ExitCode() {
+	case vulnerabilitiesFoundExitCode:
+		return cmdErr.Stdout, nil
+	case noPackagesFoundExitCode:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// parseOutput converts the JSON output of osv-scanner to matches.
+func parseOutput(out []byte) (sharedscanner.Matches, error) {
+	if len(out) == 0 {
+		return nil, nil
+	}
+
+	var o output
+	if err := json.Unmarshal(out, &o); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal output: %v", err)
+	}
+
+	var matches sharedscanner.Matches
+	for _, result := range o.Results {
+		var path string
+		// The SBOM is scanned from a temporary copy, so its path is
+		// meaningless to the user.
+		if result.Source.Type != sbomSourceType {
+			path = result.Source.Path
+		}
+		for _, pkg := range result.Packages {
+			for _, vul := range pkg.Vulnerabilities {
+				matches = append(matches, sharedscanner.Match{
+					Vulnerability: sharedscanner.Vulnerability{
+						ID:          getID(vul),
+						Description: getDescription(vul),
+						Links:       getLinks(vul),
+						Fix:         getFix(vul),
+						Severity:    getSeverity(vul),
+						Package: sharedscanner.Package{
+							Name:    pkg.Package.Name,
+							Version: pkg.Package.Version,
+							Type:    strings.ToLower(pkg.Package.Ecosystem),
+						},
+						Path: path,
+					},
+				})
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// getID prefers a CVE alias so that the vulnerability is merged with the
+// results of the other scanners, which report CVE IDs.
+func getID(vul vulnerability) string {
+	if strings.HasPrefix(vul.ID, "CVE-") {
+		return vul.ID
+	}
+	for _, alias := range vul.Aliases {
+		if strings.HasPrefix(alias, "CVE-") {
+			return alias
+		}
+	}
+	return vul.ID
+}
+
+func getDescription(vul vulnerability) string {
+	if vul.Summary != "" {
+		return vul.Summary
+	}
+	return vul.Details
+}
+
+func getLinks(vul vulnerability) []string {
+	links := make([]string, 0, len(vul.References))
+	for _, ref := range vul.References {
+		links = append(links, ref.URL)
+	}
+	return links
+}
+
+func getFix(vul vulnerability) sharedscanner.Fix {
+	var versions []string
+	for _, affected := range vul.Affected {
+		for _, r := range affected.Ranges {
+			for _, event := range r.Events {
+				if event.Fixed != "" && !sharedutils.Contains(versions, event.Fixed) {
+					versions = append(versions, event.Fixed)
+				}
+			}
+		}
+	}
+
+	state := "not-fixed"
+	if len(versions) > 0 {
+		state = "fixed"
+	}
+
+	return sharedscanner.Fix{
+		Versions: versions,
+		State:    state,
+	}
+}
+
+// getSeverity converts the severity reported by the advisory database
+// (for example GitHub advisories use LOW, MODERATE, HIGH and CRITICAL) to
+// the severities used by the other scanners.
+func getSeverity(vul vulnerability) string {
+	switch severity := strings.ToUpper(vul.DatabaseSpecific.Severity); severity {
+	case "":
+		return "UNKNOWN"
+	case "MODERATE":
+		return "MEDIUM"
+	default:
+		return severity
+	}
+}
+
+func (s *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.SBOM, utils.DIR, utils.ROOTFS:
+		return true
+	case utils.FILE, utils.IMAGE:
+		fallthrough
+	default:
+		s.logger.Infof("source type %v is not supported for osv-scanner, skipping.", sourceType)
+	}
+	return false
+}
+
+func (s *Scanner) sendResults(results sharedscanner.Results, err error) {
+	if err != nil {
+		s.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case s.resultChan <- &results:
+	default:
+		s.logger.Error("Failed to send results on channel")
+	}
+}
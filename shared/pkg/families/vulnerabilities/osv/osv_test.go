@@ -0,0 +1,148 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sharedscanner "github.com/openclarity/kubeclarity/shared/pkg/scanner"
+)
+
+func Test_parseOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		out     []byte
+		want    sharedscanner.Matches
+		wantErr bool
+	}{
+		{
+			name: "no output",
+			out:  nil,
+			want: nil,
+		},
+		{
+			name: "no vulnerabilities",
+			out:  []byte(`{"results":[]}`),
+			want: nil,
+		},
+		{
+			name: "vulnerabilities",
+			out: []byte(`{
+  "results": [
+    {
+      "source": {"path": "/mnt/app/package-lock.json", "type": "lockfile"},
+      "packages": [
+        {
+          "package": {"name": "lodash", "version": "4.17.15", "ecosystem": "npm"},
+          "vulnerabilities": [
+            {
+              "id": "GHSA-p6mc-m468-83gw",
+              "aliases": ["CVE-2020-8203"],
+              "summary": "Prototype Pollution in lodash",
+              "details": "Versions of lodash prior to 4.17.19 are vulnerable to Prototype Pollution.",
+              "affected": [
+                {"ranges": [{"type": "SEMVER", "events": [{"introduced": "3.7.0"}, {"fixed": "4.17.19"}]}]},
+                {"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.19"}]}]}
+              ],
+              "references": [
+                {"type": "ADVISORY", "url": "https://nvd.nist.gov/vuln/detail/CVE-2020-8203"},
+                {"type": "PACKAGE", "url": "https://github.com/lodash/lodash"}
+              ],
+              "database_specific": {"severity": "MODERATE"}
+            }
+          ]
+        }
+      ]
+    },
+    {
+      "source": {"path": "/tmp/osv-scanner123/bom.cdx.json", "type": "sbom"},
+      "packages": [
+        {
+          "package": {"name": "golang.org/x/net", "version": "0.1.0", "ecosystem": "Go"},
+          "vulnerabilities": [
+            {
+              "id": "GO-2022-1144",
+              "details": "An attacker can cause excessive memory growth in a Go server accepting HTTP/2 requests.",
+              "affected": [
+                {"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}]}]}
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`),
+			want: sharedscanner.Matches{
+				{
+					Vulnerability: sharedscanner.Vulnerability{
+						ID:          "CVE-2020-8203",
+						Description: "Prototype Pollution in lodash",
+						Links: []string{
+							"https://nvd.nist.gov/vuln/detail/CVE-2020-8203",
+							"https://github.com/lodash/lodash",
+						},
+						Fix: sharedscanner.Fix{
+							Versions: []string{"4.17.19"},
+							State:    "fixed",
+						},
+						Severity: "MEDIUM",
+						Package: sharedscanner.Package{
+							Name:    "lodash",
+							Version: "4.17.15",
+							Type:    "npm",
+						},
+						Path: "/mnt/app/package-lock.json",
+					},
+				},
+				{
+					Vulnerability: sharedscanner.Vulnerability{
+						ID:          "GO-2022-1144",
+						Description: "An attacker can cause excessive memory growth in a Go server accepting HTTP/2 requests.",
+						Links:       []string{},
+						Fix: sharedscanner.Fix{
+							State: "not-fixed",
+						},
+						Severity: "UNKNOWN",
+						Package: sharedscanner.Package{
+							Name:    "golang.org/x/net",
+							Version: "0.1.0",
+							Type:    "go",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid output",
+			out:     []byte("not json"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOutput(tt.out)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseOutput() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseOutput() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,8 @@ import "github.com/openclarity/kubeclarity/shared/pkg/scanner"
 
 type Results struct {
 	MergedResults *scanner.MergedResults
+	// Aliases used to normalize the vulnerability IDs, nil if not configured.
+	Aliases Aliases
 }
 
 func (*Results) IsResults() {}
@@ -0,0 +1,147 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// ValidateIgnoreRule checks that the rule has at least one condition, that
+// its conditions don't belong to different finding types, since it would
+// never match, and that its path is a valid glob.
+func ValidateIgnoreRule(rule models.IgnoreRule) error {
+	typeConditions := 0
+	for _, condition := range []*string{rule.VulnerabilityName, rule.SecretRuleID, rule.MisconfigurationTestID} {
+		if condition != nil {
+			typeConditions++
+		}
+	}
+	if typeConditions == 0 && rule.Path == nil {
+		return errors.New("at least one of vulnerabilityName, path, secretRuleID or misconfigurationTestID must be set")
+	}
+	if typeConditions > 1 {
+		return errors.New("only one of vulnerabilityName, secretRuleID and misconfigurationTestID can be set")
+	}
+	if rule.Path != nil {
+		if _, err := path.Match(*rule.Path, ""); err != nil {
+			return fmt.Errorf("invalid path glob %q: %w", *rule.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// GetIgnoredFindingsSummary returns the totals of the vulnerabilities, secrets
+// and misconfigurations of the scan result which match one of the ignore
+// rules.
+func GetIgnoredFindingsSummary(ignoreRules []models.IgnoreRule, scanResult models.TargetScanResult) *models.ScanFindingsSummary {
+	var vulnerabilities []models.Vulnerability
+	if scanResult.Vulnerabilities != nil && scanResult.Vulnerabilities.Vulnerabilities != nil {
+		for _, vulnerability := range *scanResult.Vulnerabilities.Vulnerabilities {
+			if isIgnored(ignoreRules, func(rule models.IgnoreRule) bool { return vulnerabilityIgnored(rule, vulnerability) }) {
+				vulnerabilities = append(vulnerabilities, vulnerability)
+			}
+		}
+	}
+
+	secrets := 0
+	if scanResult.Secrets != nil && scanResult.Secrets.Secrets != nil {
+		for _, secret := range *scanResult.Secrets.Secrets {
+			if isIgnored(ignoreRules, func(rule models.IgnoreRule) bool { return secretIgnored(rule, secret) }) {
+				secrets++
+			}
+		}
+	}
+
+	misconfigurations := 0
+	if scanResult.Misconfigurations != nil && scanResult.Misconfigurations.Misconfigurations != nil {
+		for _, misconfiguration := range *scanResult.Misconfigurations.Misconfigurations {
+			if isIgnored(ignoreRules, func(rule models.IgnoreRule) bool { return misconfigurationIgnored(rule, misconfiguration) }) {
+				misconfigurations++
+			}
+		}
+	}
+
+	return &models.ScanFindingsSummary{
+		TotalMisconfigurations: utils.PointerTo(misconfigurations),
+		TotalSecrets:           utils.PointerTo(secrets),
+		TotalVulnerabilities:   GetVulnerabilityTotalsPerSeverity(&vulnerabilities),
+	}
+}
+
+func isIgnored(ignoreRules []models.IgnoreRule, matches func(models.IgnoreRule) bool) bool {
+	for _, rule := range ignoreRules {
+		if matches(rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func vulnerabilityIgnored(rule models.IgnoreRule, vulnerability models.Vulnerability) bool {
+	if rule.SecretRuleID != nil || rule.MisconfigurationTestID != nil {
+		return false
+	}
+	if rule.VulnerabilityName == nil && rule.Path == nil {
+		return false
+	}
+	return stringPtrMatches(rule.VulnerabilityName, vulnerability.VulnerabilityName) && pathPtrMatches(rule.Path, vulnerability.Path)
+}
+
+func secretIgnored(rule models.IgnoreRule, secret models.Secret) bool {
+	if rule.VulnerabilityName != nil || rule.MisconfigurationTestID != nil {
+		return false
+	}
+	if rule.SecretRuleID == nil && rule.Path == nil {
+		return false
+	}
+	return stringPtrMatches(rule.SecretRuleID, secret.RuleID) && pathPtrMatches(rule.Path, secret.FilePath)
+}
+
+func misconfigurationIgnored(rule models.IgnoreRule, misconfiguration models.Misconfiguration) bool {
+	if rule.VulnerabilityName != nil || rule.SecretRuleID != nil {
+		return false
+	}
+	if rule.MisconfigurationTestID == nil && rule.Path == nil {
+		return false
+	}
+	return stringPtrMatches(rule.MisconfigurationTestID, misconfiguration.TestID) && pathPtrMatches(rule.Path, misconfiguration.ScannedPath)
+}
+
+// pathPtrMatches returns true if pattern isn't set, or if the path or one of
+// its parent directories matches the pattern.
+func pathPtrMatches(pattern, value *string) bool {
+	if pattern == nil {
+		return true
+	}
+	if value == nil || *value == "" {
+		return false
+	}
+
+	for p := path.Clean(*value); ; p = path.Dir(p) {
+		if matched, _ := path.Match(*pattern, p); matched {
+			return true
+		}
+		if parent := path.Dir(p); parent == p {
+			return false
+		}
+	}
+}
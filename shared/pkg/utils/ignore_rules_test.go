@@ -0,0 +1,184 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+func TestValidateIgnoreRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    models.IgnoreRule
+		wantErr bool
+	}{
+		{
+			name: "vulnerability",
+			rule: models.IgnoreRule{
+				VulnerabilityName: utils.PointerTo("CVE-2023-1"),
+				Reason:            utils.PointerTo("not exploitable"),
+			},
+		},
+		{
+			name: "path",
+			rule: models.IgnoreRule{Path: utils.PointerTo("/opt/*/testdata")},
+		},
+		{
+			name:    "no conditions",
+			rule:    models.IgnoreRule{Reason: utils.PointerTo("accepted")},
+			wantErr: true,
+		},
+		{
+			name: "conditions of different finding types",
+			rule: models.IgnoreRule{
+				VulnerabilityName: utils.PointerTo("CVE-2023-1"),
+				SecretRuleID:      utils.PointerTo("generic-api-key"),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid path glob",
+			rule:    models.IgnoreRule{Path: utils.PointerTo("/opt/[")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateIgnoreRule(tt.rule); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateIgnoreRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetIgnoredFindingsSummary(t *testing.T) {
+	scanResult := models.TargetScanResult{
+		Vulnerabilities: &models.VulnerabilityScan{
+			Vulnerabilities: &[]models.Vulnerability{
+				{
+					VulnerabilityName: utils.PointerTo("CVE-2023-1"),
+					Severity:          utils.PointerTo(models.CRITICAL),
+					Path:              utils.PointerTo("/usr/lib/libssl.so"),
+				},
+				{
+					VulnerabilityName: utils.PointerTo("CVE-2023-2"),
+					Severity:          utils.PointerTo(models.HIGH),
+					Path:              utils.PointerTo("/opt/app/testdata/go.sum"),
+				},
+				{
+					VulnerabilityName: utils.PointerTo("CVE-2023-3"),
+					Severity:          utils.PointerTo(models.HIGH),
+					Path:              utils.PointerTo("/usr/lib/libz.so"),
+				},
+			},
+		},
+		Secrets: &models.SecretScan{
+			Secrets: &[]models.Secret{
+				{
+					RuleID:   utils.PointerTo("generic-api-key"),
+					FilePath: utils.PointerTo("/etc/app.conf"),
+				},
+				{
+					RuleID:   utils.PointerTo("private-key"),
+					FilePath: utils.PointerTo("/opt/app/testdata/key.pem"),
+				},
+				{
+					RuleID:   utils.PointerTo("private-key"),
+					FilePath: utils.PointerTo("/root/.ssh/id_rsa"),
+				},
+			},
+		},
+		Misconfigurations: &models.MisconfigurationScan{
+			Misconfigurations: &[]models.Misconfiguration{
+				{
+					TestID:      utils.PointerTo("SSH-7408"),
+					ScannedPath: utils.PointerTo("/"),
+				},
+				{
+					TestID:      utils.PointerTo("AUTH-9262"),
+					ScannedPath: utils.PointerTo("/"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		ignoreRules []models.IgnoreRule
+		want        *models.ScanFindingsSummary
+	}{
+		{
+			name:        "no rules",
+			ignoreRules: nil,
+			want: &models.ScanFindingsSummary{
+				TotalMisconfigurations: utils.PointerTo(0),
+				TotalSecrets:           utils.PointerTo(0),
+				TotalVulnerabilities:   GetVulnerabilityTotalsPerSeverity(nil),
+			},
+		},
+		{
+			name: "rules of each finding type",
+			ignoreRules: []models.IgnoreRule{
+				{VulnerabilityName: utils.PointerTo("CVE-2023-1")},
+				{SecretRuleID: utils.PointerTo("generic-api-key")},
+				{MisconfigurationTestID: utils.PointerTo("SSH-7408")},
+				// Matches nothing, the vulnerability is found in another path.
+				{VulnerabilityName: utils.PointerTo("CVE-2023-3"), Path: utils.PointerTo("/opt/*")},
+			},
+			want: &models.ScanFindingsSummary{
+				TotalMisconfigurations: utils.PointerTo(1),
+				TotalSecrets:           utils.PointerTo(1),
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalCriticalVulnerabilities:   utils.PointerTo(1),
+					TotalHighVulnerabilities:       utils.PointerTo(0),
+					TotalMediumVulnerabilities:     utils.PointerTo(0),
+					TotalLowVulnerabilities:        utils.PointerTo(0),
+					TotalNegligibleVulnerabilities: utils.PointerTo(0),
+				},
+			},
+		},
+		{
+			name: "path glob matches the findings under a directory",
+			ignoreRules: []models.IgnoreRule{
+				{Path: utils.PointerTo("/opt/*/testdata")},
+			},
+			want: &models.ScanFindingsSummary{
+				TotalMisconfigurations: utils.PointerTo(0),
+				TotalSecrets:           utils.PointerTo(1),
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalCriticalVulnerabilities:   utils.PointerTo(0),
+					TotalHighVulnerabilities:       utils.PointerTo(1),
+					TotalMediumVulnerabilities:     utils.PointerTo(0),
+					TotalLowVulnerabilities:        utils.PointerTo(0),
+					TotalNegligibleVulnerabilities: utils.PointerTo(0),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetIgnoredFindingsSummary(tt.ignoreRules, scanResult)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetIgnoredFindingsSummary() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
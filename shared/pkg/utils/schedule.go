@@ -0,0 +1,117 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"time"
+	// Embed the time zone database so that schedule time zones can be
+	// loaded in images without one.
+	_ "time/tzdata"
+
+	"github.com/aptible/supercronic/cronexpr"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+// ScheduleCron is the parsed cron line of a runtime schedule together with
+// the time zone it is evaluated in.
+type ScheduleCron struct {
+	expr     *cronexpr.Expression
+	location *time.Location
+}
+
+// ParseScheduleCron parses the cron line of a runtime schedule and loads its
+// time zone. If timezone is not set the cron line is evaluated in the time
+// zone of the time it is evaluated from.
+func ParseScheduleCron(cronLine string, timezone *string) (*ScheduleCron, error) {
+	expr, err := cronexpr.Parse(cronLine)
+	if err != nil {
+		return nil, fmt.Errorf("malformed cron expression: %v", err)
+	}
+
+	var location *time.Location
+	if timezone != nil {
+		location, err = time.LoadLocation(*timezone)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q: %v", *timezone, err)
+		}
+	}
+
+	return &ScheduleCron{
+		expr:     expr,
+		location: location,
+	}, nil
+}
+
+// Next returns the first time after from which matches the cron line.
+func (c *ScheduleCron) Next(from time.Time) time.Time {
+	if c.location != nil {
+		from = from.In(c.location)
+	}
+	return c.expr.Next(from)
+}
+
+// FirstNotBefore returns the first time which is not before now, starting
+// from operationTime and advancing it according to the cron line.
+func (c *ScheduleCron) FirstNotBefore(operationTime, now time.Time) time.Time {
+	for operationTime.Before(now) {
+		next := c.Next(operationTime)
+		if next.IsZero() {
+			// The cron line doesn't match any time in the future.
+			return next
+		}
+		operationTime = next
+	}
+	return operationTime
+}
+
+// GetScheduleNextRunTime returns the next time a scan will be started
+// according to the runtime schedule, or nil if no more scans will be started.
+func GetScheduleNextRunTime(scheduled *models.RuntimeScheduleScanConfig, now time.Time) *time.Time {
+	if scheduled == nil {
+		return nil
+	}
+
+	var operationTime time.Time
+	if scheduled.OperationTime != nil {
+		operationTime = *scheduled.OperationTime
+	}
+
+	if scheduled.CronLine == nil {
+		if operationTime.IsZero() || operationTime.Before(now) {
+			return nil
+		}
+		return &operationTime
+	}
+
+	cron, err := ParseScheduleCron(*scheduled.CronLine, scheduled.Timezone)
+	if err != nil {
+		return nil
+	}
+
+	var nextRunTime time.Time
+	if operationTime.IsZero() {
+		nextRunTime = cron.Next(now)
+	} else {
+		nextRunTime = cron.FirstNotBefore(operationTime, now)
+	}
+	if nextRunTime.IsZero() {
+		return nil
+	}
+
+	return &nextRunTime
+}
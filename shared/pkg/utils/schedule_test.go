@@ -0,0 +1,204 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("failed to parse time %q: %v", value, err)
+	}
+	return parsed
+}
+
+func TestParseScheduleCron(t *testing.T) {
+	tests := []struct {
+		name     string
+		cronLine string
+		timezone *string
+		wantErr  bool
+	}{
+		{
+			name:     "valid cron line without time zone",
+			cronLine: "0 */4 * * *",
+		},
+		{
+			name:     "valid cron line with time zone",
+			cronLine: "0 4 * * 1",
+			timezone: PointerTo("America/New_York"),
+		},
+		{
+			name:     "malformed cron line",
+			cronLine: "not valid",
+			wantErr:  true,
+		},
+		{
+			name:     "unknown time zone",
+			cronLine: "0 4 * * 1",
+			timezone: PointerTo("Not/AZone"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseScheduleCron(tt.cronLine, tt.timezone)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseScheduleCron() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScheduleCron_Next(t *testing.T) {
+	from := mustParseTime(t, "2006-01-02T20:00:00Z")
+	tests := []struct {
+		name     string
+		cronLine string
+		timezone *string
+		want     time.Time
+	}{
+		{
+			name:     "evaluated in the time zone of from",
+			cronLine: "0 4 * * *",
+			want:     mustParseTime(t, "2006-01-03T04:00:00Z"),
+		},
+		{
+			name:     "evaluated in the schedule time zone",
+			cronLine: "0 4 * * *",
+			timezone: PointerTo("America/New_York"),
+			want:     mustParseTime(t, "2006-01-03T09:00:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseScheduleCron(tt.cronLine, tt.timezone)
+			if err != nil {
+				t.Fatalf("ParseScheduleCron() unexpected error: %v", err)
+			}
+			got := cron.Next(from)
+			if !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleCron_FirstNotBefore(t *testing.T) {
+	now := mustParseTime(t, "2006-01-02T20:00:00Z")
+	oneHourFromNow := now.Add(1 * time.Hour)
+	fiveHoursFromNow := now.Add(5 * time.Hour)
+	fiveHoursBeforeNow := now.Add(-5 * time.Hour)
+	tests := []struct {
+		name          string
+		operationTime time.Time
+		cronLine      string
+		want          time.Time
+	}{
+		{
+			name:          "operation time already in the future",
+			operationTime: fiveHoursFromNow,
+			cronLine:      "0 */4 * * *",
+			want:          fiveHoursFromNow,
+		},
+		{
+			name:          "operation time in the past",
+			operationTime: fiveHoursBeforeNow,
+			cronLine:      "0 */3 * * *",
+			want:          oneHourFromNow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseScheduleCron(tt.cronLine, nil)
+			if err != nil {
+				t.Fatalf("ParseScheduleCron() unexpected error: %v", err)
+			}
+			got := cron.FirstNotBefore(tt.operationTime, now)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FirstNotBefore() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetScheduleNextRunTime(t *testing.T) {
+	now := mustParseTime(t, "2006-01-02T20:00:00Z")
+	tests := []struct {
+		name      string
+		scheduled *models.RuntimeScheduleScanConfig
+		want      *time.Time
+	}{
+		{
+			name:      "no schedule",
+			scheduled: nil,
+			want:      nil,
+		},
+		{
+			name: "single scan in the future",
+			scheduled: &models.RuntimeScheduleScanConfig{
+				OperationTime: PointerTo(now.Add(time.Hour)),
+			},
+			want: PointerTo(now.Add(time.Hour)),
+		},
+		{
+			name: "single scan in the past",
+			scheduled: &models.RuntimeScheduleScanConfig{
+				OperationTime: PointerTo(now.Add(-time.Hour)),
+			},
+			want: nil,
+		},
+		{
+			name: "periodic scan with operation time in the past",
+			scheduled: &models.RuntimeScheduleScanConfig{
+				CronLine:      PointerTo("0 */3 * * *"),
+				OperationTime: PointerTo(now.Add(-5 * time.Hour)),
+			},
+			want: PointerTo(now.Add(time.Hour)),
+		},
+		{
+			name: "periodic scan with time zone and without operation time",
+			scheduled: &models.RuntimeScheduleScanConfig{
+				CronLine: PointerTo("0 4 * * *"),
+				Timezone: PointerTo("Europe/Berlin"),
+			},
+			want: PointerTo(mustParseTime(t, "2006-01-03T03:00:00Z")),
+		},
+		{
+			name: "malformed cron line",
+			scheduled: &models.RuntimeScheduleScanConfig{
+				CronLine: PointerTo("not valid"),
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetScheduleNextRunTime(tt.scheduled, now)
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("GetScheduleNextRunTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
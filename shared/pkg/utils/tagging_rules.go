@@ -0,0 +1,224 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sort"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+// vulnerabilitySeverityRanks orders the vulnerability severities from the
+// least severe.
+var vulnerabilitySeverityRanks = map[models.VulnerabilitySeverity]int{
+	models.NEGLIGIBLE: 0,
+	models.LOW:        1,
+	models.MEDIUM:     2,
+	models.HIGH:       3,
+	models.CRITICAL:   4,
+}
+
+// GetScanResultTags returns the sorted tags of the tagging rules matching the
+// scan result of the given target.
+func GetScanResultTags(taggingRules models.TaggingRules, target models.Target, scanResult models.TargetScanResult) []string {
+	return getMatchingTags(taggingRules, target, func(conditions models.TaggingRuleConditions) bool {
+		return scanResultMatches(conditions, scanResult)
+	})
+}
+
+// GetFindingTags returns the sorted tags of the tagging rules matching the
+// finding of the given target.
+func GetFindingTags(taggingRules models.TaggingRules, target models.Target, finding models.Finding) []string {
+	return getMatchingTags(taggingRules, target, func(conditions models.TaggingRuleConditions) bool {
+		return findingMatches(conditions, finding)
+	})
+}
+
+func getMatchingTags(taggingRules models.TaggingRules, target models.Target, findingsMatch func(models.TaggingRuleConditions) bool) []string {
+	tags := []string{}
+	if taggingRules.Rules == nil {
+		return tags
+	}
+
+	for _, rule := range *taggingRules.Rules {
+		if Contains(tags, rule.Tag) {
+			continue
+		}
+		if targetMatches(rule.Conditions, target) && findingsMatch(rule.Conditions) {
+			tags = append(tags, rule.Tag)
+		}
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+func targetMatches(conditions models.TaggingRuleConditions, target models.Target) bool {
+	if conditions.TargetLocation != nil && *conditions.TargetLocation != getTargetLocation(target) {
+		return false
+	}
+
+	var metadata models.TargetMetadata
+	if target.Metadata != nil {
+		metadata = *target.Metadata
+	}
+	if !stringPtrMatches(conditions.TargetOwner, metadata.Owner) ||
+		!stringPtrMatches(conditions.TargetApplication, metadata.Application) ||
+		!stringPtrMatches(conditions.TargetCriticality, metadata.Criticality) {
+		return false
+	}
+
+	if conditions.TargetAttributes != nil {
+		var attributes []models.Tag
+		if metadata.Attributes != nil {
+			attributes = *metadata.Attributes
+		}
+		for _, attribute := range *conditions.TargetAttributes {
+			if !Contains(attributes, attribute) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// stringPtrMatches returns true if the condition isn't set or equals the value.
+func stringPtrMatches(condition, value *string) bool {
+	if condition == nil {
+		return true
+	}
+	return value != nil && *condition == *value
+}
+
+func getTargetLocation(target models.Target) string {
+	if target.TargetInfo == nil {
+		return ""
+	}
+
+	targetInfo, err := target.TargetInfo.ValueByDiscriminator()
+	if err != nil {
+		return ""
+	}
+
+	switch info := targetInfo.(type) {
+	case models.VMInfo:
+		return info.Location
+	case models.PodInfo:
+		return utils.ValueOrZero(info.Location)
+	case models.DirInfo:
+		return utils.ValueOrZero(info.Location)
+	default:
+		return ""
+	}
+}
+
+func scanResultMatches(conditions models.TaggingRuleConditions, scanResult models.TargetScanResult) bool {
+	if conditions.FindingTypes != nil {
+		var found bool
+		for _, findingType := range *conditions.FindingTypes {
+			if scanResultHasFindings(scanResult, findingType) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if conditions.MinVulnerabilitySeverity != nil {
+		if scanResult.Vulnerabilities == nil || scanResult.Vulnerabilities.Vulnerabilities == nil {
+			return false
+		}
+		var found bool
+		for _, vulnerability := range *scanResult.Vulnerabilities.Vulnerabilities {
+			if severityAtLeast(vulnerability.Severity, *conditions.MinVulnerabilitySeverity) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nolint:cyclop
+func scanResultHasFindings(scanResult models.TargetScanResult, findingType string) bool {
+	switch findingType {
+	case "Package":
+		return scanResult.Sboms != nil && scanResult.Sboms.Packages != nil && len(*scanResult.Sboms.Packages) > 0
+	case "Vulnerability":
+		return scanResult.Vulnerabilities != nil && scanResult.Vulnerabilities.Vulnerabilities != nil && len(*scanResult.Vulnerabilities.Vulnerabilities) > 0
+	case "Malware":
+		return scanResult.Malware != nil && scanResult.Malware.Malware != nil && len(*scanResult.Malware.Malware) > 0
+	case "Secret":
+		return scanResult.Secrets != nil && scanResult.Secrets.Secrets != nil && len(*scanResult.Secrets.Secrets) > 0
+	case "Misconfiguration":
+		return scanResult.Misconfigurations != nil && scanResult.Misconfigurations.Misconfigurations != nil && len(*scanResult.Misconfigurations.Misconfigurations) > 0
+	case "Rootkit":
+		return scanResult.Rootkits != nil && scanResult.Rootkits.Rootkits != nil && len(*scanResult.Rootkits.Rootkits) > 0
+	case "Exploit":
+		return scanResult.Exploits != nil && scanResult.Exploits.Exploits != nil && len(*scanResult.Exploits.Exploits) > 0
+	default:
+		return false
+	}
+}
+
+func findingMatches(conditions models.TaggingRuleConditions, finding models.Finding) bool {
+	if conditions.FindingTypes == nil && conditions.MinVulnerabilitySeverity == nil {
+		return true
+	}
+	if finding.FindingInfo == nil {
+		return false
+	}
+
+	findingType, err := finding.FindingInfo.Discriminator()
+	if err != nil {
+		return false
+	}
+
+	if conditions.FindingTypes != nil && !Contains(*conditions.FindingTypes, findingType) {
+		return false
+	}
+
+	if conditions.MinVulnerabilitySeverity != nil {
+		if findingType != "Vulnerability" {
+			return false
+		}
+		info, err := finding.FindingInfo.AsVulnerabilityFindingInfo()
+		if err != nil || !severityAtLeast(info.Severity, *conditions.MinVulnerabilitySeverity) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func severityAtLeast(severity *models.VulnerabilitySeverity, minSeverity models.VulnerabilitySeverity) bool {
+	if severity == nil {
+		return false
+	}
+	rank, ok := vulnerabilitySeverityRanks[*severity]
+	if !ok {
+		return false
+	}
+	return rank >= vulnerabilitySeverityRanks[minSeverity]
+}
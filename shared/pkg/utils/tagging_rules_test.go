@@ -0,0 +1,211 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/utils"
+)
+
+var testTaggingRules = models.TaggingRules{
+	Rules: &[]models.TaggingRule{
+		{
+			Name: "public",
+			Tag:  "internet-facing",
+			Conditions: models.TaggingRuleConditions{
+				TargetAttributes: &[]models.Tag{{Key: "publicIP", Value: "true"}},
+			},
+		},
+		{
+			Name: "pci",
+			Tag:  "pci",
+			Conditions: models.TaggingRuleConditions{
+				TargetLocation:    utils.PointerTo("us-east-1"),
+				TargetApplication: utils.PointerTo("payments"),
+			},
+		},
+		{
+			Name: "malware",
+			Tag:  "compromised",
+			Conditions: models.TaggingRuleConditions{
+				FindingTypes: &[]string{"Malware", "Rootkit"},
+			},
+		},
+		{
+			Name: "high vulnerabilities",
+			Tag:  "urgent",
+			Conditions: models.TaggingRuleConditions{
+				MinVulnerabilitySeverity: utils.PointerTo(models.HIGH),
+			},
+		},
+		{
+			Name: "critical on public",
+			Tag:  "urgent",
+			Conditions: models.TaggingRuleConditions{
+				TargetAttributes:         &[]models.Tag{{Key: "publicIP", Value: "true"}},
+				MinVulnerabilitySeverity: utils.PointerTo(models.CRITICAL),
+			},
+		},
+	},
+}
+
+func newTestTarget(t *testing.T, location string, metadata *models.TargetMetadata) models.Target {
+	t.Helper()
+
+	var targetInfo models.TargetType
+	if err := targetInfo.FromVMInfo(models.VMInfo{InstanceID: "i-1", Location: location}); err != nil {
+		t.Fatalf("failed to create target info: %v", err)
+	}
+
+	return models.Target{
+		Id:         utils.PointerTo("target-1"),
+		TargetInfo: &targetInfo,
+		Metadata:   metadata,
+	}
+}
+
+func TestGetScanResultTags(t *testing.T) {
+	publicPayments := newTestTarget(t, "us-east-1", &models.TargetMetadata{
+		Application: utils.PointerTo("payments"),
+		Attributes:  &[]models.Tag{{Key: "owner", Value: "team-a"}, {Key: "publicIP", Value: "true"}},
+	})
+	private := newTestTarget(t, "eu-west-1", nil)
+
+	tests := []struct {
+		name         string
+		taggingRules models.TaggingRules
+		target       models.Target
+		scanResult   models.TargetScanResult
+		want         []string
+	}{
+		{
+			name:         "no rules",
+			taggingRules: models.TaggingRules{},
+			target:       publicPayments,
+			scanResult:   models.TargetScanResult{},
+			want:         []string{},
+		},
+		{
+			name:         "target conditions",
+			taggingRules: testTaggingRules,
+			target:       publicPayments,
+			scanResult:   models.TargetScanResult{},
+			want:         []string{"internet-facing", "pci"},
+		},
+		{
+			name:         "no matching rules",
+			taggingRules: testTaggingRules,
+			target:       private,
+			scanResult: models.TargetScanResult{
+				Malware: &models.MalwareScan{Malware: &[]models.Malware{}},
+				Vulnerabilities: &models.VulnerabilityScan{
+					Vulnerabilities: &[]models.Vulnerability{
+						{VulnerabilityName: utils.PointerTo("CVE-1"), Severity: utils.PointerTo(models.MEDIUM)},
+					},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name:         "finding conditions",
+			taggingRules: testTaggingRules,
+			target:       private,
+			scanResult: models.TargetScanResult{
+				Rootkits: &models.RootkitScan{Rootkits: &[]models.Rootkit{{RootkitName: utils.PointerTo("rootkit")}}},
+				Vulnerabilities: &models.VulnerabilityScan{
+					Vulnerabilities: &[]models.Vulnerability{
+						{VulnerabilityName: utils.PointerTo("CVE-1"), Severity: utils.PointerTo(models.MEDIUM)},
+						{VulnerabilityName: utils.PointerTo("CVE-2"), Severity: utils.PointerTo(models.CRITICAL)},
+					},
+				},
+			},
+			want: []string{"compromised", "urgent"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetScanResultTags(tt.taggingRules, tt.target, tt.scanResult)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetScanResultTags() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetFindingTags(t *testing.T) {
+	public := newTestTarget(t, "eu-west-1", &models.TargetMetadata{
+		Attributes: &[]models.Tag{{Key: "publicIP", Value: "true"}},
+	})
+
+	newFinding := func(info interface{}) models.Finding {
+		var findingInfo models.Finding_FindingInfo
+		var err error
+		switch i := info.(type) {
+		case models.VulnerabilityFindingInfo:
+			err = findingInfo.FromVulnerabilityFindingInfo(i)
+		case models.MalwareFindingInfo:
+			err = findingInfo.FromMalwareFindingInfo(i)
+		}
+		if err != nil {
+			t.Fatalf("failed to create finding info: %v", err)
+		}
+		return models.Finding{FindingInfo: &findingInfo}
+	}
+
+	tests := []struct {
+		name    string
+		target  models.Target
+		finding models.Finding
+		want    []string
+	}{
+		{
+			name:    "critical vulnerability",
+			target:  public,
+			finding: newFinding(models.VulnerabilityFindingInfo{Severity: utils.PointerTo(models.CRITICAL)}),
+			want:    []string{"internet-facing", "urgent"},
+		},
+		{
+			name:    "low vulnerability",
+			target:  public,
+			finding: newFinding(models.VulnerabilityFindingInfo{Severity: utils.PointerTo(models.LOW)}),
+			want:    []string{"internet-facing"},
+		},
+		{
+			name:    "malware",
+			target:  public,
+			finding: newFinding(models.MalwareFindingInfo{MalwareName: utils.PointerTo("malware")}),
+			want:    []string{"compromised", "internet-facing"},
+		},
+		{
+			name:    "no finding info",
+			target:  newTestTarget(t, "eu-west-1", nil),
+			finding: models.Finding{},
+			want:    []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetFindingTags(testTaggingRules, tt.target, tt.finding)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetFindingTags() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// NewTLSConfigWithCABundle returns a TLS config which trusts the certificates
+// of the PEM CA bundle on top of the system ones, for example the CA of a TLS
+// intercepting proxy. It returns nil, so that the default TLS config is used,
+// if the path is empty.
+func NewTLSConfigWithCABundle(caBundlePath string) (*tls.Config, error) {
+	if caBundlePath == "" {
+		return nil, nil // nolint:nilnil
+	}
+
+	caBundle, err := os.ReadFile(caBundlePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("no PEM certificates found in CA bundle %s", caBundlePath)
+	}
+
+	return &tls.Config{
+		RootCAs:    rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+// NewHTTPClientWithCABundle returns an HTTP client with the timeout which
+// trusts the certificates of the PEM CA bundle on top of the system ones, the
+// default transport is used if the path is empty.
+func NewHTTPClientWithCABundle(caBundlePath string, timeout time.Duration) (*http.Client, error) {
+	tlsConfig, err := NewTLSConfigWithCABundle(caBundlePath)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	if tlsConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone() // nolint:forcetypeassert
+		transport.TLSClientConfig = tlsConfig
+		client.Transport = transport
+	}
+
+	return client, nil
+}
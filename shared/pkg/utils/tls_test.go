@@ -0,0 +1,90 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClientWithCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	caBundlePath := filepath.Join(dir, "ca-bundle.pem")
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caBundlePath, caBundle, 0o600); err != nil {
+		t.Fatalf("failed to write CA bundle: %v", err)
+	}
+	invalidCABundlePath := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalidCABundlePath, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("failed to write invalid CA bundle: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		caBundlePath  string
+		wantClientErr bool
+		wantGetErr    bool
+	}{
+		{
+			name:         "server certificate in the CA bundle",
+			caBundlePath: caBundlePath,
+		},
+		{
+			name:         "no CA bundle",
+			caBundlePath: "",
+			wantGetErr:   true,
+		},
+		{
+			name:          "missing CA bundle",
+			caBundlePath:  filepath.Join(dir, "missing.pem"),
+			wantClientErr: true,
+		},
+		{
+			name:          "CA bundle without certificates",
+			caBundlePath:  invalidCABundlePath,
+			wantClientErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewHTTPClientWithCABundle(tt.caBundlePath, 5*time.Second)
+			if (err != nil) != tt.wantClientErr {
+				t.Fatalf("NewHTTPClientWithCABundle() error = %v, wantErr %v", err, tt.wantClientErr)
+			}
+			if err != nil {
+				return
+			}
+
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tt.wantGetErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantGetErr)
+			}
+		})
+	}
+}
@@ -108,3 +108,42 @@ func GetVulnerabilityTotalsPerSeverity(vulnerabilities *[]models.Vulnerability)
 	}
 	return ret
 }
+
+// ApplyVulnerabilitySeverityOverrides remaps the severity of the given
+// vulnerabilities according to the severity overrides, keeping the severity
+// assigned by the scanner in OriginalSeverity. Applying the overrides again
+// on already remapped vulnerabilities uses their original severity, so
+// removed overrides are reverted.
+func ApplyVulnerabilitySeverityOverrides(vulnerabilities *[]models.Vulnerability, severityOverrides models.SeverityOverrides) {
+	if vulnerabilities == nil {
+		return
+	}
+
+	overrides := map[string]models.VulnerabilitySeverity{}
+	if severityOverrides.Overrides != nil {
+		for _, override := range *severityOverrides.Overrides {
+			overrides[override.VulnerabilityName] = override.Severity
+		}
+	}
+
+	for i, vulnerability := range *vulnerabilities {
+		originalSeverity := vulnerability.Severity
+		if vulnerability.OriginalSeverity != nil {
+			originalSeverity = vulnerability.OriginalSeverity
+		}
+
+		var override models.VulnerabilitySeverity
+		var ok bool
+		if vulnerability.VulnerabilityName != nil {
+			override, ok = overrides[*vulnerability.VulnerabilityName]
+		}
+
+		if ok && (originalSeverity == nil || *originalSeverity != override) {
+			(*vulnerabilities)[i].Severity = utils.PointerTo(override)
+			(*vulnerabilities)[i].OriginalSeverity = originalSeverity
+		} else {
+			(*vulnerabilities)[i].Severity = originalSeverity
+			(*vulnerabilities)[i].OriginalSeverity = nil
+		}
+	}
+}
@@ -117,6 +117,71 @@ func TestGetVulnerabilityTotalsPerSeverity(t *testing.T) {
 	}
 }
 
+func TestApplyVulnerabilitySeverityOverrides(t *testing.T) {
+	overrides := models.SeverityOverrides{
+		Overrides: &[]models.SeverityOverride{
+			{VulnerabilityName: "CVE-1", Severity: models.LOW},
+			{VulnerabilityName: "CVE-2", Severity: models.HIGH},
+		},
+	}
+	type args struct {
+		vulnerabilities   *[]models.Vulnerability
+		severityOverrides models.SeverityOverrides
+	}
+	tests := []struct {
+		name string
+		args args
+		want *[]models.Vulnerability
+	}{
+		{
+			name: "nil vulnerabilities",
+			args: args{
+				vulnerabilities:   nil,
+				severityOverrides: overrides,
+			},
+			want: nil,
+		},
+		{
+			name: "remap severities",
+			args: args{
+				vulnerabilities: &[]models.Vulnerability{
+					{VulnerabilityName: utils.PointerTo("CVE-1"), Severity: utils.PointerTo(models.CRITICAL)},
+					{VulnerabilityName: utils.PointerTo("CVE-2"), Severity: utils.PointerTo(models.HIGH)},
+					{VulnerabilityName: utils.PointerTo("CVE-3"), Severity: utils.PointerTo(models.MEDIUM)},
+				},
+				severityOverrides: overrides,
+			},
+			want: &[]models.Vulnerability{
+				{VulnerabilityName: utils.PointerTo("CVE-1"), Severity: utils.PointerTo(models.LOW), OriginalSeverity: utils.PointerTo(models.CRITICAL)},
+				{VulnerabilityName: utils.PointerTo("CVE-2"), Severity: utils.PointerTo(models.HIGH)},
+				{VulnerabilityName: utils.PointerTo("CVE-3"), Severity: utils.PointerTo(models.MEDIUM)},
+			},
+		},
+		{
+			name: "already remapped vulnerabilities",
+			args: args{
+				vulnerabilities: &[]models.Vulnerability{
+					{VulnerabilityName: utils.PointerTo("CVE-1"), Severity: utils.PointerTo(models.LOW), OriginalSeverity: utils.PointerTo(models.CRITICAL)},
+					{VulnerabilityName: utils.PointerTo("CVE-3"), Severity: utils.PointerTo(models.LOW), OriginalSeverity: utils.PointerTo(models.MEDIUM)},
+				},
+				severityOverrides: overrides,
+			},
+			want: &[]models.Vulnerability{
+				{VulnerabilityName: utils.PointerTo("CVE-1"), Severity: utils.PointerTo(models.LOW), OriginalSeverity: utils.PointerTo(models.CRITICAL)},
+				{VulnerabilityName: utils.PointerTo("CVE-3"), Severity: utils.PointerTo(models.MEDIUM)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ApplyVulnerabilitySeverityOverrides(tt.args.vulnerabilities, tt.args.severityOverrides)
+			if diff := cmp.Diff(tt.want, tt.args.vulnerabilities); diff != "" {
+				t.Errorf("ApplyVulnerabilitySeverityOverrides() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 // nolint:forcetypeassert
 func TestStringKeyMapToArray(t *testing.T) {
 	type TestObject struct {
@@ -9,7 +9,9 @@ import (
 
 // Defines values for AssetType.
 const (
-	AWSEC2Instance AssetType = "AWS EC2 Instance"
+	AWSEC2Instance      AssetType = "AWS EC2 Instance"
+	AzureVirtualMachine AssetType = "Azure Virtual Machine"
+	GCPComputeInstance  AssetType = "GCP Compute Instance"
 )
 
 // Defines values for FindingType.
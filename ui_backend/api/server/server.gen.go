@@ -130,38 +130,39 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8RaT3PiOBb/KirtHnarvCEzW3vhRhOSdjUkFCHpnZrqg7AfoIktuSU5DNXFd5+SZGNj",
-	"S2B6oPuWoPekn35Pev/kbzjiacYZMCVx/xvOiCApKBDmP2DxnKag/6QM9/HXHMQWB5gR/eN+OMACvuZU",
-	"QIz7SuQQYBmtISVab8lFShTu45go+I+y4mqbaX2pBGUrvNsFGP4kaZbAPU0UCO96VgjX529PJRUR6hjs",
-	"SuBvA9/pGWTGmQRD2At7Y3zDRkJws4uIMwVM6T9JliU0Iopy1vtDcqZ/q1b7p4Al7uN/9Cpz9Oyo7A0y",
-	"OisWsUvGICNBMz0V7pdrIjCLGgasop63rtv/1tAcMMQXf0CkkFoThahEAlQuGMSIMkSSBEVEgkR8iZaE",
-	"JrkAeYMDnAmegVDUbjkFKcnKzC6AxE8s2ZZktm1T/GJXxbsAD6QEFbIlN4fvYOKEW7YcVi5N6RiwP5wg",
-	"VC8614J+TPNiHmB5ivu/48HnZzQa/opCJhVhEeAvQXvx0Z9Zwqlq7yV6h/DOiffAIudsVPJcRHD3wc0C",
-	"VYlbLReJQUQVpNK9Yp4kZKHVD6xIhCBbN2HFtu8piylbhWlGIgcHZLmESEFs6JVDntt74Tk0lClYgcDG",
-	"N+xZPWbVknwnxALbXACL2xdhBpkAqWdDag1IcUUSxPJ0AcIcfqss9a0w4zQFJBOu0L8WoDYADO19CiIs",
-	"RoVj/Hf7tkTlth3brJxtF9/TcHQd/exRYmSbmTGVSlNwlJYMRI2TJRdGXGYQ0SWNSjl9o9qE1AZPGfi+",
-	"Jqq3soe8P8tdtM0JMH7bee6OHPP7Q6ilZ5gOhp8GDyMc4NeX8eNoNvgQjsP5bzjAk8H482CmR55Hw9lo",
-	"rn8Kn4dPj/fhw8tsMA+fHnGAZ09P80+hHhz9fzp+CudO11IsXt2bQztZ25jTpU0DJFqXvCMzV5P34lJJ",
-	"91lMSbIhAjyDVEacLekqF8ZBe+YQnKs37woSIgG+wfc8YSDIgia0xNsUOmIg6fNA9T0f0jfnGfofKsar",
-	"gy25UBCjxRZRMyXEiBjvZZnGQbej5/SPJ4/ggRVccIvhi8Od2HnPh+s6F07gDcHL76CxwNlbyUj0Rlbg",
-	"3UExfnHgUzvv2Xjrd82Ftxi/ON6ZnfdsvLXb74Jrhy+O9tlMezZYhzdyga6LbS+O/bU++5lbOOYrTwX+",
-	"fRAxcia468KgHltMUXB2DJZdqJ9UHrBRddiBR192XIx3SSsmNVFz9dW6TceUqHWZBy1pArZi0uUdoUyW",
-	"rrhbyuX0r5dLl2tRo8O2j0Is6WvR23SwDgNVZWFLW0AKMfUXdzIijEE8LSzhGRde40t4B0HV9tww8Vzq",
-	"aUpAqiFRsOJi6y6xQKq7E8WblnHWfU7OjwatC54Ph+3OYakb+ueaDcpMuSnzka7We7n2FBOIaZ4eERjz",
-	"zX7UlTMX0bTNnbeoznKROAfeQUi3lV1kOMP45SyYVfvqkEy4Ic5gVZ0xZ0zTBcU+ihm/j4RR8tVw1RY6",
-	"xIBC2HgDPannMjuhU/lGQSrL2/lpvij0yzhcRehS88wkiMq3rQFzgaTeD65M96+JrWsGfwRlc4pr4j2Z",
-	"9nphlprXRHciyfWDKxSvia1jTuvH2Jzge9LYcyAfcwTWlzk8gagG3NltIYA2VK2L3K5weCa/2xDt+XIW",
-	"I246f+kNmtU1GK8UNjRJEOMKLQAJyAxRnRPjhjf+bjYKNj3e3NG6M36dWfO2/DqpN+dPNtSN4C7wtzid",
-	"oO09dJjODnhzvGK8S4I/q4keA3GtcC2qPXaAeRRis/c4GU2eZr/hAH8azR5HYxzgwXQ6Dodlb/E+nE1M",
-	"C9KVHtly2BE/WTzkSZ4yb596TJmnN6hro6mzgtKWPKigiuLJVJFrKJyeq8+9pGwFIhPU1fh85Ar6SK2p",
-	"RFSa+5cz+jX3N8yPbc0I+DbnMouro3C5gyP3Bjrd1XDjez300i2gV289HELYul7GpPw+JEOtefK9qns1",
-	"eDB5vRQ8aOz481QPEW5jWPSOqlkJGp3Pw6TQM5VKpOzj898sYryLtFAviITniB+8F9hYU3u+K4n1ytn2",
-	"mG/8JMJrXcL35vntbJgOoM+J2Z7+4jUiuKCKRiRpeI+h/+1yTVfr7tIJ33QXTk0XoLs8g1VCV3SRQFed",
-	"k1Zy9TKGs3AeDgc65H4MHz7iAE9Gd+HLBAd4/PQZB/hx9DAOH8IPY1fw1WvSwizFWz1+nQwTopdBLyEa",
-	"TEOdU+9vLP7l5vbmViPjGTCSUdzH/725vfkF246lsXYvJnK94ETEvWXrKWxlz5g+HaYwC2Pcxw+g7kqd",
-	"xutZ48uWX29vL/ZBS2Mlxzctz3kUgXXvMSxJnnij4B5k7+DbG/MZTJ6mRGztNhFByWFLWxYN+f2D9Z69",
-	"G6PuYLNqlndms1AJDr6s+t29l0qkV73t74KTwuWXA7svP8BoZfP+5xjtxDtEw26i1Sk6abdGc+mKhDZW",
-	"+tGENkv707dAtMvtznSWOj+Az3Kpn0Zo2VRwMmoSUvFeugHTb8a9nPa0T9992f0VAAD//zOgDEiRKQAA",
+	"H4sIAAAAAAAC/8RaX3PiOBL/KirdPdxV+UJ2r+6FN4aQjGsgoQjJ3NXWPAi7AW1sySPJYbkpvvuWJBsb",
+	"WwIzCzNvCf1Hv+6WWt0tf8MRTzPOgCmJ+99wRgRJQYEw/wGL5zQF/SdluI+/5iC2OMCM6B/35AAL+JpT",
+	"ATHuK5FDgGW0hpRouSUXKVG4j2Oi4F/KsqttpuWlEpSt8G4XYPiDpFkC9zRRILzrWSZc199WJRUR6hjs",
+	"iuEvA99pDTLjTIJx2At7Y3zDRkJwY0XEmQKm9J8kyxIaEUU56/0uOdO/Vav9XcAS9/HfelU4epYqe4OM",
+	"zopF7JIxyEjQTKvC/XJNBGZR4wErqPXWZfvfGpIDhvjid4gUUmuiEJVIgMoFgxhRhkiSoIhIkIgv0ZLQ",
+	"JBcgb3CAM8EzEIpak1OQkqyMdgEkfmLJtnRmOzbFL3ZVvAvwQEpQIVtys/kOFCfcessR5TKUDoL94YRD",
+	"9aJzzejHNC/0AMtT3P8NDz4/o9HwVxQyqQiLAAd48P9cAHqlQuUkQRMSrSnTvz8Mp2jI0yxXULF/CdpY",
+	"R39kCaeqbXr0DuGd07yDAJ7jF8lzEcHdBydRUZW4xXKRGERUQSrdK+ZJQhYJNIJOhCBbt38Ls+8piylb",
+	"hWlGIocPyHIJkYLYREMOec7UkT1GmYIVCGxSyd6rxzZB6XwnxALbXACL2+dmBpkAqbUhtQakuCIJYnm6",
+	"AGHOihWW+hAZOk0ByYQr9I8FqA0AQ/sUhAiLUZFH/9k+XFFptsPMKjd3SVWNvNgxLR91jGx7Zkyl0i44",
+	"6pYMRM0nSy4Mu8wgoksalXz6ALYdUiOeCvB9jVWbsoe838tdpLUU3gW+fXdkm98fQi0TyXQw/DR4GOEA",
+	"v76MH0ezwYdwHM7/hwM8GYw/D2aa8jwazkZz/VP4PHx6vA8fXmaDefj0iAM8e3qafwo1cfTf6fgpnDtT",
+	"S7F4dW4O42RjY3aXDg2QaF36HRldTb8Xh0q692JKkg0R4CFSGXG2pKtcmHzu0SE4V2/eFSREAnzE9zxh",
+	"IMiCJrTE22Q6EiDpy0B1mw/dN+cZ+g8q6NXGllwoiNFii6hRCTEiJntZT+Og29Zz5seTW/AgCi64Bfni",
+	"cCdW7/lwXfvCCbzBeHkLGgucbUpGojeyAq8FBf3iwKdW79l462fNhbegXxzvzOo9G2/t9LvgWvLF0T4b",
+	"tWeDdWQjF+g62/bi2F/r2s804ViuPHXx7y8Rw2cud91H1O8WedPViMNio4PrJ1UGbDQplvDoq44Lepey",
+	"YlJjNUdfrdvumBK1LuugJU3ANli6GySUyTIVdyu5nPn1cuVy7dboYPZRiKX7Wu5tJlhHgKousiUtIIWY",
+	"+ntBGRHGIJ4WkfDQhTf4Et5BULU995p4LuW0S0CqIVGw4mLrXEQz3J1o3jSPs+9z+vzopXXB/eGI3Tle",
+	"6ob+uRaDslJu8nykq/Wer61iAjHN0yMMY77ZU101c3Gbtn3nbaqzXCROwjsI6Y6yyxnOa/xyEcwquzoU",
+	"E26IM1hVe8x5pymyT/NF3kfCCPl6uMqEDndAwWyygVbqOcxO6FS+UZDK+u38Ml8U8uU9XN3QpeSZRRCV",
+	"b1sD5gJFvR9cIXhVbF0r+CMomyquifdk2euFWUpeE92JItcPrhC8JraONa0fY1PB95Sx50A+lghsLnNk",
+	"AlER3NVtwYA2VK2L2q5IeKa+2xCd+XIWI24mf+kNmtUlGK8ENjRJEOMKLQAJyIyjOhfGjWz83d4ovOnJ",
+	"5o7RncnrzIa3lddJfZZ/cv5uGHeBf8TpBG3PoSN0luCt8Qp6lwJ/VmM9BuJa17WobOwA8yjE5uxxMpo8",
+	"zfSo8dNo9jga6zeM6XQcDsvZ4n04m5gRpKs8su1w21Bg8ZAnecq8c+oxZZ7ZoO6Nps4OSkfyoIMqmifT",
+	"RepxsUXjwLmkbAUiE9Q1+HzkCvpIralEVJrzlzP6NfcPzI+ZZhh8xrnC4pooXG7jyH2ATk813PheD7N0",
+	"C+jVRw+HELaulzEpvw/JUEuefK/q3g0eKK+3ggeDHX+d6nGEOxgWvaNrVoJG5/thUshptBAp+1b9F5sY",
+	"7yIt1Asi4TniB+8F9q6pPd+VjvXy2fGYj34S4bUO4Xtz/3YOTAfQ59zZnvniNW5wQRWNSNLIHkP/2+Wa",
+	"rtbduRO+6c6cmilAd34Gq4Su6CKBrjIno+SaZQxn4TwcDvSV+zF8+KinE6O78GWCAzx++owD/Dh6GIcP",
+	"4Yex6/LVa9IiLMVbPX6dDBOil0EvIRpMQ4lrJxb/cnN7c6uR8QwYySju43/f3N78gu3E0kS7FxO5XnAi",
+	"4t6y9RS2sntM7w7TmIUx7uMHUHelTOP1rPEhzK+3txf7/qWxkuMTmOc8isCm9xiWJE+8t+AeZO/gUx2t",
+	"UuZpSsTWmokISg5H2rIYyO8frPfeuzHiDm9Ww/LO3ixEgoMPsX5z21Kx9Kq3/V1wkrn8cmD35QcErRze",
+	"/5ygnXiHaMRNtCZFJ+PWGC5d0aGNlX60Q5ut/elTINrtdmd3ljI/wJ/lUj/NoeVQwelRrQDEe5kGzLwZ",
+	"93La0zl992X35wBSyCoswCkAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -587,6 +587,22 @@ func Test_getAssetType(t *testing.T) {
 			want:    utils.PointerTo(models.AWSEC2Instance),
 			wantErr: false,
 		},
+		{
+			name: "azure provider",
+			args: args{
+				provider: utils.PointerTo(backendmodels.Azure),
+			},
+			want:    utils.PointerTo(models.AzureVirtualMachine),
+			wantErr: false,
+		},
+		{
+			name: "gcp provider",
+			args: args{
+				provider: utils.PointerTo(backendmodels.GCP),
+			},
+			want:    utils.PointerTo(models.GCPComputeInstance),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {